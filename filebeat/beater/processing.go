@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"fmt"
+
+	"github.com/elastic/beats/v7/filebeat/channel"
+	cfg "github.com/elastic/beats/v7/filebeat/config"
+	"github.com/elastic/beats/v7/filebeat/fileset"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	conf "github.com/elastic/elastic-agent-libs/config"
+)
+
+// InputProcessing returns the processing settings of the input with the given
+// ID, or of the module fileset named <module>.<fileset>. Inputs are looked up
+// in the inputs and modules settings and in the inputs.d and modules.d
+// directories. It is used by the test processors command.
+func InputProcessing(b *beat.Beat, id string) (beat.ProcessingConfig, error) {
+	config := cfg.DefaultConfig
+	if err := b.BeatConfig.Unpack(&config); err != nil {
+		return beat.ProcessingConfig{}, fmt.Errorf("error reading config file: %w", err)
+	}
+
+	inputs, err := allInputConfigs(b, config)
+	if err != nil {
+		return beat.ProcessingConfig{}, err
+	}
+
+	for _, input := range inputs {
+		var names struct {
+			ID      string `config:"id"`
+			Module  string `config:"_module_name"`
+			Fileset string `config:"_fileset_name"`
+		}
+		if err := input.Unpack(&names); err != nil {
+			return beat.ProcessingConfig{}, err
+		}
+		if names.ID == id || (names.Module != "" && names.Module+"."+names.Fileset == id) {
+			return channel.InputProcessing(b.Info, input)
+		}
+	}
+	return beat.ProcessingConfig{}, fmt.Errorf("no input with id or module fileset %q found", id)
+}
+
+// allInputConfigs returns the configs of the enabled inputs, including the
+// inputs of the enabled module filesets.
+func allInputConfigs(b *beat.Beat, config cfg.Config) ([]*conf.C, error) {
+	var inputs []*conf.C
+	for _, input := range config.Inputs {
+		if input.Enabled() {
+			inputs = append(inputs, input)
+		}
+	}
+
+	enableAllFilesets, _ := b.BeatConfig.Bool("config.modules.enable_all_filesets", -1)
+	forceEnableModuleFilesets, _ := b.BeatConfig.Bool("config.modules.force_enable_module_filesets", -1)
+	filesetOverrides := fileset.FilesetOverrides{
+		EnableAllFilesets:         enableAllFilesets,
+		ForceEnableModuleFilesets: forceEnableModuleFilesets,
+	}
+	moduleRegistry, err := fileset.NewModuleRegistry(config.Modules, b.Info, true, filesetOverrides)
+	if err != nil {
+		return nil, err
+	}
+	moduleInputs, err := moduleRegistry.GetInputConfigs()
+	if err != nil {
+		return nil, err
+	}
+	inputs = append(inputs, moduleInputs...)
+
+	if config.ConfigInput.Enabled() {
+		loader := cfgfile.NewReloader(b.Info.Logger.Named("input.reloader"), nil, config.ConfigInput, b.Info.Paths)
+		configs, err := loader.Configs()
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, configs...)
+	}
+
+	if config.ConfigModules.Enabled() {
+		loader := cfgfile.NewReloader(b.Info.Logger.Named("module.reloader"), nil, config.ConfigModules, b.Info.Paths)
+		configs, err := loader.Configs()
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range configs {
+			// Modules from modules.d are loaded like the fileset runner
+			// factory does.
+			registry, err := fileset.NewModuleRegistry([]*conf.C{c}, b.Info, false, fileset.FilesetOverrides{})
+			if err != nil {
+				return nil, err
+			}
+			moduleInputs, err := registry.GetInputConfigs()
+			if err != nil {
+				return nil, err
+			}
+			inputs = append(inputs, moduleInputs...)
+		}
+	}
+
+	return inputs, nil
+}
@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	_ "github.com/elastic/beats/v7/libbeat/processors/actions"
+	_ "github.com/elastic/beats/v7/libbeat/processors/add_locale"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+func TestInputProcessing(t *testing.T) {
+	home, err := filepath.Abs("..")
+	require.NoError(t, err)
+	beatPaths := paths.New()
+	require.NoError(t, beatPaths.InitPaths(&paths.Path{Home: home, Data: t.TempDir()}))
+
+	b := &beat.Beat{
+		Info: beat.Info{Beat: "filebeat", Version: "9.0.0", Logger: logptest.NewTestingLogger(t, ""), Paths: beatPaths},
+		BeatConfig: conf.MustNewConfigFrom(`
+inputs:
+  - type: filestream
+    id: disabled
+    enabled: false
+    fields.disabled: true
+  - type: filestream
+    id: my-input
+    index: 'logs-%{[agent.version]}'
+    fields.env: test
+modules:
+  - module: nginx
+    access:
+      enabled: true
+      var.paths: [/var/log/nginx/access.log]
+    error:
+      enabled: false
+`),
+	}
+
+	processing, err := InputProcessing(b, "my-input")
+	require.NoError(t, err)
+	assert.Equal(t, "my-input", processing.InputID)
+	assert.Equal(t, mapstr.M{"env": "test"}, processing.EventMetadata.Fields)
+	assert.Equal(t, mapstr.M{"input": mapstr.M{"type": "filestream"}}, processing.Fields)
+	event, err := processing.Processor.Run(&beat.Event{Fields: mapstr.M{}})
+	require.NoError(t, err)
+	assert.Equal(t, mapstr.M{"raw_index": "logs-9.0.0"}, event.Meta)
+
+	processing, err = InputProcessing(b, "nginx.access")
+	require.NoError(t, err)
+	dataset, err := processing.Fields.GetValue("event.dataset")
+	require.NoError(t, err)
+	assert.Equal(t, "nginx.access", dataset)
+	assert.Contains(t, processing.Meta["pipeline"], "nginx-access")
+
+	_, err = InputProcessing(b, "disabled")
+	assert.ErrorContains(t, err, `no input with id or module fileset "disabled" found`)
+}
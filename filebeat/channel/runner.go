@@ -48,6 +48,9 @@ type commonInputConfig struct {
 		DisableHost bool `config:"disable_host"` // Disable addition of host.name.
 	} `config:"publisher_pipeline"`
 
+	// input ID, used to filter events when tapping the pipeline
+	ID string `config:"id"`
+
 	// implicit event fields
 	Type        string `config:"type"`         // input.type
 	ServiceType string `config:"service.type"` // service.type
@@ -119,6 +122,21 @@ func withClientConfig(
 	return pipetool.WithClientConfigEdit(pipeline, editor), nil
 }
 
+// InputProcessing returns the processing settings applied to the clients of
+// the input configured by cfg by the runners created with
+// RunnerFactoryWithCommonInputSettings.
+func InputProcessing(beatInfo beat.Info, cfg *conf.C) (beat.ProcessingConfig, error) {
+	editor, err := newCommonConfigEditor(beatInfo, cfg)
+	if err != nil {
+		return beat.ProcessingConfig{}, err
+	}
+	clientCfg, err := editor(beat.ClientConfig{})
+	if err != nil {
+		return beat.ProcessingConfig{}, err
+	}
+	return clientCfg.Processing, nil
+}
+
 func newCommonConfigEditor(
 	beatInfo beat.Info,
 	cfg *conf.C,
@@ -187,6 +205,7 @@ func newCommonConfigEditor(
 		clientCfg.Processing.Processor = procs
 		clientCfg.Processing.KeepNull = config.KeepNull
 		clientCfg.Processing.DisableHost = config.PublisherPipeline.DisableHost
+		clientCfg.Processing.InputID = config.ID
 
 		return clientCfg, nil
 	}, nil
@@ -261,3 +261,35 @@ index: "%{[fields.log_type]}-%{[agent.version]}-%{+yyyy.MM.dd}"
 
 	rf.Assert(t)
 }
+
+func TestInputProcessing(t *testing.T) {
+	beatInfo := beat.Info{Beat: "filebeat", Version: "9.0.0", Logger: logptest.NewTestingLogger(t, "")}
+	cfg := conf.MustNewConfigFrom(`
+id: my-input
+type: filestream
+index: 'test-%{[agent.version]}'
+pipeline: my-pipeline
+_module_name: nginx
+_fileset_name: access
+processors:
+  - add_fields:
+      target: ""
+      fields.env: test
+`)
+	processing, err := InputProcessing(beatInfo, cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, "my-input", processing.InputID)
+	assert.Equal(t, mapstr.M{"pipeline": "my-pipeline"}, processing.Meta)
+	assert.Equal(t, mapstr.M{
+		"fileset": mapstr.M{"name": "access"},
+		"service": mapstr.M{"type": "nginx"},
+		"input":   mapstr.M{"type": "filestream"},
+		"event":   mapstr.M{"module": "nginx", "dataset": "nginx.access"},
+	}, processing.Fields)
+
+	event, err := processing.Processor.Run(&beat.Event{Fields: mapstr.M{}})
+	require.NoError(t, err)
+	assert.Equal(t, mapstr.M{"raw_index": "test-9.0.0"}, event.Meta)
+	assert.Equal(t, mapstr.M{"env": "test"}, event.Fields)
+}
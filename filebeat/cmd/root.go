@@ -51,9 +51,10 @@ func FilebeatSettings(moduleNameSpace string) instance.Settings {
 	runFlags.AddGoFlag(flag.CommandLine.Lookup("once"))
 	runFlags.AddGoFlag(flag.CommandLine.Lookup("modules"))
 	return instance.Settings{
-		RunFlags:      runFlags,
-		Name:          Name,
-		HasDashboards: true,
+		RunFlags:        runFlags,
+		Name:            Name,
+		HasDashboards:   true,
+		InputProcessing: beater.InputProcessing,
 		Initialize: []func(){
 			include.InitializeModule,
 			func() { fileset.RegisterMonitoringModules(moduleNameSpace) },
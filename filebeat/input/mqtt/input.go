@@ -29,6 +29,7 @@ import (
 	"github.com/elastic/beats/v7/filebeat/input"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common/backoff"
+	commonmqtt "github.com/elastic/beats/v7/libbeat/common/mqtt"
 	conf "github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/mapstr"
@@ -88,7 +89,7 @@ func newInput(
 	}
 
 	logger = logger.Named("mqtt input").With("hosts", config.Hosts)
-	setupLibraryLogging(logger)
+	commonmqtt.SetupLibraryLogging(logger)
 
 	clientDisconnected := new(sync.WaitGroup)
 	inflightMessages := new(sync.WaitGroup)
@@ -31,10 +31,12 @@ import (
 	"github.com/elastic/beats/v7/heartbeat/config"
 	"github.com/elastic/beats/v7/heartbeat/hbregistry"
 	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/passive/push"
 	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
 	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers/monitorstate"
 	hbrunner "github.com/elastic/beats/v7/heartbeat/reload"
 	"github.com/elastic/beats/v7/heartbeat/scheduler"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/coordination"
 	_ "github.com/elastic/beats/v7/heartbeat/security"
 	"github.com/elastic/beats/v7/heartbeat/tracer"
 	"github.com/elastic/beats/v7/libbeat/autodiscover"
@@ -54,6 +56,7 @@ type Heartbeat struct {
 	config             *config.Config
 	logger             *logp.Logger
 	scheduler          *scheduler.Scheduler
+	coordinator        *coordination.Coordinator
 	monitorReloader    *cfgfile.Reloader
 	monitorFactory     cfgfile.RunnerFactory
 	autodiscover       *autodiscover.Autodiscover
@@ -119,6 +122,23 @@ func New(b *beat.Beat, rawConfig *conf.C) (beat.Beater, error) {
 
 	sched := scheduler.Create(limit, hbregistry.SchedulerRegistry, location, jobConfig, parsedConfig.RunOnce)
 
+	var coordinator *coordination.Coordinator
+	if coordCfg := parsedConfig.Scheduler.Coordination; coordCfg != nil {
+		if parsedConfig.RunOnce {
+			b.Info.Logger.Warn("scheduler.coordination is ignored in run_once mode")
+		} else {
+			var esOutput *conf.C
+			if b.Config.Output.Name() == "elasticsearch" {
+				esOutput = b.Config.Output.Config()
+			}
+			coordinator, err = coordination.NewFromConfig(*coordCfg, b.Info.ID.String(), esOutput, b.Info.Logger)
+			if err != nil {
+				return nil, fmt.Errorf("could not set up scheduler coordination: %w", err)
+			}
+			sched.SetCoordinator(coordinator)
+		}
+	}
+
 	pipelineClientFactory := func(p beat.Pipeline) (beat.Client, error) {
 		return p.Connect()
 	}
@@ -128,6 +148,7 @@ func New(b *beat.Beat, rawConfig *conf.C) (beat.Beater, error) {
 		config:             parsedConfig,
 		logger:             b.Info.Logger,
 		scheduler:          sched,
+		coordinator:        coordinator,
 		replaceStateLoader: replaceStateLoader,
 		// monitorFactory is the factory used for creating all monitor instances,
 		// wiring them up to everything needed to actually execute.
@@ -174,6 +195,21 @@ func (bt *Heartbeat) Run(b *beat.Beat) error {
 
 	waitMonitors := monitors.NewSignalWait()
 
+	// The push server must accept check-ins before push monitors are created.
+	if bt.config.Push.Enabled {
+		pushServer, err := push.NewServer(bt.config.Push, push.DefaultRegistry, bt.logger)
+		if err != nil {
+			return err
+		}
+		pushServer.Start()
+		defer pushServer.Stop()
+	}
+
+	if bt.coordinator != nil {
+		bt.coordinator.Start()
+		defer bt.coordinator.Stop()
+	}
+
 	// It is important this appear before we check for run once mode
 	// In run once mode we depend on these monitors being loaded, but not other more
 	// dynamic types.
@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"fmt"
+
+	"github.com/elastic/beats/v7/heartbeat/config"
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+)
+
+// InputProcessing returns the processing settings of the monitor with the
+// given ID. Monitors are looked up in the monitors setting and in the
+// monitors.d directory. It is used by the test processors command.
+func InputProcessing(b *beat.Beat, id string) (beat.ProcessingConfig, error) {
+	parsedConfig := config.DefaultConfig()
+	if err := b.BeatConfig.Unpack(&parsedConfig); err != nil {
+		return beat.ProcessingConfig{}, fmt.Errorf("error reading config file: %w", err)
+	}
+
+	monitorCfgs := parsedConfig.Monitors
+	if parsedConfig.ConfigMonitors.Enabled() {
+		loader := cfgfile.NewReloader(b.Info.Logger.Named("module.reload"), nil, parsedConfig.ConfigMonitors, b.Info.Paths)
+		configs, err := loader.Configs()
+		if err != nil {
+			return beat.ProcessingConfig{}, err
+		}
+		monitorCfgs = append(monitorCfgs, configs...)
+	}
+
+	for _, cfg := range monitorCfgs {
+		if !cfg.Enabled() {
+			continue
+		}
+		unnested, err := stdfields.UnnestStream(cfg)
+		if err != nil {
+			return beat.ProcessingConfig{}, err
+		}
+		var monitor struct {
+			ID string `config:"id"`
+		}
+		if err := unnested.Unpack(&monitor); err != nil {
+			return beat.ProcessingConfig{}, err
+		}
+		if monitor.ID == id {
+			return monitors.ProcessingConfig(b.Info, parsedConfig.RunFrom, cfg)
+		}
+	}
+	return beat.ProcessingConfig{}, fmt.Errorf("no monitor with id %q found", id)
+}
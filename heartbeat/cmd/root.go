@@ -28,9 +28,13 @@ import (
 	"github.com/elastic/beats/v7/libbeat/publisher/processing"
 
 	// Import packages that need to register themselves.
+	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/dns"
+	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/grpc"
 	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/http"
 	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/icmp"
 	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/tcp"
+	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/tls"
+	_ "github.com/elastic/beats/v7/heartbeat/monitors/passive/push"
 
 	// include all heartbeat specific autodiscovery builders
 	_ "github.com/elastic/beats/v7/heartbeat/autodiscover/builder/hints"
@@ -54,10 +58,11 @@ var withECSVersion = processing.WithFields(mapstr.M{
 // HeartbeatSettings contains the default settings for heartbeat
 func HeartbeatSettings() instance.Settings {
 	return instance.Settings{
-		Name:          Name,
-		Processing:    processing.MakeDefaultSupport(true, nil, withECSVersion, processing.WithAgentMeta()),
-		HasDashboards: false,
-		Initialize:    []func(){include.InitializeModule},
+		Name:            Name,
+		Processing:      processing.MakeDefaultSupport(true, nil, withECSVersion, processing.WithAgentMeta()),
+		HasDashboards:   false,
+		Initialize:      []func(){include.InitializeModule},
+		InputProcessing: beater.InputProcessing,
 	}
 }
 
@@ -21,6 +21,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -49,6 +50,7 @@ type Config struct {
 	Jobs           map[string]*JobLimit `config:"jobs"`
 	RunFrom        *LocationWithID      `config:"run_from"`
 	SocketTrace    *SocketTrace         `config:"socket_trace"`
+	Push           Push                 `config:"push"`
 }
 
 type JobLimit struct {
@@ -57,8 +59,46 @@ type JobLimit struct {
 
 // Scheduler defines the syntax of a heartbeat.yml scheduler block.
 type Scheduler struct {
-	Limit    int64  `config:"limit"  validate:"min=0"`
-	Location string `config:"location"`
+	Limit        int64         `config:"limit"  validate:"min=0"`
+	Location     string        `config:"location"`
+	Coordination *Coordination `config:"coordination"`
+}
+
+// Coordination configures how monitors are shared between several heartbeat
+// instances running the same configuration. When set, every monitor is run by
+// exactly one live instance.
+type Coordination struct {
+	// Type is the backend used to track live instances, either "file" or "elasticsearch".
+	Type string `config:"type" validate:"required"`
+	// InstanceID identifies this instance. Defaults to the beat's UUID.
+	InstanceID string `config:"instance_id"`
+	// LeaseTTL is how long an instance is considered alive after its last renewal.
+	LeaseTTL time.Duration `config:"lease_ttl" validate:"nonzero,positive"`
+	// Path is the shared lock directory used by the file backend.
+	Path string `config:"path"`
+	// Elasticsearch configures the connection used by the elasticsearch backend.
+	// Defaults to the elasticsearch output settings.
+	Elasticsearch *conf.C `config:"elasticsearch"`
+}
+
+// DefaultCoordinationLeaseTTL is the lease TTL used when none is configured.
+const DefaultCoordinationLeaseTTL = 30 * time.Second
+
+func (c *Coordination) InitDefaults() {
+	c.LeaseTTL = DefaultCoordinationLeaseTTL
+}
+
+func (c *Coordination) Validate() error {
+	switch c.Type {
+	case "file":
+		if c.Path == "" {
+			return errors.New("scheduler.coordination.path is required for the file backend")
+		}
+	case "elasticsearch":
+	default:
+		return fmt.Errorf("unknown scheduler.coordination.type '%s', expected 'file' or 'elasticsearch'", c.Type)
+	}
+	return nil
 }
 
 // DefaultConfig is the canonical instantiation of Config.
@@ -85,6 +125,10 @@ func DefaultConfig() *Config {
 
 	return &Config{
 		Jobs: limits,
+		Push: Push{
+			Host: "localhost",
+			Port: 5067,
+		},
 	}
 }
 
@@ -92,3 +136,13 @@ type SocketTrace struct {
 	Path string        `config:"path"`
 	Wait time.Duration `config:"wait"`
 }
+
+// Push configures the HTTP server receiving check-ins for push monitors.
+type Push struct {
+	Enabled bool   `config:"enabled"`
+	Host    string `config:"host"`
+	Port    int    `config:"port" validate:"min=0,max=65535"`
+	// Token, if set, must be sent by clients as a bearer token or as the
+	// token query parameter.
+	Token string `config:"token"`
+}
@@ -23,6 +23,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	conf "github.com/elastic/elastic-agent-libs/config"
 )
 
 func TestDefaults(t *testing.T) {
@@ -95,3 +97,28 @@ func TestDefaults(t *testing.T) {
 		})
 	}
 }
+
+func TestCoordinationConfig(t *testing.T) {
+	unpack := func(m map[string]interface{}) (*Config, error) {
+		c := DefaultConfig()
+		err := conf.MustNewConfigFrom(m).Unpack(&c)
+		return c, err
+	}
+
+	c, err := unpack(map[string]interface{}{})
+	require.NoError(t, err)
+	require.Nil(t, c.Scheduler.Coordination)
+
+	c, err = unpack(map[string]interface{}{"scheduler.coordination": map[string]interface{}{"type": "file", "path": "/tmp/hb"}})
+	require.NoError(t, err)
+	require.Equal(t, DefaultCoordinationLeaseTTL, c.Scheduler.Coordination.LeaseTTL)
+
+	_, err = unpack(map[string]interface{}{"scheduler.coordination.type": "file"})
+	require.Error(t, err)
+
+	_, err = unpack(map[string]interface{}{"scheduler.coordination.type": "consul"})
+	require.Error(t, err)
+
+	_, err = unpack(map[string]interface{}{"scheduler.coordination": map[string]interface{}{"type": "elasticsearch", "lease_ttl": "0s"}})
+	require.Error(t, err)
+}
@@ -20,6 +20,7 @@ package ecserr
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -66,7 +67,9 @@ func (e *ECSErr) String() string {
 }
 
 const (
-	TYPE_IO = "io"
+	TYPE_IO       = "io"
+	TYPE_VALIDATE = "validate"
+	TYPE_PROTOCOL = "protocol"
 )
 
 type SynthErrType string
@@ -136,3 +139,162 @@ func NewUnsupportedMonitorTypeError(err error) *ECSErr {
 		fmt.Sprintf("%s", err),
 	)
 }
+
+const (
+	CODE_TLS_CERT_EXPIRED       = "TLS_CERT_EXPIRED"
+	CODE_TLS_CERT_NOT_YET_VALID = "TLS_CERT_NOT_YET_VALID"
+	CODE_TLS_CERT_EXPIRING      = "TLS_CERT_EXPIRING"
+	CODE_TLS_CERT_UNTRUSTED     = "TLS_CERT_UNTRUSTED"
+	CODE_TLS_CERT_REVOKED       = "TLS_CERT_REVOKED"
+	CODE_TLS_HOSTNAME_MISMATCH  = "TLS_HOSTNAME_MISMATCH"
+	CODE_TLS_WEAK_ALGORITHM     = "TLS_WEAK_ALGORITHM"
+	CODE_STARTTLS_FAILED        = "STARTTLS_FAILED"
+)
+
+func NewTLSCertExpiredErr(subject string, notAfter time.Time) *ECSErr {
+	return NewECSErr(
+		TYPE_VALIDATE,
+		CODE_TLS_CERT_EXPIRED,
+		fmt.Sprintf("certificate '%s' expired at %s", subject, notAfter.UTC().Format(time.RFC3339)),
+	)
+}
+
+func NewTLSCertNotYetValidErr(subject string, notBefore time.Time) *ECSErr {
+	return NewECSErr(
+		TYPE_VALIDATE,
+		CODE_TLS_CERT_NOT_YET_VALID,
+		fmt.Sprintf("certificate '%s' is not valid before %s", subject, notBefore.UTC().Format(time.RFC3339)),
+	)
+}
+
+func NewTLSCertExpiringErr(subject string, daysLeft int, minDays int) *ECSErr {
+	return NewECSErr(
+		TYPE_VALIDATE,
+		CODE_TLS_CERT_EXPIRING,
+		fmt.Sprintf("certificate '%s' expires in %d days, less than the required %d days", subject, daysLeft, minDays),
+	)
+}
+
+func NewTLSCertUntrustedErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_VALIDATE,
+		CODE_TLS_CERT_UNTRUSTED,
+		fmt.Sprintf("certificate is not trusted: %s", err),
+	)
+}
+
+func NewTLSCertRevokedErr(subject string, revokedAt time.Time) *ECSErr {
+	return NewECSErr(
+		TYPE_VALIDATE,
+		CODE_TLS_CERT_REVOKED,
+		fmt.Sprintf("certificate '%s' was revoked at %s according to the stapled OCSP response", subject, revokedAt.UTC().Format(time.RFC3339)),
+	)
+}
+
+func NewTLSHostnameMismatchErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_VALIDATE,
+		CODE_TLS_HOSTNAME_MISMATCH,
+		err.Error(),
+	)
+}
+
+func NewTLSWeakAlgorithmErr(weak []string) *ECSErr {
+	return NewECSErr(
+		TYPE_VALIDATE,
+		CODE_TLS_WEAK_ALGORITHM,
+		fmt.Sprintf("weak TLS parameters in use: %s", strings.Join(weak, ", ")),
+	)
+}
+
+func NewSTARTTLSFailedErr(protocol string, err error) *ECSErr {
+	return NewECSErr(
+		TYPE_IO,
+		CODE_STARTTLS_FAILED,
+		fmt.Sprintf("%s STARTTLS upgrade failed: %s", protocol, err),
+	)
+}
+
+const (
+	CODE_SMTP_GREETING_FAILED = "SMTP_GREETING_FAILED"
+	CODE_SMTP_EHLO_REJECTED   = "SMTP_EHLO_REJECTED"
+	CODE_SMTP_AUTH_FAILED     = "SMTP_AUTH_FAILED"
+	CODE_IMAP_GREETING_FAILED = "IMAP_GREETING_FAILED"
+	CODE_IMAP_LOGIN_FAILED    = "IMAP_LOGIN_FAILED"
+	CODE_POP3_GREETING_FAILED = "POP3_GREETING_FAILED"
+	CODE_POP3_LOGIN_FAILED    = "POP3_LOGIN_FAILED"
+	CODE_LDAP_BIND_FAILED     = "LDAP_BIND_FAILED"
+	CODE_LDAP_SEARCH_FAILED   = "LDAP_SEARCH_FAILED"
+)
+
+func NewSMTPGreetingFailedErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_PROTOCOL,
+		CODE_SMTP_GREETING_FAILED,
+		fmt.Sprintf("SMTP greeting failed: %s", err),
+	)
+}
+
+func NewSMTPEHLORejectedErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_PROTOCOL,
+		CODE_SMTP_EHLO_REJECTED,
+		fmt.Sprintf("SMTP EHLO rejected: %s", err),
+	)
+}
+
+func NewSMTPAuthFailedErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_PROTOCOL,
+		CODE_SMTP_AUTH_FAILED,
+		fmt.Sprintf("SMTP authentication failed: %s", err),
+	)
+}
+
+func NewIMAPGreetingFailedErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_PROTOCOL,
+		CODE_IMAP_GREETING_FAILED,
+		fmt.Sprintf("IMAP greeting failed: %s", err),
+	)
+}
+
+func NewIMAPLoginFailedErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_PROTOCOL,
+		CODE_IMAP_LOGIN_FAILED,
+		fmt.Sprintf("IMAP login failed: %s", err),
+	)
+}
+
+func NewPOP3GreetingFailedErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_PROTOCOL,
+		CODE_POP3_GREETING_FAILED,
+		fmt.Sprintf("POP3 greeting failed: %s", err),
+	)
+}
+
+func NewPOP3LoginFailedErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_PROTOCOL,
+		CODE_POP3_LOGIN_FAILED,
+		fmt.Sprintf("POP3 login failed: %s", err),
+	)
+}
+
+func NewLDAPBindFailedErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_PROTOCOL,
+		CODE_LDAP_BIND_FAILED,
+		fmt.Sprintf("LDAP bind failed: %s", err),
+	)
+}
+
+func NewLDAPSearchFailedErr(err error) *ECSErr {
+	return NewECSErr(
+		TYPE_PROTOCOL,
+		CODE_LDAP_SEARCH_FAILED,
+		fmt.Sprintf("LDAP search failed: %s", err),
+	)
+}
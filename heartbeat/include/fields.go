@@ -24,13 +24,13 @@ import (
 )
 
 func init() {
-	if err := asset.SetFields("heartbeat", "fields.yml", asset.BeatFieldsPri, AssetFieldsYml); err != nil {
+	if err := asset.SetFields("heartbeat", "/tmp/hbfields.yml", asset.BeatFieldsPri, AssetTmpHbfieldsYml); err != nil {
 		panic(err)
 	}
 }
 
-// AssetFieldsYml returns asset data.
-// This is the base64 encoded zlib format compressed contents of fields.yml.
-func AssetFieldsYml() string {
-	return "eJzsvXt3G7mxOPj/fAqssmdlzSVbpF6Wtb/s/jSSPKNz/VAsOclNnCOC3SCJqAn0AGjRnLv3u+9BFYBGPyRTsuixE987x6HIbqCqUChUFerxB/KX43dvzt/8/H+QU0mENIRl3BAz45pMeM5IxhVLTb7sEW7IgmoyZYIpalhGxktiZoycnVySQsl/stT0fvgDGVPNMiIFfH/LlOZSkMNkkAz6GbtNfvgDucgZ1Yzccs0NmRlT6KPt7Sk3s3KcpHK+zXKqDU+3WaqJkUSX0ynThqQzKqYMvrJDTzjLM5388EOf3LDlEWGp/oEQw03OjuwDPxCSMZ0qXhguBXxFXrp3iHv76AdC+kTQOTsim//b8DnThs6LzR8IISRntyw/IqlUDP5W7NeSK5YdEaNK/MosC3ZEMmrwz9p8m6fUsG07JlnMmABSsVsmDJGKT7mwJEx+gPcIubL05hoeysJ77KNRNLWknig5r0bo2Yl5SvN8SRQrFNNMGC6mMJEbsZquc9G0LFXKwvznk+gF/I3MqCZCemhzEsjTQ/a4pXnJAOgATCGLMrfTuGHdZBOutIH3G2ApljJ+W0FV8ILlXFRwvXM0x/UiE6kIzXMcQSe4TuwjnRd20Td3BsOD/mC/v7N7NTg8Guwf7e4lh/u7f9t0qzOhZW6uYaiwiH75czpmue5ceFxlObYcDl/gx2v8/oYtF1JlHQxwUmoj5/aBbaRVQbnSAbcTKsiYkdJuFyMJzTIyZ4YSLiZSzakdxH7vcCWXM1nmGWzRVApDuSCCabukCA6wtf2/4zzHtdGEKka0kZaAVHtIAwBnnnCjTKY3TI0IFRkZ3RzqkSNHi8L/vUGLIucpQLdxRDYmUvbHVG30yAYTt/abQsmsTOH3/1mF8HOmNZ2yeyg/pyadXUuRL68N+2g6KP1SKpLLqaMVsJIb1jGOoxj+ZJ90P/eILAyf898Cy1oWu+VsYbcTF4TC0/YLpgLh7HTaqDI1pSVtLqeaLLiZydIQKqodU4OhR6SZMeUkD0lx9VMpUmqYiDaNkRaIOaFkVs6p6CtGMzrOGdHlfE7Vkshos8Y7eF7mhhd5wF0T9pFrKy1mbFlNOB9zwTLChZFEivB0c61/YXkuyV+kyrMVVtHQ6X2bJ94kfCqkYtd0LG/ZERkOdvbaK/qKa2PxdO/psEsMnRJG05nHvs6ef4+5D1lyZ+Mfq3AhnTKBnOVOkOPwxVTJsjgiOx18dzVj+GZYVbcznRynhI4tU6DEnZiF3ZBWVht7nk7c0lGxtGtE7cbOc7uVeyRjBj9IReRYM3VrlxPZW1q2nEm7slIRQ2+YJnNGdanY3D7ghg2PNTe8JlykeZkx8hOjVrQArprM6ZLQXEuiSmHfdvMqncDhCYgmPzpU3ZB6ZuXxmFWiH3aChZ/yXHteRSKpUgi7ryQSyMIW4afckIsZU/FBMaNFwSzHWmRhZwdU4RCxBBCOeydSGiGN5QWP7BE5x+lSq3TICSIN+9xu3F4FX2JZgTjFZ8yoSaL9fnzxGlQgd0jXEXIrToti26LCU5aQijdigZ5J5kkHkhx0GsInyC1cE3uUEzNTspzOyK8lK+34eqkNm2uS8xtG/pNObmiPvGMZR/4olEyZ1lxM/aK4x3WZzqzgfyWn2lA9I4gHuQRyO5LhBgUmv2efxBpTtWvGJc+zxMs7N3tTAnTJgDulQHOHnX00TGRWQ7BT1Ug5cfyAa+d53OlSKPatUiXcAEaG3UnFsmM82IEUFwJVoDCk3RmFkrc8Yz2rE+mCpXzCU4Jvg+7FddAQHWUjyTRnRvHU8lRQiZ8nB8mAPKPz7GBvq0dyPoaf8eu/H9CdXXY4OZzsDib7g8FwTHf39tge29/LDrMX6fhwJx0PB8/TAKLFx5Cdwc6gP9jpD/bJzu7RcHA0HJD/GAwGA/L+6uQfgcK1FZ7QXLPasrJixuZM0fyaZ/VFZW45nmBh/RyEZ1YiTjhTKC24dvvmGZ/AAQWnmN5qLjG3ypCag+LpbQOaKqntQmhDlRWf49KQEXIIz0aw/ezGa6/QId2zhJ7UCNFE/2l4+r3gv1rN+eF4B43NSiSUY/DeAlTDMSMgtXgHAzr0shp69t91IOgUXxCn8QHQWkFNKD6Fpx9qKFN+y0DzpcK9hk+7n2csLyZlbmWmlQAOwzCwWUjy0slvwoU2VKROE24cP9pODGeQZRKnbZFK22IFVSAZwthcE8FYhubtYsbTWXuqIMhTObeTWcstwvt8YuWHP2gAVTyB/FdyYpggOZsYwuaFWbaXciJlbRXtQq1jFa+WxT3L5w83OwGh+YIuNdHG/htoa60JPfOsicvqDD181yp1SUUaEY7oQNXqWWRxN9GYVY+AxsIntYWvVqzJALXFn9N0Zq3NNonjcTydneBeA6n/7I6EOrEbMB2AC0WlO7HWqmsqa2mkkHNZanIJGsAn1NdjQWj1CioN5Nnx5RZuTKeMOsBSKQQDX8S5MEwJZsiFkkam0p/7z84vtoiSJZyGhWIT/pFpUoqM4TltT18lczuYlW5SkblUjAhmFlLdEFkwRY1UVr/17gM2o/nEvkCJVW9yRmg254JrY3fmrdel7ViZnKPiTQ1xHhFEYj6XokfSnFGVL6sTEGygAK3MeboE+2LGQGWwCCafrR+Jcj4Oeu19R2gug/JWWyJ3VOA4hOa5TEHHdpC2ls+pneHrsBHc6rqBnh1fvtkiJQyeL6uTSKNtFZYE98p5jR4RSw73hwcvaghLNaWC/wZiM2kfL5+jPoB1ex1TORKB3i1A7nUadCxfpfw0KP82wgRmaWH/s5SWI1+9Ool2ZJrzhiF5Un1zjyV57N60W89zJ9WOHbnhdmfgRvCL4zak04Q9cGghKjalKgPLwRoGUuhe9DxaDWOOrl0uBc3JJJcLolhqje2an+Pq5MKNiudUBWYLNvuFfTyCDLajZiLYi/aZy/96Qwqa3jDzTG8lMAu6RgonUFpTofvSKnq1Sb2hq0DzZtrC4UwxTyWjqNAUgEnIpZyzYByVGo1Mw9ScbHifrFQblRtGsYmXXQ4U0UBQ44ZzPzsnAK7smAUjGJwAEQHcZrRgialf5mqKGH50czgm8hPYs6zUpSWIG7Wyvrmw4P2zFLgAYIyjee095h2DVfQV0rSGtGoWrlcf9rF3SQZHJo637ecJLmnYPKi40Swjms2pMDyFk4B9NE7HYx9Re++hSuXlgA6anpHkllt0+W+s8qxYRJkCe05zU1K3HOcTspSlCnNMaJ575vPng5WhU6mWPfuoV1G04XlOmNClcvqo84NbNSZj2lj2sCS1BJvwPA9ijBaFkoXi1LB8+QRWNc0yxbRel+UFuwBdK47n3IROSwriZz7m01KWOl8il8M7QZAuLLm0nDO4FyA51+D8PL/oWSMaT2OpCLXHzEeipeWfhJD/qigetMZKh8L9oejCw+T3wyhxX4yQZHVdVBBuIlUzK9FHjQflKOHFyIIyShCsUY9krGAic8YAavJSVECAn8etZKVrJf92xznVyb/tiR55uZaG6U+o/dGKo0+o/loNkJ/sD+joC/d6bic6RkBB2l6gw70aYMjOa7H9rJTFPRxb8Q5Kx5wNax7vuOZ0abcgep7hZSsPJqU9XH61MnzCWRaPDcoIFagB2JfCqIKiBQ30xK1QzZExZQ2AQMBw6eLvWAGKLHOXp2FQJhRPZ3N7qnZZ1on7I0ndO57WUyaTlJvl9ZqcJifWjunkytfWbmLOvVoDRwrDBRPmOpXZOmC6Wsh+zoxh9jjNWP2uOcy+qbvhfnP8wyc2aDcyayLwm5iP/WRtoKUyM3I8Z4qntAPIUhi1vOZarovmJzgFOb98C0RvQXhyfCdY62JNB1LnKp9QQbM2peBk+7S3ZMrkdSF5UCvql4BSTLkpM1TBcmrgjxYEm/9NNnK4je4/300OhnuHu4Me2cip2Tgie/vJ/mD/xfCQ/M9mC8inPc4aTl7NVN+rUtFPaMR58vSIc3KhYi0nZKqoKHOquFnGOtGSpFY3A0siErwnXuUJLkTkcK5QSU6ZPeydPTXJpVROZ+iBy2zGK2ulUi4QvJwUs6Xm9oO/sUy9jNIRCG+kiSJA4J6Wo2NpDrrNlEmPbVvijqU2UvSztLU2hdSG5uvaZZsXMDyKNaq1THl1d4kxAg7kCtE/u5iKStt3V1DhuilcoI4ZuRFyIaxtR4lFBSaSivzt/IJEOBFgbVClb6lakgXPrAYHp5rb1XhxBR/b9HuxN9gbPETMKjblUqxTgL2DGe6TX/0/ndwF15okmIOpU4D9qWRj1uY/a9X8VtkET3qszhgGQ/0GftBJjeF64db2/PjNcfRcJ/DuoNo+VlM4lun2TyUTUl8fcxUpn59gDF58AsvwQA2P84tgpdX1w2fnF7d7ltvPL24PtpLaXHOarmM/vz4+6QamcWkhpAm3x3PqFPB3L0/I88HeDty/Y7Qhy47ImTWeZGqYIc/AIcB1jxz2x7xSUa2Ov4VXv041csFsC0n+XhYFUynV7B9kxj7SjKV8TnOS8Sk3cPdj1SjjtdowpgMfJ7YCRJBSaD51QTtsylRCLssU7vxv3YMu1gvvrBAGGkacLYsZ65C+g0F/MOjvn8G/u/2d3dpKCWqSJmd0no/d3LF5pajQ6EE6v7BYOX8KBoi+Ob4KzknyjCXTxPndrVSuXKYEPXHeJV+7BA6HTuSPI0ZRuKgRU5JLmpExzalI4QyccMUWNM/R/6lkaY/GhpVvkS6kMg8z8r3Jp43i3ZZ/TA07/rdCD/T7PcD6rWF9gW8/ytbdqcPRWpNVTPC71+PCrUEsKOL57HmkDVMsu+6ysp9OT7RCacanM6ZNNKmnEc7dA0SKgmUeZF2O8ado/V9Wt+Go70XDOXvb6isbDSt3w4qvjfiLbsPeXb9nzDA1B622UCzl2uoroDZR9AFCjBIE85bjnKdEl5MJ/xhGhGeezYwpjra38RF8IpFqupWQK7UEsShR0frIrRaJStZ4STSfF/mSGHpTrSv6DHOqDYhdjFxFnUpIQ8D1tWB5DthfvTqt4qI2UpmUNxttwXiXEyCQfZ3cECYBpg8mwz0uFB/PF6nwee5ZBfR1wj6mrDBV2B28Vt3Nttg9gft4SgqqDI8uGkgLAhAeHOdyv6EmU9k0YHyUdj3srCkV1S0DqfNUL8I+xOy2kBmzXC66Wbx7P9T3TEzXjcVikTCqTceWoNpsROEJCIEbYkZ1FW8LiILeEeao1LgNXY53El2Oh7UpejXurWBDS8L5sn3AWjXGRg83m5BWsvMcbq+Z4rIj5scisKoKaGRxDWh8AXHHJhN7Ot0yO6vjEof9M3b16nSrh1ZUMKEqugeioczo+XtI2P2WVz2jRLsjaUvG5rxh2CiiyK4S8MG3LRJBHN4lDauVWE0uwvc1vik1U8l6WSZ23OGVtVR4EWwnx1iVOYOLEDm56zykgrw6Pb6ACFjE+DQMFfPKZhs7Nqc8XxNy7y0GMIG3XpI2AFZ0dljG39DVi0VzU1dnAHif6C3lOR3nHVZtPmbKkDMutGGOsWoUgXvV343tYPb18x0iubYI3HYUqg+oRvx8oBzc9WwXOTVWq+5gT4Rzjb7UeCVwsjYQM6pn6+IERymQNnYe9Mgpxaw51wpJp04sCUKFFMs4twgNk4hV3mvmQllHgAXP8KIa/rDYjYIKkEoxwbWieW1OKrIOlQpCKzuYai0RzXcENCPJWrv7sj/s7/d3hv2dwc7ezt6L4c7zw+f9nYMXO3s7L/YGe/2d3f3hi/2D54cH/eFgMGgj8XRewi8sBy9n1uxEPz2kn3BxL6lowu6UgUrmzVvpJ2P5Y6Uo5JkBK8NM/qICHJL1DLQG0Jt/37jhYyroNQRrbvTIhmKgcovptR3QZ2TdSbcqmE6WCHiIpfNf3B1Khzm+BHdnCK2AocBSERNFQ/JehQY60DBo23sRIHSb3JlWNCGvq7QOruP4cirI2ckOmlp2g06YSWdMw6VMNDrhRrtMrgpIu7nrCYu1TDKuQ9xyHQQ3riqFSxFTbC5NiHImsjSaZyyaqQkZwkSJy2HyCHnWEdWr7kKpnluJg1YDQbKWm9x7euywXFegOoJFidAeOBee5uIJNMsnfZfvimYrPOVyj5IfUQzCV4aqKTPJj8TIGm+PfZAAZs3ZhzxIm5s6Ir6XrB5hF0kmJ9bOjhlEKkvUqbQ4uAhE3SOK6QK16nyZkF/kgt0yFRFMM6M7oHdjNnCYl9ZYl8blik7gfi3cUikpTY1czBDnqYYTwAsCWZGgWv0ILwg+Sk1J87BISGRMzcObMLs2nnf9ZA007GJZ6Rjymu8mYSBYRUufvSt9rlUc2GEwmvXu5cIAEQ/ZHdT8NHytBa0gu4OaK7DSUwQFpnAJuD59cLPaem4u4O84aIpnIQ/XnfVLkvHJhKnYPQ23xRyyTK2GbE/YvmGCCkOYuOVKinn9XqYSqcd/uQyT86znA7JA7JO3734m5xlmxEKwUNlUO9oG68HBwfPnzw8PD1+8eNFJznWGALQJ6k9+mnOq76FloGGg0efREm2uFjUzroucLmMLJHYfYRmOfsZuV/UiOZOO59wsr9u3p0+nn0Tz4O0o92GccEDikaoY3rAAy1SHD3FRly3FpdR9RrXpD+u3wT6HaH1b79znjp2femkMmoM/55uA8v5wZ3fPasgvBnScZmwy6IZ4jdwdYI7jA9tQR9e+8GU7We3JIHrtVY0ob+1eMpqdZM4yXtZ9/O4w+y5vn0TeriA0GgT/LpGfUiJ74v4rCebV0f52RPcjcPr9hfvqQH/94n91XFytsy9yMri5YpnbJVlqcuQivNMjx7+VikXfdFSmWPbdJI8kw5eR154QGAW3KglQytaJ0C1a50vyaDJYU3WVrKDPjlr3lIAJE498XOyLLnSPUItvj0zTorpklgrjzmguU0ZF29O4WDla0CGOEZtrQtsFbD7p4fFA/Hwhny/D3x4RXwYiLluTcW24mJZcz/xzuuGbg2pPlbLib2uwLBloKp5teoRNQRM5O9kht5q8ovNxRnvk55ML8vPJGbmtNJzjoiBnYspF2EN/fm1fsd+7EkJdO5EWBWHuNfvZgdxzmKpS9MiEqik1rEdymL69H/H7VZfs310k/7vL4n8xIRwHIX57IjYEy30XoN+MAHUe8u9Ojy/l9GgQ/LvT4ymdHp64/2ZOD4f2v5TTo4nTN+H0cED/Szg9HC7/7hp2gwz/rop2RYZ/JX17dcS/TY18dfy+6+xfu84eYuNkxq41nwpqSl9q3QXJyYyRy9ovd0fLXc2YZs3q5bXwUgg7G3NB1RLT5cOk+vMLJGZ8yrS5pvlUKm5m83Xy3IzqGdRb85MFzddihPkZWEn77myPGlcGOmCDD4oNU7gmLlk35AdBhawwpO/AYZkenlRQgNYljFT8jLSpwG3zi57Rnf2DVbc4lhOuU7gVNzuWMmdUdBHxJ/wJop9pAdGTHCtzOjpY1F0WdDso1LLBJ8I+I9cBn9p9vsby05YhonjlVTmBd5hLrvK774pB5lSUE+p6Q4yXlkK+9P8tE5lUSTQmqyqVK5azW4qJsceF5Zsf315CuFpXIs48sXOy5GOR2uP443Jl2hpqyrUVlzvOMu5KSralCJznTBlMEWQOlG4aT8rc1+ifQrkhtSyMnCpazHhKmFJS6SoQMh71luY8i8unSGWFkDZ+PvKK0VtGShFVTZz4RHx4tXrFayHV+GHYhbWdRTpj6U1Xyfezd+/evrt+/+bq3fvLq7PT63dv316tvEYldphZUzmMSxy+XtrLi/ag1VUFqHiqpOVhciJVIWtFsT+tWDA6X/M+tlM85WaG8aRyu9WVH/Zb2DUYiaJNK+fIw/bw2Z9++evfDl8fHv95ZVr6DkwrUDOrWLVGsVO7RajISL0zVf1kb/SMgkLecKa15frOYGfYH9j/roY7R8PB0e7gbyvLedhjbBXmuOdc2rw00h7CsHTRPu/YuySd1XOE/2w3PMYWV6/f9Z6PRU/l3NeX7CEpZ7w63msJvD7YuJI09vSXMteu3YSLEycgRlAvQCHVYpeHnaAgyT6Trt0HPubDgVVVP/pvmcK8cDqlXER1/OwbQYG0Kn7sKeyUxbRG/E8I2lUIU2nNoOE6GRcU5vjLe4o0hwfrhXhdidxW866o94/rF+KADFCEYH0TWrJhjHwVOf6DF1iRnj5jeRFloEHGBVYRCSNrl8shltb2sHv9CWLQ06JMytCs637G0inNWXY9ySXtLO62ecFUatXck4v3SEM0erl2XT34b1VfOFfnVE7gaXsGRqUOREa4IQobgADWA8uyw4RcphQS5K02JpU9RQaDwD8af7yOf1x1d2Vc3ySK0SzpqA36oIqwcH5Ju5cqHGFM8mxKyynbgoYURGO5H6wB8YxOp4pNo5ZhLpuI5jmApreI5iJlVRY49p+JSvqv7MsEVBeKG/YFcLXzGCZ+R3TXmR9ZbXue1aOj+ZxO1+p0iT1qMFlIe0GArIjFDkKeVnXQDJ2uCbJKpjq46LSRAx91Zrx/+qhD4z09Gptef5jVtTuszTtnc6mWTyfwXsN4BMYjBUo/+3F1ARbY/8kE2RpZrlpYESrXhWmxInXCplDy4CkEy10iBUpP2XPYHsh5HophQwWtCU3bjplqVzyZVPH4crE+hEMvVY/574mw0znWxFrvcHQyp4JOUXfnukKjZaRge9NIDbQa07U2itF5rAieWkXqsvr6E50fo1G8ZmboDcM6NFxgIX1vWgi2cK3lqvFDiWudzlh0xXMuul6pP1wVDgxFKqJHg0MXmn16gstmmVif9Bm/6lIiJzLPJXRBnVMhmDoio/+OEIZLzf/p176ynzUzjW+hZFNBU/Y/o0qZ5dCx0qU3Rx1RwV4KJQ9mFNofK28sKeehIVT7Yj8VHRkYfBEmOiGvpWp04XCsgoV7JrIULgeU69CJGipCYdBBksrtcS6n21T0uTCht2jfyL6ZsX6ITaCG9nHWPq5SH1fp7/ZtB2MhtflHWONjQc7wbc2oSme1NUil0BxyT+u9ksY0vcF+kxlPmUbrM1wY1FkFqtPOda0qUuN9V8uXnJYMmQN30S0TUIm0Pa7GfGSozoQMYodiHz1rKqatxDDYiqNWCKWT95l2pStCR9LRh1GPjLbtPz/af/5f+8+G/ed/2X/+H/vP/2f/ISPyDNiqYpMtD/GoN4KLstEfRonvNq4Zbpk60aHDC7NCD0p3VM7LO5hhWvKMbTPhe5TjMNthmO20VIoJs+0o3E8Vo4b1gUrJzMzzPzR+oQXvF9TM+gVVdK7/HpPwH09gs7lNuYIktkxnqDDX92hLG5XH2u6hqKGmmaGko4bMoSOtZkIz74ZzrrUP4ej5EJm7XnglH0SrQ+1ITLn4mFCwB+y6F0rOmZmxEv5iIoPy4aN4ZGZSZL4a5wJoENO14GBKG+wOCd9n2Pt+Rm+ZpxjRzMSjLlhoGYRi98MGeMh4+mEjlM7x78ITCRlhhQz37ch5heJRYcZwHYQDU01GHXJ1lHwQP7GlBIdTg5HjITuOjNTab4pTiyTLCByvWJFgFGDDuWdUR9sgHjZmzKMPgpAfyWtfnMDzwag/wl/eSNBd0MMhrEoaSfON5vkcr/FDNFfY/0/F2cdY7MSXbA/jJ2A8wcfg8HFdiShIQNiXXExjYrmTKPkgXlMBVdGVJjS3tvzShzsyV6jdC2Ps5EmX3qeI3FTbMl16gJALf8/txhgzbUhhic1ThiXIHTkTYsGJh0TIoDyc98DFFc3hgmXk3h4lrn1l6PxfapZBV2ToshSPa08aaL4T3r2beetnSJ1X4zEd247C0sRMC9pMJb/v4dZ4yM9k3Krb+Kpsu2Is2Cocu3ksiLxlypIQZO+yYDVB5PglbheAp1O+RNZlWRxGs5HLqd4A5ttAzV9vJOQvjLCPBUuxW5c9+GmWkQ2j7H7YqHnhNvRSmBmz67pR9TGjikxKU6qO+CM74Wp+26j/Vk1hb3x9j8IePVopnOjUbiqIzNtS9Y57AXpsV7aNpZGTumsWroiqzmlYT6fWzK3naipbDcX7KiDS2LXkQo60Cxr1UKedDe38wnbgFrWI+1RzODyHXXO4yt18V/uzaAJQUzOovorapWY5F7XGr9jjy4069o3TwMcv6hjruyb0ZKgT02+XuL/feVSWCnk1jO2vB90dfb1ePcAbt6CHildwQIru5zwVApbY9CFQ+pvqAldrbrdSK7iw7XGAp2oFF4aFlnC4E7+3gvveCu7fqxVcvB19jXyQjF9fP7gY1O9N4Z6e7t+bwn1vCve9Kdz3pnDfm8J9bwr3vSnc96Zw32RTuFhJ/Do6w0UQfW8P9xW0h+MFOMwjPvlETzRWa4ZWKH5rBe/p679tdbVDq2omf1Ud4aAFWRT46TCFcNCKNkbaxbKUOGWQmvf0GK6jx9sDjNgv1+ittu/JV9Ttrebu/N7y7XvLt+8t3763fPve8u17y7fvLd++t3x7IiC+t3z73vLte8u37y3fvrd8+97yrQPOr6TlW5bjuesDvF69gj/vz8RYpYIN+NpzPlZUcaZJthR0jt4TT1BJM3ShSV8wAK403M8QxykLplwnKpCRGgPIrXTY0DMKjdtr82ygUlgVdQFrxhsCY5+P4CwAZnA87YJLgyHlczGOPDQ/klNEoJ9zcePmW5JnoyTL89EWSeV8DrkU4BmSgvyFi0wudPX+JYL7FitBPBslWna9917wj31QZlu4t2CpgbHM+bhrwDlN314+QSpyrfxR8r2O0JerI9Qg/TdUVqgB+fcqQ+urMtQk9feiQ1990aHmkv3r1CBqYPa9JNHTlSRqkvZfrUJRE7/vBYvWVLCoQejv9YvuoJPVPpN5tr8m6fX6dB+neBA8ekaHawLo8pfj4eMgqlTaNcC0s3/wOKj23X33WqDaH+48BiqdMbaKxH4UVJenZ2cXD4NqTSpHzb/rbNXmAYxHSp4vyZwWuqtkAhhnUHhY37Q38w1TguW7O4l3ZKyAbkHNuhyZL8s8R4jtJC3cG8CfHH1wfoIPl2Dj7+58eBRCLIGkRMPSUIJ4DQVmLt6TeBrfiNv7tC3aLRQ/Huw9AAt7cFKxXBMCmH0DAacwTYvNej6xNyPUwFM8Z30o5vak+nHBkgiwdWPbiHt+BLIXNA4O/zRydvjrW6b0F8DOTfNIzA6S3eTFwWCQDJ/vDfcfgCKfF+u8DznGW5BQQayQyrjeOxdnuNPIsSAOCtLvQ4QIPEYiuIj9xV2heztnwsWUqUJx4cqMQ7LaLROETgxTRDGkmEvc9H15rL7YBzwrPU1RoYP5r7G2gkyhJEfWc7l9C4yygBReLKpiFK3KfljoMSe6ruMpgQ9TUysNMuGKsSUICiwUY2aKUdNXzFUG2RkM97YHw22jsPRKf05za7T1kTh950yE0iAdEZjpweFgN91jL3Z2hvZDltL9Fwe7lGa7B1k2eQCD+FSoa9gMa7y6Czvhc6TZ5cXx+Zur5OyvZw9A0dnB68bLTfM5+G0Ecf3h4/GZd87D57fBzY5H8Mb9BAj3JgINOn9v8uYS/rzn3uQl3pi4TA874embS/JryWADQmEhoRdMVRvB/g73PyHvmXHYiyG6Gdy2YpqzMNaSFIpLuCGZMgN4uWHdoM9GmdBQTeoInh9tETy/l36SeHQIJ/AZ+HgP6m58TMhKxmlDUr/G2BdaCypzMKBNu2DoRMG1C+kbME4bSnx1tPUUKd41Sqxc2rBVBYLC3V1UOYAK9waG/NB05uYiGgu5EcVMqUR0Te1vE5otLq5mjEDMwg1bOnpV2dV+YZD+mrlZ68nj4yU5O7ms3NHvWCpV5sYCGQ2SNfbczit08Ec/uSAL+9bZyaUbvpl0ZNfY8h7Wv4CIY4ilZ1gttFbpwT7neZwcGzLngs/Lec99Gcb1SEHtq4jfsHjOyAIHtQdaaHBdRbz0rEERhoRQwhQOVA6eOYsR1aSQWvMxRpFkUGnD6oVRXRNfZ05GbNwClGqSltpIXweumb7ucE5zurb6AtjkhWLORVgQX6KvKrrmG9vAMa/a3rvzN52g29HWpev40n6xaMTAUx/BXt8cjMKekz51Dl8tmMi0j6iB0iwgrTxJ4gE97q3jfzhI/H+dVFhnqmIz49vIuCtRA3RSMAWBuxFtzsENBm5IOSEnb45fnxEoTuQKxcn81mplkXDa3NRY3GcUiRgTVZuQgqHUgFAcXUhL4nAdEw0C+zIh50FWCWl81GRzTJ8iPvq1ZDqUNhjZY4dFpTyiZYH44TvCxf3SGLNK/OC9lZI5RHkbpm7hXsuKbkAYKNC5Ct7dS9NZLNnZBARTrSwG1ylVGcsS8jempC8DNAd36czFfaAMrQg4rqiGU3QUJOhm1DV2wLuaVd3vHiljgDdrcM8YzZi6nuR0ur5LSx9ws0NcOr0VkzgzgZlrjaYKlppavaYjcnzcI1cnPfLutEfeHffI8WmPnJz2yOnbDifz3zfenW70yMa7Yx+Lc1fJ6yddGosT5hfF12FUu9AGp3UUSk4VnSPrhVudyrCDPAOmsPhMPBAUrCx4VTcFxYLusKx3hsN6f2JZdGS7PjnyLmxGCrzAQgUK2wG4K6AbLiDJB/XWmipLyJxpTacsiQNIuIZQIUc7J8CMvxbEYVA1BspARFM85p00+tP7s3f/VaNRkIlfTFdQTjvEcwLNkU+qBTXRvc4TEY7CBmjxiRecxa5Gps9nEVL0wcVhVcG4sO0zTGzZ3YGCJxYCMtw52IpTRaSuvVEJ8TiplGrCdEoLu6eoZmQ48Mmgmjz7cHp6ulUp4D/R9IbonOqZM/R+LSWUoQkju6ESckXHukdSqhSnU+asBld3NudRmaQJY1k8ApSRVS6B8YPpkQ8K3/oggP+Yu0d82Oka1vl3T9j7nqT3NSXpBb74wtl6vOZUcBjel2LXEhbfUG7ZYrHoJvr3RDIUgd8TyR6WSFYx0JcxD5yVdL9mcXx8XK+l5E3V688pdnDc8tDlOTm/sIocg46/o9izMWq4GPyPI+/pc7zDJxOeljk4kErNemTMUlrq4JW+pYozs/SmUcypc2q0NQmjKt4JOftooGpwgC8qB+kBNTOmGFb2FTqJiDOqdFao/81N8GZBOBvU+DUzNocyJtHQqBfgS/A7o5pDUH0Y8ZbrEjpCOXXFargTqTrNnMhpYu2d6s9h0/DxevCXMAP8XN3lb968hcDNGnRr3BSb8a4IXn0fJJX1HIWhBJ9lvPqxtZSliqq3R7cCEDw25bdM24fi+4QefBHHmGH5+zBuJnQYZYKwNS8GVoWiAsB7+d0dQA2IxvxS+GqoBVMO/2eyQK9rvrRDaCnDieJsNdwWWwk5FhmhzkMTxmwV9LWb6u7bCe/Ht1acEwYt/g4O39DUN63d+5ydfOre5zUztB87qX1vOueF/vyezp0X7VEAj2K/llyxeJjPYuazk8tw6w4HW6A7NsAwMiEjlurEPTTCPE4PRiUVQVUCWVRqg+2S4Yo7d/UjY4fMX2ZM4FrCwqZK6kiD8yXd+33nNHUXGhYgCAPO+XRm8mWVpVF5eips4P0oPyhnBnukT5W74abZPy2ovsBKOmNz2qA/qWVudbDUMBkkg5ij8kmNo169JL+AU+oTjNWZh/WKi/IjOfvI0hJN31dc3MCHl1hg6dnZq5db0DoR6uV/NvN9gbij1zSdQZXrOPbIEdlSqzvu6PCgv3ro0Xhp2LVU2UoVhh+Dw09Lw4hmv5bQ+0RO7gb8FTcmZ+RMZJyuHnBflNdrPL9OLt6H4+teqp8Lw1aOWoMTgUtxHQWmPyZ+3WlR0NGSiUoJCrWRLKibumJ6cm7FBTUuASxsXG7ivnzKhxRkcLFhFTdfVnBCb9CX6oJLEBWp9MoRl+wjRPSsgPUkp8aw6ua4XpyTYzQ6DscywnI2D2mPGHq+LNjqcKE7PKFjvub4rT/Xw7YsRx1H2VY/Yfj3ue+hRp4d/3S+9VA01ulERRldv2Bs7otV4Vzj7Sq0WMOjIALSzftAMJkwahkXin2y2myOmNUEn0tR141yvTy8uTH4OMReROFW0wNcHfwrg6yv6ZivCdRP7y1PcdQg3l4+lOJrPH4cd9x3Aq0K5RcXag/caS7S86nOBRzuCc4FF8a0CmCCRRlbjwqd8jFTrbUOJ7W1pz8nPkqX4z5qs2FI8CILRs2MjFg+STzGyY+j1bdyeCmd8VXSTjqEZK3RRV0Lm/G+/rV0GYhjOuY5N0tIbVd8XMYk0w9sHxrgthJYFqsE4D8I9MsZFUIK4oYnKc3T0kUYBzXt0UCvM2zAMt+l40fYVS5S4KEwrvGitAViXKR4dQh9ofFrOZms1sDwSYDF2T4DXM1/W4WyD+kS0gIyFGG3kz0c1jWejS1Q7VAPh/CWK1PS/Hr1JkgP0u9aULr56hXZHgPw41f/EdA+cPWn9sj9UkcmTPZ7H5mI8QOPTPfSA1SMx24URzVPrMBMD4Z1zRu6AefDtjTUGboOFZHWBKbXMF0VpqrQE6QZQagU1xEyK8NvWD5ZY2aVH57o5XwsXQKS3UYrWhTBgaOUayfo/bbhi5VrZ1ER5Vq4YidwGbGEGLWwed9jG9w5bnd8zgXzFwWDJmo5IxNmsC+lv9aBAnkp1ejmUnEYLnrsudEsn0RFgAWO/gSZFmtqawFExsC+RrA4Al63pbI1QHB3SccOCFww4SfA6K5414G3j02s73dD05traA+6wpZZ8DxLacD5C9fmu8LqFSl01fS9qLlG0lluLXJI9WAfTR3JLxSwEJaxFweXYK0P8PPFKWhY7jsyWIIX/J/0liY5FdPkTZnnFxKCys/847EQufU3UV6IhC/uFyJuA9d6j7pUKqiY8dHcUZip6o4P/GQUT2vCoGqXbx8l0JnItaTUrQ6ijZ6p0JCy6kqOwqmK+Hglg2iC+z7fcTxUPKQmZDxAxIyYVmOQ0KhcTiIk3Hh+KOrL/Fgug2KIxGIP5dh7UU9XFyCNgSmhj4Ib06cxQQxP3CkAe+SFQVIphFMSx8wsGFSSixqX0nqLU5yMC26wyZFdqlxqi9uxX4lPkxt61fghIf9JlNh9JidzRnWpwM+jQ0vrNmWjx+C6w9AbFng4JnPMHhWN52wuIcuQaTuMHy6rKO0ayt7yIJEMm0NUdqlYQi4Zrrnr1W5PuhGizTGJy90qey8QFHwNCVlhC8eJZQ5SKEpkqGncvX7W9WbaztB/ug6POHqIA/ER5q7mZ6S6xx3CMCM8znoT0Vvk3Fg2AtaoIg1mVHh6p9SwqYTwDj9+WHQrSEZAqD7NMtf93+6nPuwnBl9ZJamP0RzZKG4IGZU4Eha4PF/GBoRLZEdHJOuIJSo1U/2Cam2J2ceU0/piTJkw1zy7XnN1uynuILu5PB4unAjvFaXy5Zq89jEC0BKeVUFZGEIAlAmNkl3rWOx2HalqHHpj+0ua23rJqXo3ImzUI6G328zqIKlngnqJZFN1UXZdlUNYA0azVVacqwug2CSHtuMzRmRpUumPOmoCSPKu5g+uzhOQYXNTx8KR6xhW3zdnfvXq0gupMKIDOGUq6lJtxz0/DYnEU4al1SqBBo9bSca1LrE1dnWnW18dz6nCU95F9rlaUb4SVbO6l13A2og+fcvqQ0hft7saLHoUilxhEAx0QnXRI9icPAwLVRYW3BrgVT8yLL3Q6B1f2e5QgboWpSVktFZQyJMpyxiOLO46PApRHjMi59wY1mjr3NGw/qh6YFSh1XcRk4HEEeMjgaA1SpxOReTYZYzVmsJaLokiU6rJ5lzDQJ+YLJNMQ9xsWJbGvBWt4/nvnVdzMXXTuhp4QrbnjyWwXV63BLH7ZWRnufazXN81dA0WMOGQtd3z8Tav6Ba0O9wc56dt2erXa1Ur3J8S6zn5sPCi4/OJLBVEYZ3gnL5NNNZLwGBVHgI2YnGB4X8uONytgR3IA09mnCmq0llcdap5DFYmOIqajTGfknEJPbY2IFKnGpEzXQ9Qj6R9bphyCmdjiiN3iI7I0unrIcCNQIF7FzDuHqvWNTX8lpuly0ULFWVBbYQzKXQsczPaRRn5wiu+tCWNe4rqcuzBaioYYXwfGOnmhXB0kAYWwoKpQI3fQm9/HZrb60hOUmM5C5YmROpFlGwHW9aOtE/4E57uvD93tnwapQ2GohQope35BhGrUHs5olzU1d8XPyg1C3p7xnSttKiz4DUpRdTiv0cUm1KV5fHqgwIOTxNrSpb2g1TEogc+YIhERF1f3jIFij7UBPJHsjeuua4dXa72CZqanbJi72DvsE58VPY+IQvuCs/adLsBB6mf6/ad7XrZUSSdlXkTrqKikIpRrLssUMyBNTZeYlxywQuWc8Hu5Gms/526hnn/O5RNRbFBTfxV1UfXwVqjH0DLQsjZHa3P41NZkLm1ijQ3JYaR9pyn3SwkCdO6jTZmHcGqqGX7P9M4LbxW2slfq6KBlbEc8tPRNo3jt13Gr7tLaCgiNcsRlgVexbMF1iSU688IN05KNCCZS8GNrCplVENY7VBWK2b/9DfZRpIbxgpSFqgjwkvx5qpTNaXaeQ/qdLSKO+64lOa9eGUbmlM7m2FnMDzoD/b7O7tXg8Ojwf7R7l5yuP/8b/U8Bns2t25In75iopumUeJB1CiCWUqQWIq1taylB2UbnEsrl1NLbnfcYE9PmtbOmVxOe84Fl8vpVi+ePC6QjObk0h0vWBuiEnVxpXy7KWKwYdGhrtgcZDbUzbeamo8Jh+GtiVmbG7xtodzEXGZlXrE+9jjCTg2+InsmTa/Sc+NhOg6bgqYzlkS0CMtbqlW6pndcKTbe5KIozXWIjqBCupIS3gVXmvgBql/zPOedz2CuGvDIsJNxTt3UtehzAll1Ydo6J6GcQqrbPY9/M5HBBsJ8PlPlz9UqhHTJIi9oYHaReW+MXVPe6r7ExCpFEO46UipQW6dJ8yBBfrMHp//eq1UBcHvWQPqdHIPHLqv7ntd4GfUL1TPyrGBqRgttN582cB1VVeiDsDxFF+4kMxB+TDHFK3K/z6XQRln0wWsLKQtWc2wy/XBnd2//4Pnhi0HXp+OfTk5rqK/zBuX81GLjvVqx36sB8yHdm+wPBlkdMjFl7cLgq+skV+FMwBYgXqpSpfgtCxZdyoRRNHeVWYxULQ0DdAvf+QOUgVF14MS6eIMvvbqQL0PFxMRJyuokzrVsjV7TpuIJ5swVnfe1t9HWt+e1BSg6391Zrumi0914Lpzfy+4u9LtaM0zrcm41BiGJxQ2snV7QFNzZ65O9ZkoKmctpreOPPWrkjc+w5fqoRivyv5rIVd/45R6tdGbvJ8PBcPWS8ze8KYy+MjvX10N4lKGL/nXM0bMD9f0ozeshKPTm1Yb45xiU2oWExmR2+7K7SolS27CFAFRv1/VmVt0WtPMzeasF5V3ctofmTBmvyMBeqF1QNNxXztE0aTs+q4YPmB42wz63GgvDAAS1oovRAUdmVGSQEHI1Y0tIMltYUxma/vhtqpjFGe6Lqi9RzQCCKJlXWHMDo8BOn7G8wJgabSwzLGYM3H+hNFQq5+gDItRAQt20zKkKNasq01FZ5apD5bEUrLF+TadamyKLs0TV2qCKEODS1BRdnqkzH8BAQVlVFlgC17EVdFq2JjIMjRZFXk5BE2h7UqpEVwo7QXjtGfXhY1AF4fzd6vl9gyOPGqUcaqZgdRsMNy72+bv0zBrVvex/EN3r5H1nZTf7aIKPwHKtMFyFTfbecfmdykHMLiE+BAt+2uf8wM9cOTNd5BzriXJjLbTYqVNQZfSW5eR4s3jlvkeAyhOpiGKQln6nmW5tAnjCtRjJZHpdOaCtOLC6T0jIwiJpBEv/sqzaVta+cMn2AIhRnN16a310jas/gnuZUjPoMYQ9J+UtU4pnjllplFzs8+k9uD1S5MxaoJoxMnqJ4gqSbZYF0yMvpkdnVrXkKcJI3jGnNnecZJesIMMXZHB4tHNwNBzgXerJ2cujwf/1h+HO3v99ydLSLhz+RbDy8ZwKOmUKvxsm7tHhwH2olFwr6nQJYgjbnGsji4Jl/gX8X63SPw4Hif3/Icm0+eNOMkx2kh1dmD8Od3Z3foiI0Qj0CEvVdca6C6Wv+pi1huRjT1mH38hX+MiYkC6/MMhwPDsjdzP1CwKBBZX1THlu9bfgWiqY8gWcwkkqDHhM7JmN9ZHxhqelzL2RxhVBc73uXL1gqN1Nww2d18Oz2r5GuYk1IxsqgD21fAuW6JyrTvEGYXr2CHS+S9QOeOUdihCMQD+2h6II8HuVnGK9DTgOC1l6y5U8C7i5exgsXImaShi0KvqDyqnDEbweVWPIKjo2dJkJfgjULOzokbDToZoDHlFWjtA8jxd4pWW9jVPT3cLG5SBelgr4qSKLcEV43RkHTkQo8mv1fK1l6sJNcB3uUL5MTQpXPTns4BUJJo2YIcsZflaIAQ6XEEdWtxr14iOGimVQ3uDE4VCHNFw1R7d3N9XqaCZ0x6HqyFoTMa6g9LoyuDcvQ+2Lrn2G7nTYVaio+Po8l0vtfHBt7/srOY28zXNUG2sqRlVww5uoIRnZGc1xSFroUHZPXUe3WeBIvlzqudVTZ8YU2RZ41LHTWTl2oQr+HrrRizSM+AzblfSqfhh9h2LfH1f949IakWK6dVf3ltoyKkb1+jI238HoZDFbxqUrfJhZW0i1Hc8dwTh2NKCb1YN4Ckq5E62Woo7BQ5RPLV4njPsXUMF8GAG8ParLFDdkkB/uasq9gnQbVaBVR/9sWfUSs8iHoK9GH3WyYGMCXSddRSzRgCca0u7ejAnujh2r61khGIyZcDY0wAtitLbOCCQy5WicSwjG0NywUQfTXEEBL9eGjpQiXPLX1f5P2v2K1V2Ya2A2NwF5/+4Vybm48aXB7u+f6fmyyXV+FGxXDKFuPI1D50I8LQqK48hi7gWlp1aCPnISHIF5aA9qxfB0nUsBt5lw5IYbUaBne1V8lw4UEHGtvG2YY/sPgwH4GldeHq5vrnWkI96lNU5ySTujpt9xfUNgBLAPFZeKY3WupiDUTlYRLXNIpNRR+c73mrnbM0AN7q/cXR/qAnbnJnfAfi2kWqU78p1IbL4BXxz/jWUw7CcQ6mEcpk4pXAEHJAaWZ4aDQYf/ck65axjtGuUvZQnrXr9RcicCShKoJ6wjgHT9AtEOsXD+SGsgUedSBDSQaq6GD2hJ2OC6cUfgy6WsQL0HpXdtXvo6LJiweteRDtHqjUehkhHC72/eMDuqFQfQg2tQelOvfs4+0tQQqDTjatg7nSgKCIjDATxs1R1muAlqUeuWRWb9A26t7qEUlODFAOMwQX3/1A7M+y5s/xKqnAdjIYwYV0OPau3hU/5eycdXxEa5l046cZeMZeEP7ijUNKwEBCy7WbnzKaRSaK5NrHc7zoxdjSY0/u5qSeB0vIDPmFkyQ7+mUS6niYbfE/97ksqMjRIvfP3X1fEae/OrDCHMkXZTtBSV2q0wSrUJV2xB88jdeH56uRWiUWtvBPXbsTXhRhO5EGFGLOZmz/eqSlsYN5UFBvjejW4UphQQbp8iz+s8bahaJRH5/ntCvIT85E2hC3GO7wojjsA7wyou5Y7LQrtPf5NijYUE7zdSayjZDVEJDrvCASF0tLkEDAdzXRfJFaOZ18ncYe0ZvbrwiY5J3ICeOap41tiiT1NWYDGaMKmvjQkV9qnd/lKA6Xd+6ibfOCuVLNj28VwbpjI634jKddPxWLFbtHH945dXG1tocpJffjmazythwmnun+oP9o8Gg42thhhtZxp9ZV4qM+PqkTGPEB5Yd0A1Qvk2dDnuY/DjBpz0PWQpDCSMzg5SKfKtgMooJlf3CBN2vXUUIenkagYBBjJyfCFSUDe3UHZJQel0Th1fkrQZhf4FYxedXwkKp9S5plSrdB95FOM0TQcBY0NjNK+RSRBuXEBk+y3Thk89dnUPzwpWhcCQczc03gtw0c9YYWat0fFIcpd+lbMH77NFnODn6p0KMDxJkdOU3Wmf3GGXVFv+s+yT+bLDQoEptvd3ng8zlo37k/3xoL+3MzzsHz6fDPp7NN07fD6gu4cTdr/14vlhQmmtTOhLSj9VJ9TqEaVmyif1hciI7kS+SSnQmqfaZZpF6VbgLq13om94HD4vtzfPVj2Z72kX7ruF+5QMWH248YMZXOwQ+FU8sg8or8fSsh3D9aRJo2GOKDsFGd9UqxMeaoW10MmLbJ/SvT49ONzv76X7kz7d2Rn39/b2JoeD8W6a7hyuiq5RfDpdyfN5d6WJ01pGXY3F3PCrp/C7551T6HqtDW8q4rtpgy+q5+8we940Zia9OyTqoditMSd54ypCJ7RXbvPUB9HVp+iD+BBk5QdCfgTR90HYT0U51uUYP0N4JCj/+LfVyBR+hDNgo0uCrij+uAsq8OLP/31PVvMxts1upMBC451WPApkF2sytmZhPTjdZenaXyFW3+elQkk+lPv++HsJfcVdsRNndUYXJqDfwBWsP6B84q//m4psW6oKWVKLsu25TjLhdm68xCnP/QU8eV1FOfz95fnrf/hOp7pK8XWCXW8l+LI7HNxdRyMNFpzE0CWAZUjNBj7hfKii0NyFzpOkymJM+GfYa5uvqItWc8FrOSZG+aE77zX9BVi1xBrDyKEFMBwgeAfXEYZKDZZOW1uZlKrrGK5HmC+2isKXrjwfaK23VC0tzxQ5NZb3E/ILUxguD92N2McZLTVcHuauFgvKgLoSa5Wl4CDncR6oq918y3pwkwq9AbIeybhiqZFqaVX3VC0LEwdWoOxhPTLjWcZED9Iy8F8p8mXPKY49slDcdFzcbf59wz+70SMb+LTvE7BKXprM2LXmU4HJ5Bmf2gOG5lalN7NVHK2P70qEnaNJmKwKjOdTNMTcBcTdDUjieLaAhfZX814Aul5twe4AczsM6RvHgjfKPqkg3MX1MKn8ZkibCtyOW9QZ3dk/eCTpMRXqE6byCupfFLDK4e7RzwDZq2iptg7tTSuJHss09hMX0/WpJZuNpnmr8kmUaxEyxkCmR8Vb51SUE5qGegG0uvS9ZSKTKql5JoNhHNsCx4Xlqh/fXkJniK7OMfPEzsmSj0WawIXgY0m93kT9+6/RaincBEHpJvmkxBY7uZxO7RYHsSenihYznvqKS8HhEY8Kmb6NYDqjSm38fOQVo7eMlKJy0nHfLAZfrV7xRkQ1fuVtoZqUwqWpt1cMuplcv39z9e795dXZ6fW7t2+vHrtkJZZObhesfBJH2CUOXwtbgIxLFGVNxEJYATmRqpC19JqHYmYYna9509spnnLnw3hSua3tgjP8fnfaYlJt9DDoAzf82Z9++evfDl8fHv/5saT1DuHPUP5O7X6C5MNaPmhgDjwU7EYIgS2YYwSnZfuI2BnsDPsD+9/VcOdoODjaHayeE9DEz+7PlVTbe068zUsjfSxHLCM69j32cY645M/1miB3yQvX/9n3JZZzPDggsgXSOqNk4NotArQIql0lWDVDylxXoSO3LF9ipQxUQFDAtVW8zzmbQSh+Jpm7NQu8epxyA3U8Ix3Dl0bwxT8i/ZmRMdZKd4kM0YJ0inVaW4tPyOwH0qkrB/thxhUYkL7pBlpDq9pTkPqEzFZ7v25NpVGe0VOZf5XF5IxVrIyB1YG6DUL8LfTsh2HcAqJpVRZw/zea26lG7qqA273CNBkBFlGok8vKxoR7yyam0r/toz2iuUjDcP4WwsPtdynUlmzkEcc1sp688QMMHnzB9WDCAFDLJMhoHURvDa4LSj9+nILgzKBcguiK28rHNeMyxW+j4G1o6e2uq6IrpBaG2zM5Z9s095QPmNrhrnGYz0W2k7lPFdjq2Hr8HmzrF1ogmP1ZXmmZwkeSdqY9RXnuRcFUSjXDA6B27QuHax4CSeIG7atKJZZPkn+NDlAWk2+9C5TF4ZvsBAWA/zt3g8onydfaEcrC9i/SFSpC5avvDBXB+rV3h4pA/RY6REXgfktdomKwv9FOUREKX3m3qAjSr71jlAX1a+0aFfdRWgG4f+fOUbUXv7HuUTXYv6UOUjXAv+IuUjU4v9pOUjUov41uUt0gf70dpWrwfrVdpWpQfiudpTqB/nq7S8X9lr7Q0fqtdpiqvfgNdJmqwfsVd5oCOL/xblMWh6+841Qc1WyYWKelCjdEYZYeYR/TvMz8pWPOKHzO5D0FRoJLGy74Z1RH6RN+YE2e+eB7Q1Uy/W2rB37uMCbMBhUZRezMDhn0zzamv230wJu9gSNsdOSJF07+hqhUqW46whqeMB4FpnCF/n1kClxXNeNK44DUwLIB/bcCbeseFPlytzZ+6BBSAFdyzYlao4dB3SzExdnSfEGXGhaIGru0jtowjQ85hiGtLQjcAE1tmo1Y4MS70XDlDCFhdTzeXL287Pk61IQKmsupLF2qCTnOIZPFMHREXRrF6Jw8Oz693OqFOsRuW4RRXS1GeBR6w4QrlH+WUIYlz1lG/s/T46vjhPxNCpacVwEZWHlsLl3Ccy0X3tfmMNKFjobydZlciFzSLK73DE4RwQzU3D4+vYRLNl/Lo6K6u2uTan5ERidHHwpqZh+M/GBhBu067IojLefsOjDpCCkwanwbRnZ3elU1Gr9RqsoL1VsJtm2pTzhqFriL3rRiKYai9VLz4eoBiEepuKPMc2KRdp1jEvt51MNr1fgqChivu1RvvIiRdfgJaTldW6jPheJzqpYYJw15ij+fn27de6+6ORwMhvXb3yrKet0QxrFWndC1b0PtIZXMs/01wff6dB+naE+qZ3S4plkvfzke3jNtFQu7hol39g/umXp/uIq/55FT7w937pxaZ4ytiwkvL0/Pzi6iqVfYtFysr9HDuR27Sn/1ag2eHpXm4tNEmjt4Z/9g93C3vofnfM7Wed36+vz1GXqyfQBEHB2Itma8s4lU/miUk5o3gpASGsj4NMjFYpFwKmgi1XQby3mAwbE9ZxmnffDzxp+TjzMzz/9+fvzmODrcJjzlNEev8D96LqrBX7km5C9WI+yoS29VAbxmGOesV0tvxlYJoY5shHrod7QiK83Xx0mvLSPFZOeCyNTQvOIu2pn0tzk42Bs0WOgzg6Y6YqZCsBOFsqQQ3Vbf/GvUgt80Dht3yIc+rZV14WsHY2SeiwNqkcxbCk1tXi7E2uI0MDXMTrAJCreK/aD3nJpWt3k6kL5wc9aXXlOLA+d6jeULpl1HVFbNfMuiaKeHRWVt37XiBfsSsUYnF+/rcUaGqikzVRpmZ6zR6oFGBWScF1SsK6QODROo3g7TtNS/nk8fhFhGF9bSx3jQBl6fFX5fsCQCbN3YRt8+EtkLWsUtrIKcHX7NsQMBu9v6PfEDMTtIdpMXB4NBMny+N9x/AIp8XqzRM7Z5jM4wh5S7xYb65uTiDHeata4dFKTfh4548FjcloPYXxrF3aMeGhjEzRmWoSB0YiBJHCnmSlko12oxlRnDCvmVNFNU6JBdpLG4qu/Z4PsvLFzbAyqmvm6aosE1A9BjdmY9hFw59YiammI24YqxJZamGOdyuo21nvtWtbCyaXtnMNzbHgy3wU/BxbTvQs/6SJy+y1VMrM7WtqcH6cHhYDfdYy92dob2Q5bS/RcHu5RmuwdZNnkAg/iIlmvYDGtUK8JO+BxpdnlxfP7mKjn769kDUHRpNuvGy03zOfhtBHH94ePxmfdnwee3oYDrJabcrkqAh9+AdbiU7SB2W4NBUnMQRsHNqCSgkwgrFXFNNuyfG20WHh7sHu7VAMVj+vqbVsGuUNUAJQxKHy3nUJnnizXDh9UCo+sZ8l7GFRRUcJBstXguVD8IpZDWWu0DKuScn5Jn78HjpqrKnVHW3bPLhjsOdflVnHIf9wcvEurc0vwWRdrab7VcTmQ0rwu5enZ5/GYrQZsKjOxQFqArSZSWZoYVQanIaqlIsKTj0lTOb3fZS84v/E050z1y+uaSxBgT8gw6kfA8S6nKtHPLsznlefVem7A/JgzbHiSpXPmeFmgPPZxVgnCu80DxxHd1pEDsPjt5A3xjgYA84IiEgbgtbF37dPDykV/4dEaOtS4VFSkjl0zdMkVOjh9HhFKYtaXeVASAWcizky3sWNrE7/3lY4CPSh2wbJ0LeRpP5Nbx9DHrePLH95c98vaPfj3PRdojb9//0WpkUbGwHjl588d71jxsnc9a+1ymNG+Vc33yxffTeHnzaqulNFn2sJLiz5wtHoOJVFMqXL29NWMTT6XJs7efsZnPRfq5yNL8uhR8XYpjF840J3ZGi/r7R+DeYPTH4K8NhRyqa1Ba11dbPRyddj4sho3zhYPzqkcuQXW5aLH0Cc35RCrB6YNQFNJcg/G4Ak53eWuv+BysPbQam9nb0AEJdGkwRYXmGVNY3Iu3M9x3BjuD/uB5f3hABrtHw/2j3Rf/MRgcDQYPxgpbPK0TLayZuwJKwxf9wSGgNDzaGxzt7D8CJShhnF7fsOXaKwMdt4oB+eIEWO4BILEjt1B9d/mwcyFCKi3V7bo21hVWMbxlUWgVIyzP7QOp+6lCKyovBImr4fDjOiqU5O9zWkQQXJtif2f4WEqwj4UU7KHZRo18QRwiLGDGwHXdWL5Qp2MFrA7293efe6qv2inrEdh/pm0O9e2tZe4spWhVdUFTtNi5aav3O4O9lUtTAsyaKU7z61p0/1Mzrmsri1NV5fp1WXFx9ykITVBCFfh0GTVnnMQNkGHtixl19fB7hMdBrugg9AFeEkyt3Goh1l4KWdhh6HRGIUtVtam7v//yp59enDw/Pfvp5eDF4eDF6XDn5OT4YdIiVLhYuwSMgqsmlpBxyaVQZiOSEn9hVSdwvJMORMGjewI9vbggP0vyioopOYFqTC7oc5mQS8aCt3TKzawcg6N0KnMqpttTuT3O5Xh7KofJcG9bq3QbyzltW8LAP8lU/uHV7u7z/qvd/d0W/TFYo/9Q+eyM+N/HctXBdPVgNLHCyNlkmssxzYOWJ9jKFx4NJH8Py/QzDVMP/NdgmbaqkzkXEPb1u8M0vbz6Y6W69sirP15SQV5ao5PrVEama8+aLwkYqk+77l+NVVrD/FGo/N5m6V0btbaEn43ZV2CDNhB9GC7/yvaku9Ndr1oUJRjbSZ2e0uK63fshDzGrDDebq+v8s/vznrLOPzPpixan0N1HqaWLiYcyjbQK9oIKOBZWxbCiFgSJe0hrrQtAGZ8yGV6J6z/6DkIMW/ljxDZLZ6AgVo0ZLWTnF17bk8rdHqu+Losi56Fk12eVyudmua5KiideQLbvOaUwitF6X0VsEcGEuU5bgXFPAs/VQvZddaO0FWgZZt/U3TC/WVnbqhBZE2Hf1EpTusnaAEtlZuQYbAHaABDUlmuu5bpofeI0o/PLt0DstsJw3AnSuljRgdO5sidU0EZVMb9tPwHKlMnruJhIXWJLMeWmzLBmZE4N/NG+ivpvspFLsXFE+s93k4Ph3uHuoEc2cmo2jsjefrI/2H8xPCT/U78GXGeW0HsrY3zaYyNqiQbS9HydOWyKIydkqqgoc1pr3W5mbGllKkNpGl2tn3jDtNEjliuUvil0RtM9vCPNpVTOZu4Fs7fdSRTBy6vkZVRXeyDn8KSsZ4ZVGTHoXuHCGt5yDuI9kt/tC/6x1EaKfpbW1qWQ2tB8Xbtq8wKGR/HVTNmCtfDg1gpzQt+FRtOiqKFyaIk6ZuRGyIVr2WJRgYmkIn87v4gNHGyhWFWBX/CM5Us8yLxNBE1/4GObdi/2Bnsre0wVm1olZI3C6h3McJ+s6v/ppAumNUkrB0+nsPpTycasznPdLd2e5sh0nR3Jb64tWMxkvaCpnB+/OY6e6wTcHUTbx2oKRy7d/qlkQurrY67YJ1ritjOSvH4Xvri/bxGmGTk1z0qjju6F8IyumhI0aho+bYuiTM4pX1uabKwghMB1+AsJAU1C58z1Fo27t9faLQvy6vT4wu7/Y2wCXxXDRPjjdLiQILOu6BrnP+V1d16FlMQMGcyO2Q5dKb7UsRnTHABKfqjnMsV8+4v/+x7DxLd08GxbcWrUepSbBdfuueDDjFuQ4onaCO2EJn7Bm6m8o86Owlx3GPL6dL8HCWlbBEvyMKcSJOQ4yzxQk9AIBsNT3RDjJcnlAlzKPjC/DiKe+NR7WLGOAjYO1qygCsoTupFp/fR6pgW9wZ5qPYLNkWd093p/uLMVEKxyvqtzTjMT0pPbSMPDUVnqEjrz3AazlxIFobNWz2EC+s1isCA5AxWjH6xEN6CXjf+kuy4oGCkQpDL0mMuqxC4EEbJ7wy3l0pma5JnJ0VNfsB5RzE6G9a63nsAI/NJplF8+g/L3SZ78ffImv5KUySD6pKtY7kWf//veVlvQ16rZagtvrnO3P63Y4EIbKqJ2x2cnl/Bu8qOXUJ1daK2+3G5NBZNKUW0/r8NAK6oZLQomWAY+NlB1q2CCOaO6VFiLbkE1NJEUCeDqwiLrKUgzqrIFVawXauvMsYKw7pFTmd5gdIWhXIAJZDf+f5ZjSOeHLshZKMz4Ofv+7mSlJ1Eeq5BuV0cinq+rGvL1QT1iOi3KpNR0usqRDf3ks+u7u9RfMGVNSkifgjMAVw8iWkLjd3cPW7Vdt09D9/ia5cMNngau9za2ro/UqMuU5hbvCbXakqVQra99pGUdwR+UmIWEeYAHe/GtbsW/0Gnnlik69YpGZWa713UvdCMfAKTDYHxphOk6hmlVEyzj+iZRjGZJnLH72Ct9I011Ae6zgMmzKS2nbAs6ddnDM2VaT0prmD+j06li06i7AEG60zwH0PSWq3AfyrJgzziSyjx/YMU9QBV7g60fVzuPYeL3RPfL2SFoMchJJDa9lPfGyF2WSFVNHndGGm8JO8jmpr7LRgkjSkXeMPPT+dvLmvUCM2Gl2PbYFdDRTGFEsI5c8ovqKFL/9s3V28u3qy7FlMnkK3LHAzj/Ki75OjJfqVsegfzqXPMxWF+Je96C9NW76C2Q3930X6eb3q7Nd1f9k7vqLVm/Rnd9BNfX4bK3AP3ru+3rToA1UX7zFzd2rKVFm+rcOAOvyinUZDFzUnHkIRuBP9DuFcVMqYT2/mTQUZ11/glX99Pg4/zcqBvHDcSOdaAjmq3GF0ks4ZWelY2+z3q4xpgzKriYTsrcSs2lLBVh4pYrCeWUouHP/JK7CHuFMefO2hyNGTVYca9JheITVOBFF57gG+FFM0kz+CRpui5mIa+PT+JpAwUs4kIaV7Mda1eBoHz38oQ8H+ztQO/jcjqFWsVH5IymMyJTwwx55tqY9chhf8yrxGpr721ht0un2Tovw0KSv4eo63+QGftIM5byOc2xCaAmU37rfeewppUhg3yOE1No5lYK15KZC8OmTCXkEk1KfusexGsv51t3nXnDiLNlMWMdh+fm3zcGg/5g0N8/g393+zu7Gz3S+nLPN8i++57laZbvzb37HOK3XNow7PBod0e7+r3gH51LyustYHj/WtIcSlGFMSM7Ebx+FDUg5+qv/EWltiSHdAWr3ClilzKDfk3W1K0vn5H2+cYmcq37EzaFmuBP4Xq4y+kAV0iyBE8nzXM/NbAONFFpdfIGUfRkLocGqgVNb9hKJcJXQ9aN99Why8X6llaxlEEooUf6K8F13Wsb8P6d8JU6mdA5z9cVbv72kuD45JnX2RTLZtT0SMbGnIoemSjGxjrrkQU6yNoFMPDJFtxlnj8d1F+4DEnrZgEldL0SXKhI5XxL3a4vmloqv5b/pLettb1hSrAnpNL9OOBsAWww7BRduEYNLcj3kr1k0B8Od/ruProJ/dP6Hr6OFY4rMjpC3bWkf23Sw0eEfKn19PO5vZsyYaTukXJcClPet1+pWvDWfl1jTZ3N9xql4cjNM3LeBuhPbdhUKv4bPiGbSHJhZKWYVsbmWEmagUnFFFRgBTnGG8WV/OOakYnMc7mwIzsDpl5UlTzz8SRs64jkWHx+TlOgqOAfq5zIRavt7DmC9PbSWj+bm9DTA+/nwBnjTCkXh5FzvH9j9fbj9olxpcOFq+SEXOSMaigkSUoNThl71siC+T4lkOKJU52dXPYsVQslC6kZ4SbyibnC9W0tHNB8wJG03op/LT5fVWANB8lwLxnWoG1z9dPYCVeut17DRngpFTnJZZmFWxt/oYQZGXCV71r5QkWinN8wMjI7yZxlvJyPEstMt/OK29pXRuHevoetacIdlq/gF2eCVMZ5GLHLSK/bCmWxYkXeu5SqS5ZKkelKIZpRTcaMCYJRa/Vl293Zj8M5jKlFYP5ydXUBf98dzvHSx6+FpBn7Enbsh/zmIH9KlXvZo5kJTSQ8UtbSUrkXMYr9WjL9BLGYfqCxzJaPUc8/2VvrMq4u1wCfwKxNoh8ePr8bRFc9eQUgfVms3+cMv3KWNS73vfj+wvJckoVUrtlDC+81rMoVXM3r+9bmmQUWHOjY87LjtB7u7XYv1driYDePnb+vGQoLXbBqtAbHvnIhhLmcah8dEtYyzTk0ELE4aigHBeVNoaQo9W2hwtN2RXlWhUmipMPrGCKk6GtDRUZVhmAg0Sp/8+iv/XcIWf/8tGoUIpX95cQByqWwv3ZUVNzZZXv7B8/77PDFuD/cyXb7dG//oL+3c3Aw3Bs+33tAQItfpDkzM7m2haqtBU51Xzd8xcBzxY09jyAqNvRyCX15Mfy8Hh0x+vnsalQdSaMpM74rys/sagSOP2sdN4vbeE2rfsfUJvzF28urbuqtubnA5mvuygoGtdLux6b8jygaoqbmJYTy5ct64N+YavQW+JM6ahgXC6iKmlbxc8+PTvCF/hXoyK4NLjmR84Iqb3XOY5BpGNSqf5HSEGbb3NQkHtaN6hWSGcsL57nPmGGp6wmhGDWahNBrQuZcp1JM+BS6T7lN3V5JPqdTtj3lKxfQ9TRWbMKUWlsO8Ds3fMWK8dZpyVxfW2Ocy2lcD2y7AbsupNDsi5/rOO2qB3sM5Ld6st+H8d1Hu8f8S5/tDtrHHe4O6N9b9Dkwnk72RUv4hMLPjdoh/fCXx4i/mqwLozrl5UlkniOuNtSUuiOK4fNbrNb3DU7UHcywN6jHRK/XuAe47nKsDcF4rxohOR97bA2e1768PycvDBDn5fkaZ4qlUlnFEi4SsKYvfqzPS2rmNBToVsw1hx8vsU0ssoZLa5pwxRY0z3tEyRJaf+SS2s2RU5EytRVGrbbJx7BNwlgzKjLwINFw55BKIdz1ASHn7nWq7VZwY1Jilbo8GqYiAQLnx9JMaKngVoPoggpocbiFezqGw1+0dJCiI+3h8y1lmnO6rlrTgXVwFrzrqFaySu3rdQSD+VWtvCiWZee+zRHquEBiDjpwj8jSuA+KZPPfrHUEkWjVkgg673JjuRdXlSZrMwMrep2fNolVY/uKWpdvXl+09g8h56cdJ9/KptQaQxzP47Vgd3NEuyWTmX0C/qrQwzSWX6/cn/fkJp220obAJrMnWS6nUzihWDqjguu5ZS7/JZjUFvqoRgwY5VWqkhWA1Wp9Ml2pNZ0b18vQ1JoJENq6bdVqP3+Uxly3I/VS53IaJhqz6EiD/EwysuDiY8mPoxoi/q2qM5p0fkxInnI9YusYWvXCIsGyePwfgy07Lg1R1DlNyQhh/nEE6ZTCeVPPTi4d+Z4gISr0/1yXVtdqYWUJDg0AwOpBWqWW2a3p37jRDBv2vh5XK/W2qrb+pOIGsqBabG4azMDBjJMAX49kEtYrdFW9z2OwfUvVdi6n25NSQCcQnfiNtoJEibvbPOmtwFvvRbFYhXhovwz1Ak6BNo5zZUwpd3ugHYHcUApMLWiUz26ZgoBm0yg5C6e3cLnJUwmJgcj2MAheMMC+cfNmkuGq4MZa2rcrBX0pS/DGFaWJd1vY61YqeWAI9D5EReMSt7r/aSvOXZNz5lcSRdJoQZUY9ciIKWX/h8M/la5B8w5vHVPK+SciUTttehCeLNo1DgDFidxJb89C18oRdTNfJrfUJQiheGPFo6Q51T6AiwtuuEsRrGYA3cFZKpSkpTZy3h1lINXU95vA/kjJWEqjjaJF8pP/VCMWOvygg1eS82aQ8INDaOwQURxNrREj5cLbb47nIFwCMXeOxzgXrbFfGqju7dyJxzqTIZo88FTYhe+7Kgr4q+NQTMT1f69JdowLBDd8avC9arLuV+y4IBOqftTtDRb4JvknvaWdRC9FusbikS2Su+nsrkBnd4vKn+Ad7gtBhlR2EFMrwI+94++C0km7OTMUclZiWe7SVKIzEKXnnJuYLW45dcOEG3/NGHn38kST/b2dPYv07vBgL+mAP5nQlOfcLJN1uBI2Iwxd5WfiJ2wdb4AtvaU8p+M8VgSOU2tvw06REVbW7rZo3ZGRTIUPOK7SjsOQ9t2d3Tbj7uzeS6M1SomIUvak7qNHbGViNfCAFKbnXbgUiku1WhHahy11Y5n9PG2GfuQSs2pIrskh+bEizn8EZSEJI8JRGgqZ2/cV9Gkg7GPBUnfX7wOyqeOeRn76i2HHTd/ufhdZAwAP30af3DFBSVp5x9RUZ3e0QIF6aGwYCYxYW6wq9zQnriQNUKnprDo/vdzqxYqh1exawLudOZWW8M5e8j+OkntBt3omHGZez7TAasNFaiJ11uqbVuORBSp+eQV3Kgu0yRu6ZScorSXvlAlhwdetOfzezBAmrGcKrMQE4J+8gwMiu+J3XPwIita6nzkzoRFBHvtk3kRffaJcVoj/rhWOQUfufF4KZwSgBS5vmXIaCq2q1EA4gh8nLvyia+4OH+n+mDIzfnQfAOWGbSaJUuHUpCco9FIZQOvaRtBJP6qVHE2JXRDVLU9Bsw1RK87JEC858pJ3pAfaPUM+3c6YNuT8QvfAIa57cYV6DebYgivf6mKrEaWHKrvTt5ErIiDtVqrg3AwlB8IYNVdZOyajQkvXFjnCZsx8TEelI1UEW7BxRSVLyhFcc486Ruq5uMQJTdlYyptRHAowMguruqpRI9QE0Y9uCMcsrnxjZKjYhlnHv5ZMLbmYtvcs5fMad3Vc4Lbs+odc3m7i7S3e2mJzY6YUOvrH0m4pKPHRiE06n5ARsgneKI8wAsayjDU+rO3vv1cuebpHRn4fu59Qi+EVNXU57zisDg5rBHDCxSyv1xn05TtRe1eqgKx/j5zdFlggDncG1WTB8tzJv4BPoaSRqcyrNPq6aIzaCBEjZd6nUyG1sYeiD+0y0vN6Jf8neT3kurtzdNQLxTJIzqczsx2I1+cZFNHr0AePZm//Q7/Z++U/Xv+8//q/tg9n5+qvF7+me3/702+DP9aWIrDGGvxMG6d+cK8Y+K1pFJ1MeJp8EO985xgWwquoYkcfBPkQiPOB/OgvNj8IQn50N5v4mYuxLEWGf8jSRH9x14nZvfTR/xWPTH4kpQDm/iA+CBDKc1oUVvCAmNLesWsPPGcAzaXgRipfcoV9NL14yA6PbxWYBiVxNIEKG5Yqt5wteq6mY8hc1eTDhkd4Ix5aKvJhw2G/kdwLrye1VKRgis+ZYaoFfzy2R+V++GuAN5c1TFSjRydyuEwbPfJhIywa/BUWbcNh65ctIkTyQVTupdorzsGUKqlh1gARgSmgaTyG+nGNbqgYUugVhrU3GgqQN8LMQsISalA53KV3mCRBrxfNtawNi2BWmITJazO6TdExl89Njwf1o/mLkQiIqyrGPoqod5khkzKHb88vL+wBHg/554s34UQN8f7JRtvrBLSsiZGJVAuqMpZdf052e9W6GO9gIidk9JO7FSiU/NiOnhq+2EmGyTCpe1U5FXS9XTmgNMSFPyzeoI3/zAvyxWKRWBgSqabbVGs+hTwFve2Plz4C1/4i+Tgz83yrMkcu3bECSkjumpz4t7RbfJrzqXAHGujGb5h5mcsFhi3DJ5ddEMaFaGfU7kuXXtCFU7s1Xp3QQqxE4rv9j29CSqxgKr7opVnmTmCX+GM536sjtzkV7uHYWVztLYifEUzNLZ/9+dXxG+SwX/tc9H/FLwzF62GuiSs9kJDj3Cp5UV05hMffHdppE54BWeGzu2QE2COYGve4VpcIQwIcmonMXXqDDIBFgyBFu3MPBzvJ8FfCREoLXeYuRMPISMxjBEzDEv4bYzc98heumJ5RdZNsBYJ/KgjDIpA47Na0Y4Dm7VCMWrhOa3evHGURYbBGZ8hbZ9kjMncFXdyJzgNDY9aICNSJmPJbJlwyHhaUhowiZzpUdaz8pmui8zNEbv+FT3gN7M6k9vsMni7jxmeyP8a8ce92GDjVLx0mjv8xDOmNnW4jZ6ced+hF8hr06k0XLff28py8lhnLUVNbxFl1/z97b9/cRo7kCf9/nwKhvTjZ/ZAlUm+2FDFxR0tyWzGyLItyd2+PNiiwCiQxKgJsACWJvbHf/QkkXgrFKskUyXLLbs7tzcgSCWQmgERmIvOXnSCccIanRHiComoPHbznmwa6SZi8gdDAjZSj+pxiXxfn067dG3wdEuvao+kBF3KDoAFpM5RJRXASGKv/NPOEp85DyeS5QSnI9lWWTBpIxZMGopO7/SaNx5MGIiqOXtclPxXPiK+mytA59pq9ss0+i7QEdo0cgsarE0niBprQMYilLqHoqQtS+Z6vsB/h8vIv7HYU+LSNU38Kf/cUuHmQnDmLcA7RQOyBTBr6tspM9J2LijBuQsC7yTtDKxKrhhvfJKiYLMCvjtgsWtDW+9ZXjMFHkcXedr7m2We+OExzMyhmMTGQSZZVcPp8oXEpg19xJDI2vwCQ5AOlp4sccNksxrp7N5ENiK1q/wq8ZcqUyKQNL5uLdmsigF8Y1wFDOVM0Dy/YgY1taocNSQpmhDyDlEuwvUtDa6l2Lj76ooX/lSsPvz+DlwWcpk88LFgd7pKj6QBh5ms4QOqGT+n3hXQ5oWZvyNzufkLewIUd1WR4CBpH6CORABH3R0YyMzA6uToDiH7oWi59pHEiOCCS5aEdP4zv9CGIiXfklYpOHpAod3LUXcFrCAlz5hfz6txZtyBWaMSNG5Xn30OEPkgmNx60Fg8gmfgLQ2tFsyEAgzEcQnGThEYHU1f84AKNCHVNKQEW40IEzI/ram5nXaqZogL3bgWlBdpRni0tQAG+RwgGYgmZV/lbsC8vkGhdSvBsf6kkwx++tqDE8fdZbFBi6Hs240IWvnNrrsRUGY53dREJq4UdIq97JfAhsie4e0wHQ9lF/gKIBYHcxuJdYXtkndpHhQY6sZH2/A46/vh7A324bKAzMtSf0I7erEAvsn5K454ZZv7m7OumBuumBuumBuumBuumBuumBuumBuumBuumBvPhMMz0NCjaufmj4AojGc7frz2U4QML32ssw6Fbr4MZy+AilIT4w0czyix/7+EMx9H3HM8o8PDDBDQcV98wokFZzMdh0s9iEY0cKQKbUWduC6utStEMiGL4Qb8SzTj++PvcklwsATBP8Muhx6pv8Zo63RSa3JQp8JJaN72Z2RsrbHqzOr/4KEcXeHItXe4+fBCWxwIUmGQoxcMbz6XWFsHWghzb3GwY5Nl7/pXTvzzqucaQTOEhvLQvyxAXQ8zon7Mu4ekAMR4CJkCeMyEJSULodUtXSgYKkfFEVThy7R6kzXZ/LizEui2H/cPLaNqwbsuxbsuxbsuxbsuxbsvxvbTlmAieZPE8OMSLBvLsDI8YNDMkym2L7O7xAYigOK23AsYFxuxkNuxVNN1ra18yKmL/5m7TiJgXCsjAG7t64qI5L2x7UDQRxD2luMqafKTphMioCu3L1T6JEFPeGX0A/ZVI+J8J/A8YYPADT1MCAGEmOqd/ypPcKmr/C8GpHN+WJbwOlLBfYOD5Nlx3OsZMzYS3K8/vSkjzWy24O3Msp3hEpIL6Bfiuyzad/f1X+6/YVMCggkgQ42y7QinfuqYwq9NxY8ygF6xAOFYom7g7fjlgAtqrVy92Lk69KjQF+B4eEwsxRc5swWHVsN8Id+0+Ubg9r5aP00wqIuoMIxXe4+10zyUvE3VZz18uzxxxWvBO1HbrLENzvbvkKnRMFhMuPPBO8mhxHQsP4yPobqvPa75voZYH30KJcIrjskN1m/VJ01gGc5ssgfNe1x0bTIHwYGBAo2ys0MBqvBpwYbycpiIMM1XWiAhyslU2qbDaLerivDw7jfitTrCbz6omDPznqjWsjyFSNSc8acZJfD+32en5qdFPcb3W5ufFFmXPy0SNtIe3qDvxxvIyFaf62AhGAF2G8TFOIGyR8iwZ8IwlYlrecflXnubv0WPmL/iZ338F+ygcx5UOECa0ngCL0ThVwZtsDkgU8/EEMxct48JmrhSszZlsjxAMSXoE/xFJJwAThYXAzBgLA5pqocI40M3NBQcpS8gDOGUMPugCjJ6MnJ9VYDvX9jz1pbIZl5pZkqh0WayOnG8dBAp3mgvf5Y5uYRN7r7Sb3z1Pt4X45PC8PXJg9aadjXksb4x+lzHjdcD4KwHj7zha/H1riRWHir/jOPE6SLwOEs9VIf3SI8QhBAYekvCmvwh+9eQFn1uLj9/vYB1KhdOUJL7Q183q6DtVbgSJQHtCllppKPe1PEPUKKLgWpH0z3BUyEX2Q1tCzJi25jYfCxJNoctLHJh5S4XERDyiisQqE3UpDbtWhalKq/7wdr+3X0Qf6Gc0TWqO12127FmqXE1QT5qK2WiN3y75MXe7xf8mwAfxmFBay1GFuh86psKAmUJ4AsBybogKAMjB7uANeXuQJPvtfuvg7dt+e5uQVqvVP3h7sL//dv/Nm3YrTuY9+PGIxLcyq+tuO7LDl4TlOASP5Y4IA6VadSXvv+3vbB8k+ODtwQ7Z2W0dHMRvkrc42Yv7B/HBbvF5Jpi8Jo6Oi5UhgJ9W1A6e8k8Twjwks+BDgcfwbpJiNswgKsntlpKQHLslSEpxPyVbZDCgMc2r3lGOOVD0LI04ezLmtd3zpyyBpWFDNOL3IcPQssCvqK32yyQRTShHaaBhyvs4LcnF/LqKETKPp5xgVWn2XWmFCBhglfQVJZfSmDBZm210Zoa3zaXymGlImTvsgZ7QJhXWxoNQ9q4AmRoLw4wYOvuCj1H34vg35KY7o1JpEYjQ5pCS9lOSQ+jJSfIA8Hl2SLn1uqxnOhMcj4gfeDtq1egfVF4RwRT5zuFFw7y+flkXWI2MJAvrRksbKuw9lUmxBVt/64ikKRZbQ77Vjtrb0cFsf2BAX68tYP+BjzXJJgrmJwufSLxlA/Yrlbmp4ruVoicaUHhYXa51md5M89432uCZg+tnNadwO6bQdLd8j2xv77S/mXPkQtNlWwASH61/4OzQcIuZnmzTCWm4DnRqhIsfMY9a+RMExCU8GM0hEpNxAyWT22ED9QW5byCmfzEk4wZiGfz631iUz7yYzP1CU68l5ha0OEvYDXY7OgidgqI/cII+QC/fRTyCX40fiC64UHrro5MHEmfmx1cXJ699K5/vwtw+uvhSmAYpLIZE+TAxdHkqmd/7u3Nbj4XwfS2FJAyKPmGaQgaFaQ1owXUThBV8iqYEuv6VAzs0FlxrPXTExYSLIpTVV9is36r0rCZl8/KZnF7gsDr7K5zpsWt2qzxrM37TM9naj3aig/1WK2q/2W3vzcsfHU9GWNbWVDOHxgfnZgwI+Abb/uLE9mDrMEcFajahgSh8DAV0If0Xm3PuEhcGlA2JmAjKFOpTBnjb8DCN8EARAW2qtbh8nwvTlDTmCWmGbSyRBfp07qxEIwxFCnEmhLbajXFqYAjjEbydAXq+Eti7w0C9ibB9FWr//v4+GlBByJQA3n4/5cMtNRIEq6Ygpgnh1narvbvVam8pgeNbyobNMU61PdI0wmnqCSkbRiM1TssXVSvef9vaiXfJwfZ2W/+QxHjvYH8H42RnP0nm7rfu+mj04BjUXRKnBbmMButedE7Pr6KT307m5a/eZErPVFVG5TOZ2/D6+fqhc+JuYfh59iFv42nuA95jV6HsDIPgV08/ac8VKXRTVD9I6+PsH6WhByN0ArCoc8Xe8dBzxw2HaLIVbMWgG+7YAN5FpnLqxk0/ockN4gNFGJIKT6WLSZupEFWSpAOEmV9dzdWEGjWjP2j8cdefAB67DLl5XHk5O2dYV4XyZkcIPLW47SA8LIYZAMI3tDCE8vF6yFjqS55mirg+yLmKHBFEvKEXqLiPeKqVsskkMBKbCK6tKSgEp4reFcrNK2u6wC/sU7Yl5WijgTaaqf7vTBKh/7fdivT/a+/PFnVpufUASOJ5DtNMJIKwofJXlNszemxIlZjOeiaFAqigy4GDirVtMDTH+l/9LL4lCmGG06mkEnGGRvzeDznWZptfE3Sv/WmvFBQ3axQcJfQRbhP/hbGRP2Z+RGrDUcaQkJmc0JjyTPqeVuUleIY5m5CepEOGIS6d0CGRqofTIRdUjeqKkUIOjb3wkJ/MGwKansLazSyYQfvVC2Yapw0zKkfIc2EbQGDf3tVWLvqiOmiS7Yd0FdpalcMnBYA6WxWV940zssnJLUtdjvD23v6CoicPVH4V6LzPeUowq5LpO/OnsM0tHSCciyXsTVA6slqdbS5Iuf6JsmGN/Zj0dgmigPPuEyr9R10TpdlOZPq4ZgMMrqIpWc0DRSY7PuzASfJWxIKk5M52YelM9K766VMX0DDK+yLm40jPSaKHSRxBDv+iolZYZfVdLV97DQo6wCFDSrXInQZNuemKEYvpRPGhwJMRjU23cpnfUeGodzilSYgrpd12kUnl5tMm+B1BGcsBem1PYffV/CsuuTgf3w97jyXKGDwFkYqe+ieXl58ue1/Ory6/dK9OjnuXnz5dLbpkGcDB1AUb1DXDFyxRyNwxqmylQYEZzhTB45oPvZ5ilScfxoPnN6hPgVfI/MnbGPVRftDzK/h5B/7k84fffn/78W3nl0VFq28ohceTOYT72OPQsT5PmCXm2dz3RPKbw1wK+iCYB319lKDFD9yW5Stiu7Xdbrb0/121tw/brcOd1u+LXhlwPud6+nrixtvsKu6aS4Y6ouLcazefzgCl0cT4WPnXH/ues8m0PwcXB0kM9JIa0dyOKKTBACxSAUZcmxmcp64hlzbdSDo1r9HGADEKrmxOL3M3g1JcUszVlgXkK9MhVTgt2hjmaVtvpiGmTKqCywFxnanpF1do+V+p1nFhLb6is58rp/EYs6SX0rkwW+5pmsTYq7CVpPcVpfw+S1NHFdJUmY0C7oJt5m+V3WzOpvPx/KTW15vx8cyWxWmaOxuB/KE2seSFLOEFhi4gakIPTIG85zfvMpF0EH2DV4OPOB5pkRdeDqw6ODl7/8irwdv95vwPB5qT/lSRHhdJbXW076baMiN/ZPD6yQePE39GlUoJOmEJxXMbAJqHeJL1anxGPLr4UqjGfZSBU6Z8xHc+wgWBrdoLLsxF7tWTByVMvY3JvHDZBr7NpSZ3U+bPZi6fxDqmuVGhQKX1M5oqk3AN4cIkgmw7zDxO5wDfmgvEhoxNKwme97KYi3/yALH6OTgfpFgpwkhSxf6ZLW42w5EEEYNxZ2ryzLUI5c7PoW0Er5cR7tddofpL8UVG766w29U7cz3leG6vOu9OXy/CCiAw1sSEees1II+PnZPn0Kp3aU2kHmOFkXlLDgi18y5AKmFKTEM05pWlVFih5hOsQrIGCrTuLM6N1kN7Axxu3ybdEZ3bk88iW/Zwn9ZE7tfPm5P8GWXZA/rUXUTyNV5Rdqc8dUs9h9JvrvAWOH32nXdVd4cZbkV3h+sxOQdxjAReZ6lRGDwOuOcvW5NgDrCBacPxyDW0LK97EH8geqGsn5cXZ9jQq32qAsBcPbTBAXHIIf0pklm/OdOMFlIqGdFW/U3IcfTTzfOOt/9iPKLbiynQAgzMTOIzbco/MhtJ6eM+TamaQohe0H4Wis3SsdAywxXAJ/Nk4DyL/O4IM8YZssOjGKexbZebm3ZLET5IcW3Pgnojdu3ehFMGky1GZ41ZASUyw/rC51HpkP97fDCQpK4X/xLBZrYlSV48JRQSPeci1HdGCAtunkdvjXdoiVw91GJU3lGhMpz2LP7qqu3CEqV2Pof3uhzRdSQHP0rxAjthCOGgb3S1wmR/9dVqOF7garVffIY5sujBsZJzAvObaiF6az7kM7Q+/5iPsCBJL6V9AQmBNZHqLFMzHfLTmWckSBGgMmDoWTwokg5qzMl0wyM5Hfe5TV3Ux+r5XglbqhrJ9QwJYtUIvl71mNTeb7b2mts7V623h629w53d6O3ezvwPSgY1pcbnx8eRRqreHMlMswejxcxLJLTZhReRfgDzYqrkTANxGQID+lH5AN0DqHShvhsKdKDPkiOkmb/ffflyetxA3akcc+aS/9DPX06PZV73DX2CXRIvzJwBq+nUv5Wa3mm+qSw8k5a5PuJMKpHF8IqGbU5dOrXDhZIDlOyYjzVVE4FjRWMoBRxTRYfhs/zF6TESJJMA139P0hTKfoNHXOykGfsdxgFhkY5JA+FYcClnwW2Qa3Oipcelqnhji7fj3b295GBwcLDzZm/uUtL8cWV1u/AbI0d0ZhIEi4c3SBCckVj4vDMjE1rV7O95KXxX8HJFlXmtLmby5W3BYFspIsauKSOgmEVVDeJzYwH3ja0AY3r0znwyd8otIhpUI4aZtfo/8ChXUULY3nkz79bRBzAaJ3s1qa+Px3tmivKkcuQxFlc9a/dDp/3EtHlyXA0Tb+/tPzH1XnueeMeCU++1tx+dWiaEzJPFsdDU3eOTk4tg6jn23XcNc7PprjQTNvDf7/IxgZQZFNtSe1N7brOiBJJ0TNOqAsBZ7TXBQquQdQL38xK456nMyCW7TvH+lineVvDrTO+/LNO7egW+o4TvagbWed/15X0/IvF1+veLT/9+ZOV+nCzwagbXyeCrSwZ/RMI/Wk74I2yuU8NrSg2vlvc6Q/xr4lonin8HieJ2tX6cfPGAoe89bTxg5bvMHg/p/xsnkQdieKm55AGJP0hKeZmjF59ZXib5pSeYlyn+HvLMy1R/T+nmFdR/p1nnZU5eePJ5meCXnoMeUPxSU9EDEtcZ6YtK7HtLTK9i4XvKT6+i/wWnqVeR+2Kz1auI/T6S1p+k/OXmrleR/WJT2KuI/V4y2Z+i/eUmtBeoXue1Lyax7yG9vYrsF5zlHpL7nSe7B6x8NznvjubvJ/XdU7zOgF9nwP/FGfBuL77URPh6ct2fI5h1Nvz80vqmSfHPJOvbpc0/n7BvmFj/fOK+Yer9c4l7acn5lrgXmKP/jdLw55fRhHyLd/66u8nkzPxN+srkDP+4HWZyHn/0XjM5p+uuM+uuM/Pskx++/4zn9O/YiaYsh+Fc4YlnRYNPc6/a8gtNWoKKOpv46zy7PtHjay/6uYbYZJb6UrL+87o2+nY3pTXY3d7dfiZx4HbNIdxnxaxsFml9UStQUEm0+m1xBQOj0+NVyNZSWaN+suSGL4qeYDN7s/Vcoql62fEX7zcApTORCb0D4fcNE5IzjoSv18PS71GQGToKcht96d6hH3IQ9B/HqC/4vSQCSaJAm1FliXBRoHvSN+1j4bZmKp0iPiEsyCKfdxWyiab8ebu76DiSmLOkqMJGWKsxwlA2Ke2W9s72cw22ey60MdBLqCCx4mKFbsfqd43eHJZg5AmeLf2dFcrWiI/JFk5pTOaWzY/hUf59XMkf2of8GziPa68Rrb3GpzfID+8u/u39xJfoIHrivr3756Z+Sc6dN9/+QtdthoaX4Jh5kl6g2/XEyftxfDInlb/O43IUvHR/av7tsAJny1EnyJBKZWVh+1Ffhr97vCH1e2AXmQbSYG/Zy8YPoHeCcRcsOYb9xdo1Q4FlmJ28ckv0kyuUglnQvaBKEdsGu48l2d9FhMU80UZVfgTfc+EZF2XGG0hm8Uifwi5Rv2jz7+QBClcuyfBzRsTU/q5RBCOAVtdyYnY8z9OxoBjNpGjdpJOe/t1N5BE0+MQam/1MOZMhQFYiylm9d0S4CgtAjsizWX3dvtYDlyc/996dnncu/9NwThJnwZbsyd8/v8s6R63OL5/fXXU6nQ782/znH/PaGbDE5gb6GuTSTAV/cSGPDCyBqdrVy6gPihnX1Qt5oVx4hrFE2CULV30T5G/Xwi10BMsvKRsGaVz2834zwJTolRZm9/cGCPXkt4vO+XGv+/trs+5hso+ngarcueGM2HHtlLYOHLLe7ISwUfXoH7+cXZ3CXDC2Gy5NUT+n8g4LChWZKcC0mWFZNiaCxsBrvnP1mMe/fro8Nhv35OfeZ/2vAunBLgs2kccPSkhMxzhFgth8aeNzvSLREN1stDduKlKTNv+1cXR4LRS+FiTpKTW57lN2PZ7iySQiD+QZsHawscrZxatB9VGYJVgkxfU216jVFg4nQ85yaLbEvFyM6F0dDHT6fUHuKKwXOB4uyqXnK10jH/559nFegm/JtAZ6P9A70oRbh97ZLEE+gNT8ErHdT++vfu1cnlznTpFT1edX10fGYrGFj9enY23GvKcpQSeQZqg36CeYVF7fU6YJ1ftubq8Jq1EN7AOyiB47BA7RS9XQw8EJBR1dtXDXSwvEH/MKwVwfk342HAaVcV+RUEjnKkV0HrjPBpzQ3uWlDTIfxbmxBFqtaCvlv3rcVNoM8C0lUfqqHhOLTDXAsb6IsSJoQu+4yVIWPGMJwmhCCWB9OPq0HnN3F2C8wAfgEgjR4GwcTGrTGOCP2BRNUqw/SZm+YU6OujbzFF2FJNihTYRJU2J1wbiBpIIglbud+ADAZ2AKYxPYu5GKwHjJfUmLzcfQjZVidOM56WgFGQuifHa5ltDphat5ItKF2FyAjyVEQJJ0A/G+JOKOiIZLVc93hLJJtg0Up5Qw1UDuo/qUMKK0ER0NuLjHIiFJj04idDpAU54hPJkQi69zeuH0tuI59XRy04BPapKUNheM0EBiGA3pHWGaBSXoHcVpOm0gxrXlr02w+xHx25wqmAxDILE/zdFOg6kO2wfbUSvajtp7rjJoGVO6xnBuJ03N3YHliEizPTjTghJuw1mLy+AduWPRABl67ZJJ42wCclwuVzuqFvmIpBO9nSRVmQ3KglT1VJtCbxEJKGkjXESugjBsjnFKJXplkMCIIAMO39AbTatSuAw9AfOjgUDyfo3y1eObAngfsta/CioZqgV/Ys6WF0f4eXOVEPT+8/G5bKCEjzFlpsy+Ab6mtBab/ZXe5CnF8hm193SeJF7/oRLXVp+fXlQyV4w1yNpAmNz+BvyrmUWA31Utgs/N/4qs/D2T2VVyl4z79xM3jP6MPexQduPeQBzUG8SDbE2KKZVhU687EXfywkPtOGkCbKGjK9pBOCVCBdwybgBdgLHco7KbDKYICojsaOaJxPkHxpUKCLe78NDpZkdUMqYSnr60IS14qi8zpa872XAf1YTBKTg97m6dXnTzPwyoIPc4TfVGJn03ZIA0EnwgE6lFSZMNRFhisF8Somxhq1YV5mqTBL06Ob58jSTE0n3hElHxCjQ0ztSI17WHtXmkT/AQM/qnvSC5QBNJsoSz6dgdNUMEHHX4SWtYbhCpSFJQqrCGbsf5HQPavbDvQ9euq7BonnGRPMOPi7Eiw5VG7oqH201gxWKNRztUUHhJbGcbe085EXiZ6Lsq3zSuxq5aFB2lyHiina/TwII7I/h2bu+29gf2K/DgS2/rsOx2uZ0cqpl8l/L4FgnyR0akAktxkvVTGqPj866pjPtwdXXRRVvo6qwL2IQ85qmc+2qpq7yyY3g8PTbqi0pXNXhP1chUfCMZcwPzo23jIZiZ3ibN4zdObVZunGdtmHarPa9cUhoTJut6hAndLDuTtcyNDfW0ZvCiMVVr2iXCCUH4DtO0ssCvM8HxiKDtaO6Uu1ofoEjhlRb4hFCgg1Cd71ycfTr6Z+/4vNvTh6B3ddadlzdB4OEmrovBzUs3AfpyeaZXD38NeTxca7+6lbeB/6sWox5eW/TmrrUBVgP3vLkpUcLjLK9XLs4G7po+mZub+X5iXOW7qKGdiBBhEaOUslvgx6RdGAJT84hlRNB3vkl+yVkkLzCCypFKl7dBWHRPb+mEJBRHXAy39L+2FlpebYHVhj1zPrNzJVENNOEpjacNY7EYiwASEf2tq90tONnPuvtNyeuYjPs5lFgeoLPB096FVfm998b6mldOWfZCdD/EdbjwWQxeRnAlyPxOMM5TcBkYTIevXwdFhVm+Ftqtlvn/88qu3rS1KzjFJmNtCwlyR+Ws6dAnmmvYOxA1sZ1cyqxFX+HJJ2SAhEPXqZv/5gnnqWM/pxfZQbZgaV96IJCl/8YQ9k5FzBmzyzPwhrpxhZAgQywgLCsJuC2yEXzerH+fmodbo08HKb+HdzmR5J7Uey7Q1dGFHbVhocMcmYa2mNC7PIOGMqooTlH3P8/RBMe3RL2SDjHRDqoHzGkxjz5mL3qja3YmqyDTaUke/yvXAk4ukCiH7eAQobT+EcKxygzugiQW2V+M0YYfb0PrD7jVgmEdFWyGcGkg/+2frfdolbfW4grTVOaXhR3RkALY7WzoFjifIuTDhky6hQmMXw1c2BEDmHNwTv+dMbMp4OHLRB3tt6sGy0XLuCoNOQAVrJfRZCPOutpHZvgtx0Lxbc2EyXCSIEnGmCkam2eoB7hjMUPkwaQqNgpKnUoIrQ2yVH/sjmp26Z8kf4HWjBKhcCH25uKmws8x0A61G5MZFeouEhM4tU+eUtE0RcSE6wy2EkQMwNcOgrggsAFNU6+b8GQi+ERQrIhv6bCU0z03otdCBhWcBnMl2gXz4e0CVhQe9+kw45lMp2aXw3e89od3XOmLuFMqlV7N04sGwi5uByHojNEHJLnePxFC/5lLHKf3eCpNQL94leN7R5M7DzeR/cWNEVnRdmPausqfrpPMtVeAUHlEJzealJvIkHXTQAmZEHgVQNzaEoizICKpr9mZxCEsowLe4iK5QxYkx4yDcJpyT6UNdHDGxzyTVkUYuee/9gRaDWIHetXpnr8uwdJAkjGOR3lkyojSZHmSipt7r71/MMtzGJ55iVgD82crfQo4qc7Y+5nzYUrQ2dlRQQoVyT7z5HY+imD4DtJ6AEolwOKEk2g3glHY5QV6u1sMuMB2/gplC3fQCWLvOeIpUGk3Z2X7NdeuzgRBGYT4MBpk+nL5Q+twQHYKxjYxfJa/H+QOFB7bp78xtr118jkSIuhdAIHv0aWNZtOmDdFKRN9fgo9zo5UJGo+0I1d2nwacR/YfUWy/42Q9JDyKqZrW1ZTpiKpp9a78yJkSBKdlcjhTlBFWhRm0Epqu7nkzNclagO/jL3aXSwKzb8pqus878zoARWZqEvB5oduVnaxMNBdqhDqQfYQriMyYEtMelbwumR+ZKdBp9xMIvUThUedRsurampakylU+wgwnZUnBzVZy6krkDAnvhcD5ReRBzoZUZYkxwVKs4B/lGP5/o42Us41D1HyzE+23d9/utBpoI8Vq4xDt7kV7rb2D9lv0P5slImuMy21+kUQ0nSk1E7PGyImngbCJIhnDmg/QUGCWpViEfe3UiExRDCB22pMoYMpZk0cV44BUGCM5Jsy8IUEFR8pNal2fiBwJzHkruXFhyEtzYF0TK26g2OmoMHHxnAOEpf6gcarAB9E2yxhsmyHhjtuyxu1zqThrJnFpbSZcKpzWdco2L2B4o9awlDymxTxBT3Kh1VemTeTc2rdZKT6lRt9LLr53y/g9g1xOpFkxYGwC/X56gQKeEGxtMKXvsJiie5poCw5uNXuq4fHU/FiW38Fua3fuMLQWqyBDylmdCuwSZnhKfzU/Hz1GV00azNJUqcA+Z6RPyvtPezV/8tkuXqu5Vl25jR7fP1x4jeCyXU87553gc5XE24tqqyOGcC3jrXcZYVz2OlSQ+Z+tJl/hsjobIk+MmrEPX51e3O3q3X56cbf/upgTMcZxHef5Y+eompiZID/jNoBvrEpz0i7fH6E3rd1tQB/NhkNAcT5EJ9p54rEiCr2yodcGetvs09xE1Tb+a9Pj0ZpG9mn2nqN/ZZMJETGW5L/QiDxgl3oMXe4kGtI7F2sN8w+RI99MbJLBM2Z7FVOmyJCICHWzOCZS0jv7QeO6SzLBwnUJxH7E0XQyIhXat9VqtlrNvRP4753m9k5hpRhW0RK5MptXAjNpg1JQTxcGUfpYXxTnnSsfm7R4kdR6p/nlx9FE0Dutbo8//v46WM7ipQOqO+U4QX2cYhbDtRekVHCBBM/0bTjj2Gs+J3yuArpnFaqFAoAq4ZcrAhPde4aPWyxVNN9eyKMtFuyVl2HJIkor9lAdIDRbdUQESXpVvvSKG5vT4YhIFUzqZGTmbgAjkwlJPMlZ3/xppszHiq8RlIDAcNar1lbJxowvu6GV1Eb4i8e7p5vINWDDAiwjianUVoltfQ6RvpTe2nJRkz8hs8GAPvgR4TOvRkpNDre2zEfMJyIuhq8jdGVSSxU35tQDHfvHuv4USTqepFOk8G2+riYymGKpQLmmuE9SaSwnxhWkBhoEZM391dmx9PfoRsyj7HajrP4ec/W92OvcDX4S2PTeMXgiUOKy0gJDPU9HNCmA5CEmE+NQ+PCLTYUobhW73SOETpm2ULFQNHhOQCUKQHnYNq32bzZrzXsu4GJkqa2KjzHL3xJQcU81Au5tOwRZZqZPUn5fvcWrz0PxzIRy3bi/v48IlqriSGCpNvL27ae2E60ZYoRzdGzDqKlzcnPkxtqGzPrbkcz67cIUjcLuzWkrICtbEQRjbDTMYWMcKYFpqs/KhAjKK/rUagbmNfQUn/SAjW+g7shgQKA5sZ7V7hLL/StydXb8umF8Je8o5XL3QjM6o+HeGeH0673qNkpwOqKyZpyd1w8blBbrVYJ98H2rRFCHj2nDfCXm04vw+8K+ySQRUb1bJgzP5bXEPsU5SN5AfPDYfYgZOjvuXGhd1TEcH/uhwr2yWeaOjDFNa2Lui+YAJih2zykQoFXnikFLvvEDi2ZzU+Z3AMSYnsijS/tEKHRCmVTEbqyCROD19C/bdiaBpvZ9Z5isLXno8bYcNkHI5g/Bi86WS2ev2J6GzhojpuFKmMnKRNSJ/2QlBdoGinMg7iZM8VMh09BUVhm1xBBmnE3H9M8gFd2I0P/ziySDLNWH4Qa4oIl5joZ/aO5uvAkQczYwazWb3ciSCpNKe31Vm+qrUDSr2Up2tWDK2dPdbbabe83tdnO7tb27vXvQ3n7z9k1ze/9ge3f7YLe129ze2Wsf7O2/ebvfbLdarTITq4sFfmM92B1pt5NZGPuUDyl7UlQ4Io/qQMHT2oAmOq6AErYyzOSeIyDsaGl+tHLilvYxwz2cjCnbaKANQcDkZsOeHvCr5RRhspxDXgyy5dyvnqxmJe7bpdwrFf7NVI9AaCJPCQ/6TN9jiWKepiQGxCP72ytoo2YHhjq/Kc/QgLLEHEevHFI+lFYr+HY7bm6ogzbph/akDjhXjCtyiCrot8/nkqSDpukmZ/03+zkLchb9ZPAy7C8NLGT0U57w7OVgPq/Zt9iR9js+s8YlSkHpbszhyV3LK1NDbuAjTLFEA1LujCGZTt1++MDvCVSyKtcvRppQ0DzUjjPto3KlOdaaT6sztyLuIUZwrmaERFSeCWvzfQvLbjL8CvvK9rizya/mZSdvcSNzseQU65XQOsAD+RXlZYcNpebLSrhwKZizpNksBQPnYZlymajm21G++crE5ZJ7mjg9ZmkBHcWPElfcIJV0veC0to6N/pvqW3j9dHmX1G/I4kkHjTDr9AUIKOjL5Wlev+eeF17Ryd3uoYnoCvQvOrnb/y/452uT7yaISRz0wwI0xCuTASerWh+92Y6296NWtH24t7szN/o0YXdUcDYmc7WdX0imp3kmmSk28zNaMYdalkokMsaKqEQ2ngIIYO6DImOgfTzoVziwRK8s+qB5DFN4SNmwgT53GsFFfEdSPhlDjRNRcfS6UaJPe+2+DZpJLtKXLHZYNY6qPNZgz1iOS2YsZK8vtQoLyjVlEOrW3AWTl5c4Z2nu1Z2MyJgInNbYs+/EzVEy6oIT84oOAPWHPFCpt+/McaEJYtpKTdOphRyVrq+cIIAhKE3TvhsnYG3+JpxIrfnLknqLdwd7rdagIIxa7NmKloW+KAC2cb4lTgezOz3m44mgMjD6+cDgWzCeEJtwUWA51yt+y4DJACGbhMgKwdqvlPoNhsRY0K0xvtVXukITLiXtG1w8b6HkQShtqeiNPCZK0NhYLYDpNGO3FFEitMkEMeI4S7EAev2QZEwV5LDmpqL/2zlXNpeaGjgLRsx9LQnJv2BPUoEMCF/zgtjz8x9kbZsiaePGYoVu9PesT6VdLPinlj4Y2LgifJrsvCF7pD8gLUz2492DN9tJnxwMWu03u7i9v/Om33+7vftmsF/YjzWlKRSiEW6zmWT3J28tUkrStLvUn0yw8AELxO4XnKb83iy/b20fbGav9ECqIgP4AB8JB2CJon9sLAVXguD0LDxx5ieE+eh+eENYywVL4OAkxVLR2IJtFE6Rc5XDmLl5W8yk8nnZKAgHvyNYyapBTFDVKmFotjnxwIX+o3ohb3Kn3gDHDPTBMM8xQavSirB8yEfTHrfiJuIJqTUFze0m7LcETDmjZ4KdoO650UXhBRle28xmzPu/wTENqixDKE/IqAIr2yCaNIJFcKx7tZhnkPVdg1U/qL1OPGUO1caNNt9emlHJAQnlHTVDgP6sWfOg5K64Ue0ejDQJenpZYSDpS49tbuYBBUDvtmY7PNwBc362xsxDHheOSIv5EeKM5zEMDieasmFG5civWn4o4Ujr+wJlk8JVb+85LjWpKAw1WQhHKxcGVjCkKniVULatKndNrmDc7nmNmkYreBlbpsaYmTotSSrMBDdfs2X/0y5qaOvGrX2O1focVqxr1+NHdj3cIq89kOeIa+2jrH2Ul+CjzL9j117M2otZ2It5xjZb+zlrP2ft56zWz5n/+MkAT3elVYEGpBnB+DPSmBuL09JYEyQ6PBA7yKRn30bwxZlOXsYur3j9Ldgr3lIovEAaToJJTtwinw7MIFz4MbAgM9TNnvJHFPy9s+BuCrr75iu6/ZkLVgnZs5o1+6XYXc4tmXuxn8ObtppecZRyfouwvhoNBChR5tV05q0+aGjn75CyvHai7Wjuzop/3anziS3mrXgdAVltBMSKdR0B+ZEjIG6R1xGQ54hrHQFZR0C+kwiI3bHrCMg6AlJnBMRts3UEZB0BWUdAvnkExB6/Fx0BsTSuIyDfSwTELtg6AvI1Oa139EvY0U+CbP9N9qsPyuURIldolP/miToj8ylXouM6GZXQnZEkpshkEAB0O3TjLYOxEpR3yEKfpABuWW8MXESAbliIlhCzHXAurZkQ4p8YqgpEhGjYedu+kKkAUvorYNIhhI1FlM55egwzOZgl5kzSBMActMy0a5FSRsL+vwYY2I7ad2jLUE3MinzLxyZ0YigK0+31EBz8NKhyM6EnP7aLOVikCwceaeCuXUWTd1iggM70j6v+nJOC59IgxXlxf1/Q0Vbua+joNXT0Gjr6L4WONifRdWfPleALxI82pK7xo1cv8jV+9Bo/eo0fvcaPXuNHr/Gj1/jRa/zo7xM/2tiHLwQ/GohZ40e/GPxouzu+gpustTJEXvLrj3tI5Urs5KCdG1ICQ2yRDV88lvSj4oiWlMcLxJKe38X9hoDSVj+glwQobQS1BpReA0qvAaXXgNJrQOk1oPQaUHoNKL0qItaA0mtA6TWg9BpQeg0ovQaUrqDzhQBKq5Eg2EjZpnld5b95PM1r471Jz9HHNMVS0sHUVb5A9VlKhP4xjrlInGFl50IKP3DGx9NrS+G1N4o0wx9Pry5PUOfq6v8c/fP6oXOCBgKPibapomtWygTT2kDzW6AkH9jSYRKbvItDhfX9XTDs9LjbQOc/v//VFum5hHaMYj4eay1tSY7yoSGwDAxFCseKxtFPIWFjghk07XcZcMoGIaxR7NrqIz7Ix1R+TEvY9QYdT3CsrjdeR4UZSTwChfD0pPnIJvfmljIIb4CNi+ORR4XuT937lDKJh2aeBqxbHPPxJKUSHmvyIYccp55MwhJ4WkQJYVp7aj/NZBpq0jf+F1oyVyvPJJjnPBow8MpTZzwBLYP8IYqyRPvTXEjE+/8msZJ2PhcztmmFmCUF4z8AkIaQtRuScrYVpD7M6x8GPEaepDm4NTRXcfsJ/hLkPT7CdQW3y1Ed/Z2yuJYR0ktP+1ous2thyThLLK7L3t3IC8ave1qt9e4IS7hoMpIpAY/VjoLrngAM+uteJuF/Ai2o9eA5Z2TrjN9vfSQJzcZbH+hwdN2TMU7zHE/KUGcCqZAPqOOu9u7V6W9oO2qHN1ww7i+GIJ/GnVOEYHDf/8AkXmGJ4kwqPnaJxtfs5GEC2jwc9c4+rAtyeM0Q+glSCrqupM/9ihHz0xm/Nz8Y3szPmsGN2ZU3H1hu1YMVqmnZj13+hblqbWWEyW7WAk6y2D0q5pftLKunF+ghgv8H2fqmTQIknqT0jginRjtsmBKBTv65pCqF0EXN0BQBfII30zwF9l20EEJBr6jIW1z4/cUH1o+nnL0uCW4yonL0/2YD/wsLZkBTEmF4miXzePBJXtlWdD7P4F1DG90QRaepAeFwQ1dWfzOuwKLUnzb3i0S3hEyQEji+NbtKfx3Gjb5ijM/HqbIVy/XhkziXBESQzxcIoON/ad8J8nSa/hT5CAb6QATZ3JRQt8ma5GGEMwmhGWd+GT0UmFPafhIEEauzTEK6y/Y5RFjEI3pHGgamANalkceMGoiwWEwniiR50J88kDhTpIFGNEkIayBBcGL+W19zDWsXNNC9oKqiImbzXxvus9rBMp/+qmM111rGPCE9SYcMazM9SuiQSNXD6ZALqkbjuhYYHkKxBEvPT+ZruzQ9JoQueAxhUi/KmYB8WEnruYDaXu0zwe6hEtk0Cv+EAwUMeQBlGtRS608KQN2wMf0cccXIJie3vE5yhLf35q5znX9VDIDIV4Igfc5TglmVuN+ZP4VOIIUSLDeDFpLNZCl7+NodqmGr6Z8oG9YI0qI3WRCmmnd3BQ2KCqAgAZANFD8PcKwZMVk3SPKButd6w5hvUTAmcegFEgmSkjvrGncmei/+9KkLtXJV7y/jSM9JoodJHE0Ef5jWsAoKq6xOnCmqf5yNYvrau5gIW6pFkCGlejUGmXnDSvlwCGnoWsXyocCTEY0REUI7jz6NMxz1Dqc0CdNqudAOvlRuPnRG8B1BGQsK6AYuQQu+mn/FJZLn4/th9T2dsXhE4tsqQIqTy8tPl70v51eXX7pXJ8e9y0+frmpYzQz87LoSKrtm+EJxCKS4GrVZssRpLLg+F+iIiwkX+FmddOZmWhE8rlmL6ClWqUpgPC6srrD1sE6BWOCroNOZH/SZGuTk84fffn/78W3nlxqkru9QhcfzpAo+Zu8e6wOKWWJM3ntfgum2lLmb9MkaEkZMiiMUZsJ9Xr6ptlvb7WZL/99Ve/uw3Trcaf1ew80FumAuQ/+JO3mzq7hw7l+gjyp0DIpHxWSXX7RiMu3o8q8/9j3nnJvYKQSuGkboI5obQYVMFNejLteI2kbiPLXgJNi2E0Sg7oz1ZJRp2XStyXoA3bzkClSbReYJeEgVTosGkvaoIRUKDzFlQcUaFERTpl0PCMQWYLoqbxdcWKavXB2rE6H2ppdzUN9rixrG0fc4eJTz+qRQ9Wu2aOH7K+FMj7Rixzv3Oi0aBDa1NYALUO2KOxQ/GxeGYeyOMO5pNtEUoJuxnurGgoFRfS6JRDfARYAfob8BUbV/g75xj3LgqOiPNpCkLPbDQZICy+n2yXhaQquQckICDI3VB8Qs/IRpERrkD4dslDyuBK+CMee918WbzwLJQaecb2rfEiJ06gvabYKn0UQijGqZssyG9W/zkqSSXLZGfEy2cJqv11Ly0UT0zOTLiqjy+B1DVa8tOn1CRld51RWV5ppy9lBu3zP0K2UJv5czRSAmapCDBvhCDXMdap0VSj7maVXt2pLvCSBPkg4iCCMpAi5kTdvuI45HgNERTOVO08nZ++oT9fB2v7k/N1zQV5jsTxXpcZEsv20eYfHdVDuO5I8MXiP44HG+zqhSKUEnLKF4FU6IZi+eZL0yYNLKmDu6+FLATHqUt1OmSLoqnuzl3EtK8GvPulJPHpTAED8FZeWhU311iJ5xU/qLNUKnhhgbvst9HgUGUj+jqTIQe+MJTa3GjB3wcJ+gAb41luoYp+BIAStcyCWfPbxoyMOEi7me/QcpVoqw6pf/Mxt4NsORBJGUAP6yiWODaT6dkJWRPSI4ISLCfdqrhJNa2X6dQZTS27UTxMzeGRMZ3rEHOCboVefd6esVcwmZNzXx9wGmMMk9j53JFbERdLlfuTGirViF9VkMeLDzrpYLwpSYhoX+K6uss0uRT1DzepjMlprPz+ZG66G9YWCjHa6J40euxIIPOZI93Kc1cfL1Y+/W64yy7AF96q54vWq8le3We+piXhET31xbr1YJ0PFKr0szXP3XpbRFxXPQ/Xh232aHIQyP2GHKG7MZcqYcB8cjZCcrb6SC53JLpjbylnsv9rnOwqVJ4hMGQ0S3/hTJrN80eTh+SKgEZASrEboJOY5+ulmZlvFjxiO6vZj2L6TtFQ3jEW3KPzIbLO/jPk2pmuZQ6gVYyzisOF4ZW/rm4ZN5AgbP4qw7woxxhuzwKMZpnKW23sDXVdfE0yDFw7psJ72zu3azw4mGyVbOQo2FKSUOwrzDlTHg0Hd6fDCQpK4uNSVezGz1cSPpn/Osy3NA9Uo8eOAiPdnKWanRoihxYtCJV8zAHRUqw2lv/vy9Z1nkJSbsfMVSxxr4WXxrLcDMarfWcPkygvkNDZjsrzY0DMerNTTsmM+w2xY9pFaoTpZ+K66alZp1zQwbK9U2IyxI0ktpX2BBa0sQdT6BmQ756Yo1RDmvq2JPkXRQY/GpGx7J6bjPU1N/qo/wSh1MTW2dUQx4I/azNBB5iNMscckKKcHwc8Krm1n5LBB4/IFMowL8hh9YolcugVthEQ3/fN2AFyE/pkdFgAYAXp/Zp/4EvdoY/rnRgHefDTPCRkXDwUlwQywu9AEXtyuu6p+5BvQ+uYUn4DznzjR2nME5xMGrvD8eXmifmAnJNILmjH5on/YET/mzE5VG94PaWVDHvqwbGHC9rFjpDWHXCKZxdekwpMVuMQ90+Xx+YP0NU30CKbJFPs6v3ncbRhPAyzhO+ZBn8DqPGeqkALOliAmIdpUgeIxedY67r116DHFL7kc13Q3MR035t3ui/HcmlfbWUpKg/33cuepE6HfOSHSaJ42ZznZjeLUsdATqT92LJWQOQNq+TRuQKOH3LOXYIRoXS8FQh6HOcRcezB0QWC51+27OxfgQ3RwdXk+wGl0rfq1pBufFn6VDycek5zfpjZHAzcxv/cj2fd48qIbmgktyQTf5tyJ0U57Q9ZXyQwbf1OclpKL0pdkP5x+AnLl8d2RpCg1FG/aD+uebhkmsCJ96YeMFyAzB8Q8XMXDZF9cEw9oyFS8EHWMxtYgOp8fo1c+nx6+fTJ/YbLda7VVYYnktbd18hbmnlTytKulBX77RONmriauPx3twv6/iSodx5Ai3a6K1+6HTXjmxeaVEDeRu7+2vnOC99jyBzAUJ3mtvr5hgmRBS15Hsdo9PTi5WRjBlJVDi1RUUshKGfZ5alFuarrnBrILc3tvfebuzChU5pmNSZ7bIx9OPJ+ZVyqWRhRnnFvg2UJyIC2fK8EEhzIYQ1BejkVITebi1dX9/H1HMcMTFcAtLSYem7fTWmCQUN+H1Jfw5ehipcfqv047vBaCNkQGNKU7NW81/NWyWl0sLidCv2u4fmxRRzMAeBGaodIU3fQvo5sccc6ny5lIh6w4TZxXLVt/W/Ajo4IPCfuSxwmm+XavhUjdb+7utlezJJfNmK9Jmfb6rdtp4YvqzroDUbwQ5Zdch9FgDV1a7OK50ySeNlpbHuaWrcR35PastfQ5cdZhgE7w7UYmlsCqLSpvfq2Nk9agS752/ECZwN2Z2gw9LVGTsFkIPSZDT+ryM3a3VbqAJ+RbJpkcXX4qJpqbjpvfnq5NNV5JpOoES/glmdeVvn3pIbjNNyQlpuA68kKRvMxSbpjxihuW6iuIm5BugVnhBzLQ2WUAOFzjs/7IU3/p/a07N8ozfFbNmnsn0frQTHey3WlH7zW57bzXc0/GkTjzEjolCW35tTg8AHqKLE3OqUYchSwVqNgEhGj6GArqQ/stMC9cBZUMiJoIyZeqnAProTivKgSICCWKEafs4uhaaMU9IE/jM9a3ATPr6Ymkad/M4zoQgScNijpl+rKZGx1qaAvsQJlBvsCCK1VvCmqVYFQziARWETEHzbPVTPtwyYCVNbbdpPbi13WrvbrXaWxDPo2zYtGnJTSOcpoU/iLStXNGkId5/29qJd8nB9nZb/5DEeO9gfwfjZGc/SQar2TsuzbAHR6hGE8ufn2U0Z/eic3p+FZ38drIa7m2hbd0s22mWYX3D3xoARGijxfDzpwkxqFCoa2BBViCb5z+pV7wA6UG0ngDPshCZD6p2jF1korN6UChi29D/rACkbu/vvF2FvWAsk95LN0evjAEFBqm2ouR0nFJ2u5Ln5hrjELD44Iy/Mrs8oQLaBlj6y2hM+mMr4CmrLbJ+5eDHT4/Rqy8QVBdIkjgTVE1DXIBX3ZmIu3Gu6ou7P+y1DiJsn7LondHhtb+6WxiIYF6bjPuq2zl/HRmHGgI9HpCpCjIDZ2rEQYQAaxxURMP26WcqfzBzyMh59ykiG+j4vItCjhF6ZTurJTEWibRPeQXAsDxTOF+OnyLbgj2K+cqWhUqZEREZFuq8XN26WIhYuGdeHZ3DRtREAGBKIF0v95IgbB98iKADuh7qSJkJzGKCuqYb9tHc/T3nlg90uatdNqat3quj12BAylnWv3RXzFcATUWSOpf/OJzIrv7xIqt/9I8v3Qb69A+3C05Z3ECfvvwDuqbkWHkNdHT+jyd2ij+Lde0Y6FOZQ/rUtWXcNE63nb0uWaR6U2mt9Asl9ytmMsRjrZnRcCqJXn1aQnGcsrhGOeC0lzFal8FeJQ6cIj2jlsqXBcQyc3JWLBqpMJRX98CPqA+i1t/7ej5t+rj5/K1/1UBdsPEuSmfkCKd0wAWjqwBqAO4ZVz0IEMzB7mMPF1d0DB69iQzM4uZQiRgH9wbCDUzSBLpOAehMaZG3W9utZutNs72PWjuH7b3DnYP/r9U6bM3doXUehvtkwOeK3S7M8YAKqebhtn3QbL0FbtuHu63D7b3Vcmt6IvVuybR2GMpOCXnS4UyFDZxuSflgX3ZXdqkF/MaZuKvrEGsfBsYPcmQJImmqPxDbP+UcBzCXgLrhL3UqA8BO9/hakg+jUk32tts1CIk8TDgjzy0pnsEmMEP4ZU8IPNbMLLrHcJuD4f29vZ03bkFYQh5mGoPwuGeCi7MNQ1YnmCWjNtCNkP7pQ1TBXpATHJtYDlVl72m7tft2VexIIihOe3Mj9i/RwMZM5bD44Ur1x6L6dodmT6AgpSIsnuYAv66rusmIhB0zGWGWQZ/mBqJhPYUJU7t0XA5ObqoNL+2pekwaP3Q8woCjIcqC39t7/+7dwdGb45N371sHb1sHx+3to6POyjSTRz+rXRGfFvtSFVBBPQRboJF+JeZxYEy0zGTYC8+YJAOeMUBu/pmjM8yG6AgAQ22lwDRCXUJ8OH9I1SjrQyR/yFPMhltDvtVPeX9ryNtRe3dLinjLII5uacHAf0VD/h9nOztvmmc7ezvltkeQgtZc4TVhgy5/TThB+niCI2OWYVOJEQ1T3sept3kZWcUT3wz/f0W4oL5ogePrJYQLSoi8NtCnD+ej8YLu1T9yG7+Bzv7RxQy9F5jFVMY8iCc0tHcYQfTgm+2WFxMqKAhl1Vz+1bGCx5RCYeHrZPoFBAZmZLAyNv+mTr7Ns6jXEgwgUPSk1jQrbeOdZZkSUvUkIfOczUfdd1MSNQuXTJnSLtzQNOOx1VrgyJsufATAkkeq2C1YezWevFluNyGe0W43W3tX7TeH23uHu2+iVmtpJOUh4VFM1bQucPIjp3/LiQOcKUHwkgh5QD9nimrPsReX0oJXwsTVPW9aGMy4lLfuZ9+U1YyeL2ltFlmsaZ3OC+DxdrIyK1yoEeqAA7WkUjFcgT3Xo5LXtXRH1mQ87X6CtSubSytZHcNHXWfI8lC5u44ww8vC7WoeAM21ZKmU6B8S3gux44q3FWdDqrLE6MMUK/hHWZf9N9pIOds4RM03O9F+e/ftTquBNlKsNg7R7l6019o7aL9F/7MC1VZnce8XSUTTQS3MpF5i5OTZcADRpq8nH6ChwCxLsQjbX6gRmaIYa3+6z7OwZPbIRSj0GEGyEhWm7jUmTBEhTVP9Qcq5sMGTho9/JK6rmR/UkJfm4CzGl2ig2JvLxTLwvJDVROcog0aKYyhgHhLuuC2nB/W5VJw1kyVfoPRiTrhUOK1LU2xewPBGw8+WZ8MCOh4LkP/QLS/PILeZ1R4QeYynHgr1lvF7Bt3SkGYFJuIC/X56EXqzCNlkCds3654mJJ2aMmPnAEP3a/ixLPCD3dbukmF/LWxBhtrSq1E1X8IMT2nm5uclcZ0DRmrSzZaJStX8OSN9soJ9rw3LPzmrxbJx7aT1+O7+z1WXa7x82jnvBJ+r5NZaBVsdMQTLCG+9ywjjstehIsCKWkgKdJ6SSf+hZ7cuzNOXin0LER/4Q/lE38J2tB3tREvmQKb4m7ojAMHx4ryRMRa3lA0jldZVI7txJfBgQGN0pllGF4IrHvMUIqXamrcUyAhdulC2eXTNu6KG7VLRT+jXD6dXJ6bz6c+XJyfn5sfOx3cnl+bHy5PjUjvUX0dULfna5Mr4enieCMuqtoybNewc5uXh775vv3P0Rp5DDI89s31dSwDqie8RvYCW2N1dMmJh89LrCrtcFe3LnP1N6VLiy8uZij97mUhHOFtSwwsC/RxrC6RduvHRl8szlFJ2C6WDPMTLqWqG9+Rmdk9Vtkw9yCna8l/aarVa7e2dJW8HbcRIbe4BKH9YFr5yt+aTA7mGWaD5qSLMmMF9LMn+LiIs5onW07kl/J4LD5fjiEXcVEFwJnNToksUWM0nD6ByLsnwc0bE1P6uUex/FXM4Z5wlvn2PRSDSCgoehm/SSU//7iZPDOATu6D9TLmtGzQWNYEcQWJ+R4RDqYUGZjm6nm8LpVXa5cnPvXen553L/zSc+wuhApjm87usc9Tq/PL53VWn0+nAv81//rHKHWBAEr/WfNRlXFeu85ErCdf6Xq+yPhBmXAfd7GV24eVhDCaDbVj1TVgeu1SeZNgdkrJhmjs59vN+r5jE9Fda1t3fGyDzk98uOufHve7vry1gVL5AOQ1U5cVsACkG49opbQsSaULoMCHsYz36xy9nV6cwF4zthoPuTn7EOywogPKnhA3VyAxrMwiA13xj6zGPf/10eWz29cnPvc/6XwXSg00Y7DHvYSYkpuMS0AB6RaIhutlob9xUIKFt/mvj6PBaKHwtSNJTanLdp+x6PMWTSUQeyNL9iIv7rlyhspoWlgqzBIukuB0MTqbVNR57a1YAZsesiMkRnU31Wgl/nX5fkDsTT4A71tVf6/lK18qHf559XBE/t2RaAzsf6B1pCpKaIhMoheIDgCktp1x8en/1a+fy5DqvlXPXxPnV9VEmBGHKvvhcn47xkJhSpRNozq13/yeYVF7fU6YJ1Zt6RcIpl5qtRDrvc4CzHKTANCeHFD5mr4+qZb9eWl5exVTI7fqY9LPhcFnENC/AkI26HiNM8pa1QkrbazUMyRgzRkRPKjwX8O9jXgQE6jXhnV+2To4vbSNZh92bQcP/QZamU5QQZdrYj3FKY8ozGdbbQTvkL5dnZR9iST6tm78Mj+fGA9KrQ8fQKTU0kQFghvclEXck0So7yWKL7gQ+FfQorcpa2V4yZFlj6eTGlW0qUnAI/R1tzNCjqZbKJ+AcDAbKUPfq9De0HbWiMGJQDiscmjABzhRnfMwz2TT+hP21UHSAY2X+5eFtSmGIhI8xZU0tI/NRKK9r4iQR5t96f5mf6ORuN/gDndzt23/OjDnGcfC5cabIg/lR+8L2J9Ni2fzDNUs2/8pEes1QOORPUK/ZxDEEr82n7o12azql0rwlU/OXh73WQTNIfCoFUTwfy22dTKSRkV5dLtUxjO40WibS3GrcgGIUX+a4ERjdpwxJPiYoxhLCEtpxHeMpAlfZ4qWeXuj7ZIsLE54w2yOd5phIGBVYQw4kh5mDaxotAnppHgM1kAxD7uz4GzPEjXnSCCk0DGnCbCWopjOligicotOLu30/JmFxym2K+82/bgyG6H/doFenJ1fv0eX7Iz/o9pud7deGpvCDecatcwPcs4qHDrbAcY7cPCwFZJcs56Lkl99DtUM4+87jubR9RbOfPMej9d2qhHaDgs7IsAHnwX++Ko/uGnxKohAdIKoMxq9s6M3MuELkjoipnsIgCc98f2ZwN+2ECMoTNM6k6VLcd2hfJDEuF3E5a7lJAB/uE7QxYcONPOkbgKMj/bu/B5C13nkDgQE0vq6Nd2EAnwMFZrFg4Lj9x02gzhSfbMws8s1/3JjaLYUmWORoh5boZXsOgACyNJ2D+Zl4yOrBIU4HBt74y+WZ6cRgUGUwU1qXTnkm9A2Ya91psHEAvjuPEFCGbhxrN4BUBjA7qtBrV5CYM6lEBrYkpAeGbSEAtidnw8TxH41iFvXh4e7uzpYBzfm/f/zD/t78+z8Unyy/Zk49vYR12/zC/MOGV5uwzSWSBN5Dcnl6OVaoF8oQI+qei1s05owqLigbGq3lrWJ3j/eJVo92u1joTSzDDYDBY0ApH9r8Dv1VrYEHijADbh6aoeaZAqtR4QCG+2VM7Fb0X/PDYul6ujpCG4B+kxKTeMq4KmuvhbaOHu2RPy+/qyZYykDBrRz12g7vlJi9WpfMLzaEz4V781RI98IGYgLCAs1sRb6xClqf/dj29eY++o55lPjd3XIxz9LvaZqTPzJSW9UK2GswgT2APg8LmDR/sVHpKsb9GdarN3NQSnfs/4U71hh1YVOCcJZI30+4aK4zrr8L2kXk4QdTah3QHllbX5g6Opivnyn/qUYwmWHWmIV+RNOGgiEynqicHiDdfPLGfnsGjS6hA3i4U5Bc1SfqngRdqPWk6p4bF2kVhoTxQokgSa9el/AKgr/DEQEd7iaFO8dM3AAhTSbE6xqZ9c2fZp7dCrZxMJb5MISaNwach8+TGwDoFf5i9sowNrZdjIQoIsaQ5zgRJKaSpFPXWCSlUqGU3hbqdGU2GNAHPyJ85pW+LA63tsxHzCciLoavI3Qlpu41djIR/IGOTbE3ldDCio4n6RQpfFvMULHmt17/FPdJKs3rjbYz4QK+J2kK3F+dHctcD8Y8ym4rcMxWhgih95GMR6S+zNMujP64qodredb/MS/8N4eVxrih95ELfgXicFu3zuPkJ3ENakwirgm4/pHh1Nh39jPg+lknM0iMTVMnElNgQx5iMjFW0ojb9pKmg9rMcbP6IoK4DQbh0kLjl1kKoGiHDqzihL/Z3sw+UxjcJG0gwqwxZoznxm3hXDYC7vNQzCwzfZLy+2o1Ua1TinonlKuJW2GpKtQKlspbGT5IZIcoONvAqLR1Wl7tuV0ss/623jntwhSNggbIaTO3jnWnHC5ZPsaGCYnpC0sJTNM86lChCbBcsuRR73nFJz1g8BvcJGQwsLlI2r42+8fK5RW5Ojt+3TDRMp+BnK9I7h2CRm64NkKgW0MVEZybipjM7Lx58C3/pF4/2CHf94UDl81jd02+EvPdOvD75TebQ4mvaZN9scOv1u1ZwyS+NJjENULinKL5IcAR17iINeAi/uiQiGs0xDUQ4lel8reCR/g7wR/+bZAP/0agh2u8w+fJZw11WJbJ94tyuAY4fBkAh2tsw5eBbfg3hjX8AREN12CGde6RF+PfrxDH8O8GYfjjoxf+fYELfwzMQshfJEmEFR/TuG57yLz+mbmCihdjYkKOo6XHg0gRdkcFZ+Mw1ZSwBGq0IXPQJkRC8uSjbZkXjD854cCHv41szEuzvctKgqoSUSAWL6pniAjwN6Grvxzh7b39ZeRUWze2GSH1aFICktBOaGZ515T5Vt7VXA8OdjAhe823+7jd3MX77Wa/RfabrTiJd9o7SavdX6hXq5eEdpK/lTD0XMvKg6akT7Bqvo1aUau53dpuR629aHun2Wq1Wu2FghxOFjVWz82IQtlaOpjZHJYYmyiIz69WUNZj6Awy4n2Z/JDeQUq2FeOsoPwfeibzW2TzNzIcCDwm+ijWJI2wstWWRPop8w68mTAtdbEi2gH906DzxCmWkg6KFSgKx4rGBr2HxCMTY/Cv9BbeycwUaRPRTmXHonERwMemX/gOqH1XqQoID1CVKBuI6MvAoEEoRNmQSAXFoHCbC6IEd9A4YbkMHg4Ne7DI5bDCx9OryxPUubr6P0f/LKzJUPBsEuGU4rpyAzautCbXE7wi0rspMC9EBzCUO/EBgpp3qDRTIoML3xW0hmWOsKux6WQc3xox4sIrty0n8M0q9d+06TeNrtmvI6hM4iocUpA/Mgpdk6c8g2XKJEG4IDRoPGuI9rxEpaLJzX+hjY94SGIsFPoZPr2/geZHiTCrUds1AkuR3x3PWgMQfCi15dcgF3w47lxrQJOy8H9utXyQdk5J1+imb8xCPn3HOx7S0kryfn96Pre1ZMRdNw4VyFwUwKieIfzC5g53/eLCz4es2PTzyb7ATnkRXNATK4Xj22hMlSDa096Cb8stOBRb8y5T7t1hGc3t0z328GCD8jYaj1OAvjTXFFj3vibf3n6mZCH/tRee+bPHh+t0z19rZ/6PjKRBL2KJCI5H3tznZl1MT3JSNvnae+39g4UEE4YVVqxCVl999ykgthpb9GfOhylBZ2dz48Hm0og5G+gFqO9I5ybudU+NyHXP4H40GcmUAERhR8F1z3SFv+7BE4L5eHCmzzkjW2f8fusjSWg23vpAh6PrnoxxSpBDmqYMdSYTwhL6gDpOeVioiXZuTRZAJ34xBLnHw4AiBINLX5CYJKaQMM6k4mPj68romp08TACwJBy1gIqJ0E8AFN01c5DE/YpZOIgzfm9+MLyZnzWDJYVhPvD8lQ5WpaalPi5UcDlfBgPio0M7yZ/zrNk9y97pBXqI4P8V0VISktI7IpwX2GHDlAh08s+5w3+5JCBnNLI5o/U7do8C6xZyV4uomX4f8cFTqJmTEZWj/7dAkGimfYkppSXzmK2PZaxsnmHr6OQAAnoB3dCVBcH6OsVpCp+2DhS6JWRiLmuze6DIV487fwXbLHdKCdrPakSF7AA8Hh8YtvP5AqY7/pc2xptjmPenaJJiNeBiHKEPRJDNTb1rGGdN8jDCmYQ07NTGjI1eCdxca98Qq4OMPePqmg8RFrGB14r5eAJZ0kkjR8ppIMLgKZUk+cs5Abgs0kAjmiSENZAgODH/ra+qhr3PGwA8VVEXtfmvDffZjQbaMJ9+BtbezPrFPCE9/+4cJVT71LW/gZsiRCwh8IRLCUiaHpONYTB+Hq/YCB4W89dzabA2LAIIlfAXkuTVP4Af5Ye0OlNqexU+KSCH3hZ9+DJQZGQTPM2X1uZ5QdKnV6JQxfoYxFmf85RgViXid+ZPANhvgEEQHWgD3+cYUOlAG8qZ/UpkZEVbSv9E2bBXm+O+aRx3n1Ux7y6i0n/UfttsBDyZpK4gboxZNsCxwVUB78YhTVmItSgYk4TwGCm5s4gHnYnecz996gKKS1UhzjjSc5LoYRJHE8EfpiuSvMIqq08vPw1fPPPYDqRUr8AgMwVMKR8OIYMYsk+GAk9GNEZECC5kHoINR4W8zLCZCRdI+6vKzYfOCL4jKGM55Cn14H7w1fwr5RQBP6y+azMWj4h2cssLeHJ5+emy9+X86vJL9+rkuHf56dPVilbQvJ3W1UKia1MgWKEFU+LUYMk6dsCQ6IiLCRdhBHdJRhXB45o1hJ5ilWoCxuPC6gFT8euVw0RwbZFHuVbwgz5TO5x8/vDb728/vu38siJJ67tP4fE8bQ4es0ePy7D9ha1j7hd9alwqcWJgruEerkTmbzdb+v+u2tuH7dbhzjNQ+b/Crj7bcxnfT9ylm13FhXO9Av1SlVYUj4o1zb9oRYOV860e0zXme84ZBvgb+H1iSvEK2OSFgmMo8yuAOmt7hvPUgn9i+xyCQH0ZS8cox7JpucJbH/TrklKvNmGgIpgOqcJp0ZjRHixUueMhpixAndPf6FOm3QEL5RysT+UNgQtL8xX1v5zYtPe6nHMIKLwwjr5/wZub1x8E5C+zFQvfX5gb/e0VO7q5x6e17ZgobLqPjTAbPuL6mr954H4YJnag89o1zCbQeeRmrKfy+P36zBGJboCLHMTRIrMjnPwbdIlrqwYOg3l9lJBfZIeDdGiW0+3xFLSEFpVsQu5obXHEYxjc4ogZNWc7r4Skl7yd+Zv/zTLjPOS6+HHjG2izfBNQiWycPUKnPkfdYnEYzSLCCJFpvdmw/mTea60ki60RH5MtnOZr9GyZ6Il7ZsJlxVJ5tI6hzsM2E31CLkXcNrhenL2S29nMtdyYQYMynnme++/T+801pnVQKG0oBChr1AVi7SBDkg4iCMkoAi5bTdvrI45HlBEUTuVOysnZ++rT8vB2v7k/N4p2BWP9KaRP1teG591UO2q2cYXm51FezqhSKUEnLKF4UQdAsxRPsl6NCUBHF198pPzJtTlliszdDbiKD3t59oJ7bZHr7+RBQelLYhTOhEtJ+ynJMdX0jJvSX4IROjXE2JBX7mMYPMV+RlPI8tP2JE2t1ov15WQRjAfYPtuOcQqOC7DC89qbRcRBHiZczAVnP0ixUoT531Ym8prhSIJISiBnzcR4wSw23WIWJ3VEcEJEhPu0Z7N1a9qLQZqu24qdIM70zpin8B47wDFBrzrvTl+vgDNIt6qJpw8whcnoeuyMLUG63uF1GQjQKwjQ8gO67bzLU06YEtOwV/HKsCqtyPMJapA7NzGnes/D5kbrob0BcT8Pe+t4eAae4hNcyB7u05qo//rRdetyRln2gD51V7AuNd6Udls9dVkuQfg316zLH2SDnr2yK8wMV88VJs0D9jy0MhLEwmai+a6tmUWMgoc5howuMPhiOB4hO1l5kxS8gFsyda1n8sZ2BXxwSezQBqzcZZj0p0hm/abJ/fBDAughI1iN0E3IcfTTzVKawo8Tj+j2Ypq6kAZWND5HtCn/yGwwuO+6/SVUmrBAIEVLxyo2AVw9fDKPY/0sbrojzBhnyA6PYpzGWWoUoLdOV8nHIMVzNQtaRGPoXdu1GxlOKEy2ErJrzJktUR3mqy1FtOvF3+ODgSR11R2W6DezrZaD56NBuL0fdjZ9mm43GeBGrIT8Gm/2EvV6qJUQfUeFynDamz/X61mWbolwO5/L6VopD4tvmwUYWH7bQPeSb3Xhw2R/9YVvOF7+wrfjPMNmWvTQWUE6+fkttwrya9YXM6QvrTEAD6Fn4Eho/TCrMB3y0wV4T1QG/C3DkiLpYITlPG0zFmHFDY/kdNzn0M9qBEdyaaes7kZh7ws9t+br9fVDtMiaEfSAi9s6cU42O3o/3MJTY56HBQ+jWEoe07y9Mw5efP3W94L6xEyIooEwEsS++rmhfYoMPBPPTlQa3Q9qZ0Ed+4Kb3uOpNF3ZlN4Edl1gGteP2HZXB5h381iUz+cH1t8wFQOQHlnk4/zqfdf2vIYXWJzyIc+k7VfYsajLxAT/ukoQPEavOsfd1y7Fgrhl9qMCUdJ81NRHuecyaIEU4zQlCfrfx52rToR+54xEAboTlTkOcyaDcuL+1DesV9ymY7se0yjh9yzluNBKxZfpoA5DneMuPNJOJ9oIDfa8e6vlYnyIbo4OrydYja4Vv9Y0g7Pgz8+h5GPS85v0xkjgZua3fmT7JhwgMdgj5ZIm0E3+rQjdlCe8mW1DHnxTn5eQitKXZj+cfwDyq/Ldkbc/Nh/UP980zAN++OwIGw8ILLfRDxZx/ua+M6d/WFsm24WgYyymtvzt9Bi9+vn0+PWTz/Sb7VarvajVBPN8E17CHMRKPpZ5XAcIi3GyVxMnH4/34I5e9Fp2CBvtmujrfui0V0Jgnt1eA4nbe/srIXKvPU8wb0Ei99rbKyBSJoTUday63eOTk4uliKQsB4RbefGWHjvvAuhMQ3Ov5tafLXotKbPtvf2dtzuLqrMxHZM6Mw4+nn48Ma8oLrUozBg2MYNQySEunHnBB4UQFDJQj4U+hxQzDOXLWEo6BCwfuTUmCcVNeEUIf44eRmqc/uu0c94JDIQBjSlOzZvDf9nGjz7NIEK/avt7bFICMQMbDZjR5pR58erbrit+zDGXykPDFli3rdQW3YPj+rbgR70Dw1WgDPFYQb9iuy1xGMjOd19rf7e18N5bMjeyIjXS5zRqJ8l2EV1U3jU6K+czV7u1xTzoUO4uOoBYk+Nrk/geBe5a3D3j96y2NCpwgWGCTfCgRGVd+TLWzJwNO/+yqvr3zg4PE3AbM6vuXfyKTMyCG58EeYvPy8TcWn6jTMi3SCg8uvhSTCZUWAyJ8r5xdULhwtmEEyhnnmBWV/6t8VcBfwCmKRn3jRzoCSuXndY0qeszbK6y6GhCvkGlvmc++O2CvF/gPIVoAV71/9acxuOZvStmYTyT0f1oJzrYb7Wi9pvd9tzYemWO6XhSY1h2s2MisQ5Nz+SIQLtsdHFiTinqMGSpQM0mNFqEj6GALqT/MtNtdkDZkIiJoEyZWhWAbLnTyg763QME+ITaJ3cuEDdAbTwhTeAz15kCM+nrMSUa4TuCeBxnAnB1TNeLe9OsEOokrMUnsA/vAfWmFr5YKSOseYhVwTAdUEHIFLTKVj/lwy0DytDUtpTWa1vbrfbuVqu9BbEuyoZNm27aNMJp2lLwSNus5ZhMK95/29qJd8nB9nZb/5DEeO9gfwfjZGc/SQaL7xeXetaDo1KjCeTPyTKasHvROT2/ik5+O1mcY1uYWDebdppl2N3wmh8An2zEFH7+NCEGzQZ1DfzBgvJYsneEee2A7hGUGe+tEJEOKieM3WKiknpQKBLa0P+s6MzY3t95u+jdbiyH3ks3Ea+MgQNGorZy5HScUna78PNojT49LDI4tq/MDk6oALxJS3MZOUZ/bEE+stoix1cjuGwEBI2/QNBY5LhsQV30q+5MRNk4M6uNK68bcCL2VzXgrFqKv3vnzSdl8p213HyCl5fQh+M767X5hDRfTBOOhZpsPsHYX918o/bumnPy/gIacXyTtppPiOMH7dpRxfEP10jzMSZ/nA6aVRz+aK0zv8Lj37dn5lcE8/dqlvkVYXwPXTKrWFi3x/yG7TErF2DdF/Pb9cWsXIAfvCHm0zx/X50wn+LlJbje308LzKck+WLc7oV6Xz7F2V/td6+06eW8jL4AJ/u53S6fYu1v5DB/l/0tQ0aEVD1JyDxn7VFX2JSuzMKhUqa0mzQ0jS5sVQ04xb41mqTDkQqtFlO54smb5XAT4gHtdrO1d9V+c7i9d7j7Jmq1FkJKHRIexVRN6wIWPnJ6s/yIzZkSBC+AtgU0c6ao9sh6cSk9dCWEX93zpoXIi0t5yn72TVnN3PkCll6RrZrW47wA8GwnK5PPhRqhDjgpCygGwwnYUj0qeV1LdGTNtdPuJ1ijstmy8CoY2us6E5buyp1zhBleBE5T0w3IjSWLoUTzkPBeiElVvE04G1KVJUaPpVjBP8o66L/RRsrZxiFqvtmJ9tu7b3daDbSRYrVxiHb3or3W3kH7LfqfBVVSnQWTXyQRTVd2PpOaZ5rl2spDZUtV70iq/zYUmGUpFiHMvBqRKYqx9kf7PAvLEI+cV6+KDe6oMLWEMWHadW+Yx/WUc2EDDg0fM0hcpx8/qGt37IEnjL3eQLE3T4vltHlxoIlcUQaNw8ZQFDok3HFbTj3pc6k4ayYLvKToBZxwqXBa18nfvIDhjWaeLXOFRXN8FeC3oVNUnjFss2o9yOkYTz0c4i3j9ww6CCHNCkzEBfr99CL0DBGyj/W2x8w9TUg6NeWazplU3P1YFvLBbmt3gRC3FrAgQ21x1aheL2GGp7Rr8/MC+KwB8TXpV0t4pXr9nJE+WXBPa6PuT85qsTZGBkAa6fHd/ZyrIpnFI70ZTzvnneBzlRzaW3urI4ZgreCtdxlhXPY6VAS4NnNzTucpT/MfenZ7rjwdptibC/GBP2RP9OZqR9vRTrRAjlyKv6nJDxAEL8LiH2NxS9kwUmlddYcbVwIPBjRGZ5pNdCG44jFPIUKorWdLgYyu2aWL25qXwbyVX9jjD/2Efv1wenVi2vX9fHlycm5+7Hx8d3Jpfrw8OS718IMvLSAgWz/Vw/OEIla1N9ysYQsdLwN/UX2bLaJ36RysP/ZG9PVjD/AOvlHpAsd+d3cBN98mHNcVn5jtZOwn3pQu17m8bKn4s5eJdISzBVRz3Y2CLws9glPKbqFmi4cAIFUdn57cqO6dxdb1BkkqW/5LW61Wq729s4Ba15aF1LYWoGKHdbQr9yM+ObRamAW69CnCjA3ax5Ls7yLCYp5oZZuboe+58JgfjljETRo7ZzK/67tEgcl68gAq5JIMP2dETO3vGsVGMDGHM8RZ4ntdWBgVrXDg9fImnfT0727yV2o+sYvYz5TbokE3PBP9ECTmd0Q42Ero3pNDe/leKVpFXZ783Ht3et65/E/DuVfqFegan99lnaNW55fP7646nU4H/m3+849lV90gsX2tY55Lsa1c2yNXT6t1tl5ZvfHNuA6n1cvpwsvAWDQGTK3qm7Akdnk8ybAjJGXDNPcq7Of9/jCZyK+0fLu/N0DOJ79ddM6Pe93fX1ukm3xRchqoyiuNAP8IxrVTWnx/aWLHMCHsXT36xy9nV6cwF4zthoP2J37EOywoIGenhA3VyAxrn7aB13wz6zGPf/10eWz28snPvc/6XwXSg40X7Cvv0iUkpuNSZTZ6RaIhutlob9xUwDZt/mvj6PBaKHwtSNJTanLdp+x6PMWTSUQeyELNMot7rVxusJp+bAqzBIukuAUMGJ/VKR4oaJZps0uWYGxEZ/OIVsJTp98X5M446nBPuqJWPV/pmvjwz7OPS/BwS6Y1sPCB3pEmdMTXpgrUrPABYB6W3/w/vb/6tXN5cp0XLzm1f351fZQJQZiyTxrXp2M8JKa+5AS6wuqd/Qkmldf3lGlC9YZdQiDlOqCVSOR9jrSUV3WbTriQB8bsFVC1vNdLy8irjApZXR+TfjYcLgLd5IUWkl5XFN5kBlnrobSNFmdCxpgxInpS4bkQQh+z5iFarYnt/LJ1cnxpuxw6kM8MOkcPsjSdooQo0xt5jFMaU57JsAAKenJ+uTwr2/IL8Gb952X4Ojfeh14FOoaWfqHZWmyLbtuqG8QC8GegmV5VqsT2AvG8GmvWNq4skn/BAfN3qDENj6ZaEp+AW7jQKXPd/FtR6IuX3XXbdR9nijM+5plsGrve/looOsCxMv/yOB4l9z7h/z9737bcRo4s+D5fgWBHrK1ZiiKpm+WNPhOyJJ/WjmxrLXn67PaZkMAqkMSoCJQBlCR27MP+xv7efskGEpdCXUgVKdGiZTsmpkWyCshMJBKZibxMMGWbmi7mUch32sRxLMxnzUfmL5re7gQ/0PR2z34sjTnBUfDcJFPk3vypbU/7l+nzaT64jp3mUyaS/2QoHPKvkDS3iSPw5pqn7oy02nQCY/OGTM0v97vdg80gwqbinPB4LM4umUg6hmKrMm2OYXQnoTKR5JpcCzILfK5ZK1CETxmSfEJQhCWY/tponOApAjPVFl88PddnwhYXxgVgWCKZ5gVfMCqghlxlENtu3XQQg1KIuePQ5LCPuNOtr80Q18avH0JoENKA2XQ8DWdCFRE4Qafnt3t+TMKihNtY5+s/rk1Bwn9eo9enJ5fv0ef3R37Q/v52f8PAFD6Yh2c61dzdLfjao7bKlQM3d/cA2BVttkj55fhm5XVf8+bXnsI+fdRPnhe09C1fhDZHgladwHRNisZeVkd33eokUYgOEVWmSKhsawZmXCFyS8RUT2FKkZbeLw3upk2JoDxGk0yaFpoDV76IxMb0IS44Kj/W4eEBQa2UjVp5VDBUm+3o715u9VvNbUOBoXL0qpjt3FSJDQSVLZIB2+qX60BsKZ62Sgt7/cu1ScRRKMUiL8dmgV6mwDggnSVJA4RL/oenz6w/HZo6qF8+n5lS66bEhu02PeUZdL7OJeo0YBCo85tb5JSha4faNZRegpojqtAgUpCIM6lEBjogxKGFdd+hhkmOhvF9z/QOFmXd252d7S1TQeRvX3+135vPvyieLrdOTvSsw1q9+sL8BYAXicDOEkkC9wY5DT3takQHZYgRdcfFDZpwRhUXlI2MRPLarDuXB0SLPssitgYgluGiY9DuUcJHNlBBv6ql61ARZiofh6qkce1jNS53Tvc8MiGW/fxrflgsXbNCB2jbdL8mJqqRcVWVTEuxix5txs/LcVKKpQyE15OXx7XDOwFlj8olglQNsI0Kgsxzj55bx0cATCBpLWlby8K38OXTwx049DkxE+CdnWrGxlL3Sxr6rxlZWZoC6FYwgd1QPkAIEDO/WE9uHbJ+T+pVKjF+5Wz8G5yNRgELK5CHs3T0GYOL6jTj+l2QFiI3/U2+awB7x+ripmE7hvkGmfJPtYPJDLJGhfMjmprzDJFJqnJ4AHTz5LV9u1ReK6ZDuNRSEAE0IOqOBO1SoTH/HTcmzLIKgLEGiSDx1WrNtEtwno7GBOSwmxTODTNxGwiTpsTLDpkNzE+lK6mC7hqMZR4GV21ryHl4XdeCykXhF2Wxb3RguwAxUURMIOguFSSikiRT1zkgoVKhhN4UEiplNhzSez8iPPNaC/y3W1vmEfNEh4vRRgddiqm7nUxTwe/pxGTfUgm9ZegkTaZI4Zti2IVVj/WaJ3hAEmluObROCIfoHUkSwP7y7FjmMi7ineympmDTo1LuNe/IaExWF/p4AaPPFt1wnJZtEnOzff22Vlk28M44mJckgWPRVW4bP4nrNGGiP40j82uGE6OL2WfABLPGXhCNmSSODCbTgtxHJDUazZjbvmymhVFpW1m50AGfCQaC0kIHhzIEkL1Bh1Yowm+26agPTwXTRStzMGuEGeO5IlrYf+0A+9wNUkZmQBJ+Vy8O6mVHUb6EdDU+IyxVjfjAUnlNwTto7BAFoxcQlTZJx4s3x7kyG/Q15/QKU7QLOz2HzZwo1txxhZnyMVrGHaUPIyUwTXLrv2bHY7lELpvmc8XTK0DqG5wSZDi08TVa/zU8Y2nxmlyeHW+0jXfKh73mq5BbbCBt264HCMjNUBQEe6XGH1KeN3d25U/qNQOu+L4PEzhIZp0j+Uo0O1Hg++UYzJWcXhFjfbHDP94s+VkDbh1qwP0s/zaHHN9t5befRd+eqOjbS6z39oOWevtZ5a2OEi8+X/2l13Z70WXdXnhFt5/F3B6myY9ax+37LuH2s3rb81Vv+1m47fkKt/1gNdteSLm2n5XanpoX1sZWfmSRth+hPtvLLM32Y1Vl+34LsrkI/A5OKF6V+7+l1V+Y4DWRXsK6qW1vf0lg7SDTC+K0lcgAVZf2ESYGQNwdNo3TohujbOKCX9sG7/keO/o3zdzTzn+y38cQ48tVOKQgXzMKTdqmPIM7r0wShNGH08vPJ+jw8vK/HP0d+l8FtW88CgG6nUrawas/UOs/Ng9HhKkWap7+6JdmZV2JYF1oXFmQ3ESFe0dIvoGSOpCRgwZkjG8pFyH1/HXLhMckIVa1rBAvJH49xcNBa4jvYaRxldAX3d3d/sLkXaGO0SrXJ/iuSAy3yhUiH8bvKYsXpnKaYKWF1UpljJ/k29I7TNH6R5iidfj7RSVH6vDPzCU8wZ/o8NgWWTk6N3+cUZbZvKkJjj5dmD8/mhBj+BAO+Wk4pBFB23u75rkLjO0brmkfe5AjHBuE49ZxhKdvvZCzEy4l5FZdVwP4QxSKayzGIyFtHLs8fk8WGG72meNhLGBQXQVnl2OlcHTTmVAlCDStdwNsgYzcWnh5VpouObb39lp1W3DD+hUICbnQhjWOQrNjPpjGqObDJedJYfcy1HAb1a6cxrC6YHqSpouhtCYUfRt1AKaqW4QO+p9zES5oaTBKuxBuhF6T+85sPjWvyK3Lw263299CG1WKwS91hFnlQR5mjztebUykkCYVBnk8kao0Kibrl8j0jSVtJpJ1IlY4fJVwTUcp0pVEY/CDf5ut6WZ79O50Ay1GTveW3LrsdXcPargPvp9Boafdo0+SFDZH8s5V5xdehxna1crW4YhPJpjFcBlyYbBgI9MlOhXEXcdX1+iZBERjej5gv6yMns3fnUFYmQ2+layAqHQjMMJZHyt/w7EeR95utzdLdHS63cY31zOIu4ZiZrYkWXCB5ptqK16gc35HxMWYJM211voVeh4h05jUIXlnafYrJvVi789fDr8YifG/KKpgu52Z67qR4Fn6FhmtutR/XTO998oqjrB+S9thzBQKhQRAaQtQSDTkUSYRN95XNz5CqStIS5UkyRDOJAq11ODeIZkifMtpLBFlmzFJIc8QJ1NJZR7qbkC47+x2D+yo4SXdkCYuQNuW3NdI/aWGKErYAlPhjnYUimg6Xpn3/sIkitqLA1djw0xp2DHOhP/a1OIKSV0Rl2cXVydHx7+dXH2+OLz6/fTyt6vDk4urXv/N1dG7oytzld50o0YJJUx1qvH2T55bffJh09WqlAqzeBMnnBWvXDlkjOZBJAa2SixUJjNgnkmm4I9NSJ6Vpqgtuq6idBWNoUqNhGuhPNDEDwopOSab1dwhYAWZK9VeKqennU7jm7FZkKyIxIdQPJIPC7QOJrelxCb4hqAsLV94e2IAiPPWYqk1yIvuuFXAyob75KE9phQLRDyGYZBGrgBc1WSMP1pmUVpt5P5q7om0cI6xHHcm8e6KFuaoILHYSKviFGLj3Lb/cLyLYjoi5irz+OSzXz97weipx4dNtkwp0MpkbHGoJaJxtf6vMGvPB1/VBVqZeqs+tgrGqKxE9/3+3tH++/7R7u6798f7x29O3rx7837n3ft377tHByeNOxiEayLHuPdsi3Lx22Hvu1+Vg5Ptg+3jg+3e9ps3b94c99+86e/tHfWPD3q7/d7Oce+4d3R08q7fOO6qtDr5UfMs69Pf3atfIU/D2/zu/PErlI9qVupp9s3em/33e3t7h93dnZP3vf3D7puT/vt+b69/cvhu5+jdUfe4v7d70jvef7O/++5kf+fd++2j/V7/6PCgf3z4vnGIt8XRJCGsaNFq4quCDEBXrx0gcJ9Atas9iAqlE4NVqrg88pSkz5wrdHQIqUunbCiwKZOUCYIuCZ600fHRrz5b9vjo1wVyOezk/8Lbqzq+jRAw1YXyyv5mXgmVzmOtY49NtvgUpURoVtMsdnFxtpXr3QiNMYvlGN9U6z7FO2R30HsT7w12d6P9Xn+//+Zgu9/vRQd7A9xv3iTHkuMpsjyOsSJbkAkR6MhQms1M0iTpI9yZNfkRr/rdfm+zq/93CXkRb7vdxZo2BPg+OutjUYTLSSAPIds72O8+BbJQHUqsMh7zUCveEU4SLSwZuvh4amWqIkkibTAPZBKaDJkxlwqkiuLmm+CsdPIBwseVIhPj+jT3h9qYQop30O+m5F8h1vwW0wQPtEjwgeZ+3BHRlE+psYOvY6IFnGl5ZatJ1ieLLVw+0tHcyMrnlM8ViZxLYk+WByXyZGp+A1F8zKNs4ivJP5EklllquvxcGVt6VUEm3qyy09TrDgUj3nwzJknC6wyWGRZ8f3fv6t+PPmgLfvvNjrZn8gdPjo7nPerXpbWU/fOzLsDz1QUIl+BHLwpQS4vvrCJADQ7rkN7wnZUDqKHi2uQ3LFULoAah585tWHkhgAdwXoNch29SBaCGDC80OSLE9MXl/5eReznJ/yFmLy3zfwZuP27a/wyC/Fg5/zOI8D0k/Ieg/8z2/4bZ/gXC/0z1/3ap/gXCv/A8/3pcv68k/zoc1sEE/n4y/OsouDbm71Lp/XUYPbf9+6S5/Q8huAbG7qKJ/XUo/QCG63eZ0r9Ke2ZGAGNu4bj+siN6S5i9JmmbC02cpgmN8CCp3kRLEqX93T3R2HIhUuFBAoK9AaYDzhOCWR1C78xPaJjgAlq27vvl2QViZMQVNfdVd1gG/Te14ulVKiUwk9Ch3cbJMkQY6EP6c8YYSRpvN0bu1ZULmf2mS+njdAcEvgK4SdxB57agvrGxEC327zg9/HiY901+HbYIophhCFvGUmupE8KU3FKJ3PQd1TQOm2bcmT907sdqkvyCk5RtOhg3aSw3SiFSthVLbjQk/I4I6C1S2/dqq9dpzHSCyGyyUoajshRcDQxn54V+MB5bzV73RsEpc2ljNjP36esZ8WthWzTit4rSc0X8zoJkRSReZcRvuBZLrcF6RvxaOF9MxK9bpu854jdck5cR8fucq/LUEb+l1XkhEb8NVygf9TuM+LU4rjTi92Kh2N5KTG9+RhhYK6bcN4nttZP/C2+vLIisPrjXTPxkwb3bBzs7Oz082Nvd390h/X53f9AjvcHO7v5ge2+n17yAk6HHU13hSoUnaSXW1QZ2rkNwb4Dvk9zqLoLwNw/utciuNtD0onFIaUkg1wiAStDRygTAzzjI54uDDJfgR4+DrKXFdxYHWYPDOlwCfWdxkDVUXJuLoKXiIGsQeu57oJXHQT6A8xpcDX2TOMgaMrzQ66QQ0xcXB1lG7uXEQYaYvbQ4yBm4/bhxkDMI8mPFQc4gwvcQBxmC/jMO8hvGQRYI/zMO8tvFQRYI/8LjIOtx/b7iIOtwWAcT+PuJg6yj4NqYv0vFQdZh9Nz275PGQT6E4BoYu4vGQdah9AMYrt9lHGTxmv6pof1oVDOUYuGvNtx1c4qFtPFa8D0XdEQ185notJqLnE6/sXPcrcWKwwM/auon9E8SmxA6uML20YFwiIRoPoSiKzw6E0HPdilmrjZyHU5VjGbgU8DmlVXZaa46uu4fKWagR7uGURE31f21mFACR6TzFwv5oXlYEHthBff7PNXmOYTqmUGwiQTFEL/XRjKLxhAKAC0jiFQmNhTCCuy4eqfRiMDOxSjGCg80sb9mREw7hi9y7h8OD/Cbgze9wX4Uxbs4rO0KwH5D0pWpA59N2VVpaianCUHkFkiV0BsSUsbGow2IthyR4iOiKWIsJHdzZ0fG2noWnn5jzOLEWFp+EsoUEZs2bpLEjqSyTL6dwfCgP9ze3d8fbO/EeA9vR+SgfxB3SZfs7G/v/aWGQ2252IDMDodvTGw3bWN2Dd+hpoTSmI7GmogAsn7vjosbNCFYZsIalMDDnict//qlCLnYnRElIne7w+7ePsbdAT7o9gf7DYiaCSPHbF3iL5/P4OPsusRfPp+5isNwDMZad4UiQMYm5BoUe0xiobSd/uXzmTS3lvZJh5Smy0AQfEPZCMX8jml24khGYzIhbWRqO7VRitXYvs+Ri7J9TKlhM/CK5PerYxjdsU8mklwWtYplqVqeZRA6ZUjyCYGAaS20NJ0neGoqaduw9tNzTYUtTVpN75gKEqlk2vbuCFxEzZjZHT02+Dj02G0TNu7vnNEdeDdGXM+hf7q2JbUM5UIIDUIaMHt1reFMqCICJ+j0/HbPj0lYlHDrb7z+4xrW7vqf1+j16cnle/T5/ZEftL+/3d8wMIUP5q4T536BYOGBpk+qYMfYfejA9SMasF+Vz8GagmA+p8GFva+KI6AvgAYrJ5yJudVS2k1eo7XYLe9RA16CkN/YReMlBMdm96hgqS6ro1OJIOpAEoWollo28rqt+ZJxpY8LMYVy7WM4NYvvlwZ306ZEUB6jSSYVDDLQJ4KGj8TFEyVPYTAPDwhqpWwUVM3Sr7c6+rtgro9c2aDlO1MzzuIFapCGMz/tHKQSvXZWrsKiM/pzow2Y+zGBbFir9CyMH/SM9bo1+rPVNvCYEVobVX5KrTPLMdFQ4NGkmc96KR4650JZJd2KFQQ3WmYT/HIdCBnF01Zpva5/uTZXVKqgNzugLXoelyxpot26mBT75TfuCXM6NL029OkCHUnpREtFzOCInPIMCrvnMm8arLVUPIzyogxdZyLp6PGuIWkKYk9BZpp9SyV4MpmJdiKxsQJBGXWCCNQtP6TkmYjqM19cfk4ujd7u7GxvSYJFNP7b11/t9+bzL4qnhbVxwmHt1+fVFzbhsVal4lyiAdtKJAlhBbp5etXsfMoQMy0Y0YQzqri2c4xA4QNQhGJ/Wg6IllyWLWAlBcEyXGgMOWQo4SPZ9ucZNDtQhKF/adnk7QwbSwwKSGFDhXwxIZbl/Gt+WCy1nL3D0gPaLihIjKuqYFmKRfRoM34ucE+KpQxkz5OnG9nh89YRcIB1SjCo8eLcW5pHjUtzBPLPEqJVmpaLBS8UjR/krbWsa+HguSytwLGzU71w2NnZLgAFpuYq1Q6YwDKr+XVAjPZhfrFpe3U4eH7XNC0xVeV8+RucL0Y3CT0w4SwdLbNxUYFkXL8LO1HkN2cmmiKAvWO1T2Gu6GC+Qab8U+1gMoOs0W78iJBSgBkik1Tl8ADo5slr+3aEmZYi/tqYQsoCUxQrggZE3RFSzMBUd9wo7aVD1CRlEkHiq9XaG5eBdZlPCqLWWVAa3zQlecPpbGB+Cpaxoq0FY5mHwcBrDTkPA49aekFa4RdlSWm0PkvXmCgiJpSRWJ+fEZUksfkeGHL/rLsiv7CW2XBI7/2I8Aykub7d2jKPmCc6XIw2OuhSTG3BYZymgt/TiQnhoFLbIpJO0mSKFFicVYVQL2WCBySRWvokoC7BuXNHkgSwvzw7lrmgiXgnu2lVRXg5Lsu72MCwXRUfXMDos8UiHCxl5doECly/rVUPDbwzjqgiZo6hVsnkfhKQ5VYZNsf9FH3NcGKUDfsMM83oQSDlcgAnicPOOO/JfURSc2SPubZi9GsZi61mXdnFHTDVsXNuBHZFGQJwK9p0dvtbZByW3gekXMM4mDXCjPFc0SrslnaAfW59l5EZkMTkrlQ3b/1OL0qDkK7GVYGlqtnsWKpWp+wXsEMUjDJAVNr7Hi+MHEPKbNDvyGzQK0zRLuzLHDYj1q0O72Ln8zFaxguiTwQlME1y67Rmf2LZ+PpT8fQK0PgGUpwMhySC3AOt0hkusdi/Jpdnxxtt4wa5YfyOaRLmdM8ND5CGbed2BLkW7ulgd9RY6OV5c69K0GUt4hPgg+9b2IOgnyXn85VoJvHh+wLfZJKIFYYWfLHD12jaIQTGVWp9u+7zbOcucCH4/K2L16mMiDKjDWvpgAc8MxITHjVGGrSqI7fY28DWnQjmnecS29VO88cY3xJwwRAI9eAi8OUwJSiRVl+ESUCscAEmIYPXaOwkhfNDY4YwJONbs9GI/kBKTuzCPapN3RizEZGd1UqDsOu1cfNyMc1JDjrwhED4Gx/OUuIwQ2fHh+eatIeGmY/9UKEYaF4m3eIOyUcrZOxidlPzWkkWPH2iPnFYz9M3ItV4vpL56d/WqoLvglExHA+TAREKnVAmFaFsUZIArz8bz8Lsz820hgQra/5bvT/0FZkAe9uYU06lIpOtNMFKC9SFedtgscKDJVxFM9miIAYp+0/OY198A1lbvAE6ywjTorRwSA3hTt9IS4Yw42w6oX8GTl9Dfv/xiyTDLNGb8Fq/1KHxteZB80EjeO2VzoizoVlnnBQPRhbXKPGZJPHi7Fpm1CjP73hKJnXXCbIm7fdis7e5u9nvbfa7/Z3+zkGvv/9mf7O/d9Df6R/sdHc2+9u7vYPdvf03e5u97gKlri2KVS5eFsmnF88XYy6sMcgFSvgouNGtoxXukCVFs+DJytKbfW0iE8ehZ0LYqG6K5vvc6mgllF790bqhA8zwFY4nlLXaqCUIWIhsdKUHXKDiz4vTlvzdsTMUfkiFMMd+TVXCHMCfSmENUX5gtbBMhO9VMSzjsZaqYQ7kT+XwMcphTscXrB7mSP7YCmJOhx9CRXwODSIMeFpH5aB5tM0TaA4OupeqFBTxW8vzvgjitz/K3fw/T+mZp7Qj0fd6APtK5+t1tjaXdI88eH14zo9wpiosRkT9kK4Ji/qa+iUsdOuqdzyDU8JS5KUqH4tSYC3Vk0WRWEtfhIXwp4rzGEeEJeL3qgQ1x3DN1KRv7IKwRHjBulIYLHWFRy6FJwiZQvm3DQKnzBgufIpBLj/U+p0QExSP0UDwuyCt2u/uyzGZ2jQUOeZ3SJ9EDN2RgcsJhqQVPRRlozzC3hYDyDyoLrr98bFOMdHTfisxbmcrrzE9H3NGHrBdVgJQTtKq1MFDLGgBqAUSs55PlWMBt1wVuKWM4Qf+J00SvLXb6aLXZg3+Gzo6/2LXA326QL3+Vc+EcH7Akf7iPzbQYZom5Hcy+DtVW3vd3U6v09v1cL7++2+XH87a5p1/J9EN33DFR7Z6/U4XfeADmpCt3u5Jb+eNJfLWXnfHtorypJadIZ7QZFWZM58ukBkfvXaRn4LEY6zaKCYDilkbDQUhAxm30R1lMb+TGxUCmicrcDdLrVxP0/uTKbnBRlY9dOYACzOSfesPAaW7jBJc4S7DMB/4v/AtKdPohghGVmW0VXAws3mwTcUQfDdrX+x0djrdzV6vvwkFQmlUhn4NzblHr7CrLxCs76wl/Y8yPZwJ8a3W081n925EmOKyjbJBxlQ2b79icUcr+1UDtjIzQZrg92s7jy25ANYCVmTEBf3TPMHLSFKmuF9cLY7tkTUQHMdQJpCISCv+IMcokYEN8ck/Lgka8iThd3pk218wT5KGFLjXvgbRxluUUJbdt9EER0BRRu/zZA1L12q9iE8XaMqzV6+EPuEx5GVACoDNN7JZwAmVqm3z+4M8D1NTwA+Z8jTTNlTcQecJwZKghCiUSciIQIOpJhTTM2BmyoKaqU6OLtqaqqngKZcE0SAxEMcx9I6sxvQDmk01ZS47qy17VeHzpgKr1+30ygfoakEN6ok9oEbpQz9Qwm8Te2Ba9fsfZ4cfmyje+jmncmORJ29aE3KK3nT7nd5XpPDotdwwWWMpjm6I8pWOpMn9wBJRNoIaJtBlw/wJ42MpeURt1T49BHNZ3WC7g3GvsfYbE/tSwnYycyS6DpN+p3w0ye0djX0dFoJEXMR6OMpGicVW4RHkl4F0yKAOBLS1dIs3NpUPNKBfNynb/IoIi3AqMwOlbFvXQx1kqJCwrqYpjYJEN5ttAbVdsM/Ml4RJLtBr0hl10P8i5KaNfqeCyDEWNxuQdk5vSTJF3jwDR5PAQ6i0XKIEZYyImatqhkDmIYtcvsASvXZ5JHZU+1sR/40ZSM5Hz+Bnx10UyznoGWn3FyfOk6mXv5R5CaVxZzW8ohnddDkijhwKj0YgC+yQnwauDVnA3I57OyGX21Oghv/c43ZIz9uhawmKtPhdYQuQOYdUTGUkCDjAyjvMjgkQBOPNWpchFeQOJ4lsIwHML9vGA4JjNMAJZhER8gns35U5YQHR02NjWGhWyetX+1WpyvGmZ9EKzeNPqa3mCRiA62kRHHimJI0fqIzuT4MsYUTgAfWVZt2xUPlh9vmgj4fCQA0y23DN1KiS5uZaTeeOqUellRmFb6W1IKAFFR86BULLfxGNqSKmfxcgqCr0whCGJPN830tQHG21Fadtb3p58HoY3pIcgxWs57r4cnGyof8wjRUSeNAPmr/gqjBygd7bfb5RyFTNu1x/zXAylaMMi7hj/obq4F/vyGBMknRryK+gJFCypfXDhMQjoofeKiB45XRtIjtjNfnjf8BAHrAiMfJn/7lRWxDGFbdyuYhVtfLVHy2H1wI3uVGiDxeXQb4iLoFmEYWJfIHVAhVkxEWuiRYWJ/f1hHVsoHkI9CKPbqXcqhbJ/cdF44reAcRrZmZXaBl8UU9I2HL2ZJP+oMcJnJnhtHVvz9gU0S3pTKgSxPR+1xJta4i/AnMnv0S35AoSbq8C4ORVJIg2q/44ggLzftpQ0lJiTuyT+5RLLS+O/nESYvjPyqqeMm1DfbpApjsN6nd6/c5eO6zjUiSHtQU/nx8t0O6bQK+GVW8LJzuDWynQj8zlKZVzlqa6JeqWqGZPnDQlwcr0FI25w9gKhNenxxuuuIBtwFGoxlF3dCKT491Bp2FaNsqKF312Ajuou5Wu0rV8ZjRl/bsxVldUXuktQOMNy+tlHs8dA2VePz3+Z80abZqOR91ut3HXGyjpSVZXr/wQCWLqqc0WMAUt20obU2N1QhUdGSPJ08Ithuf+uLQuZcLUr0g0opsDyvS34BWORvRv+o9fPR33er0FyKgZ72qlzG9tTS6QjDCrZ9XaHli9bu9NZxGm0OMzIjq3hMV8VZXeL22lmFnHOoCADAgVtC4Jw4OkeVujiAvSGeQNceYhM0w4rj1GX13oYUzFCIHZyN6idjtdrX/3up2urfui/0QD4m4hJlwqJMktEWFRwXdasZR2RK5tVK2nSUmknMC1LUjtNOFUOaJMiBI0kug1VgpHN+gWQnxyv6ep53dP1bSNUkFvaUJGxJY7tnEdighTC3qjjegkxZHKRw2jNPQYflz92kjAsHooG28FMNkWsFCBeoYSUKN0OQUdWHcz5lGmUd6o6Ke7nd3FlpiwWyo406M1uv38Rmt9EoL10KJjNkW+WiVwiV2hNlpmheBunwqix5drsESKTFIu1ml1Li1EDy0MXCFOsMoMoTVJYxpU0WoXzmu3VtHT7YuGFF6tRx3M94+uk0rB/5EbzK8//uN4Iz/soeSYglbWnkawDMCfmN1QNgJHduuM37XaqPWBxDSbtAw3t36jo3ELlkAbZ+i2rxfVi08/InCCLLspIYIwn0vBVPlY252urV41BU9jTIaUFevx6hHyhwtrFHARPEEl4neMxEZ7wQyPjCfq/enni8vOJzEyzXPQa/hCC0/05WLTdPtnnG2mgg9pYGoFbWva6G7MtTCg0hXRVhyNSZKC3Ae/uyQRMKfWbEFOaO0r5SxoBKcInkiEI8GlUZzvuEjiGSzKbuMOo1J1RvwWPBWbVhQBu1aFgblCacaqdklWqF34Va/VMKDuk6YeCAp3CGLoAQeN1xNPs1RQLqiyC4EEGWEBMQaBCFiOghUlXk8T+akf8Ere73YPQmckdMw5KrWCn3tfRaXWAhJzOJibGmOJ6I3l3JN6s9yX+vXLQk/O0G9JTZuPZIoSPhrZdhLo8uwCaWFq7ntiOqJwErpOfXn7PU8REmVK63hoQBkWVOsxF1sfTj+cFGdjNup9wGN4Bg5QnEwl1FGGCu0OSg5+/xu/Z393ZdzD5mcmMFaa1hb67TaU7va3wRAReK1/gK5I1x0Yxo44xnJMpOO345PPm4TpU6PYfl+LGR+zbvsN6Devoe0LVMUvXMIMSH7Z7G8Hze2WAUS/3JFj3N/du97w6J3c2kXFKg/EDRvpVpzN7oYpv36T7SIojhSmN5OhR1ig0rqj9WpbBxa6VonsBH2krm3fCDsi/BwllDBlCfr4uxKcwAbWxw1kNKwqXtQ347IN84J5bRHM1xeHHzc6JpJPzyPRLRZTfSJEpW0KaoPrEWoUiGCtwOUzgCafentCFKdZ0bx7hub+448XKMQYodd6KFe/Wlp1vZAoQqotQV/9NSj33Vj7sD28n6UFpe9AuVzz9poe/Yv35vf4P0dbSllGrXlfSgv3OrSiXGz1TCdK32lSq1Zt9OnLr6V+9NB7cs5K+72y7IqvTQvKD5optFT4ByV3CyLx3F0nl9u4pyx6BJ5r0HxyMbRLnL0g6i+0SSXj6gr6zzRAJ87P26K9QCcEQWsfGo0rSqHpAZBwNiK2dXcM5axvcULjGp9rv7vZ3d/s7aHu9tve7tvtg//a7b5tnu+jETL3VKvECHwPTbDpHWx23wA2vbc73bf93cWwCfrIr7op+KHvnO8ChswFv6o02y9juUDb7QCfKBO3q9pEcAGuxze42HAWkiT6gcj+FHTSD3qdB5YZMm3kHVmc86KCv7ZR091+4yuCgAjkPuWsWbepoKFJAdcTO0Te6oIIKD1eXDQT3NAMob3d3e19b57G5L4Uac6jKxNfVo5Ab464pH82WfxZSIOLgv7pL0CCtZQpjrSBhgZUVbXzfnfnTXM3i6A4WW3PXpskaaZyd6Zw5Hi2rT/dwGUCAkgqwqLQnz20N9lQwh1WPB1jZtrtthFVQWy4sWKV9TRwMJISrVjAtUeampBxP3Tezq9C2N3d9+/eHRztH5+8e989eNM9OO71j44Omzfkd+6MlQu602LKdKF7uwMilAi/EwidnEwIXAWFRejNkezcL+jfOTrDbISOxDRVHCV0ILCYdtAFIf4mdUTVOBtAfNOIJ5iNtkZ8a5DwwdaI9zq9nS0poq0IBtjSNj38X2fEfznb3t7fPNverTYj0mr57t7mAmLYdd5+FnNTentzVrP0x/e69/g9hzm5vDXp4F4Hc7IsepyjRm+emfbkxeWvuQ7aRme/Fhr7B/am8eWDdflkq702pmQB6UWxeG5bctamLCzcY5BaA8OxhGNjNF6oEeg64q9U0wmyiYwHHFSPCpttzwN6U8/8Fg0IXG1jFo25MB83IxfxaO9z3plnCiD8G4x95Nou2TNJv+7vJ9zVAtyEJontagnuZw1qrcccUqLGXKpAUBs64YT6rpUpVmP3cPBgDYD63zFJBYng1mITbg7yF+GaBj7RYnYUZi49qwCfxq+j6IT86fLvZ4NnouBLD0/oyMRl2quDwuiGIoVhOWwW+5X5cFXHNzNQ9+sDYTcQCjDKBCyKmawOvwak1ysUPjcXLRh02TWdO7Imrlb3iexQJlXgRH2QRuCWMO8i9y6isdsWUcKzON8BR/qjiyMQaEIUjrHC9Zvig/3VBINEhVch4DC3R3AcX8EDV25I/WREpDTBZuEeKWAOL3XoBI+Curez7qbCeicTuokHUdzrb9dKlpx1TvXY6PTYBzoaRBytjLD5BR3qJYRneBKHHOwg1Yh1DLiOCA+CO4s7aoeZyyHB7A7yqwb0mg+Ap4EfaWEYClLrkVA03S0BHBMcjSkjV0Eq97Jg2KHCrPCmUIThYVeBkFwWlFnjNYUnFRwE7KMZxA60OH8IMspV1WVnLwxSntnKt5hHN7CDrIA7dp9rpIH5DRQofdAnCYF23yDdzG9aGMgxF+rKHDG5YuT0CjPfphduM85/D1YT/PNL+eJgBTlpDkAoC+Z/rCNgQMT6V2qXcMZUWnQuPhsI82AzLzhr6c1mky4/nW0Ki35Bl5+OP71Fv/E7rUFNcGrKKPytAktBl0Hz9Rk0+2BC/nAyIHQcT2sV4y+z2Mby+W/umcrQp2zIQ+62px40QHUyLmBo/X0tO9tz8eToIkzUds06ZYdEsjOdJO78NJmDWBgnM+NsM3+zVICYz+rQ2WhnzF7KQnE9N8SA84Rg1nA5hjmtIIcpZ5PqvFx2BhlNqlNWOcCrLa3em+Ne96DVDJxPFwhmCEOL6gGJeExq9808WKQSREXj5sC4WUyVUDb1HHuTDYhgREHUhOXQv4ff1Yyb/+7V0KJOmQ+KQv6cL5/zlx6U0QWgl+XG8lqkPK4XYAuJhYA2KTc+uOqy66mymtNg2ZnOeYy+nB7XT0TTyjyFr5pPcXpenQE8GCmOno5s+YjVyXhcOZ4eOZmrhTVjspLN+PgJ3YB1Cfp6xv/3f/6vtMWvqiDZ0+avjz7Xgp+vJjhNKRvZZ1t/bShUApzsOTzBaRVkqGhqXJJrB3cAWz3wkiSQV7R+oHvI6gEXJE1ohGWxVCp6NPfm487YNDFJEz6dlDwoj584H3fGxOBbHWbJk6McDDxj6gf032Un9sPai5yYDiFBVZlmy669fF5yVGRM0QnZcEe7PUXzc/3cf1EDgf0xP9G9I6XuBM7HRk90/JL7pqaDnbuTB8bPMR/K0/A7RkRlohDAygo5ysCrRYUuf6OMFqpLBH+IMdA893stbI2qMhehKTHpo+GpK9JQnrNQNaE4a+1PjItJKRylFv2GtZbdv9wHCdXw/+J2yr94wm8o3sSZ4jGVkPWWb5v/bn5Fx/aXKQqfQ4Ev8EFPbM1Qod5s4fBDzrqjsM91jKu6mOT20F5s5LR3tzs2goUPPWhBxbh6aBp7rhoBcoKjsa2fPMaF6gQ2mi/CDA0IIlSN87WIUZyZUigKC5WljifMQBQKvE9MYQR/IQHJHykWeEKURlnYZElYa6LAJDet7+EL/bFts+8BNEixwokeQkkT0nR6bp6wAgvRuA15MZA9WQAJcq2UBMrUE9emjaSCx1nU1AhvRGKIrvNnjZ1Am4ke63kArYD5CgC9kr6k4usApo0HgAqy9Z8MJjOqD6bwJAs4S+pDGEpdUlYPYSZmZLQtD9eXz2dozO9MmJgBxO4KgHHeEkaZIE33a9EdMwOe38cENmJOkzss/SazTi2cqbE+r1wxI4EYV94jUb76bdmKIGOChYLb3QlnVHHRKkncGcLSPj1TIZl5bwmz2reLd5WztZjgCmLWSs6Z062om9SIg1qb+8kmKaxO2Rc782ifc7DPPNYhGfFPIt4iCZmWVcQe6xIpoAWdcf7FB7ainwsd9mzUeUZE46xQGgk1U0QvucKJQxAS+YlUdWPNQySTtWgEobm1cx+7I5IyNKGR4JJEnMWyxhKLxqTxNUAmkk7lhVk6/Ny1PzQpfHpEC0IxD/laRel1G5Is9X/GSumP+uCFv+V1zUYL/N1NECn0R1oakd+ch4gPfYsJo4rYldd6yJER8JABz0bg1XXP0uIC+5c085+e12D5CAfh6flcKE9DqIqQOD9YuzAeJADT1JXcjlzsiEmplTy5JTGiqcvXzAMDMgGWMQSF1DsHCnxvC4HElXVZ5iLIVHnlQi+Ck9wRhGRDGolLuXCUUBwqEZY7dQX+hDGJbq7KkmAJyA6R4jeEOZ0Z8rgl1bIOM8IzmUwRZbf8hsSuy9TQTC5NxeW8XvEd1IZzFXzR6bm5UoKH3aHuCiEff7ywhcWqCwHRMimuyj1NpSuoWtFQ0tMJsfVOQO1JTQ0C608FtR+Ud1M608QpmL8BZtBK4CmtxRMWBw/D107LY+RegTiJs4TE5uW6exzoMvL4w8uMYybPd0zRSGmjTGZQfNcaPa2MtiB4sGUv/Vs1FwHml8XOmyJ852YIcIDkBfaK2hV6Sk9I2QkDs58eP4VTo3Zo492zWii4/QIl9INdCvi+rHnayE5TMCGe7zlzA9evQWHU1rkg0lqsUI0fS2VDGMmEQp6HtXOx5UywT3O2MXvZb1vC4pRTpmQb9rcM9jdVY3Q94TGcb8l1p/WAnvtYTe302DN5SJACmfTmvMJVno3D5+fO8h7y+qBhlVkhwBNmPT02MoAQNlM7u5rIyuQlPWnO5GeEjdQY0NQA5LiCu4DcU6lMJfEJTZLg0J55aC0pU9zZaMZro1aWttqoFfM7ZsXGMDH3Ea3Cu5hNLbzamozGIGwpUxy552ccXVWYoSZXwe1agFmBXmu5VWTVBak5CBsNmaXzRtUUWG5c/ea8kTWBrsZUqrx0NIJym/rAj8t+zbxkalwlXeWaqQjO2HE21DbRHA3h3cahWNlcqxLO/1b6Ec3b4eihXY5m7fRG8y6459GD+x41tpGq4CwrA9A8OYAetzSPkQloQbmA5skGNGfTVfCYKSPQLDmxxPD18gLNlhlLzFGWHV7hyCYTDOKionKYXxq6u/Jx0HetYSwu86tHX3594QEzglJmUURIHASdPeZQWGDiIaZJzaxPddabcbzgNbYO3JvcjXlCOujEmAza1hOAa/UMx0qRSVpVuyB6qxkoUE4sJWLIxSRgHgOUz4pGPW+/2wIM+qG2LTwX2TqdjjUNV8KdjSBgXZYBn+D7Kwt8lZQLQA9eRHxPJ9kkWEjL6rCKrsuCwZDEHfSRK1u/DEcqy3O/7ZNygpMkvI/M820YTq5mkXyhCMhLkRFEh4hxNMwELLOF+Y4mSQFeQ1PomqHEtNipOQwiUWL6NH4I74EAd4QrSAE+B+dwMGUY3fr52wDrNQnE4uH5ByhPRKOKHTZDJFaSfuZcrejRnacGvHAFQxwq+rEbgN9LbuuYCkD8jUuFEs5vsrQhiPkYaJFLimCiQsDubLG6ti7Np/ZL5i7CjOWx1CN6S9gsN6FQCzpIvFPc+RNNYyZYSoShIh1c+ztnZ9OAkSf30bvNNGVqTBSNgqCg1oX/0iRGNtUzwrHq6TVjgYIJix6Th3i30eWa93jh6AaPyFXxgvrh96BCyuM0gFM9hKkjkLejgF4ecGkDaiAXsTlXfFqqdKoWZbc8KgUY5VhNE46rzpeKmfiArTlI+KB63FCGCwZrAUfzqynMWQYjHPZqQhe/npzQSd7NQ4+CbHJjcXypSFUolLfovJ01009Yc403L7ApiEWvtcHmIT6b19Bcc2JJg3DOneNsGqJGos5U2Xf3G4qk5YCrh4hXQWM+BebBVZZ55an+xTPByPQbcc8SPhUv5fCoTJXiLTAeyVysAPhaalj81o8vLGBrzBpECP5wTOhSjDGP8BMiZTFLDz3MUFLh6ObhV/LLeEEIk2OurgQZPqzdTBme0KgaeTkP+Tsaq3FjKVZapN/1y+62DUI2cpD1wqX0HhLs6iYeEzoa13sOG8z8G7z94NS1Mw8SPsuX9eCOOVRK0EFmw+mN/8Uc+zG9pXGGC3CYmTroE0umEDyobRA4N0iMJBQRoeqVcbRANWTOCLojr2L0NSNCf1x04+lJFjrN6mirAQVT2Vh+yTQPUZbGpIJcUyj/zJkiTHXQERemRr/pngqYI9f5AVFtIriKIYLfyTBEN1Ri7Y+ujn1DdTYfsn4pC+i9K04BHgslcOTz0WYHuadEUMJq0qUWuIk9HEieZIq4trBWi4QCG/kMHjrrQCliaP6Z9si3xHWHYPjWdpmy1+DG6zJLgM6XC8Oo3h374A5xJ5JG7L0rzal5ZJglKMWUuU49qz1SfNbVU+BxhsWIPDcmcZQ8HpNjtyMtKtDgl8SIsNiwyzdalaIZtCQyZxwvD/kMzakZ/FGy9F1IlCXZxOzbBE95ppAc06EyPWC8Jy1J0FBAAfyqo8Nu+ysQWo8RRTCAlRLmoo1OzGGWd1SAcwALEkqbwiBWQ7TvyiXFzWNCQFjQfS1AqV4/L3oh0DwTsrhsrkynPqL5EE2wuEFcnyIYuhkGxalmqX6iXtt5kOUnnHHFmW3aDc51LMEZAcgaWQ9XlLNpP4v+qNlerjivnsb+CDdzafXKuKzYAslV7rAT00NvldC5A6WUxHYvW6QKu9xwpq84oVQaep4vL88XjI+3I9TTfJbfWU+zmPMuT3xADfzOWh0PfljK62wvCSAi2EV/W9LUXXaUukMtYwYOeDxtzMbfQg+vC5BARjsP7R6HPCDgg4uL9zTuGWnkvFHnI5zYTj/1FpogMRUkUk8YvuCKKfqh0ZAnCb8zsGIB1bKhBKDv2KyNizMsFaLQYN1edVNTKUTZDJohFyalrDKjJopXdqhEcly4Ny2sGcExKaWLo9lOWjTbUduIFhDKgZkR7G5yt7J5uLXdrWb9alh/0WuP0uWOHj3BUyKQgLsWJWhqIn2b3ne4OOnljoD/XaGL9/wMiLojhNn75cFUgUi19PiaEegKr8/BO0GVIkxbcpXR8t0Bj9psPiNPLORcdIJJoYcdZwgnguCKREBBWcpqm6ngWsr9+8iVPjyG+WSmC4NCengNEnAoF8YBgFEqyJDet8HOrhUAyF1Px5yYkbQYmOb+CHOUwkUFaAWsGCxRWiYOgBgjUwuQFSoN5t+CmSmohtWuFpLVTfmNh4tUvBTEphNiISWg5tAx/wwZf3ICWiEn6C1PrqwYWIoT5vKBtFkFtpOg8YeHkqdGYlQlhZEgD0iMNSay4/Arczg9DZlN8ofbXU7GhwT3ORzhl5r4NcLdHAN6bwanBFzbF85NK/31euQrV924Jdn//a6c05yWjEUorhEIBiUouSWxz54OTTTkbbR6m5srvKTrao60DsFzOfqOUbQhyaRpEN5BF5qfjOZbGc4kGFFFcYIuj86D9XXRTB10wmKrN0N3plx+V0aLaeyD5UigtK3vWbAuXGwNYhWF9rBeENBNGxrDKlrYFtZTuL1t1eCFDOO0GJj4kGVcevxRlnEK1VNg0Z8iHKoUj3j0WLPA7qTl9n3VWqrd90QqPEioHCNc3r0L6PF5vNm67IYV2FlzKJrrvebqiKQ59SDLQcujInnXhVAuNI5HN3I3vFH8dPT3i119Ltw3Dr13Y9QTdVY4XDBRSXS8fUB0PHaHnl2s1w6tKA3h7gx25i3Fjmz6IRtiP0cZ8xs1GETxAunXhR/9OZaEQZp6qQiDRlL6pYWOtGThCM2AMRY6yoIGQ1eMqyuQCcX+jWh2GqdrkfEW7Xfe+Pb5VcrlvTQoQ0N8a2rylJtrd/LWkdcddIJFQolLDCv2gvQs8UoW+rrDnVWhE+RDmIatNx/C6eFc1kUQhZmvO+gMqyfE8tnlyxizWI7xzZOdWBUJM6RMixcNqp+sgRVXGXj9DrbyPIZlFlvPY6IgYcn0+An7hwX9R+Yh6jWPup5ERUCeohzi/K5E7l/QnchJWxpNQrPh9OjDeUPxat+sJ+gMbjw9NwUrmklV66JYOK26yP0fbdrVEGnk0Ek05p/twOAleQoDwI+MPgdulc8k1VZkUQQ05JunzoT4/wEAAP//fVNB5w=="
+// AssetTmpHbfieldsYml returns asset data.
+// This is the base64 encoded zlib format compressed contents of /tmp/hbfields.yml.
+func AssetTmpHbfieldsYml() string {
+	return "eJzsfW1zGzfS4Pf8Cpz2g+wcOaJkW7FVV3fHSPKu6vyitZTk2cRbIjjTQ2I9BCYARjKzt//9qQYaGAxJyZQsOm+qcrns4Uyju9FoNBr98hf2w/Ddm5M3f/0f7EgxqSyDQlhmp8KwUlTACqEht9W8x4RlV9ywCUjQ3ELBxnNmp8COD89YrdW/ILe9r/7CxtxAwZR0zy9BG6Eke54NskG/gMvsq7+w0wq4AXYpjLBsam1tDnZ2JsJOm3GWq9kOVNxYke9AbphVzDSTCRjL8imXE3CPEHQpoCpM9tVXffYB5gcMcvMVY1bYCg5w7K8YK8DkWtRWKOkesZf0DaOvD75irM8kn8EB2/6/VszAWD6rt79ijLEKLqE6YLnS4P6v4edGaCgOmNWNf2TnNRywglv/385420fcwg7CZFdTkI5VcAnSMqXFREhkYfaV+46xc+S3MO6lIn4HH63mObK61GrWQugxO69FzqtqzjTUGgxIK+TEDUQQ2+FWTppRjc4hjn9SJvj539iUGyZVwLZikT09Lx6XvGqACZMgU6u6qZAwAkuDlUIb675PRkG0NOQgLlusalFDJWSL1zviuZ8vVirNeFV5CCbz8wQf+azGSd/eG+zu9wfP+ntPzgfPDwbPDp48zZ4/e/LjNs1OyZvKXjhQcRLD9Fd8DJVZOfF+ltUYJdy94P954Z9/gPmV0sUKAThsjFUzlM4dz6uaC20ibYdcsjGwBpeLVYwXBZuB5UzIUukZRyAo60QrO5uqpircEs2VtFxIJsHglHp0nFgj3GFVMTeeYVwDM1YhA7kJmEYEjgPjRoXKP4AeMS4LNvrw3IyIHUsc/vcWr+tK5A67rQO2VSrVH3O91WNbIC/xSa1V0eTu9/+sw/gZGMMncAPnZ9zm0wslq/mFhY92BadfKs0qNSFeOSEjsCQ4xDG/wPBN+rnHVG3FTPwSRRZF7FLAFS4nIRl3cPEB6Mg4HM5Y3eS2QdZWamLYlbBT1VjGZbtiOjj0mLJT0KR5WO5nP1cy5xZksmisQjmfMc6mzYzLvgZe8HEFzDSzGddzppLFGnE6Kdmsqayoq0i7YfBRGIvLFebtgLOxkFAwIa1iSsa3F+f6b1BViv2gdFWsMYuWT25aPOkiEROpNFzwsbqEA7Y72Hu6PKOvhLFIJ31n4iqxfMKA59NAfVc8f0qlz4vk3tY/15FCPgHpJYt2kGF8MNGqqQ/Y3jKW2+dT8F/GWaWVSXqcMz5GocD/GlXaK1yQqKst7qclTR2Xc5wjblmuqgpya3qsAOv/oTRTYwP6EkwQb4ViOVU4s0ozyz+AYTPgptEwQ11BYONriwveMCHzqimAfQscVYuj1bAZnzNeGcV0I3EDp3G1ydzm6QjNviZSCaSZoj4eQ6v63UpA/LmoTJBV9y3ClbiuULFNweGW0KcJ5NUUdLpRTHldA0osEjuFlFRnkCADJElvqZSVyqIsBGIP2IkfLkejQ5WeaFxiuLRNr8UvQ1FgZPiMgZN4+fU+PH3tTCBhVhBEM87regdJETlkrJWNVKEXCsL8OE3ubBomSjQiOI6NWzmzU62ayZT93ECDDDNzY2FmWCU+APt/vPzAe+wdFMI4Cai1ysEYIScEObxumnzKuGGv1MRYbqb48vD0NTtDcdLEMr9AnZDfsE5Si6ldNeNGVEUW9B2NvqgBVumAa7XA4go7/mhBFmgh4FAdVpYkD3yS6kGypRy2yE8hCYBVcXVyOV8Bz61A7ifCm0ARJK6MWqtLUUAPbSJTQy5KkaMUzbh1tpdAc8ZbK8TZRDPNwGqRo0xFk/ibbD8bsEd8Vuw/fdxjlRi7n/3jn/b53hN4Xj4vnwzKZ4PB7pg/efoUnsKzp8Xz4kU+fr6Xj3cH3+QRRaTHsr3B3qA/2OsPnrG9Jwe7g4PdAfufg8FgwL47P/wnvbwwwyWvDHSmFeopzEDz6kIU3UkFmo57mNgwBhMFasRSgPbaQhhaN49E6TYot4uZx4tTLNAY0jNneIazAc+1MjgRxnKN6nPcWDZy4DJRjNzyQxNqeYae86fI6LLDCFFsQqa/k+LnBu5CN+m0A6eRvB5z/LpypuEYGIpQJoprySs65OHfmyCQDF8E39kAlmbQMO7Mb9r9vIUyEZd4XFJoSvmZ82+TATOFqi6bCnUmagCiMAK2V4q9JP3NhDSWy5ws4YXtx+DAbg9CISFri7XWFtRcO6UdYQvDJABqIyXZ1VTk0+WhoiLP1QwHw5NbQvdJifojbDSOVL8DhUeqtCBZBaVlMKvtfHkqS6U6s4jadROzeD6vb5g+euYGYLy64nPDjMW/I2/xNGGmQTQdreGg5+A5oy7ssQy36bBFR66273oRp4HG0L7iLBZRdiY+wlwSgM7kz3g+xdPmMotTOIHPpLg3wOrvaUvoMnsBp33nQtH5Xmq1mo7J2lgl1Uw1hp05C+AT5utQMt5+4o0G9mh49hjlkAdjlBDLlZTgfBEn0oKWYNmpVlblKuz7j05OHzOtGrcb1hpK8REMa2QBfp/G3VerCucXtZvSbKY0MAn2SukPTNXoVlIa7VuCOIYpr0r8gDM0bypgvJgJKYzFlXkZbGm0awo1wyOxUyTkEfFEzGZK9lheAdfVnAAXULozUMRWVSKfo85BRAURmH22fSSb2Rh0V2JWbqGVkpNVkkFbhYeDrg+Fp8UiYLo0fWR2xscEM5iEhBBO8pvHrHHAq3m7Exl/topTgvyEOOFLIrn7bHf/RYdgpSdcil+c2syWt5fPMR/c6fYi5XI7bHQL3Ow0uNH4WeD824QSR9wS9X9ValIBe/XqMFmReSUWDpKHlVjjJDmkL3HpBenEs40TR2EFrgy/EMLk0IIkSzgghycmtH8mXBco2QYPBkqaXvK+PzWMhXftCiV5xcpKXTENOR62o55HK+P88JSg+n2qRXMJN3yAryeYueVoQMbzIr5z9o83rOb5B7CPzOPM2TLeNVKTQlkayrsv0dDrDEowlXaWN6CnKxzFApes5tJwR2XGztQMaCU4j4J704KesS06w1iltwKmimkoQXdQkQsEGr/g6GdyAng5GkM8BDsnQAA7DSgwREtOwjS3Q6T4O9Zn7LAzAO5ljWnQ8iWo7elbSETvX410+PnDOJ5Jo+dpFbCWv1LZJZBoZvn56rt1TPIQxYTg7YRxokvaLR5vuKF308CMSytyRBBXLLKYSwYfvfXe8yYVARUmWnpW4V1BwyvxCwQPObpJWQ7aneeMsA2n6Tgp2Vw1Oo5R8orcuoyF/QF16ETpeQ9fDSaKsQI9y9I0zvvAox8czZgCjEXxQJYiw0pRVVGN8brWqtaCW6jm93Cq5kWhwZj7U51dVeNWgZvCIHM0IFlJUf3MxmLSqMZUcy/l7hsCydgVssuoGaBfH10Txjk/T057jIfdGN31uM18ZAY9zDZj7B8tx6PV2NpQzM2v5lcBp7AeRhk9GHm5jcKH532Q6JkhqLjuGu+j9qf+USbqEWq8UebRGqF7rQZZ0GHAiR2eNCNI5+fJtruzYrI/3XbOTfan3dFbXMZzC+YTZn8y494n1P2sg8i3CM87+uK9Hq1EEgSvSJcn6PnTDmJenD+B2Z10BC53WsPpKZ6wJOFMl2C8D0RX2RjI8+zY3GOclQ1uLj+jDi8FFClsZ4xw6S0API5HqJL7E7Tjp1dB7RgFaHEJ7cVocEKS01hpdCXwoqDL0wgUpBb5FJ3H2fYif0ulMvoPXkR3eD0BleXCzi+W18C9sPxQ2PlqqXyN5ybg1TI6Cm99QdqLXBWbwOn8SvUrsBZwOy2ge9ccR982q/F+M/zqEwt0NTEbYvCbVI7DYMtIK22nbDgDLXK+AslGWj2/EEZtiueHfgh2cvbWMX0Jw8PhtWhtSjQJpZWzfMglL5Y5Vak89Z1dh84E1EWthLSrxn2l5ERYvKdCE6zi1v1nCYPtf7OtSsmtA9b/5km2v/v0+ZNBj21V3G4dsKfPsmeDZy92n7P/bC8heb/bWQf37e8M6H4wpZKfUJXipbJnT4+Rk8sxCH+baC6bimthg23Pwv2tBn+dmNg+h8HkiS5EL+FCez9kDnh2p/NUWSmlyWbA60fvcw6nlbBVMUKvYvV0bjCwI95Y5kFHtUdExt4om0SAoEsPbTY0ZWbOtpmACtQua9yxMlbJfpEvzU2tjOXVplbZ9qkD71YY48aoXLR3l8jLiHJL6PcUU9Fa+7g1hVApvG6KF6hjYB+kupJ4tuMMSXEDKc1+PDllCU3MhVw4U/oSr/avRAHV3O9qtKrROKR/LvPvxdPB08Ft1KyGiVBykwrsnRvhJv3V//vhdXhtSIMRTisV2N8bGMOy/OGp5hclN4ENGjIIniH8sCUFgevFW9uT4Zth8t5K5Gmj2hlq9BkLyXe+bUAqczEUGsy6giHqT1Ap6lV0nJzGU1rYV719+Ojk9PIpmnUnp5f7j7POWDOef2Kwu7B0+/XwcDUyiaZCvktl4+3xjJMB/u7lIftm8HQPvVoUbYhhfsfoC1W5BcseOb8B3rc/749Fa6Kije/c5dE0omC2K8V+auoaNF5p/JNN4SMvIBczXrFCTIR1dz9oRtlg1UaYhL4fGBWIZI00YkJBOzABnbGzJnd3/pf0IsV6+TsrjwOPEKfzehpDJBLpGQz6g0H/2bH7+0l/70lnpiReJdZr7I+rpWP7XHNpvAfp5BQnhfwpPkD0zfA8OifZI8gmGfndeUUzR0BdQFRwyXcugeOmk/jjmNXcXdTICasUL9iYV3gBpE2PlULDFbqD3JEDff+gQxBhSnSttF2D7BVHPmN1G4VxLTcQ/u+FH97vZ7rsuOn026H61H99p7PuXhePpTlZ5wh+/Xyc0hykiiIdD/cjY0FDcbHqlL1SIO6kuFApTcVkihHO7aCBR37sniOkrvHiufRMa8b+p2T+X7a34d7eS8DReRvtla2FU+4Wqq+t9MHqgz1dv2Ngmp6527NaQy4M2ivObOLeB+hilHD4uhlXImemKUvxMUJ07zzCqO+DnR3/in8DPU2PM3au5yir6BpGQ+ujQCvSG1njOTNiVuMtAP/QzquzjxnGjLs7YB+56t2TGGLlXF9XUFWO+vNXR21c1FausubDVra9KHwJNzpSEdm+SWmIgzhFEY8MN7hQQjxfWKYMXYtBVFCeMRI0h9ofNaJjJrmbXRL3zN3Hc1ZzbUVy0cCWMHDK1B0mIPzmLZn2TIOjIvp+1JzL9paBdWWql1BP0VpmmZgx4G3VShFfvR6YvY6vW1dXVxlwY1csCW7sVtBLMUSeQGBwfIy3dYRi6EY7RmvGbZlmvJeZZrzbGaIXAXdx8ycJ8mUTCxIYWz1/pSMVanZR4VqpQQu1IuYHyVrXBLSqvnBkfAF1B2WJu/UlMKtqkhKi/hGcvzp63PNhqPEI1fKdYDLSKr1wD+lWP8pqEBSCh8RlqSR4zbg4bgSbRBThLCH4rd+3SnTq8Dpt2M7EenrRPe/ITWNA07XKpkQmddz5K2ul/UUwDo5TxNkM3I2KKlev/x4a0a+Ohqeoq4ae4qMIKpWVrvWDA2Qw46LaEHHoJWJugHB66ZohDgFUnSt8e7+jqxckc9u0e4DzPvFLLioMtVuy/YbVGLRlxxi9BUIuc8Tdq/5qYudG37zcuWGyjUXgLkehhoBqN3C4LfR3PTt1xS1a1SvE071+z1eDHUzTmfCDLSMx5Wa6oeFDvC4Si0lxUzyQ5kprwMPtUkg6J7UkGZdKztPcIn8wSUTlOwMUyjrCj1yIMl5Uu/8gR0cxZj1XsvRxWrzqjIkOxGWTCv2wq4RqIxHNy6JEs+XoWFzdZ/3d/rP+3m5/b7D3dO/pi929b55/09/bf7H3dA/9mf29J892Xzzb/+b5fn93MBgsE7Esa3cl4wvrwbMpHjsRPCqESk2EvJFVPINrdaBWFZguF+5N5Idac5dnhiMxN1K4qHAOyW4G2gLS2z9tfRBjLvmFC9bE1EAN7igjJxcIMGRkXcu3QGdeqaboxtKFB9eH0r3EOcAQuCoNrXCgkOlClprH5L2WDO9A80HbhB06LLIb0opK9rpN6xAmjS/nmP685+/AcYGWYPMpGHcpk0Bn6AjEl0LAGyKJCqU9/CxlkglM1PJxy10UCK5uJKWIaZgpG6OcmWqsEQUkIy1i5nHijHKYAkEEmCJx3Kd0odTNrXS/JIDstB08eHpEjinDLarEsKgTzyNyFJ5G8QQGqrJP+a7+2Oreotyj7Gt3j+IfWa4nYLOvmVUENAbk+bdRDPxLAaXt7YB9qlkDwRRJpko8Z6cCgoGLjZ0opIEiEE0Pk09qf77BwKm/qSu4BJ0wzIA1K7AnmAs0zBo8rCtLuaJlY9JbKq2U7bALcJE6TzUqlADTqpYF7ewndOFDntuGV3GSPJN9ap5jhwvAIIBxsAUycLIwQCfmNV/PwsgwAql0zN5VIdcqDexwoZI3TZfDL7LhGm5+Gr+lCW0xu4aba4jSfQQF5u4ScHP24Ha79GgspDwN/2KiiHm4tNfPWSHKEnTqnsYfLAaf4TWwD/LqW5BcWgbyUmglZ917mValDn84C4QyUfRCQNahw+rtu7+yk8K5m3ywULNodmTbi3vR/v7+N9988/z58xcvXqxk5wbN1hUMDTs/rwQ3N/Ay8pDgss/kJY67gpuFMHXFKbBjiXeAThWRY9WOm7frhKv+SCcqDJRavj29N9YOk3H8LaoIYZzOLeK3VA3+xsmJDC1P1IwUdblkuDSmjy6z/m73NjjkEG1u6Z3QCOzkKGhjpCBsnkuIiv7u3pOnaCG/GPBxXkA5WI3xBqU74pzGBy5jHVAKD5eT1e4No9fB1JjXNyCUsNHuZTMoRNMN9KPN7EHf3ou+XUNpLDD8QSPfp0YOzP0jKeb1yf79qO470PTrK/f1kf7tq//1aaFaZ19kZ6CxUp27SrN09Mhp/KbHhr+gk6N9sqxTZvM+DXJHNnwZfU2jUaT5uizAtxeZsFq1zuZhiNuzAY+qekMsSH3fkRNuwCwQnxb74lemx/gvjYYem+R1e8mMGdIYd8YrlQOXS4uBX5lbEo5X70puiGwK2LzXzeOW9BHoL3RCDISEMhBp2ZpCYPLhpBFmGgiOzkYCy9Dj1xor4bbGlyVzlkoQmx6DibP70D95adgrPhsXvMf+enjK/np4zC5bC2dY1+xYToSMa+j71+zSuOdUQmiVMuJ1zYA+w38Tyj2iVDeyx0quJ9xCj1Vu+OX16J+vO2V/dpVMr38hWf3t6eIg3Bui/Usr4UDW71PFBuwfFOjvR4GSh5xOp19Ej/65nR4LDP8ya+TP4vQIzP2TOT2I7D+U02ORpt+F04OQ/kM4PYgWMsi+yM5AY6U6d5VmWWllbsrCXmDDl9HXvz1Du2XDn8zpQYT/YZ0eRN+Dzf5bt9nDhGES+QVmhXLbaOgEyWGq+Vnnl+uj5c6nYEJcUojkSiNXKOxsLCSmp+OgLA5qss+OaikEtva44NVEaWGns03KHIbuYhBRHCxavkiR2zqpkvb12R4dqYx8cH03MEULG6ZgDrFP1o35QRgz2UoYlUB1FUhxZNCuAC0ljLTy7HnTorssL2bK957t3ywvLa99OeEuh5fiZsdKVcDlKiZ+63/CxZnzGgl3UfwtH3AJUxb0kkLaRjHYXhdVBCnkZIPlp1EJtfbF2pIgVhyXqPJ76IrBZlw2JafeEOM5423p/0uQhYphvqyNWEPWaajgEo9UVqGLtQL29dszF662PPO5mmU4JmQf6xy344/ztXlruW3Mpvg6LApBJSWXtQhyCmv2Yel0DJ33qKzmMcbFU43+Ca7aXM9rqyaa11ORM9AaK8XGQMgU6iWvRJGWT8FwT41xlDQeewX8Elgjk6qJZUjEd5+2n6hyEX4Ei70HGplPIf+wquT78bt3b99dfPfm/N13Z+fHRxfv3r49X3uOGtfSZVMFfc48+M5BJ6p20IuUvBZYVV2Vlh0qXatOUexPkmKBzza8jnGI+1zMDp7StFqp/HBYwtRgJIk2jUBvuYaP//63//rx+evnw+/X5mXowLQGN2NTqgWOYVcqDDf2lUViZ6ruzr7QMwpfR2auMDS39wZ7u/0B/jnf3cOOA08GP26vTRAuSyjWIOeGfWn7DDsc+Uj5dJ2vWLvYQ6yTI/w96gpuQyGO69a8/y7EoudqFupLYmJh4Qz1CLKTwBuCjVtNg7u/UhWii+0mKE6cOTXihiUltf15O6jTZJ/J19UbPuJIp6ru1n8JGtdfwfgE07bDmdKn50cDUtqup3ClLuYd5n9C0a7DmMAWsnBBdw3m9OH1tvJ2fDEYzKgQnLbBnWqpeVe76YV+IYRkxCIG67ct2VBqEyBhwXXsdCyMm2SguYwLX1UlQjaUyyHneGjCtZ5tf7a1ntdN1sRmXTcLlsl5BcVFWSluV0rXKWisTsYOT7/zath7TLHHAnIQi/3GvnBU51SV7m00WoMB4wtoCcs0lhAmqgeo/XczdpZzlyCP1pjSuIsMBlF+EEG0MdMfbxailhGFMB8y7BSRragNupId11VIwV3EKlxLLY0OJns04c0EHruGFJiZjTsYJtDO2SM+mWCF71ZzUjYRFkpA1MxjLJefQ5sF7vvPJCX9s1uReqWFhS9AK46D7S9+PXJFsQF7i/Ij22Uviqw76oxPNup0ST1qbjDa9ANCqGJ9ByElV6Fm+WRDmLU6lfDik4Uc+KQz483DJx0ab+jRuDD+iRuV2h12xp3BTOn5/Sm81w4ec/AYVgbDCukTuIUCI7Ds/hTZBkWundi2cl0c1lekzmDiSh7ch2K5TqW40lO4D+OGjCVo/NC+glbJ82XHTLsq7k2r0KCZkJsjOPZSDZT/mgSTzbEGiXcRrXceOnpauHMOoAHekpEtWogFFp/XiRmIFtOFsRr4LDUEj9CQOmsf32AKnk8hhRIsM+xF6Gom4RWwq/4ajhYSrqi1XAs/lrg2OTZDi1bxiVz1SffltnBg0Ovpq4QONfsksJQKnZ76Kekz/ZRSIktVVcp1QZ1xKUEfsNG/E4IzFND/9DuP8N8G7MJTHM7UPIf/jMLEOFfaDDilNwdksXUqnpdoIvHQ5dof63BY0uShwbJKVOyHILpBkKiEEpOx10ovdOFwZ7VQuKdUjaQcUGFiJ2pXEQoXvMizXO2MKzXZ4bIvpI29RftW9e0U+jE2gVve9/T2/Sz1/Sz9hF8TjlgG9p9xjoeSHfuvDXCdTzvTlytp0Fm72CtpzHOs8sOELPA+xJ8+44VBAgD54YpNREY5Ti98T7V82VGDRiixRklMwxVKhnu0lKU+H9lVZ/ICgqjAxyCa2HdPi9AZpVMIJYFCcN3gVLoidiQdvR/12GgH//oa//o/+NcW/vW/8K//jX/9f/yLjdgjJ1atmDwOGI96I3dRNvrLKAvdxg14Td5luuvwAqjl8QTHW+flNcIwaUQBOyBDj3I/dzsRzE7eaIyc2SEO93MN3ELfcSmb2ln1l4VfeC36NbfTPlbunJmfUhb+8x7ObLQo19DEKHSWS3vRVckdvbfVeqxxDYWVyuUcb9xQ03GMPcCOtAakgeCGI9fae4LJ2PvkuBuUV/ZeLnWoHeGd2keMk8LeHz02qjW2HJlC4/4HsnDlw0cpZLC5F76O5DrUXOLwlXBXXtZ3h3TPC9/7foq+COIYM2BTqFcQWwb5+6D3W65tjsjfb8XSOeFb90bGRi5xPKOnI/IKpVDdiPE6yH2G2m20Qq+OsvfyW5grWSwLcgpyxZaR4/lNC45Eoj8Pt1dfkWAUcfNjY127dhmkYFPBPHgvGfuavQ7FCYIcjPoj/8sb5U6Brrogl2ivJtp8a3F/Tud4XQsjbiv3JdlDnF4dm29F+Bl7E/4ZHT7UlYg7DeisBCEnKbNoJ8rey9fYbBYhYx1DPMvPQ7gjUKF2UgjUyZPPg0/RS2IKdaUdINVVuOembqBjrCVaI7Pxch0nNLAzY4hOCtJj5srDBQ9cWtHcXbCM6OuR664kFYkKuc9dV2TXZSmFizsNLv722+uFt7uHdGU1hUliO4pTkwot8ivR3zdIawryMwWXLgNucx0yr+9LYreHkqlL0MhCZBx+2VFEJC/R5GDs0O9OFZapr9QVFGkYzRa2w95ywrflLX+zlbEfsA8t1jPB2XQbPy8KtmU1rofAEO+F2zJzaaeAu+tWMCMlcM3KBn3E2fYi+3DAmxkX2Jb03+oY7AuPbzDYk1ejdUxO7UUDEcJZqttxL2Lv25Xt+NLIWdc1i4xsXdlUTyccwdxu0KOaymihEEi3K1HjGpJInNCAV4pEp6FdmNgVtCUt4j7VHM7vw9QcjiCeT+G69mfJACilGJWF123OujRQCdlp/Op7fBHUcWichvcIXHYpNtcNGNjQZSaB7PT3oxKtnpFOViPscD1Id/SqTCtR44idFvSu4pXbIOXq9wKbI5W+6UPk9D0Ybl+uC1zKbBqVVELYDBdbwRFE6jd5b63gIljs5AgPreAeWsH9GVvBpcuRpMFrxt9eP7gU1YemcA9N4R6awj00hXtoCvfQFO6hKdxDU7iHpnAPTeFcU7jUSPxtdIZLMHpoD/cbaA8napyZVE4+0RMt3me52sa1FpcYJ3r0+sfHq9qhtTWTf1Md4VwLsiTwkyhFKbMtb6zCyUJOHAFmlGX3T+Emerzd4hD75Rq9JUh1Vc9S+6suBisn+z67vaXcemj59tDy7aHl20PLt4eWbw8t3x5avj20fHto+fbQ8u2h5dtDy7eHlm8PLd/++C3fiqrqBHi9erVGJkYbfhWWTme1uDRcdDuzSow11xgNUcwxNDZPjjnoG0JflHQeJVf9w11p0M8YOMlUjRnxaJl5HYlZNyXGCiq2ZaYcj93dcbb8Obkt6oJUmnAQGId8BDoBALaAL12+g0qPQDEX4yDoka/ZkSegXwn5gcabs0ejrKiq0WOWq9kMwyNw8RSo138QslBXpv3+zKP71uXM4YdGrfruOyk+9p0xu0T7Ei4dNOaVGK8COOP527PPj47qlj/KHuoIfbk6Qgus/x2VFVrA/KHK0OaqDC2y+qHo0G++6NDilP1xahAtUPZQkuj+ShItsvaPVqFokb6HgkUbKli0wOiH+kWd+kUtn9D6zGbFszV4cxft9fromXN4ZLfCx0z57oYQOvvbcPduGLUm7QZw2nu2fzesnu3ubQ6rZ7t7d8HKFAD1prA6Ozo+Pr0dVhsyOTr+XTqrJivZbcAupRe9AmzGaxNCF1IjxR3OXLcl82F5MX/A6JTqyV4WHBlrkIvp311y7437Lxu89kGMcZAl2heQPzx4T36C92fOv/Fk7/2dCILMJSVayFHrbog2LAWWDkPFyaNPGydqicSP+09vQQVW+uNyviECfPaNCzh1w3RsYcS+FxJ7C3TF4Vuigj6aRdm92sc1ZAlim6Y2eXpHYk95Ghz+aeIQ/MUlaPMFqKNh7kjZfvYke7E/GGS73zzdfXYLEsWs3uR9yNAp8ECUmGE4BfXeOT12CiZjQ8kIC9bv4/nXv8YSvBj+Qlfo4ZxTCky3rrWQVGYc3bPoZma8tOiKBs8xStwMfXnQXuy7WYywXdRcPP4bX1tB5a4kR9Gj3L4rH2XhUnh9URWreXQfIK6UE9218bT0L3PbKQ2CAYQwd4rCF4qxUyz40Ud3Feqmnb3B7tOdwe4O5kvjbX1/hhmxGvqeOX1yJrrSIMu7ySDffz54kj+FF3t7u/iPIufPXuw/4bx4sl8U5S0EJKRCXeBk3fPV8uqV8Dna7Ox0ePLmPDv+r+NbkEjn4E3TRcN8Dn1bUV2//zg8Ds559++30c3ut+CtmxkQyC+k6dybvDn71L3JS+RkzPRAb9fRmzP2cwN4b+EO1FyaK9DtQsDfcWHamPcMwmWux+hm57aVkwoirDmrtcANWbEJWEcXgSWgj0aFNK6a1AGOPR89RtVhpzAPg6TQXThByMB3SIYbHxuzkh2YmDXOjY994Z2gMsLBn2mvQEM7dzF9w8FZxtJ/Onqcff4lRpcTa5c27M7jUDLu7u6IE57F9IUzhlzmrx+LGQz+UZJpsI2WUCzdJiy2uEDnMYb64JohHwDNyxiCW54ysw3QqN3k8fGcHR+ehTXA2DvIlS4IltPRTrOmnttZS45Xu2Fw7KvFLcIj8KkH7Y2ybo5R9rB0CkUcu1h6cL9EoXBXgvgeTUHGhpbNhBSzZtajhxFuIMrVvgpoxeI5I+SMq4yyRIYwbcRLDw8UEaQLJcRM8xITzK1yFGFVM2WMcG+jbPOiYGgXJnVN6J6TziXXIMoNyxtjVagDl22vErssr/jG6gug2Dj4SGKcEGIetEXXQmMbt83rZe/dyZuVqCO0DWEeS/vR43HwtQVUFxcHcOxJg3Q6iuhTLCpgQkQNLmKvrQJLUoCB9qXtf3eQhT8rubDBXXwp49sqMqBWTRvGo2LUaroaT5wbzNVNx7rNb4avj9EdPQYqFKeqS3RHJsppe9v44j6hUBblMEaQWArPaQ1X+9rUShbJdUwCBOcAqxVFXYVFqihqchFmSBEf/dyAiaUNRpiyA0kpj2RaXPxwGzG6cmqsrdaYmetyKmIyGCb06Et3r4Wq2xHsOLByFoK7l+fTOBCGBpVOMaWKuxAm57qAImM/gqaKTsbJcoAfagi1DBy3XPNDLK3W3eerBXWDHfDOw/JS5V11jJPNDt5T4AXoi7LiE7MhvLdjwM0eo3R6VJN+ZOZGThbTsSvB1KnXdMCGwx47P+yxd0c99m7YY8OjHjs86rGjt8syu/3T1rujrR7bejcMsTiB2I3dhOHUIE0+vyi9DuOGQhvI6qg1dnbBzCFu21sdgskozwD03Nd7SAC5sq+1aOumeLVglk3u/b3d3W5/YlWvyHa9d+IpbEZhOF9B6ovaAdAV0AchC9wSHIVkShFExmZgsF50lgaQYIcpsMFiIwVmw7WgB+M2G88ZF9GUwryWR3//7vjdPzo8ijrxi9kKmqxDv08gMQI+aRZ0VPeGsHQ7Ig63iNpiuoh7h2pkhnwWqWTfuTjQFEwqyrFHPrHlyR6eehwGbHdvP1YyRdlXpvNFq8TjwQhP1IaByXmNa4obYLuDkAxq2KP3R0dHlGyLf77F2nym4mZKB72fG2UhhUygMnbOx6aHVQa0wKrl/tRAdWertrIwYyVAWw4L9yAsI6spgfG97bH32n/1XuK2hdrMNT653e4a53kpeWmTk74qYe8hSe+3lKQX5SLyf5PyEAdhouNUIApvSrFbUha/o9yyq6ur1Ux/SCR7SCS7SyJZK0Bf5nhAp6SbLYvhcNitpRSOqhefU+xguOShqyp2coqGHFYslGwUjkp46Bp1RAbij6Pg6SPZEWUp8qZyDqTGQI+NIedYpJoE+RJj3e08VCOOML2jzaDrKanijb2V8ZrCtviFDDVoEUWHLy4C5jyiCXNGEbyv/y1s9Gbh677Gr53CDG2VFLS3C/xH7nfgBg8JVkWIl8JgmvEvQOYKWril0stCtv3TVuI0wfNO+9/dxYNPsIO/xDEgjLW6/M2bt65bZAe7DS6K7XRVRK9+CJIqesRhtEidVCbieFKyuWo0uVY736O3q5o7Z6vBl9L7hJ57QNuQe82Xv49wC2kilNLjtngxsC4WLQK0bMIdQAeJhfHRteTGx+2P6H+kHL9cNAjH8rQq7ih0VvPL4jHefRaMk4cmwiSudhf99bcTwY+vyug3WZLv6PANUgJ5597n+PBT9z6vwfJ+6qSmw2hOXujP7+m88qI9CeDR8HMjNKRgPkuY0XUebt3dxhb5jkRiDA8W4s9NRi+NcGfmEQ2CSbQ4BeMc/S6vAVUzzpoDmXo3f8CwVDeXbmLxNi+x4EJJ936fnKZ0oYEIIZ9NJSZTW83bLI0IOKHGfZ/kB1VYZsWd6rSbOqzU/y9ElXwfriA8D19HiLQZEAlLIrWbDbJBKlFV2ZGoVy/Z35xT6hOCtTIP65WQzUd2/BHyxh99Xwn5wf3jpduD2KPjVy8fY2cljhvf5wvfF4g7es2x3jp0Y4+IycevXl4Td/R8v79+6BHWjbpQOjD9/mn4do7tl+HnxvU+UeX1iL8S1lbAjmUhuFwX/7xuLja4f2HkV9i+buQ6RuWsHbXmdgSsN5cEpt+EefJaBzuyorDmFCqJaATF2kiI6rZphZ6doLrglhLACCZD8yzpy6dDSEHhLjbQcAtlBUv+Af0+c0bBJZ4UpU22Lu3wEaNR1nHwlRW3Ftqb4w7pr1BlqhI7Bijn/IEKZjHt0elWhLI+Xt4dnvGx2HD81vfdsC2UqGGSbfWtb197EnqosUfDb08e35aMTTpRvY7uXjAurot18dzg7aprsea3ggRJGveWaAKWv0wLxa551fbpomHEzHaAz+UodaPcrAxvbw0+7vpeRPFWMyDcbvxro2wu+FhsCNVPr63AcW9BvD3Lbon+Brcfko6bdqB1sfziSo3GXRdNivRcA7+19gUxu6d9gcKY1lGmEoxdjdW6oVMhZmpprgko+vXaEKW7xEeZZtx3tAaCmPciS8Co/RFUZRYozr4erb+U40f5VOzdTUl2Gl102Hc4FX3zc4P2Rq3VmI9FhVmsmNquxbhJWUZ43Hp6M9TAqp7fN+pnU6yeIhmBZzmv8oYijKOZdmekNxk2gIrmjOTRWVsUKXBbHDd4UbqEYlqkeH0MQ6HxC1WW6zUwvBdk/Wifga4Rv8Dd5PXaLiFLSIbBGA52e1w3uDcuoYpj3R7DS6GxMe0FOYnXQPZW9t0SljRecErfHeG7z/4dsL3l7E9wy/1SW6Yb7NfeMh0St90y6aMwtWvw664LhbhGQ7bCdGtcN7ygF/C83ZJ2dYYuYkWkDaEZLEyqwhSH85UXXKiUMAkxa+NvoSqTDKb7xjuAZ2Y+GytKQMITXLtkbkQ0oOlqk3T8tvHB2rWzuExyLajYibuMmLsYtbh4v8O+vnhRh3jSexTMX9eAIfqYcFqCRT/pJFzLKI1rGuvdOzeXTsNw3StMWANVGTQLhiZ56Nn2Z7uFl2/47iWj+BjRc1d6i8HiHvEb4yzvBYPrSzquwICCCT+BxurmaivoDrGJnTGM5fmHC/SALoyzcslciarAQORfpzYfHtscvszhG0P4HetQWusKY2iw7l+XyC8UsBCnsZcGl1BHSoyXTFPQfLnv5MASveD/4pc8q7icZG+aqjpFfxbo4/B6qkQuw01UUCLxwc1KhK5OSZFQk2SfSoWrGT7aawoztd3xnTxh01mC5ZVB1DlDLJ2IF3fBBPA3pktXrOFiVZW+IWXbldwrpzbi45WKqsnd94UO9LHiIbcx4wGfIqAIg8VG5apMiCB4ARQPZX6wRop2cRV4hey64PXaVqYhQNoHpsQ+CgQzpDHhvSFPOwW4jg4tkFxJSUbiGOwVhmvwtHEp77Y49YMJKSw2LSoQVl4pLCHKhmEmPs1uvDanbYb5TC3Z+DZpFSYTmEY7/z+5eFZyNnnNFfWx/ANEGU7ZnIpHy+MZzLA2NG5bOFoYtWg5TQ1lsXkGQbUwc1HZ2JSYnQHOLoRe7bjTjTzZLik4ZhGQdeaEuk3IIojxPt8llhGmOC5W9lm4e/2s6818OUP/XvaRbdSFGPujZIwDCRHmVPOzG8VG+x3FsKerm5Lj/Fd4VSWMF4020mDKZeB3zi1MlAvvYGxh0lGRjByj+rwoqPs/rqe+W0/gHqGR1HcXXlCM0oaQSYkjiSdCDOMI4kyUYdSqkzxYEUuEhVD7NTcGmdn3KafdycD2SheiuNhwdTscxp9/Ih0UTuTvFZUO5ZqC9THi+A3WFiaIJE0+9jBjJyWzU2Bm/t/svflzGzmyJ/77+ysQet/4Su4hS6Quy9rtiFVLdlvxfI0pz/T2eEIEq0CynosFdh2S1Rv7v298EgkU6pBEyaKPeS9iokeWyERmAkjknagw5mnXnqqGqgq5tEGay3rLqfo0oonkvp6yxLTkwjr1/QNhNRIWRjxV2aU1IJLplRbZvgCZmiaoYMU3dVmE2j51snAo6RvyrG2fJ2IDKsI84RjnPq52bs7i/NXICikHkRFGLycr9MxxPzu1ry6t4BfHmTZcJMniPC9Bix/Tre+OPaksWXOb2ce9omwnqmZ3L2xgDSJXBZE+hF+429U4okeuyZVJgomiqnKGMKjSiKnLwlUMn3XqdAz6ZXN2fGW7Y+u8DTmbIq+42issuVQZDgazBf/hU1BB0Yu4KGqAcKfbA+tdM1whxhVZfXFeZ7F38E0zZ7SXqJVTCT3hirHaUFicaC8zpVpsEecE6I7FIq1Q+FFU29JY10GsrX/ruqiu52W5B16q2+v7ElgUbgt898sYq1zYVS5uAl3DBToC5yTw5/1r7kBX2h3hJc5O27LV7te/3aGwW1FrX4m6jH2sl880XuRzPkV6I7KwTsyadky06ZdgklVjl7DhiwuT/sfJ4bwHAGSRF/NYZcgauvY3ovEMViY4ARcbk3gmJiUq6PIN3EMPYqzy+qQTB3UaJ4XKWOFsLHHEj+hYXLO+7hLc0B2WJb1rgu1gQju4jItrrkVzHWVJbaQ3yU0s4xWh6Yxt4xXb2lJWz7KEF8+i5fGkDt8mRvK6NNeEpAEwRLZmWN8oVv2ZJAeUPBRTJPPFaZWpZ7+r8vYhrT9pd/gTHu+9P2Nbnl9S8i64phREVA/vG44r5Sj6aYneVH/b/AD54FZvj6Cuea1F2YLPRZl6I/57GCMmsyjxd19PbS2igClZohxMZxguE+G8mExEqFK50JeIjqPvCXoCMTudce2pZTjf3PvEmJqdsmLvYO+wznyj7NX535IFN6VnbfJtMEDq7zq+s11vO0qfJpk3jTOvKWSmMEoQhZZGDyRrbHKNFONMLOOlQnncjWcaQdI4DXlg3v9ybVOhCtBB9X5VzdFlXB1MZ6oql3LmopcuA6SpvZ+hk1FeiDwuSjphZhAwfHpXWrhl+aJNVEeyKm6icv90ZpVotnayYVXF0/cSqk83tqmfv80VvxxLaCgiNcsR+XGGxcR0jDdEOMwINNS6FiwlGpgsdBoXzlAVHghoh7raMfzTRrILLT4ptRTl0uiI9CX/ctW5CtcmEdrgIxR3c+NCmfT8zW5oTq2Tv7kzGB70B/v9nd3zweHRYP9ody843H/6+2btPuAFb0VIH79jIi/jEz11+2W3kqqUqLCUTL5ijlY0aeXSghdHMxftTE8Z1t6ZRM96xvELz8qTnr+43yDZmJPX/LxAHFb3FdZCBRGXwke7wC6jGmixIC8+DWKApmZzwgk8TMza2lBFqnYTCx2VSXX08Ue46VhNnVwLKSJd9Co91wfT8dgs0VIh8HjhtresdfO7aX87QoqNb8bpsiwu7B9TmWpuKcF/12Xhf0Dmr+MkiTs/Y0pM6YwMOw/OKS9tDw879VJ/2fpJok+gUXxm/FXm3wptcDI7Obuo6uequ1N0yyIraPBngmK8MdjTuDV9SaVRnb2dz/lNT0qFaus1aT4k5rzprPq9VasYsGnxTuV3ekIeuyioobrGYNRLdL/bWqpsjt6niZ7lBX7jdeh7gv3M5BW/ZBhOi/nzifKrtSK10GleZCAf9x3+5lkGzbF56Ic7u3uY+vNs0PXT8S8np18tgnJ2iktvvVrVjrVwPpR70/3BIKpjls78mPm9dZJz9ybQeXFSFYX3l7aVCVwLaZHJhDuzoIt4RwN5exdYuRhXD46vizfOpVUXkmvXMTFgSekWoHTzJvSaNuUvgF4yhd97GwSY9xoIVVBZgRK5vPLZ7j5wlrLfC7qa8buiA0GelwtoDKkWoI2sHa7QtfQ630Q4z3SqEz2rTfzBzB/9yVbYxvlRjVfifzaJq35jt7vKK7jtzd4PhoPh6i3n4YG+4xx9WzvX9kN4kKEL6sZcowdAfQul8hcaBwI1erNqg/9nHxX72hupa4rZdelCKV5pGw4Gd293+fHsou60oNnPZK0WI+/8sT0ygVuQFRm6C+z2Z2e/fzHZQ+dsIN/x6fQZQ6OY6yvWx8EqwpUXMYfZgZ1gFFEaJbip53OF6o9rcYXsnLRwDyJ0GnSsp3hR9UujZuBCFZlOKqpjeKuuTQn9XCVLk4aaFzgMV3NF7j+ry6AeBVxGiRx6M2ZqViYSUX7Ts8oB1RncYO2rQhysHf2aTrU2Rdas4nVrgxPD0NLUFLnOlM0HfIJlVblEJnPOkylSxFKxUwa0sSiSckZ2ZduTwvuJ3Aq6CanVno0+fEyqIJTf/EnP3hsD2XVG4yPvQLrGM/aImc93MJ2A17huZf+9+F5n73vIboR5rY8ApzYt4sxdsg98ym9UDhiuYOXIPhhlkoATDvAWtzPLl0ls+onGBSw036mDZg75E5xkB3SinHLfE8RluFQyRWXpN5rpsAnoEzxiJNLhReWARgt4OfEKsvBxGMwKsk9F1bWCfcHF9oRIkcXq0lrr4wuz+2hYZ8bvwfY2MyfhSsniiA+rdA+DcPX0Ft2eWCYKFmiulBiTEDdqAi5NPrZievwcqmUcmvbE4r1itbnjJRuppRg+E4PDo52Do+HAxFJPnr84Gvz//z7c2fsfIxWW0OrMv4TpfLyQqZypzPxuGPBHhwP+waF/hUrYvCQxhKau1yIvNMbd2y+Y/8+z8OfhABWqwVBEefHzTjAMdoKdfFn8PNzZtTntxAz/LRXeVnW9sRxQuteJ/9rPLAzJh76yTN/YdviIVKq5vtDJcPqk726WdkNwBW0BmBBTGSeIrDvX0lJltoGTe0nTgjwmc1lwf2QVVYt4+L3RBTdB41l33C+Yendz/NmLLke1e00Y5z1KMXUQ6Ze4625mjffOVa94gzE9IcOQfZdGO4gr75BHoIf6MR7F1OHPO0JeH5LkoV4sdWktV7HlaOM4zFxWmooD6mhj5ZRpfNLzrraV+bWxPM4PQSQSSg7oBNoZq910psglC9e3t8ErbavL/8H/eGP97sAvyozOU8UWyMCqYt44EanJLxylea5DTjcx+3CD8uXRXpvJAeAVC6aNnKG8V61azP0gxBF0q3EFH+c7vbafBhzjRJKpCzV70btP1e7kKs07HlVma03EcEPprC5jHs1W3xy53hdd98y40+lWGUXF9ucZXefsg2t735ESVXmb4cyvnEfcWMotak1UV4w8UQ0lRFUTym7p68iXhZ7k0XW+gJ6KdkbRE/KoYyVEiczMUQu4OYvUQdwy40p61TyMPpPYt89V/7iEEZnOnrT30Xy7to2ZkrlO17WJ7wm6uJpfe+Edl2bWFlK8Abcn4wAa8Q16EBoEycym9ujMHXCWD/VosfV2UIOLzCVFmW+P6zKFQTr5waEp/orh27hCzUEGem6WmE69pK+aMBBSXKkJXpPPtiNWldZA36ySW8ztjVQa87MDXU/lnjFjpUYTPSdGa/sMiNd8KMeTRFMyRh4XatxxaM6p7x4ONjAsUxfkr6v9d9r9mdXF1nfYeAHx4f0rkcTpJz5YXn/xth1encvmqbNQyMaBP0oWceinzllbhAXFsWcx95zSY4mggba8O7B8j8g8HPdI05Y8SBjqsXlyXUSU+NneFTulwwgIv1feNq2x/e+DAfkaV96eOP90kXs64k1a4zTRsujagPdx/kkQBEg2mosApUlPW4IwZ1klcp1QIWXueg2Y9HuySg1pm3kV6zO6AIynus+4wv0CrrQ6AZ0H7EYiNt/AT4NeXJHI7iaohwQEKfJQUjIZQxRigDMzHAyaZwq5gjLmgdE8KB9dm7Dv9YgSvwhGklA/4dxDyM3YFmS4AcQV+yNhIEl2KRIZhmvcwwe6Cw+4DjZrTLTtUlbg3r3KuzZHtg+LqbWt8a/GHyXyxkeRxMW7biNvFJWuEiD4xUCQV1OTtbr/7LMMC0GdZiALKl+TlxDgpwNY3JyblLsWIu28ya1L5Zn1N12W+3HqfO4SjN0CNXbVH8zbArZ/d13OnbHgILLVAA+2VW0qk8LGlWx+hW+UW+mUBxxkLJf24fZSTd1O5NC/edWYfQoh0jpzuOwcVD6ZVlu1720uF536gLI6nqMHPYbTGXKmxTjRsyCnvwf270GoIzUO7NNof109r74339mLXCPNS7QUFZ/tLNUwLOlKJp678ex09MRlo9a+4dRvPtYoDhIIAdoVe/DMkdlXdWlzcEMUdQO/m8n10pTsHzp8IE/rZxrhw/qBfkCc0IQ474wUcoqzHytspWJVeSk3BAtxT//UqVoB6QepFOd3GKk1knAhKsGBHWaYgnbaZvRbnOsxgQQJN1Yn48faHnQH1H8mzQW0h6PKZ/XuynEIvy1ceNWitjcmddiXuP46RdRInJ3y4hvPS+SdbR8v0Ns3kosNr123nEwydWlsXPvx0fkGDTWSqXj58mixqIRJLBP7qf5g/2gw2LD65c2VRi0R+m29VMU8zh6Y8wjaxnUHVFhffgONMUzy4wZe/gLxRZVyIqH3dohKkWfgFoFaTi48BCn2O/cyJFmuRpAuUGQdSEMU9c1dZthSPFHWqWNbkjaz0L9i7iL7leDfbZyaMkvWdeObpgMS/HgwmtXINMWV4pQy2y9RsDSz1NU9PCtYFSndW6vsmbrROO1HalnMW9Dp9NkqEweV49nc6K5aGHebDE+xTGSobrRPbrBLHPwvs08W1x0WCi2xvb/zdBipaNKf7k8G/b2d4WH/8Ol00N+T4d7h04HcPZyq260Xex6mUuZ+Xd8LKe/qEwpVC7EIW9Rn7fhOC1GJaZmSTQ4Xk6k088qtcHobk+jdo/DlLR9DnUSrvsy3jAu308JtSQYJO5xy7G1ic4fIr2KJDW7nfoWiogaYlTPtsW8lNsut4VWnmINfVLvjPhQ0z6OcPov2pdzry4PD/f5euD/ty52dSX9vb296OJjshuHO4arkFlk8m6lsBWJv7jRxWquoqx0xBh/cE52AnUIXcbQCZg/dhor5vKzzRfVsDJPd1D2hirC1Dy796b7UrbEmeePcI8dWc3WcqY9p15yij+lHBirERyF+ItH3McVPy3KSlxPzM4KVRvk3/4ZGlpkf6Q3YaHIKYG5nkmURSgRr4s/++5aq5mNoCKpZAkuDd1r5KNT6ACNrVNpITucq3blEzVGMs278IYDNct8+fy/ipGp2wlanFzAh/YZCsPaBsoW/9t8yjbZ1VhHr8gxIw+zxJBkXnZtcmyXPbABevK6yHP7x4uz1P/mzMLzsXWbBnj8JzJf5ceBYR9XW2l1aSVMCEOqOkxY9DNS9BVUReLD5xc+CSXFR0Qp34sa0vFeSs9Vc31ayuCzozrimDYBVW5ybNHKk6H+CcODkm440VFmY1mkqXwHrh9xkHkSAMicQU63nkXLsfsnt+SiGd4n+p5Nr6EoFbKRAvFSZgokFX1raV5/nsswpeJhwLxZ6SRtKLJQl5yC3pdW8nTAT4kvVg68XNkGOXI0ozlRY6OwaqnuYXS8LP7HCvGeqJ+ZxFKkUrioZmf+iLUSPFceeuMrioiNwt/mPDftZDAswn7ZzAm6UK9WGwXNyAd+jLMpMBVGMvMALmcCGKeaLde0i5DHygHFV3WJVYnw8S+1YNZy0mweQ+PlsjgrODZVOAPKsNmd34OBW28ql1MYbhU9mlO7CM0wqv5nhTYVuezPyudzZP3gg60m85nWGt0zlFdQ/L2E1hq7nVgAn2FJtPdqbkEQPPTT4KU5n61NLNhtD81Y9J16thasYw7GSXvPWhUzLqQxdvwBZBX0vVRrZDjTWM+kMY98WQDNYJX56O6IBEu1zEepFgDVV8HkZBhQQfCir11uof3sYrVbCDXOiKG9gOeIeULMSPZvhipPYw4TE5Ry5DiiN4RyHJlSq9G0k0xUZ2pvwephlf6lEmVZOOq6Vt1+tvqKnTfgOLF7BMuUy9faO0TSTiw9vzt9/GJ0/P714//bt+UO3rKR+XR0T61u3+yGbNjLga2kLwIBFWZMwl1YgTnS21LXymvtSVii5WPOlxxKPefMJns74apMuU9131haD6qI7oPe88M//+vK33w9fHx7/7aGstQ7hFZh7k/J3ivsEv2e9HtQdDtpEUgddYguFGsFb64b3aNrcGewM+wP873y4czQcHO0Oft98KH240ipagbpbXrzNEQpIcrZVKxnRce8xf7I2ve5v9Z4gN8kLnv9s5xLrhXk4oNeBVcXcKwauRRHwENRDCVAztE7yKnUE4yMFiSBalgXc5qO+zSQUv5DN3ZoFUI7iWVzAcWrXg/y3rRFs8w+GCf1ZiYmZQ8CFDN6GdIp1WduLO2T2PflUM3Mfcr82yYC0Qzfolq1sT+U4ucSQ+vfr1lTo1Rk9lvlXWUxsrJrOGKY7ULdBaP7mZvaTVs0baAIC5RIYiPECS405VIB4MWLCY6LCS3XiqmxTcI9jUlT6Nz7aQ4QidOBsFMLibW8p0Gjwq9Yj67Hfo1MC7nzB9WRCh1DzUOaRrKNorcF1YWnh+yUIbAYlGtco8sfKu5AlLjMq9Rim4JHeHK7yQkitd2F7rhdqWyaW845SgLswYL6U2C5KN0+xgB09fgu19YAWCWb7ljNcAZ8Wp1l2lj0xt1DrtFyqDBkS5gGohX1xm3XiEkk8Hp2sKpXQ9fdfYwIUKPnRp0CBhh9yEhQh/l95GlQyDb7XiVDYm3+RqVAeKd/9ZCgP1+99OpSH6o8wIcpD90eaEuWj/YNOivJIWOMTxSfltlfqPph+dYHH694H1e91apQ/R2kF5B5jDMaPOjmq9sUfbHpUDfcfaYJUDfHveIpUDc+2Y359aN5rklQNyx9jmlQ3yt/vRKkavmt8Q79sqlQNyx9lslQn0t/vdCl/3tIKGP5XnjBV+6Ld4hV49tCLw5zjJe2jlAcPwnfNl/xLJk0Rnj/4tCnQ8J1PnPKQBTvXaalShMit0hPqM1zFNuiYKEmFfpEuOuMuLmQLooi/oNUrn7CA0YeUk+8LmQWzP9F6IPXKyWg1xIJQIFQJEo7mRWJrY/bnRo8iVRsGgqvj8VyQy3RWY99UZ5860hoej33HkJafuNG/zUyB96aZh1lLSHVHlmEK8RYPQ1p+7lGTL47aWNAupYBCcs2FWtAdUF5FcJ4tBtdf57RBEj1vLLdpGZtyTLjDtKTTINPIW88BxjdQRHxtMm7rdLw5fzHi1ipgSyoTPdMll5qIY5QEpYjF0ZjyUZEh32Hr+HT0xAaslb0WDir3YqSPAtEqbw4BOhgGiYrE/3d6fH4ciN91qgLb4Ftl+JQpHDEFz3aDKauOIyrAzaSOctDQlColWka2gxTQgoM7SxXK7FNxfDoCaNfLw4Hl1xJawZEYnxx9XMpi/rHQH4EzzmLgrtsRaogv3CFFIX4aiXHjtw5ynDe60diLYiPSYlx9KxDj9oK2t5kD6X0TL7uPRetL/jJx4kNFPNVeMWH6iYFonhwT4Ge0rURY1Q9FAdkbWvX6m6iyVaXlbG2pPu+yeIGsAMpmR/XH1q9np84t5ot0R8LmcDAY1qO/VZb1ujH0c606sWtHQ/EeBotof034vT7dh+iZ14tn6Tf5XA7XtOro5fHwlmWrXNg1LLyzf3DL0vvDnfUtvT/cuXHpPFJqXYdwNDp9/vydt/QKlzZO1zfo4Qywq/JXq9bgRrBt4r2brTuyubN/sHu4W7/Di3ih1hlufX32+jldMyt1a9mBeFWks8iAO+KU/DTqac0bgV4JoNiWQV5dXQWxTGWgs9m2aecBCyDfXqgoln2sWfs5+DwvFsk/zo7fHDuIejqNQ5RU0yf+2eOsBhtyDdDTKO3qSw9VwOQ0T9B4yC9vNqMSXB9Zj3TbtX/Vo7RY30l6raOaQMXx0SEyy9zp4jLl5iEaHOwNGkfoC5OmOnKmXLITtG8dIRU1CmqLrlEL9hu/Mm98I8LpCVXvYPzaVZ20WMY/BE1tXl9V6cKPTQPZQbTAJincme8HveXVhG7zeCh95eGsL6ym5ifO9Rrb50y7jqwst6LLznpQVtb2TTu+VF8j1+jk3Yd6nlEhs5kqqjLMLgXq8+qJRkuqOF/K9HpNBBjDRCaCl2mpfz1bPki5jJzW0ocYCh41/X6pAg+xdVPr/faBxL6TVd7CKsQB/JpzBxx1vMwDKTsIdoNnB4NBMHy6N9y/B4nxYrlGz9jmMSmHliiOYuMxleLdczqrZF0zFqLfh35jPiY8vAT+whW81j3izdAwSdzI7UXRq5BTJGDSNJplzBFE1Fag5pnS0ft0Ix1s6unpqovgMrlUbmaDnb9wxWMPkHnMGk8mnWsGuHKFtuceeaVkxuqRLGqKGRo3qWsSFNuTRM+2Ta/nPhRXyKbtncFwb3sw3CY/RZzO+px61jfM6WPBOJ0F0Nna9vQgPDgc7IZ76tnOzhA/RKHcf3awK2W0exBF03scEJvRcoHNWqdzzd2EL5Fmo3fHZ2/Og+e/Pb8HiVxms266eJkvkdYbTlx//Hz83GYI089vXQPXEZk3G6sy4P4RsBp5pugAQHCtySCpOQi95GajJBgnEc4JTUzdwD832kd4eLB7uFdD1DzTFz+0CnZONAjQQPpGfr1A6x8OHbmF12dv0m7h62ILjKdq8J6oMHnSOnOu+4HFrlyb2811yIHH7QN53LKqcydbxqi62xo13HFoZKeyFu4dTrnP+4NngWS3NAoCQNTao1pcE+mtyylXW6PjN08CY1NhHdSSm7YAXqERgxY0yBa13hiSGNVKkfBdqhJ3zm8O9oqzdzZSrvKeOH0zEj7FQmwB1FWcRKHMopzd8mohYxdhV3mbsT8Fyow9CEK9+W93XIIa72mGc4aXb6HTdT4olvncRworia2TN3RugAQsHp+Fjrktanl8Onn5xMt4NhfHeV5mEg2wRirDcM6T44cxoUS669oZQKuIrZMnpP7kTfo+jB6CvNfqQEXr3MhTfyFCRGydPmQfT37+MOqJtz/b/TxLw554++FnuMr4QziXPXHy5udb9pzBii/be5QDJXGx7s23y1h58+pJkyuvdWkkxd9idfUQSnQ2kyn321szNf5Sudh6+wWX+SwNv5RYmVyUaVx8RZplIrAiSP/wANobB/0h9KMoWF3o7IKU8tWaN38J9bQeFBS7nns4z3tiRKrLu9aRPpFJPNVZGst7kZjq4oKMxxVouslbi7mDsFOM1dis3o5pBjzy38gUTXPMs1DUSzOOgiYZO4OdQX/wtD88EIPdo+H+0e6zvwwGR4PBvakyI57WSZaZQ7gCScNn/cEhkTQ82hsc7ew/gCRqYRxefFLXa+8MdGzhu7wM25yAuGAwAeQWqe9Hxw8lKiyzS7UmgqBkE3wvtUoJlSQ4wSH/qSLLay9EhasMklpYuz+5eE6LCWmcF8v9neFDOYGiuVTdt9qoRvBzBuE2EK3ZL1vbx0XiK1F1sL+/+5R/ufKkrAdQ/4W2ObYaIKyl5O1qvpQhenKKSVy01fudwd7hvXDOVRbL5KKW3f/YB5fHypqluJCA3r/qFHe/gjQExXWBD6+rZmcxd6/lDqXY++Vccj/8noj9JFfjILQJXghrhTqBFgJ7yVVhO9DhXFKVatbm7v7+i19+eXby9PT5Ly8Gzw4Hz06HOycnx/eTFq7DxdolIOflxNyp0udx1WbDIRGIv6tqEriJSTNUwU/3lGZ6xan4VYtXMp2JE+rGxEmf14EYKeW8pbO4mJcTqOXbM53IdLY903CZTrZnehgM97bzLNw27Zy2cRTpP8FM//ur3d2n/Ve7+7st/sOM2z/o31c+sxH/bSzX3JmuFo0mVSZzNpgleiITp+Wlqnggkd/CMm3S9GH0IOS/B8u0KY4YN57r19o9Y5qOzn+uVNeeePXzSKbiBRwNcR5qz3TtibM0DMhQfdx9/26s0hrlDyLFt5vWTE6nWWrxaFJW28Ivpuw7sEEbhN6Pln9le5JjuutVi7wCYxwS1lNap273dswt3jOl/b7Ovyp9V1vnX5W2TYsRoQ1lll1zTjz1LJZOjaZbD6QREULjH4RxXfOT+ugCUsZnSruv+P0fORJs9Hibsa3COSmI1WBGYHb2zmp7OuPocdbPS+SmqegReiKHcXG9rk6KJ1ZAtjbzNaaMK5nUUaERESotLryn9DHxOb/Sfe5uFLYSLd3qm3k3zm+Obz+BXYSsibF+BptbrI2wzoq5OCZboN4zi9WWizjX6+L1CWtGZ6O35LNvYXdy3InSuo4io9O5sycylY2uYvba3oHKTOkLv5lIbc1XOp3FBaoHYGEhj6MoOxix+X/ERqLTjSPRf7obHAz3DncHPbGRyGLjSOztB/uD/WfDQ/F/62HANp8eTSBvfshV1rdlj96fcOSkE4I922eOjg3+NstkitGilfpFLQqvRYieQ9T01gutn1jDtDEjNs6M9A1pMhqm1CFGmmidsc3cc2avnUBSOXUMeklVvGzU1Z4InbLmofBGF950W3KvILWnLPSCxLsnv9sB/onOC532o7C2L0udFzJZ163afEfg6Ua1SrbAR4duReTfeO5CldvImYKuiZsbiTpR4lOKoUeouSJSaCGdid/P3vkGjhmhWHWBv4ojtN6kh4xvMt5G/rHNu2d7g72VPaaZmkEJWaOwek8r3Car+n896cJpTdKK8ekUVn8t1UTVz5ydVLYGTM55sqP4k8eC+Yes5zQVJN17n+tEnB+i7eNshkuZyu1fSpXq/OI4zlR++2FoVyRZ/c794mYNDzTQX62aB2nUMb2QPpNXQwkaPQ0fd0RRpBcyTtclwX0FwSWuE/qELfm+xULB2Sn01JOI9XHLqXh1evwOIapj6q7rNcM0+PvlcJayOFqv/9RLo7EZz4YotHyd2ymw224qxdd6Nn2eE0KBd3BdLiif25f237cYJnakgz221UmtRihM4gITDCnXtPJh+iNIzYvaSO2kIX5sOIKJ7KgDFMXTYcTr0/0esgeGT+g2LDPFKkEgjqPIIjV1g2BoJI8FMbkWib6ixp42Mb+OIi0ONLnqh/oo0JxekaulzGShMysUZP312spTjGgzPUMJ1Xwudy/2hztPHIFVzXf1zmFQjy1PbhNN195rS13iXdWXzuyVIqPUWeg5CKDFIScLiuekYvSdlcgArWz8T7nLScH4A3jJEGnGHI884fbouSqo8thFKSn5EAb/VoEhOmkklgrDTLAYbmRybcvKv0QYfe0yyq9fQfltiie/Td3kd1IyadGZ67yoiT7771tE3zF9qzVqy0SuE76fECxoh46UJnfxn5+M6LvBT3zSvQiYLQhnfbk9mgpfROdid/0YKLl2xFwulypFp3rM1IKq6z4nFkrmZWZ60aGrOoZIpoF4qbkzUd4oQZrLLMJIlJ7rrbMwHYSR4qjDTyqzzW5UxsNW/6OcoNMMDWFCEtwj3Pubi5UeRXmsUrq5j4S/Xks//Hx4cHGwV8MvXJZBifl4deQ6DzPNk48ubp5S/05lMCmpQTCZemb34twf/M5xWA7O6il9OtSVgmosn7gwrwHP3h5AZg/dGRRihCBEOhOYg6Mz3KbBoFvLOqJ/SFFcwVzKzBnkfhTNVtk4aPJSZXJmFQ0H0X4977lp5APCdMjHRNDAfQwV8nG6/QZXGxHF+acAY7ECv2L3oSH9QhdVANxWAYutmSxn6glN6oJhgSkk6M1wLbbkbIaB81V/KGH4LpME7QE/5U+4w71ry2JmxqF7eHLPjnuAF5jZYOunFesUKv2W5H49O4RWAg8qsWmlvDVGuu9Iz+smb25G6F8JAMHUuRtsFAdRZ+KNKn45ezuyuOBwB7QSdbPpgM0f1FN/JQeRrCMufsncRXM3++Ttm/O3o7erbsVM6eA7cscTOs6T/YO75OvErInB/ml3i93DLW+Q/O5c8z5a6zqajNKq7nmgZL1Qd6Dz7Vz0QLLNr/92038PbnrszX+76h/dVQ+2fo/ueg+v78NlD4T+9d32Fb3Q0dbE+c2XDNvSibW8S3VWsIFX1RRini1LxbHFbAyvwgJ3JVNFmaW59SfjA9Y6DzZrVMXROuhhPzetG/sDxI5zx0cozWguyU0SS/pKD7KRXb1VGAORjjidYYJonIprXWZCpZdxptNFfWAc53W5DPsM1jKZ3+DseKJkERCnmlxY3sGFeNlFJ7ZNxMtmkaaFupDhHWAffFjE6+MTf1n+IJJ2FFp2cs92HJrCCMr3L07E08HeDtielzOMLVbRkXiOtsM6LFQhtniMWU8c9icunQ1dGwv1RMSe9569DFda/MNlXf9TzNVnGakwXkiYtDOUSM3iS+s7pz11MPmcm4Uh/1NRpjySOcbsdpUFYmRMSkRt6IMm7MW+dZ7M6yDOr5dz1fF4bv5jYzDoDwb9/ef0393+zi6mYjd/ubfxz/qZWNddf3PrPQ9laq+4ueHe7fZu9Yc0/swuKau3kJ/hjxJpcrHTzYRvJ5LXT9LhtAlhlb8IWVnUVVLksJwzga2MaF4TTN369hUa97RxiXh0f6BmOJWP4nq4yekA80rA5alTcinw0iK2Q1TswsKSBy4+nsuhQepShp9U8XjEMrzvjtw4Xd/WZipUlEpoif5OaF333jq6vxG9Og+mchEn1ytQ+BB593YkDHyxZXW2TEVzWfREpCaxTHtimik1ySM0q6PmwO0GGOaTLbzLJHk8rL9yG5JWZAErNTvBuY5U7FvqVG9fy1C8HYnX+j/lpWry6BMCLsm69rZJg1nNoY2HXWTyigc1tDDfC/aCQX843OlzPLqJffsN/vF32O/IyIy6aUt/a/LDZoQ8Hk9ux9iux3cXjhKd90Q5KdOivO2+yuwqTpvYr7GHKxI+STiOeZ0xexvgF5CFQmXcn0Ze6iaRcVpohimEZ2xOMi0jMqlURh1YSY7FNXX7rft4jvLEJNFXuLtswFRxL4rWbdl8EvXkSCRw1/dglRFH0/hzVRPJfPU1Q1oDZ+Jal5ubGTq2m/gcjpM1pTgPI4lN/I0zMmz4DZ+YqFYj8igQ79CaHlmwMJYofxJqk14qO6eESjxNyPn5CXrNa9RuLHWuROzeRuEa17e1cCLz3+64P95R4YuxptPSOue83J0CazgIhnvBsIZt+1Q/jp1wzrP1GjYCQj0niS4j27IsswElU5GBbWdTn1YXSfxJiXGxE6DRcLkYB+JsKi4X1Wlrh4zYAMGo52kthmU7+PmVIJVx7iB2Gel1W6FcrtiR9yalaqRCnUZ5pRBhyMJEqVSUy/a27e7s++kcRVHLwHx5fv7ujnSOFzZ/zRXN4EtmYj9qCYWTP2WWWNmDbC07RIJRwkfKLLEiJlOolnmEXEwLaKKj64eo53aikv/VOr/97nIN9AWt2mT64eHTm1Hk7skrIGnbYn2bN/ycLWuz3bfS+1IliRZXOkuibrrXsCvnlIaQ37Y3W0CWYkNm5mXHaz3c233aifLa8mA3j0XZmQqr4I6q8RoPuM44hTDRs9xmhzBcIcIkRlN1ojGndlBIVTItRaUdC+U+jR2NoypN0ki6CN+QItVpH9lYkcwis+WGaZW/efxb/73BrH92OnZQdSbGv/VPGNFYp/hr+xkc7uyqvf2Dp311+GzSH+5Eu325t3/Q39s5OBjuDZ/u3SOhxW7SQhVzvbaNqu2FWeq2afgZEtJSKAWC82bdLBfbxZTTz+vZEeNfn5+PqydpPFOFnYryqzofk+MPjp5mcxuradVjTG3Gv3s7Ou/m3pqHC2y+jrmtoL2YdB/rl7TGUZc1tcCkHYiueuLfREKXZM++7UJfh0cCueImFD/+/PjEfKF/Tjoyj8EVJ+hinVmrc+GjLB1QqH8OprcaEld8sHxnrEIyV8mSPfeRQr9vswx67ebCpV4LsUB9fTqNZzR9ii91eyfjhZyp7Vm8cgNdxjLI1FRl2dpqgN8z+Ooo+lenJXNtbw10HnYdA9Bho4F7vtRprr76u26WXfVh95H8UV/22yi++Wm3lH/tt52xfdjjzkh/a9HHaDye7PO28BGFH0PtkH7mLw8RfzVZ56Cy8vIoMo+Zi1YLZd6RxfDlI1br98Ys1J3MsDeo50Sv17gnvHiJ9m0g490i4nzsvjXoBvHfYRJCgDgAbJSRnmJ7nKEDUwbFkoImpMyYeFFjXVEzp6lBd6Z4OPwEt1voiTkaXNaEzvVXMkl6ItMljf7A3DwxkQnqCDLbOoO9QSSQP7tr4mDNZRqRB0m6mEOo05TDB0Kc8dfhGdL25EnE0GaJB6ZigUHOwspVmqPjP9TqfClTAYoQ1Umua3jYQEsHK1in9CXAl1vKMollvqaj544OxtbAl5TXdrIq7et1JIPZXWXAQsS4PQs75sjouMTimHTgHgKg/EMmosWfItEzwPS2JJWLLjcWf3FVaRJHa+fX2WmTWbVjX3Fr9Ob1u4pABirE2WnHy7eyKfW4YYYbSMQiN5+IFvaqmN+Bv8U+0TNffr3Sszsk1+Zpq2yIbDK8ZImezSAUFiqcyzTOF3D62V8WmUxzYO8MFghBaLCuVAkCsNqtO8uVWssxXCtDQ5gJCrJzW2fV+l4Zc92OzK/zRM/cQhPlPWlUnynGQNd8LPhpXCPEfsuVQRaa/ZhYSfCM2DqFUC9AhIp8+D85Wxb94DPJTlMxJj4HP43BVSTl0x9Q8GXYF2x+sXxz8z/XdKA3WyOswHCsaqweolCEOOww/RsRTYYobp1xtdJsq2ZoxKx7JfN0c7MwFThYn/J0ZzzkI9LIjXKn8laPwfalzLYxFmZapjQJJA/sRbvxRnp30ptu86hRgbfWiwKuu3xouw31Bk6ON3xy+YP0IRs9yIX0QWVkamG0f64uFRpYI5BUbzmLxRAmo3SxmVbkbaNrRfiYAAPdG1430srsirlY1wiyVQr6tS7JG7csC/+2ubsOqWSREXOVWUVjRHfY/akqnBZipBfK7qRJGhtfySwd98RYZRn+L6b/VLqGTDq8dSrLdFbfVtz0bA37el7PTOeF+KWHCijRLouzrV2b3DIvSYXwL5YPJUxkbhO44jQuYi4RrFYg3YEtFSnCMi/0ojuJVmczO2/CzEcKJloXeZHJZfCL/anGLOPwC3D1giRO1QoCyZPWLfYAhJdH48aOsAvX2m985mCIMOXsePRr0Rr3pUHq3s6NdKxRU9hsnoHHos79vqujgA0du2YiPP/dAQEWJi8whHc7LMz3qsW6vwK4JBOqedTtC+bOTfCf8lJ2Mr1Mw3aFzKPxvMVyXg63wji7W1xucrdBUmwbQVpCjKqxAv5mdvxNWLK0W6hCUmspRpgOMJepWMlOxduQngtUZtpFhLiMJYNxEf9cKfH+xUku9vd29kD07vBgr1GKb74xlWGcINK5DlfCpkchd34WdkG7NY7lRK28lHEiJ4mvCByHsLfppmiPKtjdIOuGimSZ2oTjquzYgcR3d3bbB3dn91YerVFKeJzCS903HrGVmdWgA/UUydMuWpZZjC4n14++1Y1ttutYzL94i1UFMs7FofipYs5fnLIQ1H2O/KqYaf8ZzWkQ6jP6k7AVwgnZkk+POyi08vDZsH1Chrv7XWx1CNz/Gt15YyzsOw9BUz2smTfUoJ4GG3oCw9cWq849zYUdXMOlprPq7HT0pOcrhtDsWsjzzZxpMJ7tJfvHcXAr6tAzScG3eiaQRRvqsHDwCQEKTWlipUxc5qsQoV4am5yptl/qRKW15Z0ywX5+7ZoDo/zNDoNbsF4psNIhgCS76QR4dsU33HwPi9a+P2czwe48e0B9n8wb71e3+GVwqa3/tN44BuSGerEoUzYCjAWO5k6sociqSw012rVw/MYvVR6Et9KD2sxY6DYBisE2i0Rlas7TI/g1PANoXdeIJul7vZK9JcFR+KtjTOzIq6wVdjIwOxiq8Bzpjndb5pxuRwiln73Le+QQz3t+h/qczLGrOLOjLp5UZrKnsrO+bU6FhyTkaYXnpms54GDUXGXtnIyKrLy2yR41E2VzOhxQj2FXalJxCY7/MYW5xx2QepyXCNfoROtPYz8VYFxcQXXNKvecObFkefAbTBHCifI73xTadWwjBoo/SpVdwwHeurMyXtROV0cAt2XX3yd4u2kyswiscVtSdgA5+icaV4pafDRyk86mYmyOiYkoj0m/GOPIxBiVqVP3+4yLp3tizMy1fzJaTFxxMy8XbQbsHhzWGMDCpbi+WJu335tEzTIb1HnEibN33CDO3AyZiyuVJCz/GKSALlLoUCdVGX1dNHpjhEShddKXs1TDbyFcaleh7Vmv5P800Vd3T472ZqHggCTxbF5sO+b144ia6LX5PTyav/1L/mbv5V9e/7r/+n9vH87Pst/e/RHu/f7XPwc/17bCHY36PjyKn2nj1AK3ioG9mkUmp5ge9TF9byfHKJdeJTN19DEVHxmkEB/FTzaw+TEV4iehvJ/jdILxMuYfuiy8f8U8iZm/9Nn+y4csfhJlSof7Y/oxJaG8kMslBA89Jtyxyjx4bAAtdBoXOrMtV9TnoueD7PD4VolpALOZC+qwAa5cxuqqxz0dXeVqLj5uWII3fNA6Ex83mPqN4FZ8Lasx+UBl8UIVKmvh78O2pNyOfw3x5ra6hWr86CTObNNGT3zccJtG/3KbtsHU2m3zGBF8TCv3Uu0r7GDC0Gxa1WEkaEEaGm9S/eIcTejSwseUZoXhAE+aCpA1wtA3DFuYk8rBQW+3SIDWG5iNlOsaWINmRYlbvLYiX4qOtWxtug/UQrOBEQ+J8yrH3suo58oQZMfht2ejd3jAfZB/e/fGvaisdmd5sNGULrx5NTEy1dmVzCIVXcTLOyRJvOySFVTdXo0uNjEYzwnp/YmjAstMf25nTw2f7QTDYBjUvaqxTOV6p3JQa4h39rF4Q0uJLSvIMYcTOAQ6m23LHLXniLXn2/Z56Rvk2r8IPmPiv4s3CzHiZ4WUEBTf4BLab+W8+TKJZyk/aDioaOT1ItFXdPJz+omrCxxcynY22j26IoD1XTS1GH5QZ3SaquyL/I9svQQEyQ/0yggT8+PUFf7g5LPkCS4TmfKHGaio3y3Kn0lVtsA5+9ur4zfmhP3Rj9P+H+YXhTTh4TgX3HogEMcosvK4xPjY2CGWDeKI2Eo/c5CRcPdwasRxy9wDSXigDJ6D3ngYSbqYJEXc3MPBTjD8Q6g0lMscshmqHOirxLzJgHFAjSX8u1KfeuLvaDwyl9mn4MmqEUVifsDUrbCdD7kxxPN2KkYtXad52IaDB1CwRmfIW7bszQG6KeniRnLumRqzRkKoT8QsvlQpF+OZhtI4YzmbDvzYx87ab5Hzawlz8+/xNK6h3VnUfpvB02Xc2Er2h5g3/N0OA6f6S4eJY//oQFpjp9vI2dmrU81y8w6yH7JZm5wt93Z0Jl7rSCVG07yyDhWcuePKUhev5LXKHEJBt4VO1jMC1VGaj8k1MM7z+fqMYlcXZxnmYvDr4NiIr6bdW08h6FHaDBojI42jUlb/w6zjt2ZxrWQcriIh3m6V0bIninDZE/Hy8qAfh4tlT6giDJ6si39F2GBfK7f6q501frLNOQvAgT3Dh8qhFC9zFfbEMl4QW9bFFCxd48qP/IT9KzxelhYLxfdTv/V/d4uj+thLzqw7qtkbKF0jk57IVVjCkU4SzHNXOjTJurEZfyZJvmfh05c4C/BOiP1MJbL4f+x9X3PbOLLv+34KVF6UnJJpyf/GTtV9UGzPxHWcxBM5s7vZ3LIhEpJwQhFcgrSjvXW/+6kfCICgSNuULHrsjOres5MoEtDdaDS6Gz9023XQ0TeOmLzUT3EYOfGYi3wxNc1RvzDkQFOrGYhmVQV99qFxBcGPVixZ1FwARIpxiuk805Bysca6uTeRXZVbRXylomUepUkmdXo5dwa240Txiw9tYShNgpNe0APnvqke1iXJmVHhDEIhJakbGlIdXHzQorEdli9d/XRuFvB47+6LBTEugaNxwR/ZHtFK6rlaSKsX0mBCc92QhDaQt+JCj5ojPBLue+QDk6pE3L8zppJdUUBOL8+Rbsi7luuGGzzCga0qkhWpHTuMcaYQVkSi1IbfyAOrS06Ph2u4DWEuZn61qM7sdV3EikwFoiIXf68y9A6YXEWbkLsiojhJYBVzhVA1GN0hACgCCA0XcnoeHU95hAzzpwQ0mZUyYHZc8+aW3v+owNxbqacFCJQXnxYQp76HWwxEE3K/uVyUuWcF4m2eEiz9lKAiQx60LsA/921BheMW3YeC57U/Nqgw9JLdOJeFF+7NVZiqluNdGz8mqDAVec0tgbGk93F3lw2estINIE0YxdDls0L3yDrTlwpdcqoz7cUZdPLha5e8/9wl52yCbyDQWxToBaBN/lU+DEs3TQ02TQ02TQ02TQ02TQ02TQ02TQ02TQ02TQ3W2tRgsadB2c81BOgQvTz/qpkMHj1RKoNHJf/05eUyeLQYlm6SGUsnM3j0l8tmVFmuWo+Xlc7g0cvPZ5R4+GkSGjx68owGj3wxc0E/q2U0DLxZJzM0I9ZIG2tVyWaoLIYd9IFsxsmHr40luRoAsAD4FaXHyovbcqebUpObKgWbpjdP0PRmbXutc1xUF7h3LQ12X31RXf7pAgU5GCoV7omn0ZULxdYcjK0dmI8L9J7xKYqbR8w1U2AKW8ILsSxaL0xoxP+zGBKejUkk3IIJoDliLGCBW3pd0xWycUrYLE5rArn+Fa5158PfNm05Nm05Nm05Nm05Nm05Nm05Nm05HtuWI05EkPlpS6QC5qRnuMOhWSBR7vR6JfokSzgN230BYxJjAGlx28O/7LpXd/96fPfLaVFG15WMEpOClsFty6MtvI9zdtUlinAofwptZpi5SjEva4qR5jGTXl21L/P2KbF1+Ai5Nk4fENU8AKL6msfqP8oBU39AK0BVICxHGuFPBcitpkSYGbMk0hsWBSJpQah/qIGbKdxwPqNRupDert2/ayHNqpqewnORpok/ZajzlIoy2nTx8wf7r2gooPOCKGF5sG0eSul6WSwpzWps3IxGAPoBhumnJIvNGf8YKB6N+VWtXNe2jQcXZ9YU5g/wbXlMmiRzYtwW6r4atopw0x+xlPabWnk/zCSeLLYYCZbu4/V0y5KXJW15z18+nxviIHgjaq06j6G5XS25LBzXVYWL/5VxkS1eN4kfzfhwifL9WugtDH5Kv0O94xCZ5UVF/p6N2FbuHDZlyA3eW+LpkzMFoeMx851coQIck9dIR6jcw1bKIrwxdm2THpQQydIsrvHaddXFpjwbi/hUO9jMp00TVfwXptVhJGUy3YpFsOUH/q1cmp8W4xTTa605L/pRdlMmWqT9k6NLZser4XTtJWwbwN3wbiASMxoAFe+HIgvGAO8l86rGFT+5n787t5k94Bc+v+dJCcyXO44+awmLEtgJ5TGqSMe9ky0KEgGsTyOTLUP6TyFXSt7mAtrDepyXeZiYzzdlYYxojtAkoVHuLIx5iHoFigbVzc0kB3kUsB9Iyar4VG/yIuno8rOO2s6tXU/pfv22/k+i8S3lJSkHDi87CeSwZdN3Zj4hS0pso9JhcfbcrcjQ40+mnretHFivtIs5j8c7oy8yZ7xJGD+QMH7B2eKXbSXWnCp+wXniTZJ4kyRukiTW+6ElValoeFMjVWSIDaEoJUEnzD3pL5yP7j3gJXv4fFd1pQFkDllgH/qaWQ19Z6kZQRLVAp+GdUOZnxUIUfDQNcKGi4oesM6oCotsh9aEULcgZTEW8K4YogA+P9YLQXsAjve6WcJa0gS9VqWpKqv+4/Dg6mCvRNoo42HQcr6uM9B7qXY1sbcVFcXyjXUxJK0uekxSaEtdfRBbE8oXsxlPyfD9ACPlTWwTpsxCYIeo7Ordg/He+Bd2eBQEB/1R7+jwcNTfYazX642ODo8ODg4Pfvml3/ODphvfnzL/u8zaOtuO9fAVYRkOVcSCir1j/dq7og0Hh6PdnaOAHh0e7bLdvd7Rkf9LcEiDfX905B/tla9nnMlb4uik+IthyizWIuWfYhYZUFmciElCZ+reJKTRJMMuSIVWKanAsdsoTInq+NtsPOY+L169E2uKysGcFueV9EVr5/xZFKiliSZkKm5dhlXLArui+rVfJlmyBZsUdskkFCMaVuSSf1zHCAsaMBHQlNURegmDqGqA1dJXllzIfRZJ1mC6VWTWOc+H182lipypS5nZ7I6dgEtF4cMkqT4rlEzxS01wUew3ybFyw4uTfxAz3Tnu2m5xD2eHjFG/cRSyooSejIMfqnyeHlJuv6l6D4OY+lNmB97xei3GB7VHhDNFoTmiREWL/bIuUKdbSbK0bryiUA5125lE7ymfhtvHLAxpsj0R232vv+MdLfYHVtXXW0vYv8fVaqyvzOxk7hWJ9WxU4U0uC1fFdislbrn5BU6NKk0EbBmUqel5A4enAddLNacwGlNquluh+WBnZ7f/ZMGRSU1XfQEFfNTxgXb1SiqGzjtq5q7pQJdOafkr+aVWcQUBdopiNG9JEs+6JIi/T7pklKAqboQPJmhgGWXq4/+hSXXPJ/Gs6TK264mZBS3PYunMt5QbFJTjgVPyXvXyXSUi+HseB5ILkaRQfXL6g/lZ/sfXF6dvUDZGtfJ5Ee728cWX0jQkpcmEpTZNPOY1m/vHwV5TNSin79dNvSkcYKYpIShAetf0tAhQ6xvf4iFTXf8qTH3gqGQsxik5FkkskuJyowGbDlVts+p8uiKnF9R9nf0AZxi75bDKsqanWZGtA2/XOzro9bz+L3v9/ab88VmM4vUtseaUxgdHfIa3gPAQCIUVAoceGUSGCrK1hYA9/xpx6CL4F405N8CFMY8mLIkTFAkf8UjV21bVqwgd41YrQbn3mIe2z0XelBQveLfcNpZEF/o04awkU6oeKfgZOht09YV+XoYQ3VwnQNSjyG5CbTgMWnWG7cFS+6jQDHwVmzNVb3+EbpzpFPW+tvAkA/Zoe6fX39vu9bfThPrADmzNaAh/ZCsXzhYmREIIJZurB1XPPzjs7fp77Ghnp48/BD7dPzrYpTTYPQiCcVPtMH00rrBSNU+Z178HHmPBhheDs4+X3uk/Tpvyp6GObTOlp3kMc6+sff72Y3BqTmH15yJ5mF/kvbqfe4d337xQNo6B89HdbkGnaabQTGF3RPmHNCoupVUPRmR+TdW50ngqyWuHIzzYdlRRNyHIuwiqgncefEhGrs30MQ+uiRinLEKnjLk0Oel8KmSLWYjKfHZ1wVWMJji64H0ej+vMNVwDQ26RV36cnzORLalgZ5AkdK7rtivh0WSSqYLwXQgjSW2+HjuOjqQIs5SZPsh6SFWvgzDr6Dkm7gOdozZEjiTIJYbqv0x1p4okT/Hgy1nLqq3q/OuVigtHPNqWcornXFsh/heJEvy33/Pw//oHi4+6ILcrVVOigfTuLPp8zqJJao8oozMYW0El5g6zl26vomihy4EpFavbYIBjyHaUobQzoREN55JL1Pibils75IxG82JNyC3iaWsUUBIba+RsJfJBnSb2ByjQgUqfxjkhhOt0FA69MZGZjLnPRSZtT6vqEuzdbzEKiePwvJJ8ElH45F7AJ0ymVzRE1at02laOFAaH6AOP2MksghH0lNZuYcHUsawWLG+cNsm4nBLLhW4AQW17V/1y0T6qw2Fj9icxCDgJU66+maiiztpEFX3jctkU5FalLqd0Z/9gRdGzH1ymsizwCuB5JETIaFQn03f5P7ltblGdtBCL25tgkfIOanh2VqQcf+LRpMV+TJfTUhawqZ5wXQ/VaaK02ImMzGiUjakPAQVQBVokinJ0vFdTblq9tg3Zje7CgnrpjPzXp6GqhlHVC1/MPFR3Zd6P2PcUhn9VUac0zWRbYn7wNsjpAAc7lmZ3iNxYULTuhsn0k3mc4jYgnnI/71YuizPKHfWGhjxw60ohbE/QQU3PBxf8hpEssjfLpqew+WnxEzFeHN8Oi2x0FqmrIFbTU//08+dPn6++fLz8/GV4eXpy9fnTp8tVlyxT5WDaKhs0zIcveaKgQJuyRcYelRRY4CxldNbypscU69z5ajx1/YatjePU2e/aqfeKjW4HXXLDn/7+/h9fDz8cDv5YVbQ4oVI6ixsI967LoRPsJxQISu0tUUk5lPFWF1r5hT62Er4O2dak+js7vZ3+Vg///7K/87bfe7vb+9pZlT9saRY04O6eE68zBMJV6qYKhY2o2ffEn1K+UCiNB3mMVfz8rt8ZnwzBqjo4kESAqNIpN8aYlGEwOAjKZcThZggRmoZccN1YOCfKBKlptYHrrPVsVkbxkWKu9yxAsqojQcOyj5FfbUOZJsB0FBe6+IXK68wVlqTc8r/WrNPSWjxgs5eV02xGo+Aq5I1qttzyMPBpEqwT3leW8q9ZGBqqgNvSFSVUSMcC19gtYjZNjGcn1bHeQoyXqywNwyLYcOSv3iZWopBHRIFuCEi2VA/MhNjIr+kysXDsPcGtwQfqTyHy0s2BNgen57/ecWtweLDV/OIAnOCa7kok5qJm/Xy8m6eMSPbvTN1+ivHdxJ/zNA0ZOY0CA3JuyIMfZ1ctXiPi/sZ9jXsnA0iyh0sRjnwsCjg6B+Yq5+rpD6RwYaFUmGnRBrbNJcjtyOLazOBJdGCqxySEp+r0BR4mhdOq04WBp9B2NLJ1Osf0e15PR6eM81YSIpHeMvyzH8gzNwkexiFNUxaxoI79c/24OR+OBYTlNe6UpFEqKNDPnZehbapuLz06avuF6h/lGxlol9vt6l1+PBX13F4P3p29WYUVVYGxJSbyu968X8Vd+2QZWqGlLZF6gpdI6vhxCdXzrkAqQ41Ztxpzwwzhw5AKLdRignVINm9q0rJOd171fvRfqRtu2ybdEF34k0uRLa/oiLdE7sP7zUj+HOh18mm4iuRbPKK0ptx3Si1D6ZMbPD3vMqTqe94GNDY6O/hsjWeH6THZgLiIybSess4gyi8HzPWXfpOQb2C1txhq8enJquuuB0UajEGrdJynw0FEgrgJjszzCVUwF0Pn/WlN5ZDRnMhstLXQjFZBKiMGr/7a5dj7r6LQx1Ki8vwp32kgrxoDWioDUxLh8ZRvyX9nYCdOxIiOeIh3rUjRJ3yUuWLTdKy0zB4stIjn6yZ/OKVRJCKihyc+DX3dLrfoYP4owschbe1aEIo41LqpPDQ12Wp0VjOV7ZHpvi9cjkpT+f9KjMeSpU9FcD7bI0leHRKKCFI2ItRMVnpwsxy9LZ6hFXIx12pU3vAkzWh4peuvNiB4Kb+wQqmez9R7fRzRbYCD76R4BU2Y4Hh+qqNVTfZnH62KiFWOVv1Ds8QNZLbqxtGS01OaQ0l6K9Hb8iZfoHX5bT6lCUOadpQoQGBLpBrPNJ+O2OmQttRFcrh0GFqKh5SF4xYxmWZ4IuezkdDQRXisxRZqSmwUNKDxrsSY7Rni5KoxZP1lUv9gq7e/tbN72Tt829t/u7vnHe7vNr9QAhggnbd4/Xh3pRHN2oJhKjd7gC6l+iZStdlVNyIaaoJ8i34llzcQl25hQDuqGJNblJGz3X+VLqoHOjBsFkK0VdzffflydtIlw7mciciA/8hvX85OZPHuGzXlLIhXzZwpVsO5vStF8OM0lRXjYjKH62MRyTTJfHWLRvXTN/TDqEgOJW3U3YZAZ1rAlnz1FHDGUz4ptImQi7MTkjC8F6WS3DLcVcjSJa7u2jzmviGICFXinuOZMcXltlwsbkNMmxNIT8i05o7N3/H39veDo/HR0e4v+0FjJbSXK+vTwieuHDFYAAi6Gu7w5913vbMgE57W9G16yK0pbz6YEvaDQ/cDm2TRVBVtwZRapQywPKdt8sK+LF3LjtRtGQ5NNUZRvbOYzOxyXRFNvUbUM9tx1aVczRPC/u4vf3tA/EZM2IDeLNhvIKVVzNeHk321x8tPQNUnckr7Lc06fD/o3zNtAY5rYeKd/YN7pt7v77Q39X5/586pZcBY3NbUw5PT0wtn6gZ6t97g/omNVcccaZjB2ee4vIbnIZG1UU/tE6DNTAsHFJ2Y8bDuAeCi9YppAhOyAXAvB+BuoHiOZDcQ76eEeGvBb5DefxrSu34FXhDgu56BDe67Pdz3HRLfwL+fPfz7jpX7eVDg9QxuwODrA4PfIeGfDRN+B5sbaHhL0PB6eW8Q4ncgxK24NkDxFwAU16v18+DFHYZeOmzcYeVFosdd+v/CIHJHDM8VS+6Q+JNAyqscPXtkeZXk5w4wr1L8EnDmVapfEty8hvoXijqvctLiCbcO8HmV4Ce3kHreFSh+rlB0h8QNIr0RIr1GYi8NmF7HwkvCp9fR/4xh6nXkVjOq7VG7FFq9jtiXAVq/l/Lni12vI7vFk/hxEPY6Yl8Kkv0+2p8voL1E9QbX3gDXXiMxu+ANRLfqptIC1FOaM016jyG7ZTvwGJS7S+4LB7s7rLwYzLuh+eVA3y3FGwT8BgH/JyPgjS7ay6P1KeP6r87WhnVfRjAbNHwDNLyW1pOC4pck6+lg88sT9oTA+uWJe0Lo/bLEPTdwviZuvSmNFiAIrcHwm8soZt5P0E2mYOYv0lemYNihr22mnU+fosNMwePP3mum4HTTdWbTdeburjOFnvz0/WcspxpA3DZ7eprn0YmmKocJD5aLfB7OBp8VUbXmVzVpcV7UaeCv/hsZMURWeEfuLUs+Dx4A6y9FuXGbeDUrtLezt7MkcSrsKpP3+JyVRpG2l7VSBirw1q8Wl2pgcnayDtlqKlu0T5pc90bREpzPvtVblmj0fFofuS3GDao7lXPAXWoNVJ9385QcLLIs3utRaXUUJHjk2ME22qd7hk6lMEaghJJRIm4BK5YsVdaMp5oIkwW6ZaO8fazqERel4ZyIGCVwvc6Sq5DFoLzBMjjaXZLSkPkiCsombAqIJ2MRyeKKtvR3d5Z12G5FAmfgKuAJ81ORzJ+z1kA5NMHEEmxMvxZQRSjbUzFj2xT9nhvL5ueIKP86oeRPHUP+BYLHTdS4iRrvjRr/AuHiXz5OfI4BoiXu6cM/M/VzCu4MTX9m6LZAw3MIzCxJzzDsumfn/TwxmZHKnxdxGQqeezzVXB3WEGwZ6hI2Qen3uduP+rP72d0NqX9V7KLWinrnnQpz2NgBoAkJdXCBj2nXDDSS56KT17BuJX46n7QfQ9Qs5DbhaLCT10AZUckO9giLfIGX2s4W/FUklvGkyniXyMyfYhcOWfoHKiWd/lDVGj6zye/oXaw/65aLEahW1zLONV4UcCz1GC2HaF2H8RU+u/ZsBQ0Ra2cTFZO0y1CMOWKp8XpvWGJeWNDIhZoU7/aRm/l8+tvVu7OPg8//zDlngfFgK/7k19/fZYPj3uCP399dDgaDgfo7/jAY/J+/PaDepSXOj+aFRa6c6Qsv+MsLeZyXJcjrTmEZsVHycc17If07Qi4swxQPijRYuO6XoM6shVloTy2/5NHEni7EfN8qg5qSvIYwh1+7BP89/cfF4OPJ1fDrm3zdXbCPpYGnRXAjIqbH1VPqd+ASDpOeUCkqRv/w5fzyTM2lxjbDhSEZFVTe0ISjkAAJVSfufNgom7GE+0hH00JzMebJ3z99PskV9/S3q9/xtxLpdtySEtn6QQHz+YyGJGEaL63MgsIqketX/VfXNdCkzr9eHb/9lqT0G/C2aRp/G/Ho22xO4xhYtiXK2oGdGnRxRatWMRvDlEYBTQKrE2qs/BjV1sLUyZCLHEKww69NuZjymzYYGIxGCbvhar0wkc1yYb7KMfL+v88/NCX4O5u3QO97fsO21KmDqhWqOoYYw3GrnnnDT79e/n3w+fRbERQZU/3x8ttx7rHoh4/fzmZILf+KInWnCmYIBf2kbIr8dssj+IXQu6bcg7IW2FctKDG2WzgES9XFcGqHKhu9KAss3LdHC0SPSuoE8+2EjbLJhCVNJeTSuU4RfXTCZzWHOcsrCtKMYkOvdnXKvlLx0d2uUsepbylZiqN6xnRlqjH1cRCjMk7Mb4Q6cWgisigAmpkzVevD0Ac7Zs4uVeNFfUEdAm41OJ0Hk3CNVWfcaE7ikOKbPMIJc3o81MhTcumSoIfOM0ygRNuCGaqeisQ5nQC2DsN8CiVj46fwxHFeilhS1+aLyLWWondtORnAQPoJSy26HBI6uzBvnpg0KTaT4EOvJQWS7hIxkiy5YUnXQNX1oAGTqQbZdokfchalXWK+il0SsRROtDcWyS1NAhZc8dgjZ2MyFxmqEDJdX+fswtjtVBTU8/i6q74JklK4C7nQlPWkZMKRZjy7IGnCbzjw5l1gdmd47JzAMbFqzlM1GR55dOHW2WqnzlRv+0c7Xs/b8fr75mXQY1zpFtO5gzCEEiAymzKZq4eIIKjEKJz2uKBdqd0W8CWcgikZXCr03kyFK1c9KkQ+ZWGMLLHkaaYWWZUZTRim6iR4LCBxcYOXCXZUQ5hT45RL8hrKgMQvG0PDc0WDKYWwCgLeePcbCUe8QqZtBSmQL/QeM8kiZY2PnJcM9YI/zY8CPSwpfT8/Shj59feTj7JLAjFD3TQ1S5dgm0j9YER/BCUPOZVLvL3ncQOZ8PgurrU9P7uoZa40UyZZ0mCux+g3piALi6A+q1sEi81/QFaG/iQLWemQMX+/54T5nIX64UD+8tDcgZhSb6DBvElR5wWN5tZ2EmHkRSd4QgACkEKiqS0xSGjIktThNhLqHUYu/yKi0kqmpnAeEOnRblWcaeIDtaCJQ7jWwrfGNhuighmXcElwTqSJCHGYpTjuZNd8FYSpXXB2Mtw+uxgW/zDmCbulYYhDhI3MkE6lEecLWRLqKmmyi64oKtwmAUv1w1aYivxok4y8Pj35/IZIlUu3D5dY6q/BQtMsnYq2dBjuUZeIZEIj/h99QIqExJJlgYjmM7PVciIg2PxPsLAib2tsqSDFGhqNsxqjrHtJ763f1fnXq2FKk61zkQRLxHHozzxZa+auJJiBmUCLRVe21kM5Dy+Z7myjzykjAj0mUT5AoTRifJ8oBmnKZjGCrzPHgztn9HtTqTg8tCQYJBydD4yCgGez3EYO9Uy+C4X/nSRIWshUeYpxNgq5T04+DvPeMO8vLy+GZJtcng+RwkyFL0LZVAI8aInxQc7j2UluvlA5OX81iMRG3iSUSF/E8NHhVyvz6fikekxSmM1axVlKYfq9flO5INkbSdaScNwwS8+kPfPch7rfMugRiX61hpCIBozQG8rD2gd+g5j6U0Z2vMaQu1YvoFjpllbxKRKnhGqzfXH+6fi/r04+Dq+wCa4uz4dNeUuYqoPvt8Vg57OZgHz5fI4dSx+qPO6utR6SlNfcSMH+KwwLhodHn5+1OsGal3fvdCQJhJ8V75XLs6lwDTuz0yn0KRJpoUVdwssVFikJefRd8ZPDLnICwxxYmItgZGITO6ap5KWcIK+zuIwGt8Ei75Z/5zELOPVEMtnG37ZXWl54YCx9gp0LOUqWdkksQu7Pu7nHAv9AAxHNoThHuKV29lJnP0J5SmYMHYkr+m+Sp1cX2uRf/Zp7X03llGXPxPbjhhQyMygGPaL2qGVxJsjuwmGQNzB8+DiwI9abkn6/18v/r6ns2oWtYWsbxNo2QYbZBa8pNkcMXCvdwQFoOrlUWfMe4MlwlJ+6bug0LD65J3ga6O9BV03JFrzoh3orbx/pJmTObFDhiyjSyzO2jrpaGNSdn9AEt4REMhW2yK7z/Xz9Rzy/uM3t6TgUt+peLgmKSAr3MZfHFzrA6urSYYZM/C1hPuM3BYKGRzzlNCTDf34kMfW/s/S1NBUT9aAYsKAlv/TJddE6XYszaQMZzivy0GPiYyOXNKGRpHpwlaHU8RGa5GTIg+GE0JX9kxl5Zcd7BfuhTjVnWENFtEC4xJ2n/WcdPWrjDSueUh7K4mjSI+akgBIsDpULU7h86JTJsDRBHlcrLvSIxU0Xj7DG/5NFivf80jjPOupf1w1WiDYSaWVI7Il8GbfU5lwMtY/z4bcNC+W7NbTZipBBJZLNaJRyHwQCfgJB04iwHzlUUedW9aBcqtQauoOkgtxwmdGQ/4cVN9BglCUpLeXeTN40sXOMEXGbMeEo0+IgyROn+spTpjwMCYtknqVAbSWVMVA3GU4SV2U1xjwMrW2icZyIOMHNVThfR9DduKLXKvawo3aDWkKzYDa9XaoVRWcjPslEJsN5ruXqN3pIgtr1oQL6AE8jScglemCRs4suoSZvB2OK0+oHkQL64xHyz0LigInO8YqouGvRRzm9NTSZ/XDt6Q+uc5FZ5VPYowjelR4VLzwy014BKnbt8fgatu7ay8m67pKAxUzdCiAtptSOCFuxX9XJ5gZbYFdFeqV6i6tgh3SRnHwcJPWFpVInOkQkZiKTOpuTy734WI9pLYge6PVg+PFNpSwNznNVU8zYEp0BzFGerObk3u8fHC3y7KZn1lw+8YnRSp8cTuoRe78JMQkZOT8/LkmhBuxTuR6sgTC6PysR8g7/gPZ6qVuLU9lkrQi5wa4u0OFeibBcnR+gbCUbAbVzcu/mYNdUauWsbb+mW5xQdSumfgx7MM5wuPwbNlxVdnLGVm4IjYr7AztqRGf66m9GdW+dYo6AJbh2twI04G/t7ItEBVkBoMr4jh2URQn3pwjkvM6ifMdCePovni9mJVlPmPB8lNCq7oG1iPwYabxarfyAXDOjYZUcEaUcJadqagathabLW7EV5mAtzFAc7AZLombvaEDnIt0fB397YIPWM9OSgN1Y005WEfZHkaRTMlDoI1pDZIZK7FdcirZkfpxPQc6Gn9QDkAqFx4M7yWpLNTVJtat8TCMaVCWlTrZKUFchZ8LElVs4vzTvuYgmPMVtIFwwXNWmWY1AOv+PvApF9Oot2fpl1zvo7x3u9rrkVUjTV2/J3r6339s/6h+S/18+zkHkeo+zEu2dL5IlW8aVcv4JKkiJEU8X70SgkUpA+LdJQqMspInb1y6dsjnx4ZupSMLxfY6Ny5OW84A8URgN4jMc9jqUGodCJLpodFEJzEQr5qgimrywKKwLdyKdd4lvbFTh+xPyUaSQE/dNUKViEPgsM+XbTJgw3FYt7kjIVERbgV9Zm1jIlIZt7bLOhRpe7TBCpRQ+L+MELckFowogK11vX6NSLKQG55LJ732PxG0E0CIlYEVNJBLy9eyCODwRFV0oV/qGJgBHBvDgcGIRvavhwuo/VuV3tNfba5yGhsoDHCiiNg0YQNcius9+bf1+fBddLVkwTVOtAfs9YyNW1T9ENf8RURvU2Oc2GN8cSUbhCrTr2eDjwPleLfH6oNoeJLjw4RHdfpexSMirAU+YbKoYPH6Ay3o0RAGMWvAPX59d3OzBrTu7uDl445XmmlH/gclWEWnnw+C4nhjHUkHuQAuYbNmMagf886/H5Jfe3g6yTBLIQfQUfEtOETwJP2Upea1Tr11yuDXihYsKH/8NfmZdI301eyvIv7I4ZolPJfu/ZMp+UAM9DvgEFQUm/MbkWl38ITHk5xPDgEQAEameigSg+wlLPDLMfLyugAusvpjncSSLaWK6BJomOoRM5/GU1VjfXm+r19vaP1X/u7u1s1taqYimHo8bnI/12tG5TGgkdVIKmehSEgXvGALycXBpc5O6XiTX0akeUoHd4oTf4Orm5MPXN85ylg8dZbpDQQMyoiGNfHXsOZAKkZBEZDgNvU6FT7zibcDpUg/VXAFg/Gcsgjy7J8sSuC/GLTF6kf96pYi2/GCvugxNAu27l+BCi901B+58OHWkanx/VRdL1+rASuYJpmfKJ1N0Ci8mNTLK5wa0NeFxzAJLcjYKxIzyyPvb3/6Xva9vbttI+vz/PsUU9+ok50iIpF5sqyp1R0tyolr5JaKc5BJtUSAxJPEIxHABUC+5uu9+9evpGQwIymZEwPFW+DxbsUSBM9M9PY3unu5f845ToJzZ1+RAuB2OvWpYJY0lX7YBJdVwP1jtvnNiK5JGkhmFAeeJHIUprBJufU6Rvii85XJRnT+RLsbj8MGOSM/s4jryeG9Pp1joJ3Ab+cITVzq1FAFgmFMP4cxe1gGiPpzNEeb3b/N9JStYRD5aJ9wrEflDGaU6CImLVroMIQRkUH91cZra92hjpLzFbcPbWRY+hxsFqbBsr1Ma7CQk9NYx+EygxGSlmWMqEEA0ogJ5ThFKl3PtUNjwC6dCFEWFxd0T4hy3SXM/yULnOkGUVkDKg9u08t84a816LpgBy9ezjvw4v0sQRZlqOtRzO4S0TMxQ4jZqpYivPg8ie4qvjfv7e0/6abbiSPhp1jB6SeD6IMtjX1M/R8fWYkD5hHaO3FhrpIth10sXw05hihxGvLi2ArIys8AZo9HUFzexAnRDGOGszGUSqhxhiGc5FiBrXUMvU/MBkfEV1J0cj3FHdgfk8jn7t0z9rry6OH3R1IhM1lHK+c5jCtYqTXPPSKcfsmoEhccDcV5ZMy7Pu6q0GLuE4Rv/2SqR1OFT2jDfifX0In1ekBtkFPPlSV0i44bn8lpim+LsJG8INV59/lFOIS5Oex+hq3qa4lM7lCsrResHE3hy5odRTcQhFiRoAuOjFM0QWgBU54oI3n/QBQvI3EnzdwDFmGwGVMn260VDmWTiLIzTTIZxmSOUKPGXiR3NXr/c0TTrwWQ8h8Cn23JwghDnD9GNzp5JZ18hnvR4nRFTdyf0ZOVF1FgwZBqYgFiqGoLjSQVk8OAKmYbgoM9qCan4yFUP/7Br0I6JIyqfUok0hnAsbvAlLwz0dTT9Ao7eGCsI/4719e1ydmMcrDCpEG1dJVRh8AUvqhpR4t0iOpZPd7/VaR22up1Wt9096B687nRfvnrZ6h697h50EbVsdfcPO68Pj16+Omp12u12mYiyrD2XjK+sB/tTuJ0Gxj5SkzD+LKt8Tz6pAxOVt7ipWuR7poASMwmayVxHUNiR17w6zW/n98ZtOPRjf+AHszBuNJFCRa5MPBlgwC+WUxg64QSHo0KhUd/56DPpcib1Cu1xlnOvjMtPf0PWe6JjfXlKuNNn+t5PxUhFkUSTdXtyr6YytQMjGYnShcYh6jHjwFEOkZqkXG9p2+2YuRHf5/RDPqljpbJYZfJYrFg/X5+nMhq3dDc59t/4OQY5876jJZgPNSyk912e8Gz5oJ8H+fohM6HNrDGJUlS6O1J05Q5+LbKJws+mWKJJKYjapI8ejTz8qO4lFb1mpl9MKrN0zdXOFvBRVQbvEJoP6ozHtRcxiVLZEpNklmfCcr5vYdt1hp/5Eg+ouagLIshx9fIWN6nMyivGTgAaxwL5FfnFw7pcs2UliqsB09LSOEuBsvF5RqMa+Nvef1vqv+MuLufc5xeHMUsbaFb85OKKArJyXd9wWluPo/+6+haH2+ZdhlYgiyedNILzor5aQkARny7P8/o9Xr/YDed3B8dQdeDl7+H87uhf9OsL/O7jmFDioB0WIAliV2fApataH73set0j1FUfHx7sr40+LeO7MFHxLE/Kq5ynFhwv5WIzOyMXObtaFrcgizguohJxPIUQwMyDySIm7WNBv9yBU7HL6INUD5hm/iSMJ03xUy+PpQSgU83xeJMKHV80S+uD186myiNnFuMl6xusGrMqOyivzsElIzE35OEeJnDLNVMn1O3PCpOXtzgnae3dnU/lTCZ+VGPPvjMzR8moc07MbjhGbqSQD2GapS+Wj0sYiBhWKsKWOo83NX3lEknAhGmTYKBueEAyfwMlU2j+Mqde+Qfjw3Z7XGBGLfbsipaFRuVpMTYs4OqegqQD2zMJ8/eVgLqcoZY5VoHkhIsCyblesSJDJgMCpvjKCsbyV0r9Bt3FMOjWzL8FqEaGZIM0RD20677YkclSgSDPZJagABtLUHFekm2GLaJEwGRC3C1EE/GE1muHlDPAkwWuqWj/9l5lnEsdajiLWOr3dSpl/gU+SYVlUPjaHFNekh3WydrWRdLI4iWT4AbfIy9Vu1j0KwQOZ1/6K8Knwf5LeSiHY9n25dHo4PXLbjCUr8ftzssDv3O0/3I4fNU9eDk+Kshjda7J09EIppqT3T/71iqWAJovhml+MmGQaywQlhfkHt/r7bet7R1h5jGg9n2U4RN4ho2Eg6tp0T/GxIwT4uhZuuK0g3L+8VIfsHO2XPyUAjFnCPKGIwbbKJwi4yq7MXM8MIoWuB2wI+bh4DfSz9LiUcQfb2A6Dh+NEqZmm3MLXGgfhWa9saMycMwYBwODFFqVluVKunS0+LgVhQjZMWVJqk69G2nyrUjg4BYkpygJ2b2iVRVekPbLRivyNpIGgyTkTocoQHkiQyqAuDGiSdPZBEO6VYt5BtnQNFi1g/LrxK7MoNqY0daTpSWVbLm/SqKWFoBnadPckruioLIMerhPQ8qHsUMLJ1nJNN7ZMdaa0OjdbLbTxR0RZ2drLl3kqcQskjE/zNWE4zdRsgBOdBhPFmE6te+y/FDSkcb7QizmhVc9v+dUiqU6lUDCQDgyX2KygilVwaqEfHg1LhBdlBo7opWeF6KFPzg8ZqJmfkwVTihoLB8vM1+rzf/XKWpoduO2Pke1Pgez1bF162Lt1vX4y1wPs8l/cw+EPJD12bX1UbY+yrfgo6wvsVsvZuvFPNuL+RNiZiztoqxt/Zytn7P1c57r56x//FIHT7dKNc8gzegTky1rbe9PrrEmSHTKQzSQSX/6bURfdCQPrwptl6+4/S3YK9ZSKNxAamY7kzBE5THcbhpEJXYMVHMUV7d8yp9Q8PfGgrsp6O6bsmgV/v4nN4xvtOvYM0b9Xt4yi/vxZW+aNX2mRKTULVIafO2b4yqVrnSX7uqZmsI7pMyvfa/rHazLp7/u1JkV8F3xNgJSbQSE2eq43HWxdhsB+csiIGaTtxGQtSIgzK5tBGQbAfkPiYCwxG4jINsISJ0RECNmxgcvyto2ArKNgGwjIDVGQPj4fdMREF7jNgLynxIB4Q3bRkC+EAHZSvQ3IdFmO/7O8mp44ESITKFR/sln6oz0U1zUYDsZldCdAYBCRSZjB6DboBvvaYwVb1VtEQTDgVtG+NgZAM5XkwGYXcx2fG7MhBwnw6yqsAgXDduwvkiUAyn9BTBpF8KGEaV5RJzMJzCTnVlGKk7DgMAcwDO4FlEYS7f/rwYG5lGHBm2ZqonjIt3pUxMaNhSZyUMWwME5aqCHpbx/O7aJOTDSBcs3o2mbiibrsFABHVly8ernDK8tlRopzrLb2/nWa2xc6Gjm+xY6egsdvYWO/kuho/VJZEFwlOA3iB+tl7rFj97iR2/xo7f40Vv86C1+9BY/eosfvcWP3uJHa/xobR9+I/jRtJgtfvQ3gx/N0vEF3GQ/4sgLD0r3ZAZSeSV2stPODXCbFFuMJ988lvST7PA25Mc3iCW9vovbLS6mtBfruN9rAkqzfnDnKyHsFidfKQiVAkrTmraA0ltA6S2g9BZQegsovQWU3gJKbwGlt4DSW0DpLaD0FlB6Cyi9BZT+GwBKZ9NE+pmb5nWVf/J0mlfjLe0nHdPIT1OU8HDlCw6LH8kEP45GKgmMYcVzicx/QPrF4zWv8NoaRZDnd+dXl2eid3X1P07+ef3QOxPjxJ9J2FTedVzKBIM2AL2FleQD8zp0YpN1ccKEfX8TDDs/7TfF+x/e/sJFeiahHVh3s5mK7ZK9fGhY2ZogL/NHWTjyvnMXNpN+jBoAmwGXcRCCjWLTVl+ocT5mZsfkhV03wtncH2XXjRdeYUY5mpJC+Pyk+cg69+YWcNxIdoPbj4CrQYUePpr7qUwnXOpVNEGAP0JlWAR8tGzqDDlRfmSXKeOArhZFIGNoT/hpOtMQS29snKuVZxKscx41GPjKU6eDI2rsXkSFcQB/WiWpUMP/kqMs5cwFEzMmyYahYcYUywDSVDJkhgxVvOekPnz+IK6k0bNLWoNaveZV1H6gv5j3G6hZTfUKajdbtfd3yuLahEnfetrXZpldz+aMscRGFfKjQEojLxi/HkCtDe5kHKikFctFluBaxK7gepD4SMK5HixS+sfRglCt71Us9y7U/d47GYSL2d6P4WR6PUhHfpTneIax6M0pFfJB9MyrvX91/qvoeh33DeeM+7NekE3jzlckaHDb/0AnXiFvfJFmasb607uOzx7mpM3dUe/4Yj2Rx9exEN9RSkHflPSZj2Kpf7pQ9/oHTZv+GQQ2lndeP7DZrjs7VNO2n5r8C5E5lRF4fSvK3kbpu3kd2pftMqnnH8WDR/9P2fq6TQIuAGQU3snEqNFePIlkIs7+6W3GFQpdmLTcmvjiwCf4THb+fuB70UIIReyGSd7igocUkGztx4cqflFi3HwaptP/vRz4fzZjxmEkPZ9a+8pgDcYEeWVbgfidC0Q2kYNA+4ZhaWPN0CurvxHoQ9AcT+vXTypupZwjZDy6BSP018mY93aqoDTjiuW6hGDHuiSYT+TzOQzo2Q/5niBPpxk+ChvBED/KRO7spKgmUHFLPkz9RQpJseaX1kN2XOIpTFjJOgvOfZ7tcyxQoxPeySYsciRGpjJo5jGjppDxKHmcZzLIg/7yQY4WmWyKaRgEMkZPFj/Q/8Vrrsl2QVPcJ2FmwkOOuO783jDPwsHSTzf+VcVeIllnkIaT2IeZ7gUh2mgP/GiikjCbzuraYNhHiK5ARdnJbG0X1kMnYJ4oiO7TAXm3ktZSQbW98JlIelA/q9Mo7BUOTkK+46xjqeIVY8iEUDc4pm/LpYXmTb7c8j6lU797eFT9rmgAkeJelIIgQ6Ui6cer2P1G/8l1AhGSzTmGqw/OZCnpyh24QzWIGiZHkKC2mCfdrzphqnWly2lQxN/WAuJ0EQEayWKMjkUJZ92IVI2ze+gNbb4ZNjotgcDkREbyjl3j3hyF2d996FNPoLI0jdTMw5zSe5iPPNwFPdawC5mfLdK6dqAXBCFO/HIU09bejWTCpVqSIOkWT+wGOvEhwhKpCRrLCFKxapL482k4EjJJ4DzaNE531Ds/CgM3rRbJmwlaWPF84kKizH8ROwV0Y5OgRV/Nv6LGy+PbYfGeXsSjqRzdyqC8mWeXlx8uB5/eX11+6l+dnQ4uP3y4qmE3F+Rn15VQ2dfDuynHlNZKikQmyzS/C4FmpMaZOFHJXCVuaniFRGfSn9WsRTBFlaqExlMJ6wquhzUKhIGvvFxz2EH/pAY5++nHX3979e5V7+cauI53aObP5hvYu6c4oIjS4oUs7m0JphEpmoks4ImMZULBIjyOv8EaXiJ3p9vudlpt/O+q0z3utI/327/t1EA61IcM1iD8M+/knT6qINn9c/TRCh0DdLBCssvP0GkUCnC+/tT3jHMOc5WLJCmASHFWO2QhE8X0qMs1ImwkpSIGJ/G5naAgdUfTsjLd+VrWA+nmDXdgtVkEaihZF80IzXx4DcGjRmhC+BPUGTthKxREhzFcDwQ4izBdK98ufmGbvvDqqI6FCCLIdbj2pIP6FhY1jQMrCMd2bZ80hbyTrBS/XwllWMkmdK1wvHOvk9EgfIJF0LgAq11xg+LHcWEahiVCJ+Us5uCsuJlhvTcMBoYsx1Cm4mZEn9px6RsUVfsv0jfmUo4cFTzaFGkYj+xwkFE/zr1lo0mwjEq4HEgHQ6Pq9+wpDc6AdqmbP+ySsXw20sCvgjDjvddFmxnfAZ0yvinfJXji3Ba0c4InPRYkblRLl2U22b/NS5JKfNmbqpnc86N8vzbiDxYx0JNvyqJV/Nk5xQRM3ed4ROfFOlP0mjL2EI9LOHe/hHGg7ovWmKkiZh4DScYUaujXIeJoLudHKlpVu7bhfQK23ZPR2KMwEu44F4nclKdPiN07H1dpUrhTGaVwdvF29Yl6eHXUOjqoiEgUygxUEsikJhJRWS9StMqFIlTjp+m6CLMskuIsDkI/roi80XwxKAMmVUbcycdP9mbgs3t2HmcyqoomfjkPnHflc16pZw+IP+O1RcrKQqfa6hBQspPaFyt6sBGDEcqdSrNFBM0BA2m4CKMMb2LYsWHEGnNkgIeHUoz9Wx2MmQFnJGE7QyWpVxFr5AMSW9YJlowjoLvGMljFmQsOPOvhZCBkJDEnx7GhizBKZcueSj+QiecPw8FKOKnK5HUJUQri2nNiZm+0iQxhTcY+etT23py/qJhKyrypib4faQqd3PPUmayIDKfLfdVUnMKKzZCK69LA81ZLhUT5slvov2byxZcrungr8glq3g+d2VLz+dlptB86DaSN5rgmhp7cta6KonTgD8OaKPnysTf7dRHGiwfxoV/xftX4VmbR+9yLuSIivrq25nkroiKcVfq6DGdf53WZclHxGut+OrtvpxcLny6x3ZS3mLP66IhTjhdPVhYkHlR7LrfykSNvuffC13UMl5ZKmzDoIrqhiHIxbBErzEYIXQkYS3TauHEp9r7LsSmr4qI3mobdNVi5QvsX0vYK3D2Zhq303wtQOk/U0B+GEa5pLJS6w1FeR9XC4eHNo+aPVVPWn6JeMhY8vBj5EZq3Q6icuuqaaBpH/iStSdtAsvss7GQA02SVk1C++KqPAjfvsDICDPrOQI3Hqcy+Fi16tvqoScM/5PPOypOgeiUazGQCk1VOSo0WRYkSzFU5AXdhki38aLB+/t6fsshLRPB8xVLHGuh5vmg9g5hqRWuyeRnB+oYGTfZXGxq0iIoNDR7TCMYa7HzuIWWm8pTmPZx6VZNSs65ZIqNSbTP1ASsRhcPET0KZ1kSF8Qn0dMJOV6whymmtirxMRuMai0/N8CJ9nA1VJOhn+Ar5ca2ADiiYOqMYdEdsZ2kK+YBLFZOsEEmf6vACla28Q7VZIKCXqCnCb9iBUTLHCdyZn3iTP140KQ2mjIpAPbisPuOr/kDsNiZ/NNCLKxMNPUJjRcPBeTypgOljldxWXNVfZHoPqv4WF4p+nnOHKKJbaMUprfmtvD0ePKYQH/DCixcPTac5ox3apj3RVf7yRKXR7aA8i+jxzToBxdO2+pmIpNkjmsbUpdPaGbtFX9Dl89mB8Q1dfUIpskU63l+97QMBBFfsuBn3IzVBjRYqCWPRQ2+zGLfxFBDtZwlysnZ7p/0XJj1Gmi23o9KiUv0oFpqnGOOKHt5aJAPx3097Vz1P/KZi6dl6hoQ7280go8WOQMNHc2MJ5uLa5NYkkqQiUPcxAL0Y0bhYCiZ6seid9jG0BeO0w/KrHtbOsbg5Ob6e+9n0OlPXWDNkMVcFx6mayYEV0hvNgZulT+3IjMOiL1Rdc8EkuYib/FueuClPaPpK2SGdb0JJuasofcmdpqDRKKPCHDGhEShAdJMfxM83TZ2K4V71YrEuMoNz/N1NlEkFmmBSW6bixySc4YKFaqXF+anY/eH81AZa3deHpW6n0253dqqgytbS1k2Xm3u6kqaqkh7w8vVmwWFNVL07PYQGnHpVLTWd+p2a1tr/sdepfLF5pUQNy+0eHlW+4MNOt74FH3a6FS84DaSs60j2+6dnZx8rW3DoND2teqnncQnD3qgOdmkdi6WkTHa6h0f7r/arUJGzcCbrzBZ5d/7ujGTQvCULGecMfOsoTqESY8qocaF3hRBUXywAUpYe7+3d3997oR/7AG3c81NkX9M27s1kEPotzFn42XuYZrPodyAZ2xHVeByOgMdAT/+ryVleJi3EE7/A7p/Brsymfkz2IB6FacOFN0MGdLNjzoBtaKuSXdINJk4V21afaL5TQeFVBnlUIwCUW3FdDZe60z46aFcikxvmza5Im7X5rnDaVIB6iUr24StBTvE+uB6rNS9hOWZTU7pkk0ZL28M/eNW4juo+lklNlJOrThPsUJlV4t5pVG5RwfyujpDqUSXeGn/BTeBuLkmDDUusyNjNpzSZu8/K2N2rVoDm8mskmyJZ0Z2GO25anLmVtvpDJZmmc1SHzOZ+XFf+9rmF5NbTlJyQpunAS0n6nKHYgnL1vlZR3Fx+BdQKywjn02fy4aPv9n/ZiG7MXHNqliWcp3km0Ufevvf6qN32Oi8POofVUB/O5nXiIfbIyjf0ck4PLBVffDwj4acoFK9CtFqwK/VjwlmXwF8YQteEEcdhPJHJPAFa1xDJoYjgA/NJ+GMUAiRSM5P7OJoWmqgEa9ERt2NTa0hbX5zqxt1qNFokCdAZNObYPQJOXKPDlmbim5AirZUxaZww4oX0EzZL/axgEANUXz6S5tkbRmqyp8FKWnAzoAf3uu3OwV67s0fxvDCetDgtuaWZ08KEYTzxYCuX407t0dGr9v7oQL7udjv4IRj5h6+P9n0/2D8KgnE1smPSDAf4oM74tD0/m2jO/sfe+fsr7+zXs2qo50LbuknmaTZ5aTTsW4OACDlaTD9/mKMwFrZqn1zYRgW8+fNX6gXKd8isxCDQE+RZFiLzTtWOtot0dBbSdQN/r4FfVwBSd472Xx1UQJ62TAbfujl6RcsUWCZZUenjLArj20qum2uMQ9DmY3yxi6kIG6cp8vW/KEk3HquApkVtkfUrhmyloPonCqonuP1fJEg75DgLcAF2+0sRd+1c1Rd3fzhsv/Z8vspCkR64VPutO8NAOPNyMu5uv/f+hacdasyTWkAmp96ZhxbATZwCSueR7nncimh8l0B47IUZZ7c43adk2hSn7/vCpViIXe6sFoz8JEj5Kq8AGCbT8nZ853ELdm+kKtuWME0XMoGBMFvZ46zyfWGIWDBE7J68J0HEIvBycLlr+V5iBPfBR0C6S+h6opemiwSNhESfumabRpRV8oca3tXOG5pF7J68IHyJdJn0T/2K6XKgqWRQ5/afuhMR78Xu6XN2/+T7T/2m+PC9kYLzeNQUHz59j/gvPwRpboqT999/RlJ4WFGbxKBkOgqzukXGTGN028WLZYa9Q4NGaKWfQ3lfMZEuHmvNhLpTpWL3wwaK4zwe1cgHPxos4jD7iuzwI+DzZuDKp2ewZenkVMwaYK7IgUoG5ELVB1FrGEPzwZIz89m3/lVT9MnG+1g6IydoEqWSOKwCqIEEI1bZgAIEa5D71MXFFe4s/IwjA8u4OWEqYkXuDYUb4jQMqLEagc6UNrnb7rZb7ZetzpFo7x93Do/3X//Pdvu43a6S4KEcq2SdDX42xeMwSbN1qO28brVfEbWd44P2cfewWmp126TBrXysHYayZ8a3KF0GZ8pt4HQrywf7st+rgd7RIrmTNdEKH4bGd3JkpZBRBAEZ8Z9yih2YS0Ld4CEF/HP7J3v5WuJPHKbZ/LDbqYFJKJtHE/c1+PRUgOKMh7DbHki6rFnadIbnWYvgo8PD/Zf8YRgH8sGlUohAjQY6uLj0eYWM2TBqAwHBEMZ9dWQhnaNcH7GcMCt7T932wauqyEllEvrRoFAgV/VJYDR/PZXB4qdXqj0Wq9/u1OyJXgdpJuPRYw7wa7qq0xbrIzKf+vGC+jQ3RWgvCocmE8Ok4+I2mZBmkKEa5Jg0dujR1CccjaTM+MPDt2/evD55eXr25m379av269NO9+SkV5lmsuhntStiJ0sWkS6X/TkEm12EJ35Bv1O4uzIOdLIKjyrYJBmrRRwg+viDEhd+PBEnBBjKlQKPnuhLacP5kzCbLoZwbfYmKvLjyd5EIaY/3Juojtc52EuT0Z5GHN0DY+g/3kT942J//2XrYv9wv7Q1cKAPj1oVviY46PLXhBNSG08wy1gmWFdieJNIDf3I2ryxzKqn/68IFyyT+6lfNV3fQrhgWfXx2lCNvMq70fGC/tX3uY3fFBff9/1YvEXMKExHyoknNMV5PPIoevDVpOWbCRUUmFI1la7HWjOlK2MFZh3LRBc2vk6iv4HAwBIPKiPzb+rkc55FvZagA4GCSdk0K4nx/qZEJWk2SKWM1yDjSfcdGWVluOQwzuDCTXQzHq7WIkc+kQyXkoaTaeZaSLoiyi5vmdodimd0Oq324VXn5XH38Pjgpddub4ykPJHKG4XZY13g5CdG/5b2752Kkee8IUIerV8hg1zG2cAxCqok4upetRgGc1TKW7ez76SrCX3fq5LEmvbJzba1k5VJUUk2FT1yoDZUKpoqsucGYarq2roTNhnP+x/ohqlE0kmvOjrqOkNMw0rpOvFjf1O4XdAAm6xsqZTWP5Fq4GLHFRZ6oeJJmKF+D/oQADnZYgXLd/6vaEQqbhyL1st976hz8Gq/3RSNyM8ax+Lg0DtsH77uvBL/rwLVVt6Ryl5TO+io3TJQC86fcIx8YfjZNADRJNX42yTx40XkJ7kBTGDlj2IE7AbqwuEk5pyYCAXGcJKVwgRvHkCWI6MppRoMMY6USjh40rTxj8B0NbOD6uVFOTiL9iWaYmTNZWcJaAFnC1l1dA6ZhotMzQAALCZSGWrL6UFDlWYqbgUb3kBBROcqzfyoLk2x85GGJy1RKs8Gcy2NOWd+5m55eQY5Z1ZbQGRUQRso1NtY3cfULU2AFJpIJeK384+uNysEJ0tEOk3jPgzQh5JwpljRIE2Tfywz/PVB+2DDsD+YncgJLL0aVfMlzfA5zdz66aQyQmrSzUzEStX800IOZQVyD8PyDxXXsfwr004a45v3vxH0pm28jKIx57mV1LJVsNdLJtAmsb/3ZiFjlQ56YSLTzbgQrlMyGc5XUbhO68Lzj6v7FgplMnrF5/oWdryut+8dbEZi5H9VdwTTfXveyMxPbpF9nUXrbPhzBL5xlfgoehQXcMDEx0RlaqQigdgXrHleQeqJSxPKlkGxK6rbLlV8J3758fzqTHc+/eHy7Oy9/rH37s3Zpf7x8uy0sczAX1D4syGvuIxv4Gdr8KoqkTHFg27nMB5X5O++fJXLhNclOXCr12DDU9dsX9YSAjOYe6nnaImDgw0jFpyXXlfY5apoX+bk76QmJb68nVHyx2CRRGjfuRlxiaR+jrUF0i7N+OLT5YVAxjNOe6Yc/JqVzfA+K8zmqorL1PlzXAbt2S/ttdvtTnf/YFP2TMIU5h5sfs8tC6+aUTsfDMg1zULNTzMZU5GtGPqpPDpAM1WF0kvHR8AlrIHLMYsVSldBqDjNTYm+zMhqPnsglXMpJz8tZPLInzWL/a9Gis6ZigPbvocRiBBio4vhm2iOpgi+7duTCjXnDUWuMotuPuZQB3JwU3knE4NSi7Xkt8EGyiYglXZ59sPgzfn73uX/0ZTbF0LZ+P7tpzeL3km79/NPb656vV6PfscPvd73VUqABklckoGSRWgyrlfu84kpCUfEEruMA6HHNdDN/D0hPlp+QBUabMNV38RWmK2yS6YretwH24oCYZ43z3Bi+i543f+tKfDv2a8fe+9PB/3fXjBgVL5B+RpCWy4iCJ5Aj8tTcguSFDqNJyQ5xujvPl1cndNcNLYZLorEMF/lnZ+EBMofyXiSTfWwnEFA/ncu2Bjz9JcPl6dars9+GPyE3wpLt+MWZMx6mIEchbMS0IDYld5E3DQ6jZsVSGg7vzdOjq+TzL8GxF+Wza+HYXw9e/Tnc08+yI37EdtNBLUrMBArcUP6mR8HfhIUxYEOn9E1FntrmQHge/+3ioichnd10NcbDhN5h/gA3+2a+mvMV3qt/PjPi3cV0XMrH2sg58fwTrYSiQAf0tNQCqXG2PhyNkz/w9urX3qXZ9d5rZx5Tby/uj5BKWic8Y3P9fnMn0hdqnRGzbkh/R9IRtLr+zCGXEGoK2JOudSsEu5YwAIXpAAb3cRwpB0oI2mZVdj26435xaOKVXy7PpXDxWQik4oY6JJR12UEzWGskJJ4VUNQOvLjWCYD3LKuY1c95UXQZQMW3vt57+z0khvJpgzGt6CG/4C/exSBBO6DDMTMj8JRCABEp95OIGn70+VFidyDDelkN38TGt9rDwihIvSaXTKRqS2sGqaoMQmgsoPFiNGdUIete5SuylrpbhiyrLF0snHFTUVyKv00f0fr7lYnj+DKB6KcDIYwFv2r819F12t7bsSgHFY41mECf5GpWM3UIm1pf4I/RuKPP8r0bxbephSGCNTMD+MWxFw/SuV1LZTX6d8hX/qncH534PwhnN8d8a9LY878kfPcbJHJBz0CfGH+SbdY1r+Ybuv6t0USXcfCHfI7XB4kLX9EwWv91L3Wbi2jVFq38lH/BZf/LSfxqRREsXRsJjqLJPI092oSoJ1TGt1otEUS5VZjg4pRbJljwzG6z2MBqE+BxFPcGcBxxXUCucqwsTlyGQd7SAxBeEKLR/SYYyL5okCaMCA5wFgD9Ck1WsSqnBiohmSYKGPH3+ghbvSVhrtCTRAWxpWgWGcUZjLxI3H+8e7IjinjUaQ4xf3m9xt6C97860bsnp9dvRWXb02gX4juy/0uGfyy8GCecWv8NHOtYqGDw7iwXDuiXnbJci5yfnMZsohUdYmR7Tyec9tWNNvJczxaAyMhErhBTmdkEsB18J+vyqObBp+pzHC/GGYa4zdtQpgB2izvZPIoFgnhZAt/6ftLg5tp5zIJVSBmgOvFIEOD9oWGgnC5eNOajklADw+laMzjSSNP+sbXGwD5avw9gKwheePEJ+z7ugTvowZ8dhQYY8Hgt5t/3DjqLFNzl/GQoJt/EH4ENmbuJznaIS/a26mAAYsoWoP4pXhI9eAQ52MNb/zp8oJuCxhyB5AamRKPapHgDZhr3UdHcKgTt1maQJb8jSHtBmA5MpvKwtVyCCMEhQ9ZsiBbUiVLbSEIticnQ2eiPRnFLOrD44OD/T0NmvO//v09f65//0em5pvvmVFPX3/f/j97397ctpHs+//5FFPcW4fOlgiResVR1alcWpIT1cq2ItLJbqItakgOSZRBDIOHHnvrfvdTv54HBgQkUyCh2LLqnN2VSXCmu6fR09PT/eviujU/hvZiw5pNUvOYxUKEOXlaOZaYFz9koUhugFw9l6GfSMSclNWyXrHZx9FE3KqLht7kxjUnBeB0YmCBnOr8DvwUFniCiCyBm7tuKGJzdPDMvYCuvsyFVkX7Mzssj01PV0PoFmoNFoFAz/IIdsxrbkR1MNo9X6+vVQsex46B27QpPNfDGyOmt1ZvE4QnsxWIfiike64DMQ5hjmXWIm9sgtZHX7Z9vrkP9ph7id/b2938fRrU5U/cPazASqVtE5sfTaBfQJuHRS+d+kZHpcsY12MyWr2lF6Wwx/5Ie6xy6tymBO4sHhx2nnfXQ8mufrwi62IDN0yXWju0e9rXj6gomeM3dLtintpyJqMfaLfQjojzCG4OxHyRZPQQ6erJK/3rJTS6sT+hi7sEwVM2FMlNliKhfLDkRtKGunYvVuiDOoWirHxQ75EQnM/86UyQDTeTkt+sJt4iIS0WYmybJqZD9ZWz9gXf2BlLPUyh5sZESlvQM5LzBlax4X6Q0ydbM6kXA9GqaE55jotIjPwYCWm6sUiAEpTA/yTc8tQ4nUz8WzsiPfMKm8Xh9rZ6RD3hyWj6ncf60Z25jUUm3a2PvEJ1QEULK3++CO5Ywj/lM1S0+431D/hQBDH8hAAbFaMN+EYEAXHfPzuOMzs4kl76qQTHzJHG+noUj2ZiLupSnh6Nfq+1bEDSsbukOP+oG/6rw1JnXNFbFAqNtAFxGNWtSyJ99/0wDWogIOVh3bE/UxTD+NlrAZLNITNzungQGJHggRhtksQiIRnNpG4vqVq1Lr1u2l54jJ0ChRMnHT/X+GWZAiraoRRdYajTvZltpjBmAPlkthiC1DLz5nPv5ZbDvbXtBWaGIpA3S3RrasptCkvuk6uKW/E4KTErPE4a3nKQSA+RO2wTo7Gu07Jmz2hxnA53UHDVyU2RnfTztJGxN8cpLQJnjIYKiWFbSyLuB1nUocQS8DhZX+cTuRgQg0+wk4jJROciwb+mKYzAX4n+2TEQ1xAtsxnI2YroMRlTFnnLtBEi2+qaCD0e2C6JySzPa4edZE9i/TB84+vecGizuW+vyVZitV2HPl9f2QxKfE1K9lEPv7TleOsR/gKT+KXBJL4gJN6LkPgMwRFfcBFrwEV0hfocIRFd/tzS+5p5LEU4+IvQEF+AEMuAEL9ZDMRvCf7wm0E+XIZxe8aghy94hw/jHb5AHRahDp8PyuELwOGXAXD4gm34ZWAbfsOwhs8Q0fAFzHDDYIY5gT5HHMMcg+5Zs2YmSw/4ho5lfnPLXRO/zwa98NsFLnwemIWUvyjGHk/k3B/VxIADgABPVM3l1PUoF5NyHDU9GuMqYCK89iMZOgnIjIlwTChhWF+TEEnJkwWRrBl/MsKhh59GNjSV2csKgioTkR6UuaJ6hIiQgu+hMaUXz/jO/sE6cvKfSEgD3+YtZbIZy1GqeQdlpvjgHq4nP+xyIfZbrw94p7XHDzqtYVsctNqj8Wi3sztud4b7a0kC/QCeShiYa115+IEYCp60Xnttr93aae90vPa+t7PbagNiorOOLGqsnlsSRaJr6UgfVUnGiKsoiOEc8ZCh0HSKcT6FEAKc+uhGa8W4LCj7xYAmGURpIFYVziTic4FXsSZpuJWtuiTSTmmDQZM0olx/OMg4gP4HeAdjNgp4HPuTu3z2IyoqR/R9IkYzFWPQE1h4JzWTx97aqfRYfg7zkZn0C42ZQcVayP1TJZyqKjHeYgKbAdWyU8b7FDmMAAejOHskkkgaaBy3XIZPpyBFqkUuhhXenfYvTli33//vo3/k1mQayXTh8cDncU2r0uhjy8MEr0Rsjyk0LyUQcip3khM0SCW+/TCJUngVtqDVLXMkrUb1BzUZVuEd7p5wTTmBbVaJ79DR6s67DH+bUWWSTNwhkXDhU9fkO5nSMqUxygdcoVHjWUW05cUrFE02/2CNd3wqRkjt+YmePmiw1VEiaIL6thFaCn9caQ3InLhSW38NMsG74660Bv64KPyf2u3d7x8n6RqP6Y1lyKevWOMxSVHeb0/fr+wtEfO141CRzO0klIv/COG7AsppfXXhZ0OWKP1qss+x4zXuKxLiScJHn7y5n0TocT7dpl/H2/RSbK+6THZ393jsrXymu+/iQQfldTSeB4joQOK68jmryde7nypZyD7WYzL9tcWH6/bef4fD/J+pCJxexDETfDSz7r5UL4Vqwy6KLl9nv3PwQyXBuGGFDZuQzVfffXCIJYUtCOInKaeBYGdnR4+XxkiGE39c5yudubiXg2QmLgcK96MVijRBIXxGweUAeGjh9HJAVwjqcT0m3un3MhTbZ/Jm+50Y++l8Gy1cLwfxiAdI9lZI037IuouFCMf+Lesay62hJjqZA5sDnfhVEWQuDx2KGA0e24LEMXApeMxGaZzIuSpcir3L8OQWLp0Yu6PmUDGB2ACg6J6aQ4zNR6GGgziTN+oPxZv6GwwWDIZ64PEr7axKTUt9nKvgMmcZWGIZGrST7DpPu93L7J2es1uP/i+PljIWgX8tIoWWIlgXcGkRO/mH93hJUM6op3NGa5KFc7Dj5iyzDKyby13No2bqIRkE+ABq5mLmx7P/WyFIlAmDwieqlFaMVxDGfRkrzTOuDzo6DBQIWkAzdGlBMLZT1GGACH2AYp+EWKjNGszrIl+M6zWrcpckkT9Ma0SF7BI8npwotrP5HKa79kMd480wzId3bBHwBFFRj/0sItFsolY5lGFL3AI8FBqhsvblRNsVO671b4S2QcqZNHXNhww16QSvhXploI3Ac7FIOVsAbcRdrBhnN+eC4MXEFpv547EIt1gk+Fj9NzK2t/R+vkXAUyV1Uc0/GubZxhZrqKcb/666fkjvHdh7Z2/s40xd+x14E74M4oowOXYyG4YAPaTpGuPn/ooN52Ixuz2PFdaGRgABBKI/xf5lq3+g8ZkDpW1mDH8VY4iIcuh10YctA2VKNs7VfGFtHhckfXglclWs90GcDaUMBA/LRPxGfYWwhwYGAWoKd3IM/NiANhRsXzOJUrEhlcKEfjgd1HZwb6qDu94TopW1yI/to/rXShH4YhHo8BSb8zCd8BEY0acbgzSlIda8JcgaDXcQiUBca8SD7gK5v3//0CMUl+IbPZJzD3MK73Yx8lCFerchySc8SeO6pN59GL546bKdSClfgUmqCpgCOZ3CHqjsk2nEFzN/xEQUySjOQrDuqJSXmd2CE3gdzquJmY+dCX4tWBpmkKe67tH8NPuJnCyPb4fFXpuGo5nAIbe4gCcXFx8uBh/f9y8+9vonx4OLDx/6G1pBdQtdkjFRMAVV1rCnUyDcSAx0SJvBZT4tMCQ7ktFCRm4Ed01GE8HnNVsITLFJM0Hj4X6S7ADE5xiHRSSBP+hlVsEO+kjrcPLLz//8/fW7191fNyRp7H0Jny9WkPV9/uhxEbY/pzo0E3moJpV4TPcEoEIUE6mbO+2dTquN/+93dg477cPdR6Dyf4ZdmAMxXoHZB/bSZg8ISfro5diXEpuB9MVcTfOvMDRcI1Gan9/3O3MYhjtJexD8SUguh02eKzjGnpIHDoc/I2WgwT+5vg5hZL5oWm0cm3Xu+mRf15R6uQsDDsb+1EfHJDsfahRwgsXxn/EpitCdcNBMsKEf4jigoZyd9SndIXhuaT5j/tcTGw7qYhVJ3Xs4JNRiGgf7L17Jlc+DhPxFOpH/fWVuMPs6vJQcdLMTH/bquUg48MDxvoTTe46+6jsL3E/D6JVX1dPpAtJkV3PQa/H78c6JmF2N6FM7rkZmZ3wM3CvoV5IdGPDoFospv0gPB13kYUa3edtBRmXJjsW1X1sc8ZgG1zhisBu284pL+rLex2NelRlzQq6LHzM+9iat4OYsqOPsHju1Oeoai4MeG0duhEi13tzS58ms11pBFtszORfbPMjW6NEywcQDNeG6YimTSfMYE2iOHpJLHreNthfjr+hx0e6AaWxw5/3rW4BMLdehyNL71TaGmJQrbSoE8JrL0qwQa8fyeiKYeBSSQeJAGol15XiPer3jyCASzJ3KvOQnZ2/L35bb1wetg701GENTlIGM6mvD8+YuEbZxBfi5l5czP0kCwU7Csc/DNVgaLdJBjQlAR+cfbaT8wbXBNViwDh968xw4+1qV7e/kFrFZ+CBkcBYyjn0geltMNVDfjO0mCDRlEqoOeekxmcFTHKZ+kGDXhD/pB9rqjXhoEYwnXF/bznlABxfyA2RWe1NFHOIW2T+rBB4mAQqDQjEuk4ZJ5FXDiTETgaCcNegTttix6RZTndSZ4GMReXzoD3S27gpUr5mma1Sx68SZ3ij3FIoYTVB29qr75vS7DXBG6VY18fQzTaGyw+57x9YgHRpeE+XH8CATQst36Nbzrk+5QGWN26t4xQSBz2NVapFnE9Qgd0lBoZrfh2ajfdtpUNzPwt4aHh6Bp/gAF/GAD/2aqP/8q2vW5cwP01v2obeBdalxp9Rq9dBmuQbhT25Z9bxrUO7PN7qF+fP6trAYt9oyXIXWUMRJOaHNrmlrphGjEK/iIVO2gF5Tyh/SkxWVRA+qrkHQj0m3nrEnAYP6TDeoIhZ6aAVWbjJMAIGWDlvEvmGIKdDDUABj+Mrl2Pv71VqWwo4zmvk7K4ivxFLn0sByEj2a+a34zxTcLSI5NN3+cGFJYQFHipqOTSiBh51BLu42zU1vBgjIkOnh2YgHozRQBtB6p5vkYxLwabwCF1UsBrS2pxWZnEyabCNkFy9q6qPazVdbi2jTi38gJ5NYJE9Fv5ptsxw8Hg3C6L7b2fRhus1khBuxEfJr3NkL1GOujRB97UdJyoPB6rlej/J0C4Tr+UxO10Z5qK42FRhYX22oe8lTbfg02V+94RMRG9jw9ThGAVYQYdWXTgtST8msym2C/JrtxRLpa1sMwkMYKDgSX8Q1UW58bQW/oNFPUAeZ4T35scPfOiwlIpggfa0mVszwLL6bDyX1s5rRK5m9fhVpNz2maiK8+TbXc2u1Xl/PokXWkqAnMvpUJ85Jswt9+IQbGqeNPCJmjMexHPlZe2fu3Pha1ddjMvYBm1OY3m4xziKhb/3M0DZFhq6JlycqjG4H1bOwrr7BDW74XUxLyRMWCLMuNI3pR6y7q1O7L3VZlM1nB8YvVMUApUfm+Xjff9vTPa8hlpAHcor2psDaDllXoy4LFfzrJRHyd151j3sa7ZiIUFbZjkpExepREJqllOIqGKegQIzZ/znu9rse+12GwrO56RGesjjMaZYATgmnprMubqgpHVtfT8dsLG/CQPJcKxVbpsO6Iese9zB0creAE+rovN6i4Zkcsqujw0s0ZLpM5CVohi5mr/8h2jsOrJJeKQlcLX1qR9bA7A4Sg36lTNIEu8p+5bGr4oRX+iW0Qzq/hGFyqSj8aPnh7AHcVppXjLFJ1v5YPYi/0cEMF/jutSOIpfe2+Mq7iyiiim//tLZMtvPIn+OigArY2Okxe/XT6bENNrpbg+Wo2Wm3O82qnGCeJ+HFzUEs5WOdy3VsoN58vF8TJ++O92HdZt465MUz3qmJvt7P3c5GCMyy22sgcWf/YCNE7nd26iNyv7OzASLjsRB1vVa93vHJyflaRPphBgi3afJOMXbWw9q4hphXHxUdj6JgBJo7+we7r3ermrO5Pxd1Zhy8O313QhbR7Fy5jGHszNze2IFLJiPjXshJLgTFGNVp2hJmYMv7PORUvsxjJD7icBZvz8XY5y3Mmfvbu50l8+CP0+57g8rImJxM/JHPA6Iw/rdu/GjTDDz2G7IO5vD1khmC/6GuNIQ7pW68hrrrih1zjtZEtrrTZV23Uquqg/P6VPCdHOe2GuidHCGf1KoldwPZmfa1D/balXVvzdzIktRIm9OIQ5LuIlpV3jUeVlzoGS1v91Ro3bmsdzhacNskwcIy6D+86sczeZOVN2yaWzoC0wRNOkFFbpx+I97Mig07/7Kq+rfGD3cTcLeWVt0e8UsyMbMpTUZmpUzM7fUVZSGeIqEQiWruNCzh0VQk9mxc6g/fVs4mXCBbf77g4V1N/KjzKuEP0DQ5qBeQsJUBPSFdW2WntWAgvTqLjhbiCSr1LfPOpxV5P+dZClEFXjFbzWk8llk9TUVGD7xd74eDdtvrfL/X2a/OsT9f1BiWbXbJqzY86hwReA+cnZ+QK0ERGk0Fa7Xg36nHmEMXwzca8MyE2CZ+OBXRIgIsI9WqIHItgDan+t1HQgmQHBMMKxVQmxyLFr2+duwk4mFs6zERdrsWTI5GaQQwKd314obCN6pOQnt8ETfhNqJV18I7IbYzwSPtHvIk55hO/EiIO7Iq28NATrcVKEMLLj7s2vZOu7O33e5sI70Vre1aOt20pYTTwoR+OPXgsxZjMu3Rwev27mhP/LCz08Ef4xHf/+Fgl/Px7sF4PKmuLyb1bIAP6ozX2vdkHUvYO++evu97J/88qc6xLkysm009zTqGv2EtP4Ft6Ygp/f1hIbAPhFPWo2Nio6I8Hn/tm+NW3XZgENgAOr3lItJO5YTyW1RUElpErXAb+GdJZ8bOwe7rvYosKc9h8KW7iH0ik4FM8nLiu3ngh58qX4/WeKanRcb47BWmInyPLZbR/F1Bc/FYRT7S2iLH/RmdxyMKGn+koHGU4bLpOAXqol/1liLK6jCz2bjySwPOv64BZ9lSfOudNx+UyVfWcvMBXr6EPhxfWa/NB6T5dTfZfIAxF8OxZuZKm2/U3l1zRd6/gEYcT9JW8wFxPNOuHWUcP7tGmvcx+Xw6aJZx6PQGtFBtK/D6xbbO/AyP327PzM8I5ttqlvkZYXwNXTLLWHhpj/mE7TFLF+ClL+bT9cUsXYBn3hDzYZ6/rk6YD/HyJRy9l03Zl9sC8yFJft29Lx/izD0J1sxd6bnb0LHMaG6BN83oF3DIXuJ7Lda+oQPzV9nf0mUkipNBLES4Aun3HoWRoVSEQ/XDBMekKVpvGCw9mi9rjRb701niei3kMWbkLXPYpHhAp9Nq7/c73x/u7B/ufe+125WQUqdCeiM/uasLWPjI2M0CF+9kiHzXCmhbRLNExrAIk4GzUW+S8P6NbGmIvFEhT9nO3ozLmXvfXZetmtbDzcC0kxXJl1EyY13q4V/BMChOyJca+LGsa4mOtLt22vtA6S8FNo6669Fe1zuh6S7VnCMe8ipwmqAb/lDRYyjQPBVy4GJS5Yg7k+HUT1AfBTsGYI8kLRFt8/+xRiDDxiFrfb/rHXT2Xu+2t1gj4EnjkO3te/vt/R86r9n/r2iSipLf2DbS/BiLqGXKzp2v8FqofsK68jDRparXIsB304iHacCjzOGk6tI7NkIdO6HdO0kfR+ZUjzGc5Bc/wi4BWGFkyMSUQ88mgUQHXoptbNmYwdh0+rGDmnbHFnhC+etbbGTdU4cEtEKyxYG48qczMBqHzakodCqk4baYejKUcSLD1rjCTQpUcSHjhAd1vfnNcxqe3vpCmSsEavnKpPGr7hSVZQzrrFoLcopqUgOH+CmUNyFDnSqqIhKaSEbs99Nz92TImL6sD1SawI0/Rq81KtfURgTBQv1nUcg/7LX3KoS4IeBITOFx1WheL2iGh6xr65ejtYivyb5qwkvN6y+pGIqKOg2n7j8yrINkxNcxPMP4Zn82SrzF4nQ0gzKiiMd5rpRDvWtvd6MprEPIt9+kIpTxoOtHIn485/4q5Wn+ooyrVdpznZ6X9+Zi0mRzsod6c3W8HW/X23s8WwF/Upc/4F+Kxz/nERJvvSRYZWGrKHOjH3EUmLEzHGzYeSQTOZIBRQjhPWsK0NLvwsRtxTjfys/t8cf+zn77+bR/otr1/XRxcvJe/dl99+bkQv15cXLcWJYa/aiCgHT91IAnT6gbpmrLbaGjx2XZRpVRuczsJlUEmdgrsH7fHdHnX3uCdzAXLFVe+729Csd8nXBcV3xiuZOxnbgZm1zn4rIF0X8GaRSg/9zjGbJNb2vi6MJtqsuQ4orXN5EOIEdpx6cHFdXcs+i6Xv05bjW27Y+22+12Z2d3r4pIpn4MXwtOtufW0W5aOM0PBq2WZqEufYkI6e6ODXksDvbQ9U+izs1xynFTaDA/DLFMqjR2GcbZXt8TCbmsJ7dkQi7E9JdURHf6s618I5iRpHdIhmPb60LDqCD+RLeXV8FigM9sk4uYyYVeRCSqahXNxhyq6Aeu1q5FZGArQUt2ZWnwOMZkoi5Ofhq8OX3fvfiX4twa9aLn+/svb9LuUbv76y9v+t1ut0v/xh/d7v+su+oKiW1p3QtumkmxLV3bI1NPixAeVhaKr8Y1OK36d4ydWxnAtBkwtbJfQvxmeSzJpBGo8Lap4sw8b57RmcivIN/e71sM/3vyz/Pu++NB7/fvNNJNtigZDb7N/WdUw63GVdfVBt8/hr3SE5LuYvR3H8/6pzQXjW2GCwI2zKi85pFPyNmBCKfJTA2rr7bpkJspM8Y8/u3DxbHS5ZOfBr/gXznS7bg5vbJHurEY+fNCZTZ7Jbwpu2p0GlclsE3NPxpHh5dRwi+BO5Yki8uhH17O7/hi4YlbUalZpl04cFgCwLaR80Av4eGYR+O8CtBLZmyK6WAULzMNWfd+X4OxmX9dB0/d4TAS1zh468tIU9SK+QrbxM//OHu3Bg+fxF0NLPzsX4sWdcSHq0I1K3KCBS6mYPQ+vO3/1r04ucyKl4zZf9+/PEINXpjoK43L0zmfClVfckJdYaHZH0gX4ssbP4T+QGHXEEixDmgjErEV3m5VNxZ0C8PR207lg8viwfJeri0jPSork9XlsRim06mI1hCaS3pdUXiaw3gPBTWqzkQ84mEoogGuBVfxge7z5inKDmK7v26fHF/oLoexRv9KqXM08Lbu2FigOB7tdXngj3wgrjkFUAyVUh8vzgos7lXgTZ+f1+HrvTp9INaCRohLbmu+Lbpuqw7d1ucZaqZXliqxUyGeV2PNWqOvkfwzznic7aEql/PoDpL4QNzShu6Hppt/23PP4sXjuu66j6ByKOcyjVvKr9cfI8OEjxL1L4vjUTjej+Wc+2ELKqwepXqnFuqd1L+hR+ovf3G953zhL64P9D+XxpzzkfPcPE3ErRoBZ0/9l+rzqf5hOnaqf6VRcBkyd8i/I4IetfiIornqqRtlrVrGYLQ+iTv1DW6oW06GTSE4Yfl4vLqkUeApidWkNM1jGt1YqDQKMk+uQZUFttas4TjCpyEDbiBDly8Ezj9enBFCIx1T4ffqcF843kaWAkIASiWCuwzwhbMca8wgg+h26xgbXqNwA4eqhn0qjW99pYa4UnF9l0LFEAjT5XigM/ATEfGAnZ5fH9gxRTgKpM51vvrjinayq39fsVenJ/237OKtiXwztvP97g454SL3YJaeac5L5m7BYo/6YY5cO6Iiu+DN5iVfTW8sxE5dqpM1v7YStuWjdvIM0NLU3bMIxxGnVScp3Sqgsf3i6KZbXSwSXKb5iQIJjbegwOg8J65FdMfSiAB1GV/6/dLgZtqFiHyJ3vFxQnCxQwNfhG5aOProhdpytnV6eChYYxFOG1lWMH7eAGpR4/mi30LbJhEn0Ou6lO1cRi6oChaU6nboX1d/u3LMViIXrrChNVd/o+J7LMaCRxkcmybaa1ZkOg2CFRheij9svrL+dKJwUD9enFFUXeOP6G7TdzKlzteZRb1zFITawRrSUJ/ArgxrgFGdCcIcce5OqZc1MuCTKCUfUEZLuO+EYZKxoVKh7o0O5m3d4d7e7rZCEPnxz//Rn6t//y2Ri2rrZEzPl7BWzY+hvQCwJpHUOWaxEGFOhlZ2JabDD1kokhvA2s5l6CcScR1lkaw3a/bloWDcqojGAOTGpaZF5+Tds0BOdaICfgrrOkGkk5CPXVcS8S86DOZeNFdH5kKrn/2ZHZbHplmhIXQLCeiLQKBxbgQb5TU3oi4Y7Z6vq2nSgsexY7w2bebO9fDGQOmt0qtKbDJbgdAllV8iKJktEeNYWi3aRlX6Hn359PkOHNgn7iV4b293M/dLUIU/EaNfgfxK2x02LZpAv1A2QYheIvWNjuSWMavHZLRKS4pf2Bt/pL1ROWAuArk7iweHmufd6VCyqx+vyFrYQAnT9a4O7Z72xdHJHVbj6scruoUwT205k9EPtAtnR8R5AdF2MV8kGT1EunrySv96CV5r7E/oUitBIJINRXKT5QAofym5kbQpVmo+CB1Qp0FUuw7qPaaB25k/nQmyw2ZS8mvVxFskmMVCjG2HsXSovnLWu+C7OmOphylU25hIaSs4RnLewMo13A9yOmSL3vQCIDoUzSnpbhGJkR8jU0p3DghQixD4n4RbUxink4l/a0ekZ17B4B9ub6tH1BOejKbfeawf3ZnbSaR43fpIclOHRvSW8eeL4I4l/FM+7UK7x1jzgA9FEGOvD7DZMNpEbwQa5cuI9c+O48zGjaSXfioBbHKkUU134tFMzEVdCtOj0e+1hA1IN3aXEWcSdbN9dVjqLCt6i4KgkSqKwKhoXVLou++B6TQBoShv6I79maIqws/UH2Saw17mIPEgMGLAAzF6nIhFQh/MpO7LpnoWLr1W2i54jJ0CMhCnDz/XwWGZAqreoLxQYajTTUdteipmAPlkkhiCv9Lsoyz//m053Fu7XWBmKAJ5s0S3pqbcdrDkPrmqmBGPkxLzweOk4S0HaPQQuUMvMRrrIh1r3ozmxulwB5U3ndwU2Yk7TxsZcnPc0SJwxmiocBS2rCTifpCd/kveeB4n1fQ8kYsBMfUEu4SYTHR+DfxfmsII+ZXonx2j8wmiUzbtNVsFPSZjytpumR4gZDddU6DHA6sl8ZDlee2wk+xJrBmGb3zdmwltJPftI9lKrLaj0OfVFMxATtekWB/18Evbifd4Yl8w4L4EDLgX+Lcc/NszQX57AX3bEOibK8jngvfm8uTWRdfMV2nJ+RNCvb2gvBmUt28K4O25Y7s9a1i3ZRyrZ4bo9gLmVgRze8FxUzhuXzeE2wt621+H3vYC3PbXAbd9Y5htzwSu7QWpbQNIbTkhPheQthxT7hmuZsZKD8uGjmUec8u6QR6/ami2bwuV7esFZDMZ+B4PfB7XRHQDpx+a4JWIrYU1U+ve/kgclhP0bKJqNT9MohTytGUfbmEA5d0hv5J6milnk7v7sEnesz128F0awnxfhr/NKMdXJu6QuErxqUnbnUxxl4v0FcbZu9P+xQnr9vv/ffQP6n/lYN9YFhx2vULZQfMP1vhnqwtokAZbvfzRDO7V1pWI1sXP8lQMN9kRle4dqfiGIHWoIocNxYxf+zJypWevW9A5OBDatSwIzxV+ucTdQUuEb2n0x0VB99r7+zuPFm+NPkZjGZ/gqxIxBFMUcnf81g/Hj5byIuAJjFWtNsZO8rTydku0fnVLtLq/9Qo1Ut3/pKbgif5k3WP1r5+OztUfZ36Y6rqpOR996Kk/36sUY/qHO+QHwL4ItnuwT1+xHuf6F7qu8zL8rEYYNXDHLdMIK9+iWsDI6QkrGbm6cTVIP+wklD/5OB1xZWPUZf130h11aTFKVyDHgde4LzebJwkfffLmfhKhz+Z02wywTTZy+9HLU2u55Ezf28uJnXDVF9augCvIR72wFGfS9YjvVGNU9Y++lEHu7Q3Ziq9R6cpBgsUFwySrLkYCT2j0NO4ATVW2CB7714MMu+ugCN7KpRuhesq7X0/VT+Ltfrfdbu9ss++KEqNvygRT50buVo8bXV1ZSK5MCgqyvpCKMsoX6y+JyZqQumSVt7TIjP6ChOUOXxTcqqPk5SpGM4qDP82raWZbQZYFAbrc24EeJ07zq3i732nv/1CiffT5PRLa7Du6kaKwByzvg+78o9fBFb6r03Wtw5Gcz5HEhv/0lC6FU9UlehEJcx1fXKO/yECsLE9XjsUNqD55rv7bewQbp8OnshWUla6O9u6sK+iqK92CbN2x1hNvu90pETF957XbK99c23E9l7Qv0czcb0keuUAPH9VqXqBzeSOi3kwEwZor9NcYmZVF7YrXkfpTivpxv394OexiBCr+kvgJpuifqeu6aSTTxSHb+a+CGN9iHNSZK8RBJOez/lkPh+IQ1Q0ypALAWANQ4Pp4lAIKgo7WZnyGfDMFSOsnsQgmdEEPmMQQQ6AMh/Fr6Y+BBtQaiwWKCkIe3MV+nKW6KxJuvf32D3pU95IOeBT6Xl9D7oOp/2KMsbGY8DT4X/a+dbltXGnwf54Cpflh+6xES7LlS7ZmpxzJOeM9uXhjZ+arnZqSIBKSMKYIDkHa0fza19jX2yfZ6gZAghfJlC0ltpM6qTOWRDb6hkYD6EvKlDjSBabsGW045PJwtrXT+yuVKKovDkyNDTWkUkcvidKvVb0ym9VFFbl+dzU87w9+PR9+ujob/n5x/evw7Pxq2OmeDPtv+kN1lb5aMyzKfQ4VGCyebokLl+fvW6ZWpYSiey3qQ6VEW5oCM0b19GQGt1IsVCITVJ55EuMfLUyelZDqISZkVCZp6M6wSo3Ea6Es0CQFigVjVDarukOgkNgi4xLv319cOI7zcOYqTLbE4jMsHikmOV5bg+tSYnN6w0gSFi+8NURsd8iDVbJ4kAxGKXwjBRrrcJ8stAdQ0hGP2ZvmVgfxKklk54+GEkqjScxf9U8iNZ4zKmfO3OttSTB9i5gJh1bEYcSDWB9VAW3vBz3i8SnkF4sJGZx/SuWnLxg1WALcrTFlCoFWKstOYC0RoFXXFLOz9rgsSSMLtIJ6E0EWWwVFcmYlSbTfHh/1j992+73em7eD48HJ+cmbk7eHb96+edvun573HyITOaOdbyaUq1/POs9eKqfnB6cHg9ODzsHJycnJoHty0j066ncHp51et3M46Aw6/f75m+7ZA6WTLTXfRD7d3lG1hDREYiS1GQllUJWkNjNvjk6O3x4dHZ21e4fnbzvHZ+2T8+7bbueoe3725rD/pt8edI96553B8clx78358eGbtwf94063f3baHZy9ba8pOQzUjL5efFUWUWKcOoWB+YSunS0bDZeYskYlKRUZaKUkfRIiJv0zTF26CCYRVWWS4MbtmtF5kwz6P+v38O81cjn04H/Rgy3x7gzNqq4ulFX2V+NKrHTugY89U9niCxKyCFQNVOzq6t1+5ncTMqOBJ2f0plzu1ztkvXHnxDsa93rucad73D05Peh2O+7p0Zh2D9fVpk1keQxozPYxE8LykbE0mxqkTtKHhkuW5EdA04ZOqw3/rjEv4nW7vV7TBoveR2d9rEtwMQnkPmI7p8ftTRCL1aGibcZjnoHjDW2zoKh7QK4+XGibCr0cpQ7mASR0hgxUewB04FYQv9FAiWUfgGNgfudhrFPL9WaKxMIhvwObLbMND99S7kM5VivQPIU7ZcD5ECpExIKMPAYGDkP1dGnQUYn/+RpZ6/Jc2cot8buWfS5Z5MwSa5jkfos8X6jf0BQPhJvM00ryG7LEMgmhpAjzhmovLbe9rdLDVPsOuU08Uk7gwEyUeLPzR2PJDr7bOxr+u/8edvAHJ4ewn8kePO8PVj2qByGk8aD9z4+6AN+uLoAtgu+9KEAlL55ZRYAKGn6UA1i7HEAFF593LYAKguwo/C0TVZnbsPVCAPfQ/L1UAahgwwtNjrApfXH5/0XiXk7yv02Zlf6cJpDWoPHJZv4voe37TftfwpDvK+d/CROeQ8K/jfqPbP+vmO2fY/yPVP+vl+qfY/wLz/OvpvV5JflX0fAjw3+dDP8qDj7v9P4qiuyd2Zapqtz/GjyKBOYEuikCn2FifxVJ38HG9Vmm9G9zP7MkgDHb4Zj+slN+C5e2eE2CbW4CqOrscxcu10q0SeaG3d5RVHvnwmRMxz6uIDUoHQvhMxpUEfRG/UQmPs2Rpeu+Q6hrwKYCOlfAfRW0ysn6b8ItgwZKSBzRQGKHdh0nG0BDbnCo4HMSBMx36pIXsC/x0ITM1iBwc6JM43THDL9CvKHZ2qUuqI8RLhCmq2Gq69aLsw9numY9FAcyPiOcEXMaUIwwpxK8VLj7k/uxL1tpRzVQx5aCu/QH58ssnvs/UT8MWgbHFvfkXraVwH2EbsWSbRp8CFjH3iIlrQMs9ztObaWLmEzmzKshj4cqHJeF4GpsiarHxX4wGiSBK3KMUgUpFbS0tpqp+3Q7NLMGbV8p4lfjtm7Eb5mkbxXxuwyTLbF4mxG/mpS6Eb9lyp9mxK/G88VE/Gp6vkls6aYifm2ZvIyI328plU1H/Bak80IifmtK6FlH/Goatxrxe6UPUerF9pZiejVIYrSsyKqvE9urB/+LHsgtsWlJcK8aeGPBvQenh4eHHTo+6h33Dlm32z4ed1hnfNg7Hh8cHXa8NfmxqStcGdN5aPu9uDXUgZ01bnTvi3d9dHCvRe9GbnXXIbh4yXsfsY8O7tXE6hOdGpRuwCzcbwiMzhXp7X8oBx1tzQD8iIP8dnGQtgi+9zjISl48szjIChp+xEGuHQdZwcXnHQdZQZB9abFloirvgbYeB3kPzd9LHGQFG17odZJN6YuLgywS93LiIG3KrKiwFxEHuYS27zcOcglDvq84yCVMeA5xkDbqP+Igv2IcZI7xP+Igv14cZI7xLzwOsprW5xUHWUXDjzjIdeIgqzj4vOMgqyiyd2Zbpqpy/2vwKBKYE+imCHyGcZBVJH0HG9dnGQepkd4Sth+Ua0ZCGqVXG3pE+A7u8CBeC78XEZ/ygPo6Oq1E0k7H6e6sSda2wwM/APd9/g/zVAgdBhmYMRGVHJn3kRj7cjWBhjwZ0sDURq6iqUzREnpy1Oxolz29b03vpWE89KN1oJF0haruz2MJMZ0uc15pzM/UwxHTF1bgcBMRskjHhiogFN4KJNSLF0GTyMSdYbAG9v2AWAWooRpgOI6GC0aFuwxnLoWjEQp3wOTvhEULZ+dVjo8Hk8kpPTk96YyPXdfrUbu2KyL7FVlX5A5+VrVkpaqZDIej7BZZ5fMbZnNGx6ONGRxWkVhMGXBE7ZA0tWZ3RCG0OEr5BwWtoLvHeJENAqVko5aOm4TGXIqlssi+w/HktDs56B0fjw8OPXpED1x22j312qzNDo8Pjl5VaKguF2ux2dDwNfXUGra2utrvQCGlGSMzPp2BEiLKABt6CpE5ozKJ9IYSdTjVSa2/qShsLTZrRIHJ7fakfXRMaXtMT9vd8XENpiaRb9cl/vzp3T11iaF/j644jE3+PPBdsQgQrBuwQwl9ppdJGsWwT//86Z1Ut5b6SWORgI/jiNEbOPn3xF1AeBALIt0Zm7MmUbWdmiSk8Uy/L4gIHl9qWAHWSFTpUJUWLdWjoiYNELpRnyTyM1vUyJelaqQqQ8hFQKSYMwyYBqMFfJ7ThaqkrcPaLy6BC/vggQC/PR4xN/YXzfQ4guZJU9tsB2DjGQfAhtpezLpzJncYQDUVMAb8NNIltVRdaRtDRRAgpq+uAU+fxyyiPrm4vD1KYbLA9YU+bxz9MQKsyejPEdm9OL9+Sz69NVGJhHSPD7p7Cif7wezoxBy/YHngMfAnhLMOPDWw0U0hKrTNqr6qIJhRhzTsfVsaAYewiFbGOEAdCmdng1d4LXrKa5gEaqk3CYb8eiYaz2cU//ZEbInqugwd6nlDvyzo9shhkdWR103QS2isxG5ZtIAhIEqK0ML7BeBm2JBFXHhknsgYW2yN4VwR8GNefkXJUhjUw2NGGmEwtapmwesNB76zxvogYh20jOFKKddAcIhnttoZTCUUgcdBnZhGzvSfvSZSnsIEIKAIBALhs8O9VLF2G9N/Gk0kp6EgNPbK+hQG05wSTSI6ndc7s36QDl2KKObCNisEb7SQsaOfRpaRiUVo8xCUYfTTCM42gSbbbzZIOzt5WhLfr0GHiUnZZLOGpW5qiSMXE8QTGspJAo3L+BxWIRrgErkQSQTeS2bzFpasZSzsKC8ekFES+Q7AG2HSFLhEKswU0QDewUlmoKKdmIfLm3JGjSFCdysFKUUSueXgQHOWmbdGrw8PD/Ylo5E7++Xvn/X36vNPsQhzsjHG4cnLZ+dzMBce+K1eZtFQbSWRjAU5vqX8qpj5PCCBasFI5iLgsYCtnFoyxBgdIS9dLcfQMMKoBUoyYqkPhYKmmENGfDHFQGK1noFxnMQsIH+BbUr3GTqWGB2Q3ISy9WLOtMqlr6VgqQQ7C5lEBtFmzkEKRFw2LA9SEdDHJT/ntCekUlq2Z9NW6lKDN/ZFL2BOAYd4VmP8gvYWxolnhTEs+6cZ0SgMK6K4xrDWfZqKKH+td9aVeIgoXorH4WH5wuHw8CCHFG41a2D1oCUDDD8OoJVVsXDMlPehftFpe1U0aJgEaGkUlKq0vvyC64vyTczRRHEUB1xImncgA0FGv4xwJqbxC0RHU1i4O9r7BDMPM3L0ywiDKc1TTWswfEF7NylE8JDh1IDNwzjDB1FXT47029DtZ6xvy2NBPI5JJQFk+DEyZvEdY5l7DYPGd1A9V6ZbXiNalZQJoRLD7e43rq3dZTYoenJmBwX0hiHLGk4nY/WTJcaSt2bBUg/jBq8xESK9RnDFvAECadhf5FQjvVnVfPWg/dmcB8yDNASXS+brfA/YSsD+mN/kbullMpnwLylEfGYXbOTr/X31iHrCEdF0zyHX0UIXHKZhGIkvfA5yw5V8vCCSz0N/QWLccZYdQhClT8fMl7B2+ugC4rpzx3wfqb9+N5CZoXGFk9w0yibc4kZOJdTGdlt6cIXQl5qjBjBN2tIB51pFiIxeV7qHCt8yfQgpT5lRqG0Rd21rLQkzZ1gt9wvydwIH7TxTVt2MHg1S5gFQ3zfUwQMStlMsjJFcKGyN9JAk8FhUmAR6FjsEttPUHG5Y+4oiBnisqNPZ9W+QiSCC7AwoNg3jcFSXBoHIHK3cbGla1KfGs0QMHOrdFfDW2FTPdBIv46s6qqAyrpjsVMYNp3guoEHkNmVIqNT3PakxMgopk3EXam13ckNkO8I8bsqsax9es8CC0VCnILBuxBHlfrY7rZifNN1UL/VmjWbHIhwiGV/BirPJBFp7QZiSCLWWaOp32fW7wV5T5UzfBHBWRqXFdw2TEGUNm+bYEfYSuTmt4QFxFTv04rgpWLvLmivmAL7xvI09Gvpldj6TRD2Lj9/n9AZOzrcYWvBZgy+Ye8c+2pUsyp3tms/LD3dRCwFzc8RrXEbCA+UNwxEEHYtEWUx8VG3SYIPss1ua7oH1cSJui1Mt0V3tQD9mFPpUBYwwCCgBW5md5QRxxJnU/iIOgmZFQDfvGdz9BHBSbyyFOYemAaGYjK8w0qbfMvlzZ+fRZ8fujAZTJp3tWgO767U65hXRImM5BCySOYM7YSIm1aYdTszJu8HZJbD2TCnzIAVlm4GdurbQ0I7JR1siHRQ7n93krIserKgbDuvZyNlJic4dma3+TYhETLtgOEUDc+aPWRSTcx7ImPFgXZbglP9mOoujf2ulRSTMxeHmyS9f1qYVmWBg05hTLmTM5vuhT2MwqGvrtqJiiwuLLUU12LooWin7m0bO3NKaJWEGR72uiFSL0twiBdzXawec+gUiWMwhqEKDJbCdm1tK+FmySeLDJBzBSw73RqCD6gMQODJ+N/x3ou6JqZ9fGAOvwomHo4T11bWoqG6W37FJJdWSRiqLNueq1Wn1Wt1Oq9vuHnYPTzvd45PjVvfotHvYPT1sH7a6B73Oae/o+OSo1Wm32+uSWNbihxK5efN8NYOjPYAAWuCLKQ9W8oo67IGmORI+k3k2bL42EeBMcCRIYQIqYp7Nc+2jFUja+aNxw8c0oEPqzXkAvXAihjvvYDoEgGtU/Hlx3pIhLN0ofJcOYUb9E3UJMwR/OIWpU5gx5Tt2C4tMeK6OYZGOzap4jpKHu4YZkj+cw8c4hxkfX7B7mBH5fTuIGR++CxfxW3gQZuyn6hysYNvmPQeD3Ut1CvL0Pcn1Po/iZhWyzlJuxv+xSi9dpQ2LnusCbPB/YmtrfUv3yIXXYPZdrKkxjaYs/i6PJjTpT/RcQmP341AiPZTQHPmOTyRyHHiS7sm6RGxWs3NkLHFg6mP4w8VZ6uLUZ+JzdYLqU/jE3KRNekL1mfCCfSVDKTw8pFOTwmOFTJHs2xqBUwqGCZ8K4GoYolNdSO3EBD9KxpG4s9Kq09l9PWMLnYYiZ+KOJFC9mtyxsckJBhFLSCCBoLc0wl4XA0hSVE10++NjnTwGw34tM65HK8qYX85EkFfLr4RQxtKS4l3RCY34QxOzvpnF+BxY2jLMaUuRwvfiH+77dL/ntMmuksF/J/3Lz1oe5OMV6XSHHRWv/5668MV/7ZGzMPTZ72z8Hx7vH7V7TsfpmP4uhOz+59fr9++a6p1/M/dG7JniI/udrtMm78WY+2y/0zvvHJ5oJu8ftQ+dTp7V0pnQOfcXm+N1jk0fr4iCT3ZN5GfEvBmNm8RjY06DJplEjI2lB0HHgSfu5F6JgerJEt6J/9Q0ZKltLPNFldwIpto9NNuBwM5INrVYsBiAp8Lsy9qlFOa9+IvesiKPblgUMH9bsi3SoEZLO5Zg7nNE75bNi0Pn0Gm3Op1ua8oCKERTxH6zxulpSNjUF7Dku0yk/1Xkh9lCbI4nqzE24+m567IgFrJJknESxMmq+UqjOx4UsQdF2xLmO58lhDMzMtLjjHSOA8S00ZhBGcV/1BOiSCTUydAwCUZD6yVrHAnqgYswZ5HLqa/sGMRSZ3uIj+njErrm+L64A8i6v2CWJA07ArKb1iDae018HiRfmmROXeRowL9kyRqar86rYl7IxyuyEMnOTgQrPMW8DFAnk2+ks4Ahr0sl8eXyPOCJsREAIaEIE4j0g7aIPqMS6h9AiVfMiIDitiJkAYxAoe6KTJjKCTnvXzVhDxZGIhSSQcmWFCT1POwd6ewUFQLJfHXP/LFURU+MLWlLSc/1cPcarE7b6RQX0O2iatUTu8eNgkXfcsJvfRrY7vdv784+1HG84TnjctMoS97UW8gFOWl3nc7fJKbTXYnl5yB/y71hsdFfKlXuB2RsB1M4ysNgTqb+RPhUSuGqbqSY0QRpCGPdToYHkP2Av5F0YtK0lLAeDM6rsw6T6Uz5oJLbHaC+igooPBB5hBJokeZramM6xfwyYLBIsA4E9lHVMOFrSEoFRP9u8aD1N/REpaGE6QNFoJr66KEKM5JLWI8XIXetRDedbYG1XWiamS9ZIEVEdpkzdcj/ZuymSX7nEYMapDd7mHbObyH7J92e4UFTRCdYabnACR4ELFoqVQWCqIc0cZmAJdk1eSQaqv4tT//eEiJXk6fo03DXpXIFecraabjgn6X2lwephQJdCCp0JRamyxEz7IjpdIrOiwb5USuqYyu3pj5ybC3Xq0CF/pnHNchUt+2jJSzSYh40BcjMgZTHpRtB5YPyDNMwUeIWvGVymfCI3VHfl00SofJLnAs+rH1j6kO/l0huYP+7tUNYJPRiADqotDmrX224V7aVtY+utrg9/hjqap5IAQy0Fg0iiaExwmpCDBm3iQ8V+Mc8rTRrloXSD8vXB1gecoBqZLbRiqFJKc1N1+SyDqYeo2ra4VtsSW54PgWvw4KgHQiw/5E74zFzoaKWIjAu8YtiGFJ6tYX1HiQz1VaMt91K7cGulSraJAPcBcPsvPp8db4Hf+C+ifr4YAo0e8FUYRQReavn+V4uUzXrcg1Z1Qs5TWjkOepvyCDe//uOjWfMD/cnYgiKSf198A995k3ZmEq2nyNwaHxtJp1ZPP/jfyGgFLE8M7Jn/9yrLAhjiluZXMSyW7nzR8PQtcZNruvD4mIyyLekJaAk+YGMD5fngnRFlHmiOeFosCTfihybh0DS7r57K+V+uUjub1e1K3pbGG+ODRvZZpd4aX1RzUiccnplk+lCT324vMkNW/X2kknh3jJnzuOIIb8xV3d/Qv9G5fZ/cm/ZEBNuhxZycuhGDLZVf/SxwHw6rG1pObgFgYedNSTYi/5v57b6/FmS6kUAW8WPV0R1pyFdp9N1jnStFzClBUNr9oKfLvtrtPtmARQE3va0MLbTupVC/wiMHSacLxdNeUpUiahiTpzXZcHW/BSg3FCsDcLuxWDPFBfQDThy1ThyfNAwCWZ0LxxyYadlkyR/0acH0EDNrXSZrxnQ9VT/bkbjIZdDmALc29O6nvMmOLMOBoq6fjH481VuYJRRS3U8arfbtbveYElPtr165WckYqqe2nIDk/OytbWBmAaPzHnMp/hDxgsjDCMq5hXkUmRMtUTcKW+NebDv3jJQXMed8l/gj59TPh51OmuwERRvuFXl13tNEREJNQsqVbVEPFDSaXdOnHWUAuAHLHJuWeCJaIsk2VUjckI0KBCFQomsaxbQsc/qEyQi5oyzhjiriJn4glYuoztXcN8uIa2WRJA2qkqLtZ02+N+dttPWdV/gTzJm5hZiDrV8JNQ0tYsKvgHHUmqIAk5uwE+TkkkJZTDxTIR9CX3BY8OUOYsj7kqyS+OYujfkFuPbsnNPVc/vC48XTRJG/Jb7bMp0uWMd1wEFcrEW9F6T8HlI3TiDakdpAIwULhTWnkLvIgVKx1shTroFLFagXuIEVDhdxkHHqd3yhJsAyXsl/7Tn9NYTMQtueSQCgEb9pyPrcxut+4ROgwVJq1WilmgJNclDJISF9HjEYHD5BEQUM6iN+pSkc60xuk8w0P0H3O1ETQVgqcetKlqZOGCWGFm5bGNMr8nh7Z6o4/b9g+mkYnssi2zDvPvht8FettjDhpjHFDKZNUho8H/LgJFgSqEqEh5kN96JO4icec88nswbyrg0oH1yAw1i/7erK3LbBfOams8UImoCHJqnzoWpI26NBSeh0oJ14LR19aoFnux6bAIVAVOgeh+QPZyTkaVF+ASXRNxBjSnAe04DOlUnUW8vPl1dOx+jqeqGRHbxCzCe5PNVa0zBfQ9E0AojMUmb4ZBc2xqoIAsXRnMupanlLwicLcAdWwhHj0QyF5UTPFvQvRi8r1AEWk3gX8zoXBLqRkIi1eRORL63REWDW8+BPorOVNziSUVLmyK0EWVjoK5Q6qmqFsmWtPTalnqlhwG2A7mHhkLThfoGxjTKomcIrKXQy04LAurw0QhjDCwT8DAOFhnYh2Fc6q/mouEhdMixDyPhM+lnnbzuv6/iErwAXy0OyCTd1g0MiTmehMnypdCvX+Z6ctrnllxiTI2/gBCxqW4nQa7fXRFwbcCVbxKPT3lM/axTX9Z+T0NkX5ibxODjkTEPKJxyNcnV/vuL9+e5U1Ie6Kj3sfDwGThJDODUDKbiBCvHGywFnvvfpHP2d1PG3W5+hndnUJZW6LrzTbjsyW6DMSJwBGCxAdTIQTAaIgTYMmk82sH5pxYLYNXwckOAmdErtKl1N4I3R9j2Bavi5y5hxiy7bE5vB/H2RyMCLztyRru9o9FeSt75rRYqjbNAXAsNm424PzU3Otb1m2zmUTGsANINP+wClfo4GqStD7DIKPalo8/g4bWR7huhIeLPrs/h5Bp/3sBdCfVxAsNygxkNcktGJm3GpRvmWePqIpi7V2cf9hwVyQfjSHJLowWsCJZCaNAk6xEKLMrJCt7FIsNqemIUp5Jo1j0DtH/w4YrYFBOyC6BM/Wqp3fVcogiTTtEM7fzLKvdd2/vQPby/SQvKtAPlw5q3V/ToX783f0r/t2hLKYukfb5aE++n0IpyPempTpRpp0lwrZrk4+efC/3osffkCklrsOTBEn8yLSjfi0RZhd84u1uTCNvX3DIhlV0nHzZxLwL3EXQ+geaT65Fd0Ow1SX+hTSoDEQ+x/0wNcrxsvc2hfM0hfCAmdzPuzkpOIcYHYjsGplt3e1jO+pb63CsJqdvutlvt41bniLQPXnd6rw9O/1u7/bp+vg8QpO6ptkkRnj3UoQbuFE6Qms7rw/brbm89aqw+8ttuCn5m4KcBQ+qCP85VdoZm+0Uq12i7bdHjJtEt2xItsINF+IoWHc7CfB+IdfVPGUV2r3NrZ4b9K9Kf0sOLEv2wyw973c4DmMC+hCIwaUm1G5rkaD3XIFKxeSzityWhIWE1CTrq9Q6O9Zc88NgXmwpCPOEOVXxZ4fs1CJf8H/YIokHAAMJsFy1ZyhCaaPKAjHlc9s677cOTuuhKFnHqb7dnr06SVEOZi1hcclK1rV7d8MgEDZCMWeDa59kTfZMNIlISD2cUb9e524RORllsuNrFxvqkAfaxrvDBsYCdTxKGKmQ8BZ218ysxttd7++bNaf94cP7mbfv0pH066HT7/bPaliE9zhimCrolll+Yy88IjzJt9qZI2Bbhd9icw3aJAU+kXYQe9CQ7fiH/FuQdDaakHy3CWBCfjyMaLRxyxVh6kzrl8SwZY3zTVPg0mO5Pxf7YF+P9qeg4ncN9Gbn7LgLYhz09/p8zFT+9Ozg4br076JWbEYFb3jtqrWGGTeftb7LdlOl+06BRJOjxve5T+r7FdrJIzuerdfF+CtvJounRuEEISJV3rfaTV9c/Zz5ok7z7OdfY39pvqrN83F1uTNpPZiuZI3pdKuwdz5YpqdxLGjyKROUE9xiinsDGsUBjbTJe6CZQX3pu19OxsolgSdOuR0nNDlYh3QJ1f03GDK+2aeDORKQ+ttQC8ypN+X+jnsmh8D8Qdt+0XdJrEryuj8KzqwW8CfV93dUSqEZfsvLEHFOioOmUZajhjdeE+jztWgntEs3D1oMVCMK/ATShhN2mR1rg2VsvQuy1+sTz2VFw6z7NurQY/IA+B8qE/yOC+9BDcosPz/kUUjNBiHh1kIOuOKKfVGAFTi79lfowrNKbJaSn8sGwGwwFmCYRCkUNVkVfDdaDhOznVpKFTHuoTFdCBuaCu8+kA1U8rEPUe3mEBy3qXWLeJdwz08L1ReJlM6APH00cQQShShTuyKonxXv9qwrLcnOvYkRzto2mnjfEB4YGJAwC3VhFVJwjOcrxJYfP6dSqe7vsbsoyCXTOW3Tsep3uweFq1bkA2ORikAY64pAprwA7Qn4iZyBCfEb4nsY3hykQ5uC7jmHCvegu045KMCs1xBrdYD6swa/VCKQ84N5DcbA0/NFY1J0tFh5z6s54wIZWKvdD0dCg7KzwuljoFQJjdoaWkXwoKsvg1cUnjAQa2EcriAaUzcy6GECLQhE8cvQckOLI2r55wr1hUWbgBuZzhTVQv6EDBQu97zNs943WTf0GxkBC5aWhWmIyx8j4FWq8Vmrclqz/KVp16M8u5fPAbID6ehqb16Q/VjHQYmL1K5UiXDIUmM71R4O37KVvzVELb9Yb9OHDYa9ACUvA9cfBx9fkV3EHHtSchrBaSPaLBbbCl7nHn1mxMGWLk0LBMToNLsarZWqj9fxX80wJ9EUwEbZ261UPgBJj4yyFhu8r1Vmvi+d9E3qC4XymWad0mCudxdw366fKHIRHwB+A2LvszUIBYiHjR8yM5aLMFdczIMZC+IwGNcUxyXgFh6SWmpTHFdIZJ9wvD1nWgNRtaXROBp32aaMeOpBeBSPYEVrViMARU+W8WYWLjCMWu7P6yJhRVD+7YJFq7E0yhko7MZOZhv7H/q4CbvZ76obmfcoMaOZL3mufs5futdHZo4/QxqIsQuE5NQWxgtcWb0LhIb5lscNQCfc2NtKl8Mjni0H1QDwsjcPDBw1xcVkeAf4f72g2RkwGsTyY8ErL0yMHM7WwlgxW2DM+fkADsCpBH0b8f//n/0qiS22VUNKrzb8eva5ZPw/nNAyhMKOiq/Gvxto06XV4TsMyF7HvHroPTw9vC7dq5CUDR1NETw/1FLNqxCMW+hzu+nIHGRnyZfTqDZvBXTJpPBb6YjFnwYYHzuAuGRi2BlCEd+MkW4CXDJ15GhsdOAWrL3I8PsEEVagGQYO0vXxWcjRKAjiN2jNLu15Fs3X9Mv2iAgP9Y7aipwcpVStwBntTyy/7UnfroMd2ssD4FduH4jCQkxKVBrIRLEnIcAZfzTt05vkyWfaYuZV+lWKsPH6vxK1WVeY8NgUlfTQ+VUUaimPmqiaY/6lRK3+CCr2FcJRK8mvWWi450BT7Nr8yM+Uv4YsbTls0iQXUQ4Ic1Gza/E/1K9yuYj7cgtjPpeeqdU5iK0DZfrPGIwW57I5CP+eoe4N8ktt9czEv5QqM4Z+53dERLGKSoqavJJZjw71NInJOodArjAmNhe3qBDqaD3KXxowwHs8yWXjES1QplJhGMZTPV7tDxBDuguC2Cr6k2YUEjExCGtE5g/wxEelkSZQ1g2o8zFOt7/EL+NjU2feIGqZYUR9AxFKFNF1cqie0wYLe+PDoDDZjeZQgPofHEjlTzVydNhJGwkvceJMsBkyztUYPANvElOpVCG1B+XII7UhTFpHsWjjt3YOUla2/MZwUVCO4jGWWZkkSJQGWuuRBNYZJ5G8ar8+f3pEZHH1BSKBCRM8KxHGVCN0kYl5NfPLHMUvw+X3G4lmOJ3dUppNMH2pB2BvEneh6DSIigYjTE4ni1W9DVwSZMRrFcJFH5iLgsYgaBYu7xFjqp18tW++XUKJH1W/n7yrzA9mDWVcQyyS5YkwjUTMovF5xavR4N9MaJCedAuTlS/uKhX3pso7JiP+w6DWRmGn56tVKR+mxZGFnnL/EGC4QqUxDh1M1cr4hoZ5ecNZzRK8FZIpqAsHIxEzGVbBWEZLISjKs0NzKsQd6FFg95xxyppkrAk+WaZPujM3r+vJJ5DulF5b58Ctlf6ZS/0gS+RqFfB7yKHbDURNzMOE/EMA5UimA+LccVUw0fZxfl5Bcf6QHE/KrOSESk7TFhHJFtOTBD+krA48Z8MEUT3XNs1bAO/xLXwIn8+KygspHHBBeXK7E8sLGKo+JOQdr5uDBUjrioSm5rddYqVNqpfBvofhqaPI1s8CAJMKdMUCtoBD28Dm914VA8svcA43OharyKiIQgqaRuBiSjWkkJuXCcCIWILlSp64MW3fG3Jth0RI8ALMzEosbFhifGSpjgulN/JgGTCTSXxAe3Iob5pkuUxM1uITTSV3uAU6RyN2MRYyYCr7k4lLVQsaHzaJuCiFD4q0qLFYWBJxPyDB3p5Ol7gyxakU90jB2Bp9Xbg+cYGbnqej2wze6dKaKU1B/I87oleBT4MWzwLMexq+NlxewLzGaEy/xmae4U0GX6jLyOGldQ81ohKMGz2ZMukKjLYEs/AQqP5tNTyPhDQwebOhL/0YZQf3LeutNHr9LBQIPQPTEy1CsufjkFvhVfCrhYka/GFQCLjguDwKNMIwXCibDurRtvNeiwO+LnqeO7FQFE6wQJJsVBlMDuFoGOaiNy4hJvWMFZfSpjHUII5vzOM72uVRrJu5PM7WB72Q2bVnghYIHMdTBkqZaoJrfUPhjNBceLtT+yGm8qhZmpSCXcXqFMl0MUiW3GZJjE1yNDGlcGshK87tnlLeY1wdGR0sIC3DgqBcDZQMYC0pjG49qOJev7vGTVgz+jgXTeIZkAgIZrXAGQNgXLkGA6E75PpclLEqL1gPYrDPncG1U8JqkkYRQV8gTd4E2GxNfXQE1XuW92IXGF3aT7gyNLQ9iQczzS5auMs5QRmbKomU4x+jXam2NkrJAkvBhIJNwFVTgwMPgwpurIAODhjMu46x0NPxTlfpyMdv2WyzwZAmf0jVTHp2Z0WysbQIajeHd4GFVTC57Pv9/9q7uyXEct7/7r1DlZe+q+pTNXTYf95Cqycxcduvmo6vtyz56aIm2tS1LOpHqbqfyx6d+IChREvVlu2dnq7L7NG2b+BEEQRAAgSDo0r9cObeFbmKHT+7ywZ0+i+7CPT+97wf3/gw4l+qAUT1w5dJcoxOW6oVR3TC26ebriEE9ccHwVTFFoaMzLqDR1R21wVGdTqI8+0wO88lMdxd/+zdvYbTWcojHI6K+aSoh5/sGGIKnOlBVFEnZvIMZXOBbE96LJJXxq5312JcVV91KFN91KG7yfMxTGQbvzZUBd72S5to/w/GA+FS4CtmAoezaeVBQBywoZIkwiyM8BhTzJlHBP9X3dy7AALh3XHgu4jqdVjSN3Usxm1LS7bIL/CRetgxeXYMeknMSL8mpOjkLyaJO4mO7LJgZwh/zKddcv0xEumrefvM3FfqNyrKPeZ9kIrWor8uA3JSVhAsiy4N9VdIyM+bnJE1beA1PE+Rz4KVrq1Nzg40+vI0fovZAPMqsrmxAI1sVwmUY7fqFtV4s8zStCo9aNB/0rmJ/K+iopfuyVAGirPRVyCF3Q7urlVshyySPuZcNtcn5gu9WRci22hdck5D6kWeN2hsKy9ZQF2pe7shjVCS/2SIu177qfzRDf7mjrnL0FQBzpsFzm1Sr5mvXrSc2iBnHtqtjZlNA/I52tXG5RDL44z8f+6JVFdfdrcj9U5tNxhPNZW3NwQQ7ryrs4aVrwH0oUIJfAQzIzIFTkfhuC4+vRkUilfHWLQWM//FnJJPtBRLCkKLx/ffzgN/LEn0YkcfO7q5mVTucDFeT5twFR+WnrnYtKw+TUF9Y0ys5+UJdCuI+GrB3e1tIHUNtzuLZWrM3wwBZ6MgStv5cYUKhCxR+la8IiGnIeA6gk9bldZvto0TuG7Y/90CjhJzu7mNxrtfDGF+LoMIU3t7CLls7Nhlb2OxG9pKkz65ze22cDu1j9CBGW+U8fL+E2Np1l+fZIYcMWMZTYMqVlkQNWJ27Ch0PZ/g/BpwooxgfxDMiMihmzIFgEh74Z+CYk3xyB1Q6jQxMckzUKQkcunGMkDf3H6lGYhLJmfcyx0PtE6IWeoxuw0X4XTsaQGWFM0RtdN6YSSY65kBEHDFI8/yxKmZCbMaYYcA0HHYI8bB2eV0iv4m46q2Do02cEjWF7G3nkDzJbChWWeo+a9xFGI7M26Am3sDxUgaCyuJSirCNuDZ7r79ALpCW3htU1QsSBexmOmf6KHUSOS+O/mFd/5GYrGZKbGssP78GFsgh2A7bhLfI8LFfRh9XcZDbdpbc9O+oTNt1Z/ZPGMJovaYnFjUUg4I2fuy8RCmBfN/UxlD2aERg2Bb276IrxBlNPS/W1XYc1Mnq33lR4/w8NEeqgH5GpUfRg+EOuz0lJznJ7s7g+E3dUgyjBFxhoT2+0rKY3KJd8Rk4CiZziYYzk9wR+xObmviwrI3J24jHah4x62L3/rDLw2Hd4qo60+rHJllo6XgyhpdiVNGNc2CJzuuS+iWvykyeV1Mz90FeLj0XBHbsT7Q4qNXIjDdo6VyrFYIPrcHzU4vpvbZcMLBvWDRkWebl6wjGGONPUilx8P9uUKCUFtHj9E/qr0ellJk65npbyv3kHONzJk5J1Lfwxyb/nMT66IXkX6jWIv2MH9s7DOWNNpCxcEXyIlMVegkfZXI46ksp/0i/niTtpbxL87a7Y5ilPbpvtC6TXcVv+kwQCBsYLcufkrgSLRyGUhh8Rg90vGCA85HODRkj7Qt1i/R3iq+A6Zm8lM/yuzj4e4Xrefup1NBStrgqVHc1x+XZx1sApYu/cT+n5+adFLBiDih4gWA5Mg3hdw3e5qVpFAQfXm5mjhK51H4qQIlre+PalfmzkqXfiOUPbUe5meZsM6R/KVvT+882CQqb6FJEtbOny2NLRb7A7e10uvQTm7Av3+xUnlZa2t70bEVSla+GQo2OozjtGdpkzNQ0CuEWVZl44laXnIvH/u4BBdqfqDvZfeTOZ3imvdnaEwl78y+2PriWmd5XeOSTZLZdYGcQH5grjhT7s/QW8/ggyoP8tWcSR/YFzBUzeWd3JE8lwIVExgi3movM15lL5xp04WQ+5OJy5Hagi/BHqfLC9/2mM4GoSis8g32Cj/GMWtPqmOyRLAunmg3npWmwx5M6zzsBu+23pLRWUxwcUUU0AAeIKYimk5M5zOqnusYBjJxlR9u0BmELEb5l6os3ZK/5V+QiG6wzKeucwinqTMlLp+OFGDDAPERMYvoO5zRdc0+ifEQuwEkKhUrZToVML2HSyJeJ/CnPcp1nHG1NMjgjFDkjaLI0cqDrYJqX91fu5Z7zamIXzZyZ/bVn9bpzCW83mbGN3W4HOfWrznSeySiFaYdR7KRau5zmVptCeEDj2EE/bjb31qk60/LhEfw8H9j6RGaZ8655fTnH7wxz/FqvMwcJ4Meun6Axa8IevF6LSh83hqTGDrLLY9fBMDzI0EDuYLeww9sMsf+Rdc6iBUbXk6cJ1C+c2ski9jucLEGNPaFPkFJ9bLq3tedQyjgpZaSVlyszFHN/Araicz10sM/TNH82WEVJLTuoDnEm0uZy8QHBuETLU5Bzvh3G4TQ5POOFj5betfcogim1sYOclGMrjNZaMyli2alZM+KkHQ2qzeAFskEikRnFbonblWVRl7HdrWb9wlUX9OKwRxsIjZ6KMyX7INaiy6Sgw0SFM/eNfazl5Zpv77Tg/G/nw6Dx/OykfpYy4y5Du7Omg5f58fcKBrl5OfdcIp0nw02uN5rlHH+VSwqYt3SMPC9Dh6jAxkHWUlpK0dMIgVMbu9/rMlz1vo4Etz8jmlsTgw8gyxFVF/R8iiQU7+PhJBBBUcp98oKcuE5ec/M/58jFOap+5ZrUwLnxR9CuMIEKLGSQtTM22/9RzEvE9BsgCRcquiVGg2f5a753TZeVjxjzUG4X6eq58pa7i+R6RLFSEC77Mce+WbJ6AwLc/0vC60oCtrzcshq4SBJG5UDx00ZuZ2z84a7m8WiM3oCsQSY0xjfMZKs7t+Zwug2bKcmm3l1Wx7sMrx+Sun8E83tj8WLQ3nROCQrb82q1tD/Wo1m53nBd3f/bXTlrOfWIDhJsEWuvER0RSCTEI3JbwsW9ogWMJfSDoaPoIuEZ09YuPD7Va0HBzThTwqyTybQylm9vOPwiyRKdiDTYvL131tfm84fBe2TG4dec51Tr795occJPnVsHxLd8FnwrUuxciLfIE3BzXGgn0x8vuBzXo/mlbsA0/ojsgT/gp4F7KV+a8tIR/CFHwqwMUqfaDHC5m83SsydVfCuaRMi2f+exzRsgpcjoDIM1fQV5vknGeTHQxkrnhS0MYDQzXvBUpa8sj7tC/lWaAPwZuf0ZXmQgod+UN2Q23UFfmr9A7AigK/PdpbuVT9J/3cNMaa3orSAKlxW5SvALfoCCzzqVn8KVF9zCPJJJeG4BKoJZP/UKflcVvwderKCMg98hEfX3A7CseXSS+pjHt4O3sZrdDGyvyYTU0hxH1HZh3QCOSzv428OHu/p4KBOyGVS1UzrRledm2IDjI8Is59bpmzepXOdyzNLg1KMANKbgIKPL7TByI5Y1iWK2Hp1ThdXC9FyfevjoO7D11I24tXa6qFoq5gxOsoAIzYLkcftdwTLT0ZJ8dF5ssyCxsXZR0MMDyRSF8qGBqjXPTAIRRXlJZozOW18M+St0gQ+U1Gi7ODANSuwJF+XZTMKHGkH0hN2ItIWNWhvipdbhFfbrfwzbryT47WuPo09Qkjjt85jOBrrFdAb2nWNf0W47lEXkWGyHh/u3C201HsHP2wGeEpmjFKk+stm9zD7jbPZVlyP9zToAwIqUTYt/PuZKWkRYKi70FAbvT4U+14nseO4nkLJExUn5B+GqB7B3il6IjxGBXXW9C0QTUcTpLSDieP9Z6MgHomdpXMOk7GCPoW4Rf/BQlneBDA9hsH7/8N8/ffovwPr0ebPlfw6C656bFyIkeXJOSavmGvYxvM9/JWS5/kteZfEtHiO0wRCQS/3xzUYap79EWfUYYQMxUmmxS6lB72/FN/KK6txyIDs4CtxhWik1kiW+Ne1dVOroaO/7Sh0Xam8ewc/KARaS4vkDOpCWMpIJnEs7cnU45MOVn0GWLunXbZKp1QRfZl13oz4ik6T5o31PbgvvhKuxR4hJdsW7wN4jxOa+7VzCLFQPkFiKOE0yeS2G3ZlLhdf1/CxRRLCQqggD3lp91oo5UE4K0hhzr2I0ZZ77r1eWVGxw76ooVFsfJcKsz12wqzTVc0iyRB2Ds9QeKN4aShdxSmiHU7aQNYANS0vfrPWatCOUP5oRAtVkb1mJmSEjrBFWUypwBED3cCBhbdxEv+S7O5sdxdc8ODyIJTU0SHTXEPgl37n6sbv1R/XioE5cpA915GblwC1MEfKZulBHxVJVCBI2wsDB+EWGbJGXS96Fdr5+VX4OxmLX8+3toM3ba5MT+ERufTYEZMlx70YfahsoEN0YQjei1BuwiTA1r17duQ3Nb3QXjO6E+bth5SPGAQu58pG7hqNN9B3/ostwzT0q+Aal2mbvN8coS6goc51HebqaYtAIcx6kqlJdJwSJgjpIYdr18LbwE1oTNn9M2t7jcfa8nq+7DRKGAmfOqpMu7vCCpLgLirz4E86ANBZFuPIiO5RS6rbJcAN0dlRzfLauoEFV5FlLyry4IlGYfsXtKiE3wLb+uLkP5IuWGdp3oO34kyx1omz9KYmgXnqG6nn/44fPA/jooNUDeep9c2sONi6UxSFgZg8XujmU9I5A58Hmw9rYI+vNm4fN5sN6AKDTzULGr4OSbxGtkFWbbiAOKJTOFaxp/Yf4KUUZHUNU6B5acY8SmQDb3D143PqKaGXyw7s395a2tU/izA2Pvvu0nmmZxPU9qa+NBoCiKLl5i2a0B8x9nFMmaMXeXbS9jTPFX+GhdvZGQOEXfFtkcSAy9Ywcy5VfJV1sNwy7VQ16jz/1WTT3vLlqsrps4acMToVngj84a7r+/Pav6x+g019m16+0Y/g5NrC+LqGO0Wk50P7XFWvUUMX/UBPflG3XS3px7TpH3z0lwrJN53Wdyt5wjelXm3jOIDq3Y9Aau1MemvaoHI7K4nx5tAKpU1fDYKlkFpVn+vmyy1B9APXXZWA9HMFYdAmKcEjuSa1vs1xvyVLd7uQ+L2f4QopS4pfxn4N/Df8tHCwE967+IvbyXjwhRWdP3VFsE62XH77/9xAADOkvYfBelGkibXXllregEYnvlDsD0yeCphDOnClFn2fOadrNsWSiRPkL0vT1DWf5q+uXo8hidRSPLo+GoVyiYdg/BYmvic3IQuwN3PDXndvQ/L6GIunSMSKzmmJie3CpjSMLO8oVm7rX6mp8opY4VxHaGjfFyjfZC3t4tvDa5o/53iA2jygHe2n+adXFGYuz2pKlspUvRVKeVxNrMiJv78RZOVYPFwp2eMhpgTLu3H6IslR3QSbxmPwJLx4jyX+Ow2At6/uSTuviauGqNxsUUNwnnmS0vlk/09frikB0FMYRrctK6UXAbEGv7esiTJTRbHXUlxkM0gvQPkvxuJoW1zGAUjw213HeDOouiJICXnRVJRrrrZJDJjTesor0kJeJPp7Q6juGRYCqFXiGtwA3LdCyDd+GvTYF2XG8SnQznSG8d0Eqxd48GBqGOk9pqIqqcoUR9TPcXuMg4ZaI7jtldzKW0iiM2HjAKgq1XoXmnTvSZaASpSpZfgXWMKExEF+bMWOQlCwTkS4/Z1ow1jSITefuI/CTbgzOztheU69HtB/Hcue8k1GO+rUdS61Lv2uB3oo8H0l+wmOH5uDB2QPQOS491IcORC+oWpdua116qTi8sQPUBVgxeBelH0ZR7dIk2j7K8y1xeDaFIYRhJoGo5H/kRUvkpmg+rN/Abfxu/YaHBmmcUbtEKz8EZFGG6ij++MO/XMoCzsfcJ9lBlkWJUjZ9XniOwjxSxVUnoRYF7ODPb9dNOu/V55sZ1MuLvv3TQ+haPWzYKIYpsg7SIfpXJLBvOgJoG/JwjOGQ5zHKn1OTxrugyh4zlP5G0n02osS6teQWQfr5aOzo1txRSDSN6T0OGkmIUg2pDNNA/PKeTn0V2gYCdyc0xxB9ZHhsq8JD5hL6mXzGzVvaYqitmjK2r0Z9sW2KgyXRyY29//T24/1MTxP/0r/BBjbXT/cB+i7xeOHKv2ssBc7NVTd5XZPsA0wueB8d8wceGMEob9LMUk9IPXLAQ5Md/0BRo443xBmiO+9RP8HMY3XIP/B/AwAzLgXE"
 }
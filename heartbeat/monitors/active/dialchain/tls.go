@@ -18,6 +18,7 @@
 package dialchain
 
 import (
+	"context"
 	cryptoTLS "crypto/tls"
 	"fmt"
 	"net"
@@ -42,17 +43,40 @@ func TLSLayer(cfg *tlscommon.TLSConfig, to time.Duration) Layer {
 		next = startTimerAfterDial(&timer, next)
 
 		dialer := transport.TLSDialer(next, cfg, to, logp.NewLogger(""))
-		return afterDial(dialer, func(conn net.Conn) (net.Conn, error) {
-			tlsConn, ok := conn.(*cryptoTLS.Conn)
-			if !ok {
-				panic(fmt.Sprintf("TLS afterDial received a non-tls connection %t. This should never happen", conn))
-			}
-			connState := tlsConn.ConnectionState()
-			timer.stop()
-
-			tlsmeta.AddTLSMetadata(event.Fields, connState, timer.duration())
-
-			return conn, nil
-		}), nil
+		return afterTLSHandshake(event, &timer, dialer), nil
 	}
 }
+
+// TLSLayerALPN configures a TLS layer like TLSLayer, that additionally
+// negotiates one of the given application protocols, e.g. h2 for gRPC.
+func TLSLayerALPN(cfg *tlscommon.TLSConfig, to time.Duration, protos ...string) Layer {
+	return func(event *beat.Event, next transport.Dialer) (transport.Dialer, error) {
+		var timer timer
+		next = startTimerAfterDial(&timer, next)
+
+		h2Dialer, err := transport.TLSDialerH2(next, cfg, to)
+		if err != nil {
+			return nil, err
+		}
+		alpn := &cryptoTLS.Config{NextProtos: protos}
+		dialer := transport.DialerFunc(func(ctx context.Context, network, address string) (net.Conn, error) {
+			return h2Dialer.DialContext(ctx, network, address, alpn)
+		})
+		return afterTLSHandshake(event, &timer, dialer), nil
+	}
+}
+
+func afterTLSHandshake(event *beat.Event, timer *timer, dialer transport.Dialer) transport.Dialer {
+	return afterDial(dialer, func(conn net.Conn) (net.Conn, error) {
+		tlsConn, ok := conn.(*cryptoTLS.Conn)
+		if !ok {
+			panic(fmt.Sprintf("TLS afterDial received a non-tls connection %t. This should never happen", conn))
+		}
+		connState := tlsConn.ConnectionState()
+		timer.stop()
+
+		tlsmeta.AddTLSMetadata(event.Fields, connState, timer.duration())
+
+		return conn, nil
+	})
+}
@@ -0,0 +1,181 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// responseCheck validates a DNS response against the check settings.
+type responseCheck struct {
+	responseCodes  []int
+	answers        []string
+	answersInclude []string
+	ttl            ttlConfig
+}
+
+func makeResponseCheck(config *checkConfig) (*responseCheck, error) {
+	c := &responseCheck{
+		answers:        config.Answers,
+		answersInclude: config.AnswersInclude,
+		ttl:            config.TTL,
+	}
+	for _, s := range config.ResponseCodes {
+		rcode, err := parseResponseCode(s)
+		if err != nil {
+			return nil, err
+		}
+		c.responseCodes = append(c.responseCodes, rcode)
+	}
+	return c, nil
+}
+
+// check validates the response to a query of the given record type. Only
+// answers of that type are compared, so CNAME records leading to the
+// requested records don't need to be listed.
+func (c *responseCheck) check(resp *dns.Msg, qtype uint16) error {
+	if len(c.responseCodes) > 0 && !slices.Contains(c.responseCodes, resp.Rcode) {
+		expected := make([]string, len(c.responseCodes))
+		for i, rcode := range c.responseCodes {
+			expected[i] = dns.RcodeToString[rcode]
+		}
+		return fmt.Errorf("received response code %s, expected one of %v", dns.RcodeToString[resp.Rcode], expected)
+	}
+
+	var values []string
+	for _, rr := range resp.Answer {
+		if rr.Header().Rrtype != qtype {
+			continue
+		}
+		values = append(values, normalizeValue(qtype, answerData(rr)))
+
+		ttl := time.Duration(rr.Header().Ttl) * time.Second
+		if ttl < c.ttl.Min || (c.ttl.Max > 0 && ttl > c.ttl.Max) {
+			return fmt.Errorf("TTL %v of answer '%s' is out of bounds", ttl, answerData(rr))
+		}
+	}
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	if c.answers != nil {
+		expected := normalizeValues(qtype, c.answers)
+		if !slices.Equal(values, expected) {
+			return fmt.Errorf("received answers %v, expected %v", values, expected)
+		}
+	}
+	if c.answersInclude != nil {
+		for _, expected := range normalizeValues(qtype, c.answersInclude) {
+			if _, found := slices.BinarySearch(values, expected); !found {
+				return fmt.Errorf("received answers %v, expected them to include '%s'", values, expected)
+			}
+		}
+	}
+	return nil
+}
+
+// answerData returns the data of a resource record in presentation format,
+// e.g. the address of an A record or "10 mail.example.com." for MX.
+func answerData(rr dns.RR) string {
+	switch v := rr.(type) {
+	case *dns.A:
+		return v.A.String()
+	case *dns.AAAA:
+		return v.AAAA.String()
+	case *dns.TXT:
+		return strings.Join(v.Txt, "")
+	default:
+		return strings.TrimPrefix(rr.String(), rr.Header().String())
+	}
+}
+
+// normalizeValue makes answers comparable with configured values, which may
+// differ in case, IP address notation and trailing dots of domain names.
+func normalizeValue(qtype uint16, value string) string {
+	value = strings.TrimSpace(value)
+	switch qtype {
+	case dns.TypeTXT:
+		return value
+	case dns.TypeA, dns.TypeAAAA:
+		if ip := net.ParseIP(value); ip != nil {
+			return ip.String()
+		}
+	}
+	return strings.TrimSuffix(strings.ToLower(value), ".")
+}
+
+func normalizeValues(qtype uint16, values []string) []string {
+	normalized := make([]string, len(values))
+	for i, v := range values {
+		normalized[i] = normalizeValue(qtype, v)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized)
+}
+
+// responseFields returns the ECS dns fields describing the response.
+func responseFields(resp *dns.Msg) mapstr.M {
+	answers := make([]mapstr.M, len(resp.Answer))
+	for i, rr := range resp.Answer {
+		hdr := rr.Header()
+		answers[i] = mapstr.M{
+			"name":  hdr.Name,
+			"type":  dns.TypeToString[hdr.Rrtype],
+			"class": dns.ClassToString[hdr.Class],
+			"ttl":   hdr.Ttl,
+			"data":  answerData(rr),
+		}
+	}
+
+	fields := mapstr.M{
+		"id":            resp.Id,
+		"response_code": dns.RcodeToString[resp.Rcode],
+		"answers":       answers,
+	}
+	if flags := headerFlags(resp); len(flags) > 0 {
+		fields["header_flags"] = flags
+	}
+	return fields
+}
+
+func headerFlags(resp *dns.Msg) []string {
+	var flags []string
+	for _, f := range []struct {
+		set  bool
+		name string
+	}{
+		{resp.Authoritative, "AA"},
+		{resp.Truncated, "TC"},
+		{resp.RecursionDesired, "RD"},
+		{resp.RecursionAvailable, "RA"},
+		{resp.AuthenticatedData, "AD"},
+		{resp.CheckingDisabled, "CD"},
+	} {
+		if f.set {
+			flags = append(flags, f.name)
+		}
+	}
+	return flags
+}
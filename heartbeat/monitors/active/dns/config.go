@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+type config struct {
+	// Resolvers to query, e.g. 8.8.8.8, tcp://ns1.example.com,
+	// tls://1.1.1.1 or https://dns.example.com/dns-query.
+	Hosts []string `config:"hosts" validate:"required"`
+
+	Query queryConfig `config:"query"`
+	Check checkConfig `config:"check"`
+
+	// configure tls for DNS over TLS and DNS over HTTPS
+	TLS *tlscommon.Config `config:"ssl"`
+
+	Timeout time.Duration `config:"timeout"`
+}
+
+type queryConfig struct {
+	Names            []string `config:"names" validate:"required"`
+	Types            []string `config:"types"`
+	RecursionDesired bool     `config:"recursion_desired"`
+}
+
+type checkConfig struct {
+	// Accepted response codes, e.g. NOERROR or NXDOMAIN.
+	ResponseCodes []string `config:"response_code"`
+
+	// Answers must match this set exactly, ignoring order.
+	Answers []string `config:"answers"`
+
+	// Answers must include all of these values.
+	AnswersInclude []string `config:"answers_include"`
+
+	TTL ttlConfig `config:"ttl"`
+}
+
+type ttlConfig struct {
+	Min time.Duration `config:"min"`
+	Max time.Duration `config:"max"`
+}
+
+func defaultConfig() config {
+	return config{
+		Timeout: 16 * time.Second,
+		Query: queryConfig{
+			Types:            []string{"A"},
+			RecursionDesired: true,
+		},
+		Check: checkConfig{
+			ResponseCodes: []string{"NOERROR"},
+		},
+	}
+}
+
+func (c *config) Validate() error {
+	if len(c.Query.Types) == 0 {
+		return errors.New("at least one query type is required")
+	}
+	for _, t := range c.Query.Types {
+		if _, err := parseType(t); err != nil {
+			return err
+		}
+	}
+	for _, rcode := range c.Check.ResponseCodes {
+		if _, err := parseResponseCode(rcode); err != nil {
+			return err
+		}
+	}
+	if c.Check.TTL.Min < 0 || c.Check.TTL.Max < 0 {
+		return errors.New("check.ttl bounds must not be negative")
+	}
+	if c.Check.TTL.Max > 0 && c.Check.TTL.Min > c.Check.TTL.Max {
+		return fmt.Errorf("check.ttl.min (%v) must not be larger than check.ttl.max (%v)", c.Check.TTL.Min, c.Check.TTL.Max)
+	}
+	return nil
+}
+
+func parseType(s string) (uint16, error) {
+	t, ok := dns.StringToType[strings.ToUpper(s)]
+	if !ok {
+		return 0, fmt.Errorf("unknown DNS record type '%s'", s)
+	}
+	return t, nil
+}
+
+func parseResponseCode(s string) (int, error) {
+	rcode, ok := dns.StringToRcode[strings.ToUpper(s)]
+	if !ok {
+		return 0, fmt.Errorf("unknown DNS response code '%s'", s)
+	}
+	return rcode, nil
+}
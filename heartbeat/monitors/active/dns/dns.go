@@ -0,0 +1,146 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"net/url"
+
+	"github.com/miekg/dns"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers/wraputil"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+func init() {
+	plugin.Register("dns", create, "synthetics/dns")
+}
+
+var debugf = logp.MakeDebug("dns")
+
+func create(
+	name string,
+	cfg *conf.C,
+) (p plugin.Plugin, err error) {
+	jf, err := newJobFactory(cfg)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	js, err := jf.makeJobs()
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	return plugin.Plugin{Jobs: js, Endpoints: len(js)}, nil
+}
+
+// jobFactory creates one job per resolver, name and record type.
+type jobFactory struct {
+	config    config
+	tlsConfig *tlscommon.TLSConfig
+	check     *responseCheck
+}
+
+func newJobFactory(commonCfg *conf.C) (*jobFactory, error) {
+	jf := &jobFactory{config: defaultConfig()}
+	if err := commonCfg.Unpack(&jf.config); err != nil {
+		return nil, err
+	}
+
+	var err error
+	jf.tlsConfig, err = tlscommon.LoadTLSConfig(jf.config.TLS, logp.NewLogger("dns"))
+	if err != nil {
+		return nil, err
+	}
+
+	jf.check, err = makeResponseCheck(&jf.config.Check)
+	if err != nil {
+		return nil, err
+	}
+	return jf, nil
+}
+
+// makeJobs returns the actual schedulable jobs for this monitor.
+func (jf *jobFactory) makeJobs() ([]jobs.Job, error) {
+	var js []jobs.Job
+	for _, host := range jf.config.Hosts {
+		u, err := parseResolverURL(host)
+		if err != nil {
+			return nil, err
+		}
+		r := newResolver(u, jf.config.Timeout, jf.tlsConfig.BuildModuleClientConfig(u.Hostname()))
+
+		for _, name := range jf.config.Query.Names {
+			for _, typ := range jf.config.Query.Types {
+				qtype, err := parseType(typ)
+				if err != nil {
+					return nil, err
+				}
+				js = append(js, jf.makeQueryJob(r, u, name, qtype))
+			}
+		}
+	}
+	return js, nil
+}
+
+func (jf *jobFactory) makeQueryJob(r *resolver, u *url.URL, name string, qtype uint16) jobs.Job {
+	return wraputil.WithURLField(u, jobs.MakeSimpleJob(func(event *beat.Event) error {
+		return jf.query(event, r, name, qtype)
+	}))
+}
+
+// query sends a single query and validates the response.
+func (jf *jobFactory) query(event *beat.Event, r *resolver, name string, qtype uint16) error {
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(name), qtype)
+	msg.RecursionDesired = jf.config.Query.RecursionDesired
+
+	eventext.MergeEventFields(event, mapstr.M{
+		"dns": mapstr.M{
+			"question": mapstr.M{
+				"name":  msg.Question[0].Name,
+				"type":  dns.TypeToString[qtype],
+				"class": dns.ClassToString[dns.ClassINET],
+			},
+		},
+	})
+
+	resp, rtt, err := r.exchange(msg)
+	if err != nil {
+		debugf("query for %s %s to %s failed with: %v", name, dns.TypeToString[qtype], r.url, err)
+		return reason.IOFailed(err)
+	}
+
+	fields := responseFields(resp)
+	fields["rtt"] = look.RTT(rtt)
+	eventext.MergeEventFields(event, mapstr.M{"dns": fields})
+
+	if err := jf.check.check(resp, qtype); err != nil {
+		return reason.MakeValidateError(err)
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/hbtestllext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// testHandler answers A queries for example.com and NXDOMAIN otherwise.
+var testHandler = dns.HandlerFunc(func(w dns.ResponseWriter, req *dns.Msg) {
+	resp := new(dns.Msg)
+	resp.SetReply(req)
+	resp.Authoritative = true
+	q := req.Question[0]
+	switch {
+	case q.Name == "example.com." && q.Qtype == dns.TypeA:
+		resp.Answer = []dns.RR{
+			&dns.CNAME{
+				Hdr:    dns.RR_Header{Name: q.Name, Rrtype: dns.TypeCNAME, Class: dns.ClassINET, Ttl: 60},
+				Target: "www.example.com.",
+			},
+			&dns.A{
+				Hdr: dns.RR_Header{Name: "www.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 300},
+				A:   net.IPv4(192, 0, 2, 1),
+			},
+			&dns.A{
+				Hdr: dns.RR_Header{Name: "www.example.com.", Rrtype: dns.TypeA, Class: dns.ClassINET, Ttl: 300},
+				A:   net.IPv4(192, 0, 2, 2),
+			},
+		}
+	case q.Name == "example.com.":
+	default:
+		resp.Rcode = dns.RcodeNameError
+	}
+	_ = w.WriteMsg(resp)
+})
+
+func startUDPServer(t *testing.T) string {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &dns.Server{PacketConn: pc, Handler: testHandler}
+	startServer(t, server)
+	return pc.LocalAddr().String()
+}
+
+func startTCPServer(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := &dns.Server{Listener: l, Handler: testHandler}
+	startServer(t, server)
+	return l.Addr().String()
+}
+
+func startServer(t *testing.T, server *dns.Server) {
+	started := make(chan struct{})
+	server.NotifyStartedFunc = func() { close(started) }
+	go func() { _ = server.ActivateAndServe() }()
+	<-started
+	t.Cleanup(func() { _ = server.Shutdown() })
+}
+
+// dohHandler serves testHandler over HTTPS as described in RFC 8484.
+func dohHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, dohMediaType, r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := new(dns.Msg)
+		if err := req.Unpack(body); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rec := &recordingWriter{}
+		testHandler.ServeDNS(rec, req)
+		packed, err := rec.msg.Pack()
+		require.NoError(t, err)
+		w.Header().Set("Content-Type", dohMediaType)
+		_, _ = w.Write(packed)
+	})
+}
+
+type recordingWriter struct {
+	dns.ResponseWriter
+	msg *dns.Msg
+}
+
+func (w *recordingWriter) WriteMsg(m *dns.Msg) error {
+	w.msg = m
+	return nil
+}
+
+func testDNSCheck(t *testing.T, cfg mapstr.M) []*beat.Event {
+	config, err := conf.NewConfigFrom(cfg)
+	require.NoError(t, err)
+
+	p, err := create("dns", config)
+	require.NoError(t, err)
+
+	sched := schedule.MustParse("@every 1s")
+	js := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "dns", Schedule: sched, Timeout: 1}, nil)
+	require.Equal(t, len(js), p.Endpoints)
+
+	events := make([]*beat.Event, len(js))
+	for i, job := range js {
+		events[i] = &beat.Event{}
+		_, err = job(events[i])
+		require.NoError(t, err)
+	}
+	return events
+}
+
+func upChecks(qtype string) validator.Validator {
+	return lookslike.Compose(
+		hbtest.SummaryStateChecks(1, 0),
+		lookslike.MustCompile(map[string]interface{}{
+			"monitor.status": "up",
+			"dns": map[string]interface{}{
+				"question.name":  "example.com.",
+				"question.type":  qtype,
+				"response_code":  "NOERROR",
+				"rtt.us":         hbtestllext.IsInt64,
+				"answers":        isdef.KeyPresent,
+				"header_flags":   []string{"AA"},
+				"id":             isdef.KeyPresent,
+				"question.class": "IN",
+			},
+		}),
+	)
+}
+
+func TestTransports(t *testing.T) {
+	doh := httptest.NewTLSServer(dohHandler(t))
+	defer doh.Close()
+
+	for name, host := range map[string]string{
+		"udp":   startUDPServer(t),
+		"tcp":   "tcp://" + startTCPServer(t),
+		"https": doh.URL + "/dns-query",
+	} {
+		t.Run(name, func(t *testing.T) {
+			events := testDNSCheck(t, mapstr.M{
+				"hosts":                   host,
+				"query.names":             "example.com",
+				"check.answers":           []string{"192.0.2.2", "192.0.2.1"},
+				"ssl.verification_mode":   "none",
+				"timeout":                 "1s",
+				"check.ttl.min":           "1m",
+				"check.ttl.max":           "10m",
+				"query.recursion_desired": false,
+			})
+			require.Len(t, events, 1)
+			testslike.Test(t, upChecks("A"), events[0].Fields)
+
+			answers, err := events[0].GetValue("dns.answers")
+			require.NoError(t, err)
+			require.Len(t, answers, 3)
+			assert.Equal(t, "www.example.com.", answers.([]mapstr.M)[0]["data"])
+			assert.Equal(t, "192.0.2.1", answers.([]mapstr.M)[1]["data"])
+		})
+	}
+}
+
+func TestChecks(t *testing.T) {
+	addr := startUDPServer(t)
+
+	tests := map[string]struct {
+		cfg     mapstr.M
+		errType string
+		message string
+	}{
+		"answers include": {
+			cfg: mapstr.M{"check.answers_include": "192.0.2.1"},
+		},
+		"empty answers": {
+			cfg: mapstr.M{"query.types": "MX", "check.answers": []string{}},
+		},
+		"answers mismatch": {
+			cfg:     mapstr.M{"check.answers": "192.0.2.1"},
+			errType: "validate",
+			message: "received answers [192.0.2.1 192.0.2.2], expected [192.0.2.1]",
+		},
+		"answers missing": {
+			cfg:     mapstr.M{"check.answers_include": "192.0.2.3"},
+			errType: "validate",
+			message: "expected them to include '192.0.2.3'",
+		},
+		"ttl too large": {
+			cfg:     mapstr.M{"check.ttl.max": "2m"},
+			errType: "validate",
+			message: "TTL 5m0s of answer '192.0.2.1' is out of bounds",
+		},
+		"response code": {
+			cfg:     mapstr.M{"query.names": "missing.example.com"},
+			errType: "validate",
+			message: "received response code NXDOMAIN, expected one of [NOERROR]",
+		},
+		"expected response code": {
+			cfg: mapstr.M{"query.names": "missing.example.com", "check.response_code": "NXDOMAIN"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := mapstr.M{
+				"hosts":       addr,
+				"query.names": "example.com",
+				"timeout":     "1s",
+			}
+			cfg.DeepUpdate(test.cfg)
+			events := testDNSCheck(t, cfg)
+			require.Len(t, events, 1)
+
+			if test.errType == "" {
+				testslike.Test(t, hbtest.SummaryStateChecks(1, 0), events[0].Fields)
+				return
+			}
+			testslike.Test(t, lookslike.Compose(
+				hbtest.SummaryStateChecks(0, 1),
+				hbtest.ErrorChecks(test.message, test.errType),
+			), events[0].Fields)
+		})
+	}
+}
+
+func TestConnectionFailure(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	events := testDNSCheck(t, mapstr.M{
+		"hosts":       "tcp://" + addr,
+		"query.names": "example.com",
+		"timeout":     "1s",
+	})
+	require.Len(t, events, 1)
+	testslike.Test(t, lookslike.Compose(
+		hbtest.SummaryStateChecks(0, 1),
+		hbtest.ErrorChecks("connection refused", "io"),
+		lookslike.MustCompile(map[string]interface{}{
+			"dns.question.name": "example.com.",
+			"url.scheme":        "tcp",
+		}),
+	), events[0].Fields)
+}
+
+func TestJobsPerQuery(t *testing.T) {
+	events := testDNSCheck(t, mapstr.M{
+		"hosts":       []string{startUDPServer(t), "tcp://" + startTCPServer(t)},
+		"query.names": []string{"example.com", "example.org"},
+		"query.types": []string{"A", "aaaa"},
+		"timeout":     "1s",
+	})
+	require.Len(t, events, 8)
+}
+
+func TestParseResolverURL(t *testing.T) {
+	tests := map[string]string{
+		"8.8.8.8":                              "udp://8.8.8.8:53",
+		"2001:db8::1":                          "udp://[2001:db8::1]:53",
+		"[2001:db8::1]:5353":                   "udp://[2001:db8::1]:5353",
+		"ns1.example.com":                      "udp://ns1.example.com:53",
+		"tcp://ns1.example.com":                "tcp://ns1.example.com:53",
+		"tls://1.1.1.1":                        "tls://1.1.1.1:853",
+		"https://dns.example.com/dns-query":    "https://dns.example.com/dns-query",
+		"https://dns.example.com:8443/resolve": "https://dns.example.com:8443/resolve",
+	}
+	for host, expected := range tests {
+		u, err := parseResolverURL(host)
+		if assert.NoError(t, err, host) {
+			assert.Equal(t, expected, u.String(), host)
+		}
+	}
+
+	for _, host := range []string{"quic://1.1.1.1", "udp://:53", "udp://%zz"} {
+		_, err := parseResolverURL(host)
+		assert.Error(t, err, host)
+	}
+}
+
+func TestConfigValidation(t *testing.T) {
+	for name, cfg := range map[string]mapstr.M{
+		"missing names":    {"hosts": "8.8.8.8"},
+		"unknown type":     {"hosts": "8.8.8.8", "query.names": "example.com", "query.types": "NOPE"},
+		"unknown rcode":    {"hosts": "8.8.8.8", "query.names": "example.com", "check.response_code": "NOPE"},
+		"ttl bounds":       {"hosts": "8.8.8.8", "query.names": "example.com", "check.ttl.min": "10m", "check.ttl.max": "1m"},
+		"invalid scheme":   {"hosts": "ftp://8.8.8.8", "query.names": "example.com"},
+		"missing resolver": {"query.names": "example.com"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := create("dns", conf.MustNewConfigFrom(cfg))
+			assert.Error(t, err)
+		})
+	}
+}
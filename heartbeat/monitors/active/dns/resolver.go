@@ -0,0 +1,174 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package dns
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+)
+
+const dohMediaType = "application/dns-message"
+
+// maxDoHResponseSize is the largest DNS message, responses over HTTPS
+// can't be any larger.
+const maxDoHResponseSize = 65535
+
+// resolver sends queries to a single DNS server over UDP, TCP, TLS or HTTPS.
+type resolver struct {
+	url     *url.URL
+	timeout time.Duration
+	tls     *tls.Config
+	http    *http.Client
+}
+
+// parseResolverURL parses a resolver host into a URL. Hosts without a scheme
+// are queried over UDP, and the default port of the transport is added when
+// missing.
+func parseResolverURL(host string) (*url.URL, error) {
+	if !strings.Contains(host, "://") {
+		if ip := net.ParseIP(host); ip != nil && ip.To4() == nil {
+			host = "[" + host + "]"
+		}
+		host = "udp://" + host
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid resolver '%s': %w", host, err)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid resolver '%s': missing host", host)
+	}
+
+	var defaultPort string
+	switch u.Scheme {
+	case "udp", "tcp":
+		defaultPort = "53"
+	case "tls":
+		defaultPort = "853"
+	case "https":
+		return u, nil
+	default:
+		return nil, fmt.Errorf("invalid resolver '%s': unsupported scheme '%s', use udp, tcp, tls or https", host, u.Scheme)
+	}
+	if u.Port() == "" {
+		u.Host = net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+	return u, nil
+}
+
+func newResolver(u *url.URL, timeout time.Duration, tlsConfig *tls.Config) *resolver {
+	r := &resolver{url: u, timeout: timeout, tls: tlsConfig}
+	if u.Scheme == "https" {
+		r.http = &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				Proxy:             http.ProxyFromEnvironment,
+				TLSClientConfig:   tlsConfig,
+				DisableKeepAlives: true,
+			},
+		}
+	}
+	return r
+}
+
+// exchange sends the query and returns the response along with the round
+// trip time.
+func (r *resolver) exchange(msg *dns.Msg) (*dns.Msg, time.Duration, error) {
+	switch r.url.Scheme {
+	case "https":
+		return r.exchangeHTTPS(msg)
+	case "tls":
+		return r.exchangeConn("tcp-tls", msg)
+	case "tcp":
+		return r.exchangeConn("tcp", msg)
+	default:
+		resp, rtt, err := r.exchangeConn("udp", msg)
+		if err == nil && resp.Truncated {
+			// The answer didn't fit into a datagram, ask again over TCP
+			// like a stub resolver would.
+			return r.exchangeConn("tcp", msg)
+		}
+		return resp, rtt, err
+	}
+}
+
+func (r *resolver) exchangeConn(network string, msg *dns.Msg) (*dns.Msg, time.Duration, error) {
+	client := &dns.Client{
+		Net:     network,
+		Timeout: r.timeout,
+	}
+	if network == "tcp-tls" {
+		client.TLSConfig = r.tls
+	}
+	return client.Exchange(msg, r.url.Host)
+}
+
+// exchangeHTTPS sends the query as described in RFC 8484.
+func (r *resolver) exchangeHTTPS(msg *dns.Msg) (*dns.Msg, time.Duration, error) {
+	// A zero ID makes responses cacheable by HTTP caches.
+	query := msg.Copy()
+	query.Id = 0
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, r.url.String(), bytes.NewReader(packed))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Content-Type", dohMediaType)
+	req.Header.Set("Accept", dohMediaType)
+
+	start := time.Now()
+	resp, err := r.http.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxDoHResponseSize+1))
+	rtt := time.Since(start)
+	if err != nil {
+		return nil, rtt, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, rtt, fmt.Errorf("DNS over HTTPS request failed with status %d", resp.StatusCode)
+	}
+	if len(body) > maxDoHResponseSize {
+		return nil, rtt, errors.New("DNS over HTTPS response is too large")
+	}
+
+	answer := new(dns.Msg)
+	if err := answer.Unpack(body); err != nil {
+		return nil, rtt, fmt.Errorf("invalid DNS over HTTPS response: %w", err)
+	}
+	answer.Id = msg.Id
+	return answer, rtt, nil
+}
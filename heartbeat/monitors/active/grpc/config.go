@@ -0,0 +1,98 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+const (
+	methodCheck = "check"
+	methodWatch = "watch"
+)
+
+type config struct {
+	// Servers in host:port, grpc://host:port or grpcs://host:port form.
+	Hosts []string `config:"hosts" validate:"required"`
+
+	Mode monitors.IPSettings `config:",inline"`
+
+	// Services to check, the empty string checks the overall server health.
+	Services []string `config:"services"`
+
+	// RPC of the grpc.health.v1.Health service to call, check or watch.
+	Method string `config:"method"`
+
+	// Metadata sent with the health check.
+	Headers map[string]string `config:"headers"`
+
+	// configure tls
+	TLS *tlscommon.Config `config:"ssl"`
+
+	Timeout time.Duration `config:"timeout"`
+
+	Check checkConfig `config:"check"`
+}
+
+type checkConfig struct {
+	// Accepted serving statuses.
+	Status []string `config:"status"`
+}
+
+func defaultConfig() config {
+	return config{
+		Mode:     monitors.DefaultIPSettings,
+		Services: []string{""},
+		Method:   methodCheck,
+		Timeout:  16 * time.Second,
+		Check: checkConfig{
+			Status: []string{healthpb.HealthCheckResponse_SERVING.String()},
+		},
+	}
+}
+
+func (c *config) Validate() error {
+	c.Method = strings.ToLower(c.Method)
+	if c.Method != methodCheck && c.Method != methodWatch {
+		return fmt.Errorf("invalid method '%s', use %s or %s", c.Method, methodCheck, methodWatch)
+	}
+	if len(c.Services) == 0 {
+		return fmt.Errorf("at least one service is required, use \"\" to check the server health")
+	}
+	for _, s := range c.Check.Status {
+		if _, err := parseStatus(s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseStatus(s string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	v, ok := healthpb.HealthCheckResponse_ServingStatus_value[strings.ToUpper(s)]
+	if !ok {
+		return 0, fmt.Errorf("unknown serving status '%s'", s)
+	}
+	return healthpb.HealthCheckResponse_ServingStatus(v), nil
+}
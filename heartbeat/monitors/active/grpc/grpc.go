@@ -0,0 +1,335 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers/wraputil"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/version"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/transport"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+func init() {
+	plugin.Register("grpc", create, "synthetics/grpc")
+}
+
+var debugf = logp.MakeDebug("grpc")
+
+var errConnectFailed = errors.New("failed to establish gRPC connection")
+
+func create(
+	name string,
+	cfg *conf.C,
+) (p plugin.Plugin, err error) {
+	return createWithResolver(cfg, monitors.NewStdResolver())
+}
+
+func createWithResolver(
+	cfg *conf.C,
+	resolver monitors.Resolver,
+) (p plugin.Plugin, err error) {
+	jf, err := newJobFactory(cfg, resolver)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	js, err := jf.makeJobs()
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	return plugin.Plugin{Jobs: js, Endpoints: len(js)}, nil
+}
+
+// jobFactory creates one job per host and service.
+type jobFactory struct {
+	config        config
+	tlsConfig     *tlscommon.TLSConfig
+	defaultScheme string
+	metadata      metadata.MD
+	statuses      []healthpb.HealthCheckResponse_ServingStatus
+	resolver      monitors.Resolver
+}
+
+func newJobFactory(commonCfg *conf.C, resolver monitors.Resolver) (*jobFactory, error) {
+	jf := &jobFactory{config: defaultConfig(), resolver: resolver}
+	if err := commonCfg.Unpack(&jf.config); err != nil {
+		return nil, err
+	}
+
+	var err error
+	jf.tlsConfig, err = tlscommon.LoadTLSConfig(jf.config.TLS, logp.NewLogger("grpc"))
+	if err != nil {
+		return nil, err
+	}
+
+	jf.defaultScheme = "grpc"
+	if jf.tlsConfig != nil {
+		jf.defaultScheme = "grpcs"
+	}
+
+	jf.metadata = metadata.New(jf.config.Headers)
+	for _, s := range jf.config.Check.Status {
+		st, err := parseStatus(s)
+		if err != nil {
+			return nil, err
+		}
+		jf.statuses = append(jf.statuses, st)
+	}
+	return jf, nil
+}
+
+// parseHostURL parses a configured host. Hosts without scheme use TLS if
+// the ssl settings are set.
+func parseHostURL(host, defaultScheme string) (*url.URL, error) {
+	if !strings.Contains(host, "://") {
+		host = defaultScheme + "://" + host
+	}
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid host '%s': %w", host, err)
+	}
+	if u.Scheme != "grpc" && u.Scheme != "grpcs" {
+		return nil, fmt.Errorf("invalid host '%s': unsupported scheme '%s', use grpc or grpcs", host, u.Scheme)
+	}
+	if u.Hostname() == "" || u.Port() == "" {
+		return nil, fmt.Errorf("invalid host '%s': host and port are required", host)
+	}
+	return u, nil
+}
+
+// makeJobs returns the actual schedulable jobs for this monitor.
+func (jf *jobFactory) makeJobs() ([]jobs.Job, error) {
+	var js []jobs.Job
+	for _, host := range jf.config.Hosts {
+		u, err := parseHostURL(host, jf.defaultScheme)
+		if err != nil {
+			return nil, err
+		}
+		for _, service := range jf.config.Services {
+			job, err := jf.makeServiceJob(u, service)
+			if err != nil {
+				return nil, err
+			}
+			js = append(js, wraputil.WithURLField(u, job))
+		}
+	}
+	return js, nil
+}
+
+// makeServiceJob makes a job that resolves the host and checks the service
+// on each resolved IP, as configured by mode.
+func (jf *jobFactory) makeServiceJob(u *url.URL, service string) (jobs.Job, error) {
+	return monitors.MakeByHostJob(
+		u.Hostname(),
+		jf.config.Mode,
+		jf.resolver,
+		monitors.MakePingIPFactory(func(event *beat.Event, ip *net.IPAddr) error {
+			return jf.check(event, net.JoinHostPort(ip.String(), u.Port()), u, service)
+		}))
+}
+
+// dialerChain connects to dialAddr, and when TLS is used verifies the server
+// certificate against the hostname of the canonical URL.
+func (jf *jobFactory) dialerChain(dialAddr string, canonicalURL *url.URL) *dialchain.DialerChain {
+	dc := &dialchain.DialerChain{
+		Net: dialchain.CreateNetDialer(jf.config.Timeout),
+	}
+	dc.AddLayer(dialchain.ConstAddrLayer(dialAddr))
+	if canonicalURL.Scheme == "grpcs" {
+		// gRPC servers require HTTP/2 to be negotiated.
+		dc.AddLayer(dialchain.TLSLayerALPN(jf.tlsConfig, jf.config.Timeout, "h2"))
+		dc.AddLayer(dialchain.ConstAddrLayer(canonicalURL.Host))
+	}
+	return dc
+}
+
+// check connects to the server and calls the health service once.
+func (jf *jobFactory) check(event *beat.Event, dialAddr string, canonicalURL *url.URL, service string) error {
+	method := "Check"
+	if jf.config.Method == methodWatch {
+		method = "Watch"
+	}
+	grpcFields := mapstr.M{
+		"service": service,
+		"method":  method,
+	}
+	defer func() {
+		eventext.MergeEventFields(event, mapstr.M{"grpc": grpcFields})
+	}()
+
+	// gRPC dials in the background, so the dialer chain reports the connect
+	// and TLS timings into a separate event that is merged once connected.
+	dial := &recordingDialer{event: &beat.Event{Fields: mapstr.M{}}}
+	var err error
+	dial.dialer, err = jf.dialerChain(dialAddr, canonicalURL).Build(dial.event)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), jf.config.Timeout)
+	defer cancel()
+
+	start := time.Now()
+	conn, err := grpc.NewClient("passthrough:///"+canonicalURL.Host,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithContextDialer(dial.dial),
+		grpc.WithUserAgent(fmt.Sprintf("Elastic-Heartbeat/%s", version.GetDefaultVersion())),
+	)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	err = waitReady(ctx, conn)
+	if dialErr := dial.mergeInto(event); err != nil && dialErr != nil {
+		err = dialErr
+	}
+	if err != nil {
+		debugf("connecting to %s failed with: %v", dialAddr, err)
+		return reason.IOFailed(err)
+	}
+
+	rpcStart := time.Now()
+	ctx = metadata.NewOutgoingContext(ctx, jf.metadata)
+	st, err := jf.call(ctx, healthpb.NewHealthClient(conn), service)
+	end := time.Now()
+
+	grpcFields["status_code"] = status.Code(err).String()
+	grpcFields["rtt"] = mapstr.M{
+		"rpc":   look.RTT(end.Sub(rpcStart)),
+		"total": look.RTT(end.Sub(start)),
+	}
+	if err != nil {
+		debugf("health check of service '%s' on %s failed with: %v", service, dialAddr, err)
+		switch status.Code(err) {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.Canceled:
+			return reason.IOFailed(err)
+		default:
+			// The server answered, but can't report the service health.
+			return reason.MakeValidateError(err)
+		}
+	}
+
+	grpcFields["status"] = st.String()
+	if !slices.Contains(jf.statuses, st) {
+		return reason.MakeValidateError(fmt.Errorf("service '%s' has status %s, expected one of %v", service, st, jf.config.Check.Status))
+	}
+	return nil
+}
+
+// call invokes the configured health RPC. Watch returns after the first
+// status update, which reports the current status.
+func (jf *jobFactory) call(ctx context.Context, client healthpb.HealthClient, service string) (healthpb.HealthCheckResponse_ServingStatus, error) {
+	req := &healthpb.HealthCheckRequest{Service: service}
+	if jf.config.Method == methodWatch {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := client.Watch(ctx, req)
+		if err != nil {
+			return 0, err
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		return resp.GetStatus(), nil
+	}
+
+	resp, err := client.Check(ctx, req)
+	if err != nil {
+		return 0, err
+	}
+	return resp.GetStatus(), nil
+}
+
+// waitReady connects and waits until the connection can be used. It doesn't
+// wait for gRPC to retry failed connection attempts.
+func waitReady(ctx context.Context, conn *grpc.ClientConn) error {
+	conn.Connect()
+	for {
+		switch state := conn.GetState(); state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.TransientFailure, connectivity.Shutdown:
+			return errConnectFailed
+		default:
+			if !conn.WaitForStateChange(ctx, state) {
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// recordingDialer dials through the dialer chain and keeps the first error,
+// which explains connection failures better than the gRPC connection state.
+type recordingDialer struct {
+	dialer transport.Dialer
+
+	mu    sync.Mutex
+	event *beat.Event
+	err   error
+}
+
+func (d *recordingDialer) dial(ctx context.Context, addr string) (net.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	conn, err := d.dialer.DialContext(ctx, "tcp", addr)
+	if err != nil && d.err == nil {
+		d.err = err
+	}
+	return conn, err
+}
+
+// mergeInto adds the dial timings to the event and returns the first dial
+// error, if any.
+func (d *recordingDialer) mergeInto(event *beat.Event) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	eventext.MergeEventFields(event, d.event.Fields.Clone())
+	return d.err
+}
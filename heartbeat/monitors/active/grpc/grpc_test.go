@@ -0,0 +1,297 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package grpc
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+
+	"github.com/elastic/beats/v7/heartbeat/ecserr"
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/hbtestllext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// startHealthServer starts an in-process gRPC server with a health service
+// reporting the given statuses. Requests without the x-token metadata are
+// rejected if token is set.
+func startHealthServer(t *testing.T, creds credentials.TransportCredentials, token string, statuses map[string]healthpb.HealthCheckResponse_ServingStatus) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	var opts []grpc.ServerOption
+	if creds != nil {
+		opts = append(opts, grpc.Creds(creds))
+	}
+	if token != "" {
+		opts = append(opts,
+			grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+				md, _ := metadata.FromIncomingContext(ctx)
+				if got := md.Get("x-token"); len(got) != 1 || got[0] != token {
+					return nil, status.Error(codes.PermissionDenied, "permission denied")
+				}
+				return handler(ctx, req)
+			}))
+	}
+	server := grpc.NewServer(opts...)
+	healthServer := health.NewServer()
+	for service, st := range statuses {
+		healthServer.SetServingStatus(service, st)
+	}
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	go func() { _ = server.Serve(l) }()
+	t.Cleanup(server.Stop)
+	return l.Addr().String()
+}
+
+func testGRPCCheck(t *testing.T, cfg mapstr.M) []*beat.Event {
+	config, err := conf.NewConfigFrom(cfg)
+	require.NoError(t, err)
+
+	p, err := create("grpc", config)
+	require.NoError(t, err)
+
+	sched := schedule.MustParse("@every 1s")
+	js := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "grpc", Schedule: sched, Timeout: 1}, nil)
+	require.Equal(t, len(js), p.Endpoints)
+
+	events := make([]*beat.Event, len(js))
+	for i, job := range js {
+		events[i] = &beat.Event{}
+		_, err = job(events[i])
+		require.NoError(t, err)
+	}
+	return events
+}
+
+func grpcChecks(service, method, servingStatus string) validator.Validator {
+	return lookslike.MustCompile(map[string]interface{}{
+		"grpc": map[string]interface{}{
+			"service":      service,
+			"method":       method,
+			"status":       servingStatus,
+			"status_code":  "OK",
+			"rtt.rpc.us":   hbtestllext.IsInt64,
+			"rtt.total.us": hbtestllext.IsInt64,
+		},
+		"tcp.rtt.connect.us": hbtestllext.IsInt64,
+	})
+}
+
+var testStatuses = map[string]healthpb.HealthCheckResponse_ServingStatus{
+	"":            healthpb.HealthCheckResponse_SERVING,
+	"app.Serving": healthpb.HealthCheckResponse_SERVING,
+	"app.Down":    healthpb.HealthCheckResponse_NOT_SERVING,
+}
+
+func TestServing(t *testing.T) {
+	addr := startHealthServer(t, nil, "", testStatuses)
+
+	for _, method := range []string{"Check", "Watch"} {
+		t.Run(method, func(t *testing.T) {
+			events := testGRPCCheck(t, mapstr.M{
+				"hosts":    addr,
+				"services": []string{"", "app.Serving"},
+				"method":   method,
+				"timeout":  "1s",
+			})
+			require.Len(t, events, 2)
+
+			u, err := url.Parse("grpc://" + addr)
+			require.NoError(t, err)
+			for i, service := range []string{"", "app.Serving"} {
+				testslike.Test(t, lookslike.Compose(
+					hbtest.BaseChecks("127.0.0.1", "up", "grpc"),
+					hbtest.SummaryStateChecks(1, 0),
+					hbtest.URLChecks(t, u),
+					grpcChecks(service, method, "SERVING"),
+				), events[i].Fields)
+			}
+		})
+	}
+}
+
+func TestNotServing(t *testing.T) {
+	addr := startHealthServer(t, nil, "", testStatuses)
+
+	tests := map[string]struct {
+		cfg     mapstr.M
+		errType string
+		message string
+	}{
+		"not serving": {
+			cfg:     mapstr.M{"services": "app.Down"},
+			errType: "validate",
+			message: "service 'app.Down' has status NOT_SERVING, expected one of [SERVING]",
+		},
+		"not serving accepted": {
+			cfg: mapstr.M{"services": "app.Down", "check.status": []string{"serving", "not_serving"}},
+		},
+		"unknown service": {
+			cfg:     mapstr.M{"services": "app.Unknown"},
+			errType: "validate",
+			message: "NotFound",
+		},
+		"unknown service watch": {
+			cfg:     mapstr.M{"services": "app.Unknown", "method": "watch"},
+			errType: "validate",
+			message: "service 'app.Unknown' has status SERVICE_UNKNOWN",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := mapstr.M{"hosts": "grpc://" + addr, "timeout": "1s"}
+			cfg.DeepUpdate(test.cfg)
+			events := testGRPCCheck(t, cfg)
+			require.Len(t, events, 1)
+			if test.errType == "" {
+				testslike.Test(t, hbtest.SummaryStateChecks(1, 0), events[0].Fields)
+				return
+			}
+			testslike.Test(t, lookslike.Compose(
+				hbtest.SummaryStateChecks(0, 1),
+				hbtest.ErrorChecks(test.message, test.errType),
+			), events[0].Fields)
+		})
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	addr := startHealthServer(t, nil, "secret", testStatuses)
+
+	events := testGRPCCheck(t, mapstr.M{
+		"hosts":           addr,
+		"headers.x-token": "secret",
+		"timeout":         "1s",
+	})
+	testslike.Test(t, hbtest.SummaryStateChecks(1, 0), events[0].Fields)
+
+	events = testGRPCCheck(t, mapstr.M{
+		"hosts":   addr,
+		"timeout": "1s",
+	})
+	testslike.Test(t, lookslike.Compose(
+		hbtest.SummaryStateChecks(0, 1),
+		hbtest.ErrorChecks("permission denied", "validate"),
+	), events[0].Fields)
+}
+
+func TestTLS(t *testing.T) {
+	// Borrow the certificate of an httptest TLS server, it's valid for 127.0.0.1.
+	httpsServer := httptest.NewTLSServer(http.NotFoundHandler())
+	cert := httpsServer.TLS.Certificates[0]
+	certFile := hbtest.CertToTempFile(t, httpsServer.Certificate())
+	defer os.Remove(certFile.Name())
+	httpsServer.Close()
+
+	addr := startHealthServer(t, credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{cert}}), "", testStatuses)
+
+	events := testGRPCCheck(t, mapstr.M{
+		"hosts":                       addr,
+		"ssl.certificate_authorities": certFile.Name(),
+		"timeout":                     "1s",
+	})
+	u, err := url.Parse("grpcs://" + addr)
+	require.NoError(t, err)
+	testslike.Test(t, lookslike.Compose(
+		hbtest.SummaryStateChecks(1, 0),
+		hbtest.URLChecks(t, u),
+		grpcChecks("", "Check", "SERVING"),
+		lookslike.MustCompile(map[string]interface{}{
+			"tls.rtt.handshake.us": hbtestllext.IsInt64,
+		}),
+	), events[0].Fields)
+
+	// Without the CA, the certificate can't be verified.
+	events = testGRPCCheck(t, mapstr.M{
+		"hosts":   "grpcs://" + addr,
+		"timeout": "1s",
+	})
+	testslike.Test(t, lookslike.Compose(
+		hbtest.SummaryStateChecks(0, 1),
+		hbtest.ErrorChecks("certificate", "io"),
+	), events[0].Fields)
+
+	// A plaintext connection to the TLS server fails.
+	events = testGRPCCheck(t, mapstr.M{
+		"hosts":   "grpc://" + addr,
+		"timeout": "1s",
+	})
+	testslike.Test(t, lookslike.Compose(
+		hbtest.SummaryStateChecks(0, 1),
+		lookslike.MustCompile(map[string]interface{}{"error.type": "io"}),
+	), events[0].Fields)
+}
+
+func TestConnectionRefused(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	events := testGRPCCheck(t, mapstr.M{"hosts": addr, "timeout": "1s"})
+	testslike.Test(t, lookslike.Compose(
+		hbtest.SummaryStateChecks(0, 1),
+		hbtest.ECSErrCodeChecks(ecserr.CODE_NET_COULD_NOT_CONNECT, "connection refused"),
+		lookslike.MustCompile(map[string]interface{}{
+			"grpc.service": "",
+			"grpc.method":  "Check",
+		}),
+	), events[0].Fields)
+}
+
+func TestConfigValidation(t *testing.T) {
+	for name, cfg := range map[string]mapstr.M{
+		"missing hosts":  {},
+		"missing port":   {"hosts": "localhost"},
+		"invalid scheme": {"hosts": "http://localhost:50051"},
+		"invalid method": {"hosts": "localhost:50051", "method": "list"},
+		"unknown status": {"hosts": "localhost:50051", "check.status": "UP"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := create("grpc", conf.MustNewConfigFrom(cfg))
+			assert.Error(t, err)
+		})
+	}
+}
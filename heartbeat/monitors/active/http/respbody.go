@@ -44,9 +44,16 @@ const (
 )
 
 func processBody(resp *http.Response, config responseConfig, validator multiValidator) (mapstr.M, string, reason.Reason) {
+	bodyFields, mimeType, _, errReason := processBodyKeep(resp, config, validator, false)
+	return bodyFields, mimeType, errReason
+}
+
+// processBodyKeep is like processBody, but also returns the buffered body.
+// When keepBody is set the whole body is buffered even if no validator needs it.
+func processBodyKeep(resp *http.Response, config responseConfig, validator multiValidator, keepBody bool) (mapstr.M, string, string, reason.Reason) {
 	// Determine how much of the body to actually buffer in memory
 	var bufferBodyBytes int
-	if validator.wantsBody() {
+	if keepBody || validator.wantsBody() {
 		bufferBodyBytes = maxBufferBodyBytes
 	} else if config.IncludeBody == "always" || config.IncludeBody == "on_error" {
 		// If the user has asked for bodies to be recorded we only need to buffer that much
@@ -60,7 +67,7 @@ func processBody(resp *http.Response, config responseConfig, validator multiVali
 	respBody, bodyLenBytes, bodyHash, respErr := readBody(resp, bufferBodyBytes)
 	// If we encounter an error while reading the body just fail early
 	if respErr != nil {
-		return nil, "", reason.IOFailed(fmt.Errorf("failed reading HTTP response body: %w", respErr))
+		return nil, "", "", reason.IOFailed(fmt.Errorf("failed reading HTTP response body: %w", respErr))
 	}
 
 	// Run any validations
@@ -86,7 +93,7 @@ func processBody(resp *http.Response, config responseConfig, validator multiVali
 		bodyFields["content"] = respBody[0:sampleNumBytes]
 	}
 
-	return bodyFields, mime.Detect(respBody), errReason
+	return bodyFields, mime.Detect(respBody), respBody, errReason
 }
 
 // readBody reads the first sampleSize bytes from the httpResponse,
@@ -0,0 +1,309 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/PaesslerAG/gval"
+	"github.com/PaesslerAG/jsonpath"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers/wraputil"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func init() {
+	plugin.Register("http_steps", createSteps, "synthetics/http_steps")
+}
+
+// compiledStep is a step ready to be executed.
+type compiledStep struct {
+	name      string
+	request   stepRequest
+	validator multiValidator
+	extract   []compiledExtract
+}
+
+type compiledExtract struct {
+	name string
+	// value returns the variable value from the response and its body.
+	value func(resp *http.Response, body string) (interface{}, error)
+}
+
+// createSteps makes a new http_steps monitor.
+func createSteps(
+	name string,
+	cfg *conf.C,
+) (p plugin.Plugin, err error) {
+	config := defaultStepsConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	steps := make([]compiledStep, len(config.Steps))
+	for i, s := range config.Steps {
+		steps[i], err = compileStep(i, s)
+		if err != nil {
+			return plugin.Plugin{}, err
+		}
+	}
+
+	transport, err := newRoundTripper(&config.Transport)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	job := newHTTPStepsJob(&config, steps, transport)
+
+	// Report the URL of the first step when it does not depend on variables,
+	// so the monitor is listed with a stable URL.
+	if first := config.Steps[0].Request.URL; first.IsConst() {
+		urlStr, err := first.Run(&beat.Event{})
+		if err != nil {
+			return plugin.Plugin{}, err
+		}
+		u, err := url.Parse(urlStr)
+		if err != nil {
+			return plugin.Plugin{}, fmt.Errorf("invalid url '%s' in step '%s': %w", urlStr, steps[0].name, err)
+		}
+		job = wraputil.WithURLField(u, job)
+	}
+
+	return plugin.Plugin{Jobs: []jobs.Job{job}, Endpoints: 1}, nil
+}
+
+func compileStep(idx int, s *stepConfig) (compiledStep, error) {
+	name := s.Name
+	if name == "" {
+		name = fmt.Sprintf("step %d", idx+1)
+	}
+
+	validator, err := makeValidateResponse(&s.Check.Response)
+	if err != nil {
+		return compiledStep{}, fmt.Errorf("invalid check in step '%s': %w", name, err)
+	}
+
+	step := compiledStep{name: name, request: s.Request, validator: validator}
+	for _, e := range s.Extract {
+		ce, err := compileExtract(e)
+		if err != nil {
+			return compiledStep{}, fmt.Errorf("invalid extract in step '%s': %w", name, err)
+		}
+		step.extract = append(step.extract, ce)
+	}
+
+	return step, nil
+}
+
+func compileExtract(e *extractConfig) (compiledExtract, error) {
+	switch {
+	case e.JSON != "":
+		eval, err := gval.Full(jsonpath.PlaceholderExtension()).NewEvaluable(e.JSON)
+		if err != nil {
+			return compiledExtract{}, fmt.Errorf("could not compile gval expression '%s': %w", e.JSON, err)
+		}
+		return compiledExtract{name: e.Name, value: func(_ *http.Response, body string) (interface{}, error) {
+			decoded, err := decodeJson(body, false)
+			if err != nil {
+				return nil, err
+			}
+			return eval(context.Background(), decoded)
+		}}, nil
+	case e.Header != "":
+		header := e.Header
+		return compiledExtract{name: e.Name, value: func(resp *http.Response, _ string) (interface{}, error) {
+			v := resp.Header.Get(header)
+			if v == "" {
+				return nil, fmt.Errorf("header '%s' not found", header)
+			}
+			return v, nil
+		}}, nil
+	default:
+		re := regexp.MustCompile(e.Regexp) // already validated by extractConfig
+		return compiledExtract{name: e.Name, value: func(_ *http.Response, body string) (interface{}, error) {
+			m := re.FindStringSubmatch(body)
+			if m == nil {
+				return nil, fmt.Errorf("regexp '%s' did not match", re)
+			}
+			return m[1], nil
+		}}, nil
+	}
+}
+
+func newHTTPStepsJob(config *stepsConfig, steps []compiledStep, transport http.RoundTripper) jobs.Job {
+	return jobs.MakeSimpleJob(func(event *beat.Event) error {
+		// Cookies and variables are shared between the steps of a single run only.
+		jar, err := cookiejar.New(nil)
+		if err != nil {
+			return err
+		}
+		client := &http.Client{
+			CheckRedirect: makeCheckRedirect(config.MaxRedirects, nil),
+			Transport:     transport,
+			Timeout:       config.Transport.Timeout,
+			Jar:           jar,
+		}
+
+		vars := &beat.Event{Fields: mapstr.M{}}
+		for k, v := range config.Variables {
+			_, _ = vars.Fields.Put(k, v)
+		}
+
+		var stepFields []mapstr.M
+		defer func() {
+			eventext.MergeEventFields(event, mapstr.M{"http_steps": mapstr.M{
+				"steps":     stepFields,
+				"completed": countUp(stepFields),
+				"total":     len(steps),
+			}})
+		}()
+
+		for i, step := range steps {
+			fields, errReason := step.run(client, vars, config.Transport.Timeout, config.Response)
+			stepFields = append(stepFields, fields)
+			if errReason != nil {
+				return wrapStepReason(errReason, i, step.name)
+			}
+		}
+		return nil
+	})
+}
+
+// run executes the step, adding any extracted values to vars.
+func (s *compiledStep) run(client *http.Client, vars *beat.Event, timeout time.Duration, responseConfig responseConfig) (fields mapstr.M, errReason reason.Reason) {
+	fields = mapstr.M{
+		"name":   s.name,
+		"status": "up",
+		"request": mapstr.M{
+			"method": strings.ToUpper(s.request.Method),
+		},
+	}
+	defer func() {
+		if errReason != nil {
+			fields["status"] = "down"
+			fields["error"] = mapstr.M{"message": errReason.Error()}
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := s.buildRequest(ctx, vars)
+	if err != nil {
+		return fields, reason.ValidateFailed(err)
+	}
+	_, _ = fields.Put("request.url", req.URL.String())
+
+	start, resp, errReason := execRequest(client, req)
+	if errReason != nil {
+		return fields, errReason
+	}
+
+	bodyFields, mimeType, body, errReason := processBodyKeep(resp, responseConfig, s.validator, len(s.extract) > 0)
+	end := time.Now()
+
+	responseFields := mapstr.M{"status_code": resp.StatusCode}
+	if bodyFields != nil {
+		responseFields["body"] = bodyFields
+	}
+	if mimeType != "" {
+		responseFields["mime_type"] = mimeType
+	}
+	fields["response"] = responseFields
+	fields["rtt"] = mapstr.M{"total": look.RTT(end.Sub(start))}
+
+	if errReason != nil {
+		return fields, errReason
+	}
+
+	for _, e := range s.extract {
+		v, err := e.value(resp, body)
+		if err != nil {
+			return fields, reason.ValidateFailed(fmt.Errorf("could not extract '%s': %w", e.name, err))
+		}
+		_, _ = vars.Fields.Put(e.name, v)
+	}
+
+	return fields, nil
+}
+
+func (s *compiledStep) buildRequest(ctx context.Context, vars *beat.Event) (*http.Request, error) {
+	urlStr, err := s.request.URL.Run(vars)
+	if err != nil {
+		return nil, fmt.Errorf("could not build url: %w", err)
+	}
+
+	var body string
+	if s.request.Body != nil {
+		body, err = s.request.Body.Run(vars)
+		if err != nil {
+			return nil, fmt.Errorf("could not build request body: %w", err)
+		}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, strings.ToUpper(s.request.Method), urlStr, strings.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("could not make http request: %w", err)
+	}
+	for k, fs := range s.request.Headers {
+		v, err := fs.Run(vars)
+		if err != nil {
+			return nil, fmt.Errorf("could not build header '%s': %w", k, err)
+		}
+		if strings.EqualFold(k, "Host") {
+			req.Host = v
+		} else {
+			req.Header.Add(k, v)
+		}
+	}
+
+	return req, nil
+}
+
+// wrapStepReason prefixes the reason with the failed step, keeping its type.
+func wrapStepReason(r reason.Reason, idx int, name string) reason.Reason {
+	err := fmt.Errorf("step %d (%s) failed: %w", idx+1, name, r.Unwrap())
+	if _, ok := r.(reason.IOError); ok {
+		return reason.IOFailed(err)
+	}
+	return reason.ValidateFailed(err)
+}
+
+func countUp(stepFields []mapstr.M) int {
+	n := 0
+	for _, f := range stepFields {
+		if f["status"] == "up" {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,121 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/fmtstr"
+	"github.com/elastic/elastic-agent-libs/transport/httpcommon"
+)
+
+// stepsConfig configures an http_steps monitor, which runs a sequence of
+// requests sharing variables and cookies.
+type stepsConfig struct {
+	Steps        []*stepConfig     `config:"steps" validate:"required"`
+	Variables    map[string]string `config:"variables"`
+	MaxRedirects int               `config:"max_redirects"`
+	Response     responseConfig    `config:"response"`
+
+	Transport httpcommon.HTTPTransportSettings `config:",inline"`
+}
+
+type stepConfig struct {
+	Name    string           `config:"name"`
+	Request stepRequest      `config:"request"`
+	Check   stepCheckConfig  `config:"check"`
+	Extract []*extractConfig `config:"extract"`
+}
+
+// stepRequest is a request whose URL, headers and body may reference
+// variables, e.g. "Bearer %{[token]}".
+type stepRequest struct {
+	Method  string                               `config:"method"`
+	URL     *fmtstr.EventFormatString            `config:"url" validate:"required"`
+	Headers map[string]*fmtstr.EventFormatString `config:"headers"`
+	Body    *fmtstr.EventFormatString            `config:"body"`
+}
+
+type stepCheckConfig struct {
+	Response responseParameters `config:"response"`
+}
+
+// extractConfig sets a variable from one of the response JSON, a response
+// header or the first capture group of a regexp matching the body.
+type extractConfig struct {
+	Name   string `config:"name" validate:"required"`
+	JSON   string `config:"json"`
+	Header string `config:"header"`
+	Regexp string `config:"regexp"`
+}
+
+func defaultStepsConfig() stepsConfig {
+	cfg := stepsConfig{
+		Response: responseConfig{
+			IncludeBody:         "on_error",
+			IncludeBodyMaxBytes: 2048,
+		},
+		Transport: httpcommon.DefaultHTTPTransportSettings(),
+	}
+	cfg.Transport.Timeout = 16 * time.Second
+
+	return cfg
+}
+
+// InitDefaults sets the defaults of each step.
+func (s *stepConfig) InitDefaults() {
+	s.Request.Method = "GET"
+}
+
+// Validate validates the step request.
+func (r *stepRequest) Validate() error {
+	return (&requestParameters{Method: r.Method}).Validate()
+}
+
+// Validate checks that exactly one source is set.
+func (e *extractConfig) Validate() error {
+	n := 0
+	for _, s := range []string{e.JSON, e.Header, e.Regexp} {
+		if s != "" {
+			n++
+		}
+	}
+	if n != 1 {
+		return fmt.Errorf("extract '%s' requires exactly one of 'json', 'header' or 'regexp'", e.Name)
+	}
+	if e.Regexp != "" {
+		re, err := regexp.Compile(e.Regexp)
+		if err != nil {
+			return fmt.Errorf("invalid regexp for extract '%s': %w", e.Name, err)
+		}
+		if re.NumSubexp() < 1 {
+			return fmt.Errorf("regexp for extract '%s' requires a capture group", e.Name)
+		}
+	}
+	return nil
+}
+
+func (c *stepsConfig) Validate() error {
+	if len(c.Steps) == 0 {
+		return errors.New("at least one step is required")
+	}
+	return nil
+}
@@ -0,0 +1,245 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/hbtestllext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+)
+
+func stepsServer(t *testing.T) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method != http.MethodPost || string(body) != `{"user":"alice"}` {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1"})
+		w.Header().Set("X-Request-Id", "r1")
+		_, _ = io.WriteString(w, `{"token":"abc"}`)
+	})
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		c, err := r.Cookie("session")
+		if err != nil || c.Value != "s1" || r.Header.Get("Authorization") != "Bearer abc" || r.Header.Get("X-Trace") != "r1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = io.WriteString(w, `{"user":"alice","role":"admin"}`)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func runSteps(t *testing.T, cfg map[string]interface{}) (*beat.Event, error) {
+	config, err := conf.NewConfigFrom(cfg)
+	require.NoError(t, err)
+
+	p, err := createSteps("steps", config)
+	require.NoError(t, err)
+
+	sched := schedule.MustParse("@every 1s")
+	job := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "http_steps", Schedule: sched, Timeout: 1}, nil)[0]
+
+	event := &beat.Event{}
+	_, err = job(event)
+	return event, err
+}
+
+func loginSteps(serverURL, wantRole string) map[string]interface{} {
+	return map[string]interface{}{
+		"variables": map[string]interface{}{"user": "alice"},
+		"steps": []interface{}{
+			map[string]interface{}{
+				"name": "login",
+				"request": map[string]interface{}{
+					"method": "POST",
+					"url":    serverURL + "/login",
+					"body":   `{"user":"%{[user]}"}`,
+				},
+				"extract": []interface{}{
+					map[string]interface{}{"name": "token", "json": "$.token"},
+					map[string]interface{}{"name": "request_id", "header": "X-Request-Id"},
+				},
+			},
+			map[string]interface{}{
+				"name": "profile",
+				"request": map[string]interface{}{
+					"url": serverURL + "/me",
+					"headers": map[string]interface{}{
+						"Authorization": "Bearer %{[token]}",
+						"X-Trace":       "%{[request_id]}",
+					},
+				},
+				"check.response": map[string]interface{}{
+					"status": []interface{}{200},
+					"json": []interface{}{
+						map[string]interface{}{"description": "role", "expression": `role == "` + wantRole + `"`},
+					},
+				},
+			},
+		},
+	}
+}
+
+func stepChecks(name, status string, statusCode int) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                 name,
+		"status":               status,
+		"response.status_code": statusCode,
+		"rtt.total.us":         hbtestllext.IsInt64,
+	}
+}
+
+func TestHTTPStepsUp(t *testing.T) {
+	server := stepsServer(t)
+
+	loginURL, err := url.Parse(server.URL + "/login")
+	require.NoError(t, err)
+
+	event, err := runSteps(t, loginSteps(server.URL, "admin"))
+	require.NoError(t, err)
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.SummaryStateChecks(1, 0),
+			hbtest.URLChecks(t, loginURL),
+			lookslike.MustCompile(map[string]interface{}{
+				"monitor.status": "up",
+				"http_steps": map[string]interface{}{
+					"completed": 2,
+					"total":     2,
+					"steps": isdef.IsSliceOf(lookslike.MustCompile(map[string]interface{}{
+						"name":         isdef.IsString,
+						"status":       "up",
+						"request.url":  isdef.IsString,
+						"rtt.total.us": hbtestllext.IsInt64,
+					})),
+				},
+			}),
+		),
+		event.Fields,
+	)
+
+	steps, err := event.GetValue("http_steps.steps")
+	require.NoError(t, err)
+	testslike.Test(t, lookslike.MustCompile(stepChecks("login", "up", 200)), steps.([]mapstr.M)[0])
+	testslike.Test(t, lookslike.MustCompile(stepChecks("profile", "up", 200)), steps.([]mapstr.M)[1])
+}
+
+func TestHTTPStepsCheckFailure(t *testing.T) {
+	server := stepsServer(t)
+
+	event, err := runSteps(t, loginSteps(server.URL, "viewer"))
+	require.NoError(t, err)
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.SummaryStateChecks(0, 1),
+			lookslike.MustCompile(map[string]interface{}{
+				"monitor.status":       "down",
+				"error.type":           "validate",
+				"error.message":        isdef.IsStringContaining("step 2 (profile) failed"),
+				"http_steps.total":     2,
+				"http_steps.completed": 1,
+			}),
+		),
+		event.Fields,
+	)
+
+	steps, err := event.GetValue("http_steps.steps")
+	require.NoError(t, err)
+	testslike.Test(t, lookslike.MustCompile(stepChecks("profile", "down", 200)), steps.([]mapstr.M)[1])
+}
+
+func TestHTTPStepsExtractFailure(t *testing.T) {
+	server := stepsServer(t)
+
+	cfg := loginSteps(server.URL, "admin")
+	steps := cfg["steps"].([]interface{})
+	steps[0].(map[string]interface{})["extract"] = []interface{}{
+		map[string]interface{}{"name": "token", "regexp": `"session":"(\w+)"`},
+	}
+
+	event, err := runSteps(t, cfg)
+	require.NoError(t, err)
+
+	testslike.Test(
+		t,
+		lookslike.MustCompile(map[string]interface{}{
+			"monitor.status":       "down",
+			"error.message":        isdef.IsStringContaining("step 1 (login) failed: could not extract 'token'"),
+			"http_steps.completed": 0,
+		}),
+		event.Fields,
+	)
+}
+
+func TestHTTPStepsConfigValidation(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"no steps": {"steps": []interface{}{}},
+		"no url": {"steps": []interface{}{
+			map[string]interface{}{"name": "a"},
+		}},
+		"bad method": {"steps": []interface{}{
+			map[string]interface{}{"request": map[string]interface{}{"url": "http://localhost", "method": "FETCH"}},
+		}},
+		"two extract sources": {"steps": []interface{}{
+			map[string]interface{}{
+				"request": map[string]interface{}{"url": "http://localhost"},
+				"extract": []interface{}{map[string]interface{}{"name": "x", "json": "$.a", "header": "X-A"}},
+			},
+		}},
+		"regexp without group": {"steps": []interface{}{
+			map[string]interface{}{
+				"request": map[string]interface{}{"url": "http://localhost"},
+				"extract": []interface{}{map[string]interface{}{"name": "x", "regexp": "a+"}},
+			},
+		}},
+	}
+
+	for name, cfg := range tests {
+		t.Run(name, func(t *testing.T) {
+			config, err := conf.NewConfigFrom(cfg)
+			require.NoError(t, err)
+			_, err = createSteps("steps", config)
+			require.Error(t, err)
+		})
+	}
+}
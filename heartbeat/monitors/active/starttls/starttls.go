@@ -0,0 +1,253 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package starttls upgrades plaintext connections to TLS for the protocols
+// supported by the tcp and tls monitors.
+package starttls
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/textproto"
+	"strings"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+)
+
+// negotiators run the exchange from the server greeting up to the reply to
+// the STARTTLS command. On success the next bytes on the connection are the
+// TLS handshake.
+var negotiators = map[string]func(net.Conn) error{
+	"smtp":     negotiateSMTP,
+	"imap":     negotiateIMAP,
+	"pop3":     negotiatePOP3,
+	"ftp":      negotiateFTP,
+	"postgres": negotiatePostgres,
+	"ldap":     negotiateLDAP,
+}
+
+// Supported returns true if STARTTLS can be negotiated for protocol.
+func Supported(protocol string) bool {
+	_, ok := negotiators[protocol]
+	return ok
+}
+
+// Negotiate asks the server to upgrade conn to TLS, starting from the server
+// greeting. On success the TLS handshake can be started on conn.
+func Negotiate(protocol string, conn net.Conn) error {
+	negotiate, ok := negotiators[protocol]
+	if !ok {
+		return fmt.Errorf("STARTTLS is not supported for '%s'", protocol)
+	}
+	return negotiate(conn)
+}
+
+// Handshake runs the TLS client handshake on conn, returning the TLS
+// connection and the duration of the handshake.
+func Handshake(conn net.Conn, cfg *tls.Config) (*tls.Conn, time.Duration, error) {
+	tlsConn := tls.Client(conn, cfg)
+	start := time.Now()
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, 0, err
+	}
+	return tlsConn, time.Since(start), nil
+}
+
+// SMTP sends STARTTLS on an SMTP session, ehlo is the server's reply to
+// EHLO.
+func SMTP(tp *textproto.Conn, ehlo string) error {
+	if !hasLine(ehlo, "STARTTLS") {
+		return errors.New("server does not advertise STARTTLS")
+	}
+	return textprotoCmd(tp, 220, "STARTTLS")
+}
+
+func negotiateSMTP(conn net.Conn) error {
+	tp := textproto.NewConn(conn)
+	if _, _, err := tp.ReadResponse(220); err != nil {
+		return fmt.Errorf("unexpected greeting: %w", err)
+	}
+	id, err := tp.Cmd("EHLO heartbeat")
+	if err != nil {
+		return err
+	}
+	tp.StartResponse(id)
+	_, ext, err := tp.ReadResponse(250)
+	tp.EndResponse(id)
+	if err != nil {
+		return fmt.Errorf("EHLO rejected: %w", err)
+	}
+	return SMTP(tp, ext)
+}
+
+func negotiateFTP(conn net.Conn) error {
+	tp := textproto.NewConn(conn)
+	if _, _, err := tp.ReadResponse(220); err != nil {
+		return fmt.Errorf("unexpected greeting: %w", err)
+	}
+	return textprotoCmd(tp, 234, "AUTH TLS")
+}
+
+func textprotoCmd(tp *textproto.Conn, expectCode int, cmd string) error {
+	id, err := tp.Cmd("%s", cmd)
+	if err != nil {
+		return err
+	}
+	tp.StartResponse(id)
+	defer tp.EndResponse(id)
+	if _, _, err := tp.ReadResponse(expectCode); err != nil {
+		return fmt.Errorf("%s rejected: %w", cmd, err)
+	}
+	return nil
+}
+
+// IMAP sends a STARTTLS command with the given tag on an IMAP session and
+// waits for its tagged reply.
+func IMAP(w io.Writer, r *bufio.Reader, tag string) error {
+	if _, err := fmt.Fprintf(w, "%s STARTTLS\r\n", tag); err != nil {
+		return err
+	}
+	for {
+		line, err := readLine(r)
+		if err != nil {
+			return err
+		}
+		status, found := strings.CutPrefix(line, tag+" ")
+		if !found {
+			continue // untagged data
+		}
+		if !strings.HasPrefix(status, "OK") {
+			return fmt.Errorf("STARTTLS rejected: %s", line)
+		}
+		return nil
+	}
+}
+
+func negotiateIMAP(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+	if err := expectLine(r, "* OK"); err != nil {
+		return fmt.Errorf("unexpected greeting: %w", err)
+	}
+	return IMAP(conn, r, "a001")
+}
+
+// POP3 sends STLS on a POP3 session.
+func POP3(w io.Writer, r *bufio.Reader) error {
+	if _, err := io.WriteString(w, "STLS\r\n"); err != nil {
+		return err
+	}
+	if err := expectLine(r, "+OK"); err != nil {
+		return fmt.Errorf("STLS rejected: %w", err)
+	}
+	return nil
+}
+
+func negotiatePOP3(conn net.Conn) error {
+	r := bufio.NewReader(conn)
+	if err := expectLine(r, "+OK"); err != nil {
+		return fmt.Errorf("unexpected greeting: %w", err)
+	}
+	return POP3(conn, r)
+}
+
+// postgresSSLRequestCode is the protocol version code of an SSLRequest message.
+const postgresSSLRequestCode = 80877103
+
+func negotiatePostgres(conn net.Conn) error {
+	var req [8]byte
+	binary.BigEndian.PutUint32(req[0:4], 8)
+	binary.BigEndian.PutUint32(req[4:8], postgresSSLRequestCode)
+	if _, err := conn.Write(req[:]); err != nil {
+		return err
+	}
+
+	var resp [1]byte
+	if _, err := io.ReadFull(conn, resp[:]); err != nil {
+		return err
+	}
+	if resp[0] != 'S' {
+		return fmt.Errorf("server refused SSL, responded with '%c'", resp[0])
+	}
+	return nil
+}
+
+// ldapStartTLSOID is the name of the LDAP StartTLS extended operation.
+const ldapStartTLSOID = "1.3.6.1.4.1.1466.20037"
+
+// ldapStartTLSRequest returns the LDAPv3 StartTLS ExtendedRequest with
+// message ID 1.
+func ldapStartTLSRequest() *ber.Packet {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Request")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, 1, "MessageID"))
+	request := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationExtendedRequest, nil, "Start TLS")
+	request.AppendChild(ber.NewString(ber.ClassContext, ber.TypePrimitive, 0, ldapStartTLSOID, "TLS Extended Command"))
+	packet.AppendChild(request)
+	return packet
+}
+
+func negotiateLDAP(conn net.Conn) error {
+	if _, err := conn.Write(ldapStartTLSRequest().Bytes()); err != nil {
+		return err
+	}
+
+	// The connection is handed to the TLS client afterwards, the reader
+	// must not consume more than the response.
+	resp, err := ber.ReadPacket(conn)
+	if err != nil {
+		return err
+	}
+	if len(resp.Children) < 2 || resp.Children[1].Tag != ldap.ApplicationExtendedResponse {
+		return errors.New("unexpected LDAP response to StartTLS")
+	}
+	return ldap.GetLDAPError(resp)
+}
+
+func hasLine(msg string, want string) bool {
+	for _, line := range strings.Split(msg, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) > 0 && strings.EqualFold(fields[0], want) {
+			return true
+		}
+	}
+	return false
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func expectLine(r *bufio.Reader, prefix string) error {
+	line, err := readLine(r)
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(line, prefix) {
+		return fmt.Errorf("got '%s'", line)
+	}
+	return nil
+}
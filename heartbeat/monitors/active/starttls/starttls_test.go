@@ -0,0 +1,186 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package starttls
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scriptedServer writes each reply after reading a request, starting with
+// the greeting, if any.
+func scriptedServer(t *testing.T, conn net.Conn, greeting string, exchanges [][2]string) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	if greeting != "" {
+		_, _ = io.WriteString(conn, greeting)
+	}
+	for _, ex := range exchanges {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		if line != ex[0] {
+			t.Errorf("expected request %q, got %q", ex[0], line)
+			return
+		}
+		_, _ = io.WriteString(conn, ex[1])
+	}
+}
+
+func TestNegotiateText(t *testing.T) {
+	tests := []struct {
+		protocol  string
+		greeting  string
+		exchanges [][2]string
+		wantErr   string
+	}{
+		{
+			protocol:  "imap",
+			greeting:  "* OK [CAPABILITY IMAP4rev1 STARTTLS] ready\r\n",
+			exchanges: [][2]string{{"a001 STARTTLS\r\n", "* BYE soon\r\na001 OK Begin TLS negotiation now\r\n"}},
+		},
+		{
+			protocol:  "imap",
+			greeting:  "* OK ready\r\n",
+			exchanges: [][2]string{{"a001 STARTTLS\r\n", "a001 BAD not supported\r\n"}},
+			wantErr:   "STARTTLS rejected",
+		},
+		{
+			protocol:  "pop3",
+			greeting:  "+OK POP3 ready\r\n",
+			exchanges: [][2]string{{"STLS\r\n", "+OK Begin TLS negotiation\r\n"}},
+		},
+		{
+			protocol: "pop3",
+			greeting: "-ERR go away\r\n",
+			wantErr:  "unexpected greeting",
+		},
+		{
+			protocol:  "ftp",
+			greeting:  "220-Welcome\r\n220 FTP ready\r\n",
+			exchanges: [][2]string{{"AUTH TLS\r\n", "234 AUTH TLS successful\r\n"}},
+		},
+		{
+			protocol:  "ftp",
+			greeting:  "220 FTP ready\r\n",
+			exchanges: [][2]string{{"AUTH TLS\r\n", "502 not implemented\r\n"}},
+			wantErr:   "AUTH TLS rejected",
+		},
+		{
+			protocol: "smtp",
+			greeting: "220 mail ESMTP\r\n",
+			exchanges: [][2]string{
+				{"EHLO heartbeat\r\n", "250-mail\r\n250-STARTTLS\r\n250 8BITMIME\r\n"},
+				{"STARTTLS\r\n", "454 TLS not available\r\n"},
+			},
+			wantErr: "STARTTLS rejected",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.protocol, func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			go scriptedServer(t, server, tt.greeting, tt.exchanges)
+
+			err := Negotiate(tt.protocol, client)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNegotiatePostgres(t *testing.T) {
+	for _, resp := range []byte{'S', 'N'} {
+		client, server := net.Pipe()
+		go func() {
+			defer server.Close()
+			req := make([]byte, 8)
+			if _, err := io.ReadFull(server, req); err != nil {
+				return
+			}
+			assert.Equal(t, []byte{0, 0, 0, 8, 0x04, 0xd2, 0x16, 0x2f}, req)
+			_, _ = server.Write([]byte{resp})
+		}()
+
+		err := negotiatePostgres(client)
+		if resp == 'S' {
+			require.NoError(t, err)
+		} else {
+			require.ErrorContains(t, err, "server refused SSL")
+		}
+		client.Close()
+	}
+}
+
+func TestNegotiateLDAP(t *testing.T) {
+	tests := map[string]struct {
+		response []byte
+		wantErr  string
+	}{
+		"success": {
+			// messageID 1, ExtendedResponse { success, "", "" }
+			response: []byte{0x30, 0x0c, 0x02, 0x01, 0x01, 0x78, 0x07, 0x0a, 0x01, 0x00, 0x04, 0x00, 0x04, 0x00},
+		},
+		"long form length": {
+			response: []byte{0x30, 0x81, 0x0c, 0x02, 0x01, 0x01, 0x78, 0x07, 0x0a, 0x01, 0x00, 0x04, 0x00, 0x04, 0x00},
+		},
+		"unavailable": {
+			response: []byte{0x30, 0x0c, 0x02, 0x01, 0x01, 0x78, 0x07, 0x0a, 0x01, 0x34, 0x04, 0x00, 0x04, 0x00},
+			wantErr:  "LDAP Result Code 52",
+		},
+		"not an extended response": {
+			response: []byte{0x30, 0x05, 0x02, 0x01, 0x01, 0x61, 0x00},
+			wantErr:  "unexpected LDAP response",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			go func() {
+				defer server.Close()
+				// messageID 1, ExtendedRequest { requestName }
+				want := append([]byte{0x30, 0x1d, 0x02, 0x01, 0x01, 0x77, 0x18, 0x80, 0x16}, ldapStartTLSOID...)
+				req := make([]byte, len(want))
+				if _, err := io.ReadFull(server, req); err != nil {
+					return
+				}
+				assert.Equal(t, want, req)
+				_, _ = server.Write(tt.response)
+			}()
+
+			err := negotiateLDAP(client)
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -19,8 +19,11 @@ package tcp
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/go-ldap/ldap/v3"
+
 	"github.com/elastic/beats/v7/heartbeat/monitors"
 	"github.com/elastic/elastic-agent-libs/transport"
 	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
@@ -43,6 +46,79 @@ type config struct {
 	// validate connection
 	SendString    string `config:"check.send"`
 	ReceiveString string `config:"check.receive"`
+
+	// Protocol replaces the send / receive check with a protocol handshake
+	Protocol string     `config:"protocol"`
+	SMTP     smtpConfig `config:"smtp"`
+	IMAP     mailConfig `config:"imap"`
+	POP3     mailConfig `config:"pop3"`
+	LDAP     ldapConfig `config:"ldap"`
+}
+
+type smtpConfig struct {
+	// EHLO is the name sent in the EHLO command
+	EHLO     string `config:"ehlo"`
+	StartTLS bool   `config:"starttls"`
+	Username string `config:"username"`
+	Password string `config:"password"`
+}
+
+type mailConfig struct {
+	StartTLS bool   `config:"starttls"`
+	Username string `config:"username"`
+	Password string `config:"password"`
+}
+
+type ldapConfig struct {
+	StartTLS bool              `config:"starttls"`
+	BindDN   string            `config:"bind_dn"`
+	Password string            `config:"password"`
+	Search   *ldapSearchConfig `config:"search"`
+}
+
+type ldapSearchConfig struct {
+	BaseDN     string `config:"base_dn"`
+	Filter     string `config:"filter"`
+	Scope      string `config:"scope"`
+	MinEntries int    `config:"min_entries" validate:"min=0"`
+}
+
+func (c *ldapSearchConfig) InitDefaults() {
+	c.Filter = "(objectClass=*)"
+	c.Scope = "base"
+}
+
+func (c *ldapSearchConfig) Validate() error {
+	if _, ok := ldapScopes[c.Scope]; !ok {
+		return fmt.Errorf("unknown ldap.search.scope '%s', expected base, one or sub", c.Scope)
+	}
+	if _, err := ldap.CompileFilter(c.Filter); err != nil {
+		return fmt.Errorf("invalid ldap.search.filter: %w", err)
+	}
+	return nil
+}
+
+// defaultProtocolPorts are used when no port is configured, for plaintext and implicit TLS connections.
+var defaultProtocolPorts = map[string][2]uint16{
+	"smtp": {25, 465},
+	"imap": {143, 993},
+	"pop3": {110, 995},
+	"ldap": {389, 636},
+}
+
+// startTLS returns true if the configured protocol upgrades the connection with STARTTLS.
+func (c *config) startTLS() bool {
+	switch c.Protocol {
+	case "smtp":
+		return c.SMTP.StartTLS
+	case "imap":
+		return c.IMAP.StartTLS
+	case "pop3":
+		return c.POP3.StartTLS
+	case "ldap":
+		return c.LDAP.StartTLS
+	}
+	return false
 }
 
 func defaultConfig() config {
@@ -59,5 +135,23 @@ func (c *config) Validate() error {
 		}
 	}
 
+	if c.Protocol != "" {
+		if _, ok := defaultProtocolPorts[c.Protocol]; !ok {
+			return fmt.Errorf("unknown protocol '%s', expected smtp, imap, pop3 or ldap", c.Protocol)
+		}
+		if c.SendString != "" || c.ReceiveString != "" {
+			return errors.New("check.send and check.receive can not be used with protocol")
+		}
+	}
+	for name, creds := range map[string][2]string{
+		"smtp": {c.SMTP.Username, c.SMTP.Password},
+		"imap": {c.IMAP.Username, c.IMAP.Password},
+		"pop3": {c.POP3.Username, c.POP3.Password},
+	} {
+		if creds[0] == "" && creds[1] != "" {
+			return fmt.Errorf("%s.password requires %s.username", name, name)
+		}
+	}
+
 	return nil
 }
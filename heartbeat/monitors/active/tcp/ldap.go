@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tcp
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/elastic/beats/v7/heartbeat/ecserr"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlsmeta"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+var ldapScopes = map[string]int{
+	"base": ldap.ScopeBaseObject,
+	"one":  ldap.ScopeSingleLevel,
+	"sub":  ldap.ScopeWholeSubtree,
+}
+
+func (jf *jobFactory) checkLDAP(event *beat.Event, conn net.Conn, serverName string) error {
+	cfg := jf.config.LDAP
+	fields := mapstr.M{"name": "ldap"}
+	defer func() {
+		_, _ = event.Fields.Put("tcp.protocol", fields)
+	}()
+
+	l := ldap.NewConn(conn, isTLS(conn))
+	l.SetTimeout(jf.config.Timeout)
+	l.Start()
+	defer l.Close()
+
+	if cfg.StartTLS {
+		start := time.Now()
+		if err := l.StartTLS(jf.startTLSConfig(serverName)); err != nil {
+			return ecserr.NewSTARTTLSFailedErr("ldap", err)
+		}
+		state, _ := l.TLSConnectionState()
+		tlsmeta.AddTLSMetadata(event.Fields, state, time.Since(start))
+		fields["starttls"] = true
+	}
+
+	if _, encrypted := l.TLSConnectionState(); cfg.Password != "" && !encrypted {
+		return ecserr.NewLDAPBindFailedErr(errPlaintextCredentials)
+	}
+	_, err := l.SimpleBind(&ldap.SimpleBindRequest{
+		Username:           cfg.BindDN,
+		Password:           cfg.Password,
+		AllowEmptyPassword: cfg.Password == "",
+	})
+	if err != nil {
+		return ecserr.NewLDAPBindFailedErr(err)
+	}
+	fields["authenticated"] = cfg.BindDN != ""
+
+	if s := cfg.Search; s != nil {
+		entries, err := ldapSearch(l, s)
+		if err != nil {
+			return ecserr.NewLDAPSearchFailedErr(err)
+		}
+		fields["search"] = mapstr.M{"entries": entries}
+		if entries < s.MinEntries {
+			return ecserr.NewLDAPSearchFailedErr(fmt.Errorf("found %d entries under '%s', expected at least %d", entries, s.BaseDN, s.MinEntries))
+		}
+	}
+
+	_ = l.Unbind()
+	return nil
+}
+
+// ldapSearch returns the number of entries found, up to the required minimum.
+func ldapSearch(l *ldap.Conn, s *ldapSearchConfig) (int, error) {
+	req := ldap.NewSearchRequest(
+		s.BaseDN,
+		ldapScopes[s.Scope],
+		ldap.NeverDerefAliases,
+		max(s.MinEntries, 1),
+		0,
+		true,
+		s.Filter,
+		// 1.1 requests no attributes, we only count entries
+		[]string{"1.1"},
+		nil,
+	)
+	res, err := l.Search(req)
+	// The size limit is only exceeded when enough entries were found.
+	if err != nil && (res == nil || !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded)) {
+		return 0, err
+	}
+	return len(res.Entries), nil
+}
@@ -0,0 +1,158 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tcp
+
+import (
+	"crypto/tls"
+	"net"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/go-lookslike/testslike"
+
+	"github.com/elastic/beats/v7/heartbeat/ecserr"
+)
+
+// startLDAPServer serves a single connection, accepting binds with the given
+// password and returning entries results for each search.
+func startLDAPServer(t *testing.T, tlsCfg *tls.Config, password string, entries int) uint16 {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	message := func(msgID int64, op *ber.Packet) []byte {
+		packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAP Response")
+		packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, msgID, "MessageID"))
+		packet.AppendChild(op)
+		return packet.Bytes()
+	}
+	result := func(msgID int64, tag ber.Tag, code int, diagnostic string) []byte {
+		op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+		op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "resultCode"))
+		op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+		op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, diagnostic, "diagnosticMessage"))
+		return message(msgID, op)
+	}
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		for {
+			packet, err := ber.ReadPacket(conn)
+			if err != nil || len(packet.Children) < 2 {
+				return
+			}
+			msgID, _ := packet.Children[0].Value.(int64)
+			op := packet.Children[1]
+			switch op.Tag {
+			case ldap.ApplicationExtendedRequest:
+				_, _ = conn.Write(result(msgID, ldap.ApplicationExtendedResponse, ldap.LDAPResultSuccess, ""))
+				tlsConn := tls.Server(conn, tlsCfg)
+				if err := tlsConn.Handshake(); err != nil {
+					t.Errorf("TLS handshake failed: %s", err)
+					return
+				}
+				conn = tlsConn
+			case ldap.ApplicationBindRequest:
+				// version, name, simple credentials
+				if creds := op.Children[2].Data.String(); creds != password {
+					_, _ = conn.Write(result(msgID, ldap.ApplicationBindResponse, ldap.LDAPResultInvalidCredentials, "bad password"))
+					continue
+				}
+				_, _ = conn.Write(result(msgID, ldap.ApplicationBindResponse, ldap.LDAPResultSuccess, ""))
+			case ldap.ApplicationSearchRequest:
+				for i := 0; i < entries; i++ {
+					entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Entry")
+					entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "cn=entry", "objectName"))
+					entry.AppendChild(ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes"))
+					_, _ = conn.Write(message(msgID, entry))
+				}
+				_, _ = conn.Write(result(msgID, ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess, ""))
+			case ldap.ApplicationUnbindRequest:
+				return
+			}
+		}
+	}()
+
+	return uint16(l.Addr().(*net.TCPAddr).Port)
+}
+
+func TestLDAPCheck(t *testing.T) {
+	tlsCfg, caFile := testServerTLS(t)
+
+	t.Run("anonymous search", func(t *testing.T) {
+		port := startLDAPServer(t, tlsCfg, "", 3)
+		event := testProtocolCheck(t, port, "ldap", mapstr.M{
+			"search": mapstr.M{"base_dn": "dc=example,dc=com", "scope": "one", "min_entries": 2},
+		}, "")
+		testslike.Test(t, protocolUpChecks(port, map[string]interface{}{
+			"name":           "ldap",
+			"authenticated":  false,
+			"search.entries": 3,
+		}), event.Fields)
+	})
+
+	t.Run("starttls and bind", func(t *testing.T) {
+		port := startLDAPServer(t, tlsCfg, "secret", 0)
+		event := testProtocolCheck(t, port, "ldap", mapstr.M{
+			"starttls": true,
+			"bind_dn":  "cn=monitor,dc=example,dc=com",
+			"password": "secret",
+		}, caFile)
+		testslike.Test(t, protocolUpChecks(port, map[string]interface{}{
+			"name":          "ldap",
+			"starttls":      true,
+			"authenticated": true,
+		}), event.Fields)
+	})
+
+	t.Run("bind failed", func(t *testing.T) {
+		port := startLDAPServer(t, tlsCfg, "secret", 0)
+		event := testProtocolCheck(t, port, "ldap", mapstr.M{
+			"starttls": true,
+			"bind_dn":  "cn=monitor,dc=example,dc=com",
+			"password": "wrong",
+		}, caFile)
+		testslike.Test(t, protocolDownChecks(ecserr.CODE_LDAP_BIND_FAILED, `"Invalid Credentials": bad password`), event.Fields)
+	})
+
+	t.Run("plaintext password", func(t *testing.T) {
+		port := startLDAPServer(t, tlsCfg, "secret", 0)
+		event := testProtocolCheck(t, port, "ldap", mapstr.M{
+			"bind_dn":  "cn=monitor,dc=example,dc=com",
+			"password": "secret",
+		}, "")
+		testslike.Test(t, protocolDownChecks(ecserr.CODE_LDAP_BIND_FAILED, "unencrypted connection"), event.Fields)
+	})
+
+	t.Run("too few entries", func(t *testing.T) {
+		port := startLDAPServer(t, tlsCfg, "", 1)
+		event := testProtocolCheck(t, port, "ldap", mapstr.M{
+			"search": mapstr.M{"base_dn": "dc=example,dc=com", "scope": "sub", "min_entries": 2},
+		}, "")
+		testslike.Test(t, protocolDownChecks(ecserr.CODE_LDAP_SEARCH_FAILED, "found 1 entries"), event.Fields)
+	})
+}
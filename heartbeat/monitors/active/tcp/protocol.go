@@ -0,0 +1,320 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tcp
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"net/textproto"
+	"slices"
+	"strings"
+
+	"github.com/elastic/beats/v7/heartbeat/ecserr"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlsmeta"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/starttls"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// protocolCheck runs a protocol handshake over an open connection, adding the
+// tcp.protocol fields to the event. Protocol failures are returned as ecserr errors.
+type protocolCheck func(event *beat.Event, conn net.Conn, serverName string) error
+
+var errPlaintextCredentials = errors.New("refusing to send credentials over an unencrypted connection, enable ssl or starttls")
+
+func (jf *jobFactory) makeProtocolCheck() protocolCheck {
+	switch jf.config.Protocol {
+	case "smtp":
+		return jf.checkSMTP
+	case "imap":
+		return jf.checkIMAP
+	case "pop3":
+		return jf.checkPOP3
+	case "ldap":
+		return jf.checkLDAP
+	}
+	return nil
+}
+
+// startTLS upgrades conn to TLS after a successful STARTTLS negotiation and
+// records the TLS metadata in the event.
+func (jf *jobFactory) startTLS(event *beat.Event, conn net.Conn, serverName string) (net.Conn, error) {
+	tlsConn, duration, err := starttls.Handshake(conn, jf.startTLSConfig(serverName))
+	if err != nil {
+		return nil, err
+	}
+	tlsmeta.AddTLSMetadata(event.Fields, tlsConn.ConnectionState(), duration)
+	return tlsConn, nil
+}
+
+// startTLSConfig returns the TLS settings of connections upgraded with STARTTLS.
+func (jf *jobFactory) startTLSConfig(serverName string) *tls.Config {
+	if jf.tlsConfig != nil {
+		return jf.tlsConfig.BuildModuleClientConfig(serverName)
+	}
+	return &tls.Config{ServerName: serverName, MinVersion: tls.VersionTLS12}
+}
+
+func isTLS(conn net.Conn) bool {
+	_, ok := conn.(*tls.Conn)
+	return ok
+}
+
+func (jf *jobFactory) checkSMTP(event *beat.Event, conn net.Conn, serverName string) error {
+	cfg := jf.config.SMTP
+	fields := mapstr.M{"name": "smtp"}
+	defer func() {
+		_, _ = event.Fields.Put("tcp.protocol", fields)
+	}()
+
+	tp := textproto.NewConn(conn)
+	_, greeting, err := tp.ReadResponse(220)
+	if err != nil {
+		return ecserr.NewSMTPGreetingFailedErr(err)
+	}
+	fields["greeting"] = greeting
+
+	ehlo := cfg.EHLO
+	if ehlo == "" {
+		ehlo = "heartbeat"
+	}
+	ext, err := smtpCmd(tp, 250, "EHLO %s", ehlo)
+	if err != nil {
+		return ecserr.NewSMTPEHLORejectedErr(err)
+	}
+	capabilities := smtpCapabilities(ext)
+
+	if cfg.StartTLS {
+		if err := starttls.SMTP(tp, ext); err != nil {
+			return ecserr.NewSTARTTLSFailedErr("smtp", err)
+		}
+		if conn, err = jf.startTLS(event, conn, serverName); err != nil {
+			return ecserr.NewSTARTTLSFailedErr("smtp", err)
+		}
+		fields["starttls"] = true
+		tp = textproto.NewConn(conn)
+		// Capabilities may change once the connection is encrypted
+		if ext, err = smtpCmd(tp, 250, "EHLO %s", ehlo); err != nil {
+			return ecserr.NewSMTPEHLORejectedErr(err)
+		}
+		capabilities = smtpCapabilities(ext)
+	}
+	fields["capabilities"] = sortedKeys(capabilities)
+
+	if cfg.Username != "" {
+		if !isTLS(conn) {
+			return ecserr.NewSMTPAuthFailedErr(errPlaintextCredentials)
+		}
+		if err := smtpAuth(tp, capabilities["AUTH"], cfg.Username, cfg.Password); err != nil {
+			return ecserr.NewSMTPAuthFailedErr(err)
+		}
+		fields["authenticated"] = true
+	}
+
+	_, _ = smtpCmd(tp, 221, "QUIT")
+	return nil
+}
+
+func smtpCmd(tp *textproto.Conn, expectCode int, format string, args ...interface{}) (string, error) {
+	id, err := tp.Cmd(format, args...)
+	if err != nil {
+		return "", err
+	}
+	tp.StartResponse(id)
+	defer tp.EndResponse(id)
+	_, msg, err := tp.ReadResponse(expectCode)
+	return msg, err
+}
+
+// smtpCapabilities parses the EHLO reply into a map of extension keyword to parameters.
+func smtpCapabilities(ext string) map[string]string {
+	capabilities := map[string]string{}
+	// The first line is the server's greeting
+	lines := strings.Split(ext, "\n")
+	for _, line := range lines[1:] {
+		keyword, params, _ := strings.Cut(strings.TrimSpace(line), " ")
+		if keyword != "" {
+			capabilities[strings.ToUpper(keyword)] = params
+		}
+	}
+	return capabilities
+}
+
+func smtpAuth(tp *textproto.Conn, mechanisms string, username, password string) error {
+	mechs := strings.Fields(strings.ToUpper(mechanisms))
+	switch {
+	case slices.Contains(mechs, "PLAIN"):
+		creds := base64.StdEncoding.EncodeToString([]byte("\x00" + username + "\x00" + password))
+		_, err := smtpCmd(tp, 235, "AUTH PLAIN %s", creds)
+		return err
+	case slices.Contains(mechs, "LOGIN"):
+		if _, err := smtpCmd(tp, 334, "AUTH LOGIN"); err != nil {
+			return err
+		}
+		if _, err := smtpCmd(tp, 334, "%s", base64.StdEncoding.EncodeToString([]byte(username))); err != nil {
+			return err
+		}
+		_, err := smtpCmd(tp, 235, "%s", base64.StdEncoding.EncodeToString([]byte(password)))
+		return err
+	}
+	return fmt.Errorf("no supported AUTH mechanism, server offers '%s'", mechanisms)
+}
+
+func (jf *jobFactory) checkIMAP(event *beat.Event, conn net.Conn, serverName string) error {
+	cfg := jf.config.IMAP
+	fields := mapstr.M{"name": "imap"}
+	defer func() {
+		_, _ = event.Fields.Put("tcp.protocol", fields)
+	}()
+
+	br := bufio.NewReader(conn)
+	greeting, err := readTextLine(br)
+	if err != nil {
+		return ecserr.NewIMAPGreetingFailedErr(err)
+	}
+	if !strings.HasPrefix(greeting, "* OK") && !strings.HasPrefix(greeting, "* PREAUTH") {
+		return ecserr.NewIMAPGreetingFailedErr(fmt.Errorf("unexpected greeting '%s'", greeting))
+	}
+	fields["greeting"] = greeting
+
+	if cfg.StartTLS {
+		if err := starttls.IMAP(conn, br, "a1"); err != nil {
+			return ecserr.NewSTARTTLSFailedErr("imap", err)
+		}
+		if conn, err = jf.startTLS(event, conn, serverName); err != nil {
+			return ecserr.NewSTARTTLSFailedErr("imap", err)
+		}
+		fields["starttls"] = true
+		br = bufio.NewReader(conn)
+	}
+
+	if cfg.Username != "" {
+		if !isTLS(conn) {
+			return ecserr.NewIMAPLoginFailedErr(errPlaintextCredentials)
+		}
+		if err := imapCmd(conn, br, "a2", "LOGIN "+imapQuote(cfg.Username)+" "+imapQuote(cfg.Password)); err != nil {
+			return ecserr.NewIMAPLoginFailedErr(err)
+		}
+		fields["authenticated"] = true
+	}
+
+	_ = imapCmd(conn, br, "a3", "LOGOUT")
+	return nil
+}
+
+// imapCmd sends a tagged command and waits for its tagged reply, which must be OK.
+func imapCmd(conn net.Conn, br *bufio.Reader, tag string, cmd string) error {
+	if _, err := fmt.Fprintf(conn, "%s %s\r\n", tag, cmd); err != nil {
+		return err
+	}
+	for {
+		line, err := readTextLine(br)
+		if err != nil {
+			return err
+		}
+		status, found := strings.CutPrefix(line, tag+" ")
+		if !found {
+			continue // untagged data
+		}
+		if !strings.HasPrefix(status, "OK") {
+			return fmt.Errorf("server replied '%s'", status)
+		}
+		return nil
+	}
+}
+
+func imapQuote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+func (jf *jobFactory) checkPOP3(event *beat.Event, conn net.Conn, serverName string) error {
+	cfg := jf.config.POP3
+	fields := mapstr.M{"name": "pop3"}
+	defer func() {
+		_, _ = event.Fields.Put("tcp.protocol", fields)
+	}()
+
+	br := bufio.NewReader(conn)
+	greeting, err := pop3Reply(br)
+	if err != nil {
+		return ecserr.NewPOP3GreetingFailedErr(err)
+	}
+	fields["greeting"] = greeting
+
+	if cfg.StartTLS {
+		if err := starttls.POP3(conn, br); err != nil {
+			return ecserr.NewSTARTTLSFailedErr("pop3", err)
+		}
+		if conn, err = jf.startTLS(event, conn, serverName); err != nil {
+			return ecserr.NewSTARTTLSFailedErr("pop3", err)
+		}
+		fields["starttls"] = true
+		br = bufio.NewReader(conn)
+	}
+
+	if cfg.Username != "" {
+		if !isTLS(conn) {
+			return ecserr.NewPOP3LoginFailedErr(errPlaintextCredentials)
+		}
+		if _, err := pop3Cmd(conn, br, "USER "+cfg.Username); err != nil {
+			return ecserr.NewPOP3LoginFailedErr(err)
+		}
+		if _, err := pop3Cmd(conn, br, "PASS "+cfg.Password); err != nil {
+			return ecserr.NewPOP3LoginFailedErr(err)
+		}
+		fields["authenticated"] = true
+	}
+
+	_, _ = pop3Cmd(conn, br, "QUIT")
+	return nil
+}
+
+func pop3Cmd(conn net.Conn, br *bufio.Reader, cmd string) (string, error) {
+	if _, err := fmt.Fprintf(conn, "%s\r\n", cmd); err != nil {
+		return "", err
+	}
+	return pop3Reply(br)
+}
+
+func pop3Reply(br *bufio.Reader) (string, error) {
+	line, err := readTextLine(br)
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasPrefix(line, "+OK") {
+		return "", fmt.Errorf("server replied '%s'", line)
+	}
+	return line, nil
+}
+
+func readTextLine(br *bufio.Reader) (string, error) {
+	line, err := br.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	return slices.Sorted(maps.Keys(m))
+}
@@ -0,0 +1,355 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tcp
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+
+	"github.com/elastic/beats/v7/heartbeat/ecserr"
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/libbeat/beat"
+)
+
+// exchange is a single request/reply step of a scripted server. When upgrade
+// is set the server starts TLS after writing the reply.
+type exchange struct {
+	request string
+	reply   string
+	upgrade bool
+}
+
+// startScriptedServer accepts a single connection, writes the greeting and
+// then replies to each expected request in order.
+func startScriptedServer(t *testing.T, tlsCfg *tls.Config, greeting string, exchanges []exchange) uint16 {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		_, _ = io.WriteString(conn, greeting)
+		for _, ex := range exchanges {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			if line != ex.request {
+				t.Errorf("expected request %q, got %q", ex.request, line)
+				return
+			}
+			_, _ = io.WriteString(conn, ex.reply)
+			if ex.upgrade {
+				tlsConn := tls.Server(conn, tlsCfg)
+				if err := tlsConn.Handshake(); err != nil {
+					t.Errorf("TLS handshake failed: %s", err)
+					return
+				}
+				conn = tlsConn
+				r = bufio.NewReader(conn)
+			}
+		}
+		// Wait for the client to hang up
+		_, _ = io.Copy(io.Discard, r)
+	}()
+
+	return uint16(l.Addr().(*net.TCPAddr).Port)
+}
+
+// testServerTLS returns the TLS config of the httptest server along with a CA
+// file trusting its certificate, valid for 127.0.0.1.
+func testServerTLS(t *testing.T) (*tls.Config, string) {
+	server := httptest.NewUnstartedServer(nil)
+	server.StartTLS()
+	tlsCfg := server.TLS.Clone()
+	server.Close()
+
+	cert, err := x509.ParseCertificate(tlsCfg.Certificates[0].Certificate[0])
+	require.NoError(t, err)
+	certFile := hbtest.CertToTempFile(t, cert)
+	require.NoError(t, certFile.Close())
+	t.Cleanup(func() { _ = os.Remove(certFile.Name()) })
+
+	return tlsCfg, certFile.Name()
+}
+
+func testProtocolCheck(t *testing.T, port uint16, protocol string, protocolCfg mapstr.M, caFile string) *beat.Event {
+	config := mapstr.M{
+		"hosts":    "127.0.0.1",
+		"ports":    port,
+		"timeout":  "1s",
+		"protocol": protocol,
+	}
+	if protocolCfg != nil {
+		config[protocol] = protocolCfg
+	}
+	if caFile != "" {
+		config["ssl"] = mapstr.M{"certificate_authorities": caFile}
+	}
+	return testTCPConfigCheck(t, config)
+}
+
+func protocolUpChecks(port uint16, protocol map[string]interface{}) validator.Validator {
+	return lookslike.Compose(
+		hbtest.BaseChecks("127.0.0.1", "up", "tcp"),
+		hbtest.SummaryStateChecks(1, 0),
+		lookslike.MustCompile(map[string]interface{}{
+			"tcp.protocol": protocol,
+			"url.port":     port,
+		}),
+	)
+}
+
+func protocolDownChecks(ecode ecserr.ECode, msg string) validator.Validator {
+	return lookslike.Compose(
+		hbtest.BaseChecks("127.0.0.1", "down", "tcp"),
+		hbtest.SummaryStateChecks(0, 1),
+		hbtest.ECSErrCodeChecks(ecode, msg),
+	)
+}
+
+func TestSMTPCheck(t *testing.T) {
+	t.Run("up", func(t *testing.T) {
+		port := startScriptedServer(t, nil, "220 mx.example.com ESMTP\r\n", []exchange{
+			{request: "EHLO monitor.example.com\r\n", reply: "250-mx.example.com\r\n250-SIZE 1000\r\n250 8BITMIME\r\n"},
+			{request: "QUIT\r\n", reply: "221 bye\r\n"},
+		})
+		event := testProtocolCheck(t, port, "smtp", mapstr.M{"ehlo": "monitor.example.com"}, "")
+		testslike.Test(t, protocolUpChecks(port, map[string]interface{}{
+			"name":         "smtp",
+			"greeting":     "mx.example.com ESMTP",
+			"capabilities": []string{"8BITMIME", "SIZE"},
+		}), event.Fields)
+	})
+
+	t.Run("starttls and auth", func(t *testing.T) {
+		tlsCfg, caFile := testServerTLS(t)
+		port := startScriptedServer(t, tlsCfg, "220 mx.example.com ESMTP\r\n", []exchange{
+			{request: "EHLO heartbeat\r\n", reply: "250-mx.example.com\r\n250 STARTTLS\r\n"},
+			{request: "STARTTLS\r\n", reply: "220 go ahead\r\n", upgrade: true},
+			{request: "EHLO heartbeat\r\n", reply: "250-mx.example.com\r\n250 AUTH LOGIN PLAIN\r\n"},
+			{request: "AUTH PLAIN AHVzZXIAc2VjcmV0\r\n", reply: "235 ok\r\n"},
+			{request: "QUIT\r\n", reply: "221 bye\r\n"},
+		})
+		event := testProtocolCheck(t, port, "smtp", mapstr.M{
+			"starttls": true,
+			"username": "user",
+			"password": "secret",
+		}, caFile)
+		testslike.Test(t, protocolUpChecks(port, map[string]interface{}{
+			"name":          "smtp",
+			"starttls":      true,
+			"authenticated": true,
+			"capabilities":  []string{"AUTH"},
+		}), event.Fields)
+	})
+
+	tests := []struct {
+		name      string
+		greeting  string
+		exchanges []exchange
+		cfg       mapstr.M
+		code      ecserr.ECode
+		msg       string
+	}{
+		{
+			name:     "bad greeting",
+			greeting: "554 no service\r\n",
+			code:     ecserr.CODE_SMTP_GREETING_FAILED,
+			msg:      "no service",
+		},
+		{
+			name:      "ehlo rejected",
+			greeting:  "220 ready\r\n",
+			exchanges: []exchange{{request: "EHLO heartbeat\r\n", reply: "502 command not implemented\r\n"}},
+			code:      ecserr.CODE_SMTP_EHLO_REJECTED,
+			msg:       "command not implemented",
+		},
+		{
+			name:      "starttls not offered",
+			greeting:  "220 ready\r\n",
+			exchanges: []exchange{{request: "EHLO heartbeat\r\n", reply: "250 mx.example.com\r\n"}},
+			cfg:       mapstr.M{"starttls": true},
+			code:      ecserr.CODE_STARTTLS_FAILED,
+			msg:       "does not advertise STARTTLS",
+		},
+		{
+			name:      "plaintext credentials",
+			greeting:  "220 ready\r\n",
+			exchanges: []exchange{{request: "EHLO heartbeat\r\n", reply: "250-mx.example.com\r\n250 AUTH PLAIN\r\n"}},
+			cfg:       mapstr.M{"username": "user", "password": "secret"},
+			code:      ecserr.CODE_SMTP_AUTH_FAILED,
+			msg:       "unencrypted connection",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			port := startScriptedServer(t, nil, tt.greeting, tt.exchanges)
+			event := testProtocolCheck(t, port, "smtp", tt.cfg, "")
+			testslike.Test(t, protocolDownChecks(tt.code, tt.msg), event.Fields)
+		})
+	}
+}
+
+func TestIMAPCheck(t *testing.T) {
+	t.Run("starttls and login", func(t *testing.T) {
+		tlsCfg, caFile := testServerTLS(t)
+		port := startScriptedServer(t, tlsCfg, "* OK IMAP4rev1 ready\r\n", []exchange{
+			{request: "a1 STARTTLS\r\n", reply: "a1 OK begin TLS\r\n", upgrade: true},
+			{request: "a2 LOGIN \"user\" \"p\\\"w\"\r\n", reply: "* CAPABILITY IMAP4rev1\r\na2 OK logged in\r\n"},
+			{request: "a3 LOGOUT\r\n", reply: "* BYE\r\na3 OK\r\n"},
+		})
+		event := testProtocolCheck(t, port, "imap", mapstr.M{
+			"starttls": true,
+			"username": "user",
+			"password": `p"w`,
+		}, caFile)
+		testslike.Test(t, protocolUpChecks(port, map[string]interface{}{
+			"name":          "imap",
+			"greeting":      "* OK IMAP4rev1 ready",
+			"starttls":      true,
+			"authenticated": true,
+		}), event.Fields)
+	})
+
+	t.Run("login failed", func(t *testing.T) {
+		tlsCfg, caFile := testServerTLS(t)
+		port := startScriptedServer(t, tlsCfg, "* OK ready\r\n", []exchange{
+			{request: "a1 STARTTLS\r\n", reply: "a1 OK begin TLS\r\n", upgrade: true},
+			{request: "a2 LOGIN \"user\" \"wrong\"\r\n", reply: "a2 NO [AUTHENTICATIONFAILED] invalid credentials\r\n"},
+		})
+		event := testProtocolCheck(t, port, "imap", mapstr.M{
+			"starttls": true,
+			"username": "user",
+			"password": "wrong",
+		}, caFile)
+		testslike.Test(t, protocolDownChecks(ecserr.CODE_IMAP_LOGIN_FAILED, "AUTHENTICATIONFAILED"), event.Fields)
+	})
+
+	t.Run("bad greeting", func(t *testing.T) {
+		port := startScriptedServer(t, nil, "* BYE too many connections\r\n", nil)
+		event := testProtocolCheck(t, port, "imap", nil, "")
+		testslike.Test(t, protocolDownChecks(ecserr.CODE_IMAP_GREETING_FAILED, "too many connections"), event.Fields)
+	})
+}
+
+func TestPOP3Check(t *testing.T) {
+	t.Run("up", func(t *testing.T) {
+		port := startScriptedServer(t, nil, "+OK POP3 ready\r\n", []exchange{
+			{request: "QUIT\r\n", reply: "+OK bye\r\n"},
+		})
+		event := testProtocolCheck(t, port, "pop3", nil, "")
+		testslike.Test(t, protocolUpChecks(port, map[string]interface{}{
+			"name":     "pop3",
+			"greeting": "+OK POP3 ready",
+		}), event.Fields)
+	})
+
+	t.Run("login failed", func(t *testing.T) {
+		tlsCfg, caFile := testServerTLS(t)
+		port := startScriptedServer(t, tlsCfg, "+OK POP3 ready\r\n", []exchange{
+			{request: "STLS\r\n", reply: "+OK begin TLS\r\n", upgrade: true},
+			{request: "USER user\r\n", reply: "+OK\r\n"},
+			{request: "PASS wrong\r\n", reply: "-ERR [AUTH] invalid credentials\r\n"},
+		})
+		event := testProtocolCheck(t, port, "pop3", mapstr.M{
+			"starttls": true,
+			"username": "user",
+			"password": "wrong",
+		}, caFile)
+		testslike.Test(t, protocolDownChecks(ecserr.CODE_POP3_LOGIN_FAILED, "invalid credentials"), event.Fields)
+	})
+
+	t.Run("bad greeting", func(t *testing.T) {
+		port := startScriptedServer(t, nil, "-ERR maintenance\r\n", nil)
+		event := testProtocolCheck(t, port, "pop3", nil, "")
+		testslike.Test(t, protocolDownChecks(ecserr.CODE_POP3_GREETING_FAILED, "maintenance"), event.Fields)
+	})
+}
+
+func TestProtocolConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     mapstr.M
+		wantErr string
+	}{
+		{
+			name: "smtp",
+			cfg:  mapstr.M{"protocol": "smtp"},
+		},
+		{
+			name:    "unknown protocol",
+			cfg:     mapstr.M{"protocol": "gopher"},
+			wantErr: "unknown protocol",
+		},
+		{
+			name:    "protocol with data check",
+			cfg:     mapstr.M{"protocol": "pop3", "check.send": "hello"},
+			wantErr: "can not be used with protocol",
+		},
+		{
+			name:    "password without username",
+			cfg:     mapstr.M{"protocol": "imap", "imap.password": "secret"},
+			wantErr: "username",
+		},
+		{
+			name:    "invalid ldap scope",
+			cfg:     mapstr.M{"protocol": "ldap", "ldap.search.base_dn": "dc=example,dc=com", "ldap.search.scope": "all"},
+			wantErr: "scope",
+		},
+		{
+			name:    "invalid ldap filter",
+			cfg:     mapstr.M{"protocol": "ldap", "ldap.search.base_dn": "dc=example,dc=com", "ldap.search.filter": "(cn=foo"},
+			wantErr: "filter",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg["hosts"] = "localhost:1234"
+			c, err := conf.NewConfigFrom(tt.cfg)
+			require.NoError(t, err)
+			cfg := defaultConfig()
+			err = c.Unpack(&cfg)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
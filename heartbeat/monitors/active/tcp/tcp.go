@@ -81,6 +81,7 @@ type jobFactory struct {
 	defaultScheme string
 	endpoints     []endpoint
 	dataCheck     dataCheck
+	protocolCheck protocolCheck
 	resolver      monitors.Resolver
 }
 
@@ -108,16 +109,27 @@ func (jf *jobFactory) loadConfig(commonCfg *conf.C) error {
 	}
 
 	jf.defaultScheme = "tcp"
-	if jf.tlsConfig != nil {
+	// With STARTTLS the ssl settings are used to upgrade a plaintext connection
+	if jf.tlsConfig != nil && !jf.config.startTLS() {
 		jf.defaultScheme = "ssl"
 	}
 
-	jf.endpoints, err = makeEndpoints(jf.config.Hosts, jf.config.Ports, jf.defaultScheme)
+	ports := jf.config.Ports
+	if defaults, ok := defaultProtocolPorts[jf.config.Protocol]; ok && len(ports) == 0 {
+		if jf.defaultScheme == "ssl" {
+			ports = []uint16{defaults[1]}
+		} else {
+			ports = []uint16{defaults[0]}
+		}
+	}
+
+	jf.endpoints, err = makeEndpoints(jf.config.Hosts, ports, jf.defaultScheme)
 	if err != nil {
 		return err
 	}
 
 	jf.dataCheck = makeDataCheck(&jf.config)
+	jf.protocolCheck = jf.makeProtocolCheck()
 
 	return nil
 }
@@ -216,7 +228,7 @@ func (jf *jobFactory) dial(event *beat.Event, dialAddr string, canonicalURL *url
 		return err
 	}
 
-	return jf.execDialer(event, dialer, dialAddr)
+	return jf.execDialer(event, dialer, dialAddr, canonicalURL.Hostname())
 }
 
 // exec dialer executes a network request against the given dialer.
@@ -224,6 +236,7 @@ func (jf *jobFactory) execDialer(
 	event *beat.Event,
 	dialer transport.Dialer,
 	addr string,
+	serverName string,
 ) error {
 	start := time.Now()
 	deadline := start.Add(jf.config.Timeout)
@@ -239,6 +252,9 @@ func (jf *jobFactory) execDialer(
 		return reason.IOFailed(err)
 	}
 	defer conn.Close()
+	if jf.protocolCheck != nil {
+		return jf.execProtocolCheck(event, conn, deadline, serverName)
+	}
 	if jf.dataCheck == nil {
 		// no additional validation step => ping success
 		return nil
@@ -270,3 +286,25 @@ func (jf *jobFactory) execDialer(
 
 	return nil
 }
+
+// execProtocolCheck runs the configured protocol handshake on an open connection.
+func (jf *jobFactory) execProtocolCheck(event *beat.Event, conn net.Conn, deadline time.Time, serverName string) error {
+	if err := conn.SetDeadline(deadline); err != nil {
+		debugf("setting connection deadline failed with: %v", err)
+		return reason.IOFailed(err)
+	}
+
+	validateStart := time.Now()
+	err := jf.protocolCheck(event, conn, serverName)
+	eventext.MergeEventFields(event, mapstr.M{
+		"tcp": mapstr.M{
+			"rtt": mapstr.M{
+				"validate": look.RTT(time.Since(validateStart)),
+			},
+		},
+	})
+	if err != nil {
+		debugf("%s check failed with: %v", jf.config.Protocol, err)
+	}
+	return err
+}
@@ -0,0 +1,267 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tls
+
+import (
+	dsa2 "crypto/dsa" //nolint:staticcheck // DSA keys are reported as weak
+	"crypto/rsa"
+	"crypto/sha256"
+	cryptoTLS "crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/elastic/beats/v7/heartbeat/ecserr"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+// minRSAKeySize is the smallest RSA key size not reported as weak.
+const minRSAKeySize = 2048
+
+var weakSignatureAlgorithms = map[x509.SignatureAlgorithm]bool{
+	x509.MD2WithRSA:    true,
+	x509.MD5WithRSA:    true,
+	x509.SHA1WithRSA:   true,
+	x509.DSAWithSHA1:   true,
+	x509.ECDSAWithSHA1: true,
+}
+
+var insecureCipherSuites = func() map[uint16]bool {
+	m := map[uint16]bool{}
+	for _, cs := range cryptoTLS.InsecureCipherSuites() {
+		m[cs.ID] = true
+	}
+	return m
+}()
+
+// certCheck validates the certificates presented by a server. It always
+// receives the full chain, since the handshake itself does not verify it.
+type certCheck struct {
+	verification tlscommon.TLSVerificationMode
+	roots        *x509.CertPool
+	minDays      int
+	rejectWeak   bool
+	ocsp         bool
+}
+
+// check returns the tls fields describing the connection and the first
+// failed validation, if any. It sets the verified chains of state if the
+// chain is trusted.
+func (c *certCheck) check(state *cryptoTLS.ConnectionState, serverName string, now time.Time) (mapstr.M, error) {
+	certs := state.PeerCertificates
+	if len(certs) == 0 {
+		return nil, errors.New("server presented no certificates")
+	}
+	leaf := certs[0]
+
+	fields := mapstr.M{}
+	chainFields := make([]mapstr.M, len(certs))
+	pemChain := make([]string, len(certs))
+	minDaysLeft := math.MaxInt
+	for i, cert := range certs {
+		chainFields[i] = certSummary(cert, now)
+		pemChain[i] = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+		if d := daysUntil(cert.NotAfter, now); d < minDaysLeft {
+			minDaysLeft = d
+		}
+	}
+	_, _ = fields.Put("server.certificate_chain", pemChain)
+	fields["chain"] = chainFields
+	fields["days_until_expiry"] = minDaysLeft
+
+	weak := weakParameters(state)
+	if len(weak) > 0 {
+		fields["weak"] = weak
+	}
+
+	var err error
+	setErr := func(e error) {
+		if err == nil {
+			err = e
+		}
+	}
+
+	// Expiry is checked regardless of the verification mode, as reporting it
+	// is the purpose of this monitor.
+	if now.After(leaf.NotAfter) {
+		setErr(ecserr.NewTLSCertExpiredErr(leaf.Subject.String(), leaf.NotAfter))
+	} else if now.Before(leaf.NotBefore) {
+		setErr(ecserr.NewTLSCertNotYetValidErr(leaf.Subject.String(), leaf.NotBefore))
+	}
+
+	if c.verification != tlscommon.VerifyNone {
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		chains, verifyErr := leaf.Verify(x509.VerifyOptions{
+			Roots:         c.roots,
+			Intermediates: intermediates,
+			CurrentTime:   now,
+		})
+		fields["verified"] = verifyErr == nil
+		if verifyErr != nil {
+			var invalidErr x509.CertificateInvalidError
+			if errors.As(verifyErr, &invalidErr) && invalidErr.Reason == x509.Expired {
+				// an expired intermediate
+				setErr(ecserr.NewTLSCertExpiredErr(invalidErr.Cert.Subject.String(), invalidErr.Cert.NotAfter))
+			} else {
+				setErr(ecserr.NewTLSCertUntrustedErr(verifyErr))
+			}
+		} else {
+			state.VerifiedChains = chains
+		}
+
+		if c.verification != tlscommon.VerifyCertificate {
+			hostErr := leaf.VerifyHostname(serverName)
+			fields["hostname_verified"] = hostErr == nil
+			if hostErr != nil {
+				setErr(ecserr.NewTLSHostnameMismatchErr(hostErr))
+			}
+		}
+	}
+
+	ocspFields, revokedAt := checkOCSP(state, leaf)
+	fields["ocsp"] = ocspFields
+	if c.ocsp && revokedAt != nil {
+		setErr(ecserr.NewTLSCertRevokedErr(leaf.Subject.String(), *revokedAt))
+	}
+
+	if c.minDays > 0 && minDaysLeft >= 0 && minDaysLeft < c.minDays {
+		setErr(ecserr.NewTLSCertExpiringErr(leaf.Subject.String(), minDaysLeft, c.minDays))
+	}
+
+	if c.rejectWeak && len(weak) > 0 {
+		setErr(ecserr.NewTLSWeakAlgorithmErr(weak))
+	}
+
+	return fields, err
+}
+
+func certSummary(cert *x509.Certificate, now time.Time) mapstr.M {
+	m := mapstr.M{
+		"subject": mapstr.M{
+			"common_name":        cert.Subject.CommonName,
+			"distinguished_name": cert.Subject.String(),
+		},
+		"issuer": mapstr.M{
+			"common_name":        cert.Issuer.CommonName,
+			"distinguished_name": cert.Issuer.String(),
+		},
+		"serial_number":        cert.SerialNumber.String(),
+		"not_before":           cert.NotBefore,
+		"not_after":            cert.NotAfter,
+		"days_until_expiry":    daysUntil(cert.NotAfter, now),
+		"signature_algorithm":  cert.SignatureAlgorithm.String(),
+		"public_key_algorithm": cert.PublicKeyAlgorithm.String(),
+		"hash": mapstr.M{
+			"sha256": fmt.Sprintf("%x", sha256.Sum256(cert.Raw)),
+		},
+	}
+	if size := publicKeySize(cert); size > 0 {
+		m["public_key_size"] = size
+	}
+	return m
+}
+
+// daysUntil returns the number of whole days until t, negative once t has passed.
+func daysUntil(t time.Time, now time.Time) int {
+	return int(math.Floor(t.Sub(now).Hours() / 24))
+}
+
+func publicKeySize(cert *x509.Certificate) int {
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return key.Size() * 8
+	case *dsa2.PublicKey:
+		return key.P.BitLen()
+	}
+	return 0
+}
+
+// weakParameters lists the weak protocol parameters and algorithms in use.
+func weakParameters(state *cryptoTLS.ConnectionState) []string {
+	var weak []string
+	if state.Version < cryptoTLS.VersionTLS12 {
+		weak = append(weak, "protocol "+cryptoTLS.VersionName(state.Version))
+	}
+	if insecureCipherSuites[state.CipherSuite] {
+		weak = append(weak, "cipher "+cryptoTLS.CipherSuiteName(state.CipherSuite))
+	}
+	for _, cert := range state.PeerCertificates {
+		name := cert.Subject.CommonName
+		// the signature of a self-signed root is not used for verification
+		selfSigned := cert.Subject.String() == cert.Issuer.String()
+		if weakSignatureAlgorithms[cert.SignatureAlgorithm] && !selfSigned {
+			weak = append(weak, fmt.Sprintf("signature %s (%s)", cert.SignatureAlgorithm, name))
+		}
+		switch cert.PublicKeyAlgorithm {
+		case x509.RSA:
+			if size := publicKeySize(cert); size < minRSAKeySize {
+				weak = append(weak, fmt.Sprintf("RSA key size %d (%s)", size, name))
+			}
+		case x509.DSA:
+			weak = append(weak, fmt.Sprintf("DSA key (%s)", name))
+		}
+	}
+	return weak
+}
+
+// checkOCSP reports on the stapled OCSP response, returning the revocation
+// time if the certificate was revoked.
+func checkOCSP(state *cryptoTLS.ConnectionState, leaf *x509.Certificate) (mapstr.M, *time.Time) {
+	fields := mapstr.M{"stapled": len(state.OCSPResponse) > 0}
+	if len(state.OCSPResponse) == 0 {
+		return fields, nil
+	}
+
+	var issuer *x509.Certificate
+	if len(state.VerifiedChains) > 0 && len(state.VerifiedChains[0]) > 1 {
+		issuer = state.VerifiedChains[0][1]
+	} else if len(state.PeerCertificates) > 1 {
+		issuer = state.PeerCertificates[1]
+	}
+
+	resp, err := ocsp.ParseResponseForCert(state.OCSPResponse, leaf, issuer)
+	if err != nil {
+		fields["status"] = "invalid"
+		fields["error"] = err.Error()
+		return fields, nil
+	}
+
+	fields["produced_at"] = resp.ProducedAt
+	if !resp.NextUpdate.IsZero() {
+		fields["next_update"] = resp.NextUpdate
+	}
+	switch resp.Status {
+	case ocsp.Good:
+		fields["status"] = "good"
+	case ocsp.Revoked:
+		fields["status"] = "revoked"
+		return fields, &resp.RevokedAt
+	default:
+		fields["status"] = "unknown"
+	}
+	return fields, nil
+}
@@ -0,0 +1,138 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tls
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+const schemeTLS = "tls"
+
+// defaultPorts lists the supported schemes with their default port. All
+// schemes but tls are upgraded with STARTTLS.
+var defaultPorts = map[string]int{
+	schemeTLS:  443,
+	"smtp":     25,
+	"imap":     143,
+	"pop3":     110,
+	"ftp":      21,
+	"postgres": 5432,
+	"ldap":     389,
+}
+
+type config struct {
+	// Hosts are either host:port pairs or URLs whose scheme selects the
+	// STARTTLS protocol, e.g. smtp://mail.example.com:587.
+	Hosts []string `config:"hosts" validate:"required"`
+
+	Mode monitors.IPSettings `config:",inline"`
+
+	// StartTLS is the protocol used to upgrade plaintext connections for
+	// hosts without a scheme.
+	StartTLS string `config:"starttls"`
+
+	// ServerName overrides the name used for SNI and hostname verification.
+	ServerName string `config:"server_name"`
+
+	TLS *tlscommon.Config `config:"ssl"`
+
+	Timeout time.Duration `config:"timeout"`
+
+	Check checkConfig `config:"check"`
+}
+
+type checkConfig struct {
+	Expiry struct {
+		// MinDays fails the check if the server certificate expires sooner.
+		MinDays int `config:"min_days" validate:"min=0"`
+	} `config:"expiry"`
+
+	// RejectWeak fails the check if weak algorithms are in use.
+	RejectWeak bool `config:"reject_weak"`
+
+	// OCSP fails the check if the stapled OCSP response reports the
+	// certificate as revoked.
+	OCSP bool `config:"ocsp"`
+}
+
+func defaultConfig() config {
+	cfg := config{
+		Mode:    monitors.DefaultIPSettings,
+		Timeout: 16 * time.Second,
+	}
+	cfg.Check.OCSP = true
+	return cfg
+}
+
+func (c *config) Validate() error {
+	if _, ok := defaultPorts[c.StartTLS]; c.StartTLS != "" && !ok {
+		return fmt.Errorf("unsupported starttls protocol '%s'", c.StartTLS)
+	}
+	if c.StartTLS == schemeTLS {
+		return fmt.Errorf("starttls must be one of the plaintext protocols, not '%s'", schemeTLS)
+	}
+	return nil
+}
+
+// endpoint is a single host to check.
+type endpoint struct {
+	scheme string
+	host   string
+	port   string
+}
+
+func (e endpoint) url() *url.URL {
+	return &url.URL{Scheme: e.scheme, Host: net.JoinHostPort(e.host, e.port)}
+}
+
+func makeEndpoint(h string, startTLS string) (endpoint, error) {
+	scheme := schemeTLS
+	if startTLS != "" {
+		scheme = startTLS
+	}
+
+	hostPort := h
+	if u, err := url.Parse(h); err == nil && u.Host != "" {
+		if _, ok := defaultPorts[u.Scheme]; !ok {
+			return endpoint{}, fmt.Errorf("'%s' is not a supported scheme in '%s'", u.Scheme, h)
+		}
+		scheme = u.Scheme
+		hostPort = u.Host
+	}
+
+	host, port, err := net.SplitHostPort(hostPort)
+	if err != nil {
+		// no port given, use the protocol default
+		host, port = hostPort, strconv.Itoa(defaultPorts[scheme])
+	}
+	if host == "" {
+		return endpoint{}, fmt.Errorf("could not parse host '%s'", h)
+	}
+	if _, err := strconv.ParseUint(port, 10, 16); err != nil {
+		return endpoint{}, fmt.Errorf("invalid port in host '%s': %w", h, err)
+	}
+
+	return endpoint{scheme: scheme, host: host, port: port}, nil
+}
@@ -0,0 +1,191 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tls
+
+import (
+	"net"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/ecserr"
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/dialchain/tlsmeta"
+	"github.com/elastic/beats/v7/heartbeat/monitors/active/starttls"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers/wraputil"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+func init() {
+	plugin.Register("tls", create, "synthetics/tls")
+}
+
+var debugf = logp.MakeDebug("tls")
+
+func create(
+	name string,
+	cfg *conf.C,
+) (p plugin.Plugin, err error) {
+	return createWithResolver(cfg, monitors.NewStdResolver())
+}
+
+func createWithResolver(
+	cfg *conf.C,
+	resolver monitors.Resolver,
+) (p plugin.Plugin, err error) {
+	jf, err := newJobFactory(cfg, resolver)
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	js, err := jf.makeJobs()
+	if err != nil {
+		return plugin.Plugin{}, err
+	}
+
+	return plugin.Plugin{Jobs: js, Endpoints: len(js)}, nil
+}
+
+// jobFactory creates one job per host.
+type jobFactory struct {
+	config    config
+	tlsConfig *tlscommon.TLSConfig
+	endpoints []endpoint
+	check     certCheck
+	resolver  monitors.Resolver
+}
+
+func newJobFactory(commonCfg *conf.C, resolver monitors.Resolver) (*jobFactory, error) {
+	jf := &jobFactory{config: defaultConfig(), resolver: resolver}
+	if err := commonCfg.Unpack(&jf.config); err != nil {
+		return nil, err
+	}
+
+	var err error
+	jf.tlsConfig, err = tlscommon.LoadTLSConfig(jf.config.TLS, logp.NewLogger("tls"))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range jf.config.Hosts {
+		ep, err := makeEndpoint(h, jf.config.StartTLS)
+		if err != nil {
+			return nil, err
+		}
+		jf.endpoints = append(jf.endpoints, ep)
+	}
+
+	jf.check = certCheck{
+		verification: tlscommon.VerifyFull,
+		minDays:      jf.config.Check.Expiry.MinDays,
+		rejectWeak:   jf.config.Check.RejectWeak,
+		ocsp:         jf.config.Check.OCSP,
+	}
+	if jf.tlsConfig != nil {
+		jf.check.verification = jf.tlsConfig.Verification
+	}
+
+	return jf, nil
+}
+
+func (jf *jobFactory) makeJobs() ([]jobs.Job, error) {
+	var js []jobs.Job
+	for _, ep := range jf.endpoints {
+		job, err := monitors.MakeByHostJob(
+			ep.host,
+			jf.config.Mode,
+			jf.resolver,
+			monitors.MakePingIPFactory(func(event *beat.Event, ip *net.IPAddr) error {
+				return jf.checkEndpoint(event, net.JoinHostPort(ip.String(), ep.port), ep)
+			}),
+		)
+		if err != nil {
+			return nil, err
+		}
+		js = append(js, wraputil.WithURLField(ep.url(), job))
+	}
+	return js, nil
+}
+
+// checkEndpoint connects to dialAddr, upgrades the connection if required and
+// reports on the TLS handshake and the presented certificates.
+func (jf *jobFactory) checkEndpoint(event *beat.Event, dialAddr string, ep endpoint) error {
+	dc := &dialchain.DialerChain{
+		Net: dialchain.CreateNetDialer(jf.config.Timeout),
+	}
+	dc.AddLayer(dialchain.ConstAddrLayer(dialAddr))
+	dialer, err := dc.Build(event)
+	if err != nil {
+		return err
+	}
+
+	conn, err := dialer.Dial("tcp", dialAddr)
+	if err != nil {
+		debugf("dial failed with: %v", err)
+		return reason.IOFailed(err)
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(jf.config.Timeout)); err != nil {
+		return reason.IOFailed(err)
+	}
+
+	if starttls.Supported(ep.scheme) {
+		if err := starttls.Negotiate(ep.scheme, conn); err != nil {
+			debugf("starttls failed with: %v", err)
+			return ecserr.NewSTARTTLSFailedErr(ep.scheme, err)
+		}
+	}
+
+	serverName := jf.config.ServerName
+	if serverName == "" {
+		serverName = ep.host
+	}
+
+	tlsCfg := jf.tlsConfig.BuildModuleClientConfig(serverName)
+	roots := tlsCfg.RootCAs
+	// The handshake must complete for any certificate so the chain can be
+	// reported, verification is done by the certCheck afterwards.
+	tlsCfg.InsecureSkipVerify = true //nolint:gosec // verified by certCheck
+	tlsCfg.VerifyConnection = nil
+
+	tlsConn, handshakeDuration, err := starttls.Handshake(conn, tlsCfg)
+	if err != nil {
+		debugf("tls handshake failed with: %v", err)
+		return reason.IOFailed(err)
+	}
+
+	state := tlsConn.ConnectionState()
+	check := jf.check
+	check.roots = roots
+	checkFields, checkErr := check.check(&state, serverName, time.Now())
+
+	fields := mapstr.M{}
+	tlsmeta.AddTLSMetadata(fields, state, handshakeDuration)
+	fields.DeepUpdate(mapstr.M{"tls": checkFields})
+	eventext.MergeEventFields(event, fields)
+
+	return checkErr
+}
@@ -0,0 +1,341 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tls
+
+import (
+	"bufio"
+	cryptoTLS "crypto/tls"
+	"crypto/x509"
+	"io"
+	"net"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+	"github.com/elastic/go-lookslike/validator"
+
+	"github.com/elastic/beats/v7/heartbeat/ecserr"
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/hbtestllext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// testCert returns the certificate of the httptest TLS server, which is
+// valid for 127.0.0.1 and example.com, and a file containing it as a CA.
+func testCert(t *testing.T) (cryptoTLS.Certificate, *x509.Certificate, string) {
+	srv := httptest.NewTLSServer(nil)
+	defer srv.Close()
+
+	certFile := hbtest.CertToTempFile(t, srv.Certificate())
+	t.Cleanup(func() { os.Remove(certFile.Name()) })
+	return srv.TLS.Certificates[0], srv.Certificate(), certFile.Name()
+}
+
+// startServer accepts connections, runs preamble on them and completes a TLS
+// handshake with the given certificate.
+func startServer(t *testing.T, cert cryptoTLS.Certificate, preamble func(net.Conn) error) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				if preamble != nil {
+					if err := preamble(conn); err != nil {
+						return
+					}
+				}
+				//nolint:gosec // test server
+				tlsConn := cryptoTLS.Server(conn, &cryptoTLS.Config{Certificates: []cryptoTLS.Certificate{cert}})
+				_ = tlsConn.Handshake()
+				_, _ = io.Copy(io.Discard, tlsConn)
+			}()
+		}
+	}()
+
+	return l.Addr().String()
+}
+
+func testTLSCheck(t *testing.T, cfg mapstr.M) *beat.Event {
+	config, err := conf.NewConfigFrom(cfg)
+	require.NoError(t, err)
+
+	p, err := create("tls", config)
+	require.NoError(t, err)
+
+	sched := schedule.MustParse("@every 1s")
+	js := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "test", Type: "tls", Schedule: sched, Timeout: 1}, nil)
+	require.Len(t, js, 1)
+
+	event := &beat.Event{}
+	_, err = js[0](event)
+	require.NoError(t, err)
+	return event
+}
+
+func tlsChecks(cert *x509.Certificate) validator.Validator {
+	return lookslike.Compose(
+		hbtest.RespondingTCPChecks(),
+		hbtest.TLSCertChecks(cert),
+		lookslike.MustCompile(map[string]interface{}{
+			"tls": map[string]interface{}{
+				"established":              true,
+				"rtt.handshake.us":         hbtestllext.IsInt64,
+				"days_until_expiry":        isdef.IsIntGt(0),
+				"server.certificate_chain": isdef.KeyPresent,
+				"chain": isdef.IsSliceOf(lookslike.MustCompile(map[string]interface{}{
+					"subject.distinguished_name": cert.Subject.String(),
+					"serial_number":              cert.SerialNumber.String(),
+					"days_until_expiry":          isdef.IsIntGt(0),
+					"signature_algorithm":        cert.SignatureAlgorithm.String(),
+				})),
+				"ocsp.stapled": false,
+			},
+		}),
+	)
+}
+
+func TestTrustedCert(t *testing.T) {
+	cert, x509Cert, caFile := testCert(t)
+	addr := startServer(t, cert, nil)
+
+	event := testTLSCheck(t, mapstr.M{
+		"hosts":                       addr,
+		"ssl.certificate_authorities": caFile,
+		"timeout":                     "1s",
+	})
+
+	u, err := url.Parse("tls://" + addr)
+	require.NoError(t, err)
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.BaseChecks("127.0.0.1", "up", "tls"),
+			hbtest.SummaryStateChecks(1, 0),
+			hbtest.URLChecks(t, u),
+			tlsChecks(x509Cert),
+			lookslike.MustCompile(map[string]interface{}{
+				"tls.verified":          true,
+				"tls.hostname_verified": true,
+			}),
+		),
+		event.Fields,
+	)
+}
+
+func TestStartTLSSMTP(t *testing.T) {
+	cert, x509Cert, caFile := testCert(t)
+	addr := startServer(t, cert, func(conn net.Conn) error {
+		r := bufio.NewReader(conn)
+		_, _ = io.WriteString(conn, "220 mail.example.com ESMTP\r\n")
+		if _, err := r.ReadString('\n'); err != nil {
+			return err
+		}
+		_, _ = io.WriteString(conn, "250-mail.example.com\r\n250-SIZE 1000\r\n250 STARTTLS\r\n")
+		if _, err := r.ReadString('\n'); err != nil {
+			return err
+		}
+		_, err := io.WriteString(conn, "220 ready\r\n")
+		return err
+	})
+
+	event := testTLSCheck(t, mapstr.M{
+		"hosts":                       "smtp://" + addr,
+		"ssl.certificate_authorities": caFile,
+		"timeout":                     "1s",
+	})
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.BaseChecks("127.0.0.1", "up", "tls"),
+			hbtest.SummaryStateChecks(1, 0),
+			tlsChecks(x509Cert),
+			lookslike.MustCompile(map[string]interface{}{"url.scheme": "smtp"}),
+		),
+		event.Fields,
+	)
+}
+
+func TestStartTLSNotAdvertised(t *testing.T) {
+	cert, _, _ := testCert(t)
+	addr := startServer(t, cert, func(conn net.Conn) error {
+		r := bufio.NewReader(conn)
+		_, _ = io.WriteString(conn, "220 mail.example.com ESMTP\r\n")
+		if _, err := r.ReadString('\n'); err != nil {
+			return err
+		}
+		_, _ = io.WriteString(conn, "250 mail.example.com\r\n")
+		_, _ = r.ReadString('\n')
+		return io.EOF
+	})
+
+	event := testTLSCheck(t, mapstr.M{
+		"hosts":    addr,
+		"starttls": "smtp",
+		"timeout":  "1s",
+	})
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.BaseChecks("127.0.0.1", "down", "tls"),
+			hbtest.SummaryStateChecks(0, 1),
+			hbtest.ECSErrCodeChecks(ecserr.CODE_STARTTLS_FAILED, "does not advertise STARTTLS"),
+		),
+		event.Fields,
+	)
+}
+
+func TestCertErrors(t *testing.T) {
+	cert, x509Cert, caFile := testCert(t)
+	addr := startServer(t, cert, nil)
+
+	expiredCert, err := cryptoTLS.LoadX509KeyPair("../fixtures/expired.cert", "../fixtures/expired.key")
+	require.NoError(t, err)
+	expiredAddr := startServer(t, expiredCert, nil)
+
+	tests := []struct {
+		name    string
+		cfg     mapstr.M
+		code    ecserr.ECode
+		message string
+	}{
+		{
+			name:    "untrusted",
+			cfg:     mapstr.M{"hosts": addr},
+			code:    ecserr.CODE_TLS_CERT_UNTRUSTED,
+			message: "certificate is not trusted",
+		},
+		{
+			name: "hostname mismatch",
+			cfg: mapstr.M{
+				"hosts":                       addr,
+				"server_name":                 "other.example.net",
+				"ssl.certificate_authorities": caFile,
+			},
+			code:    ecserr.CODE_TLS_HOSTNAME_MISMATCH,
+			message: "other.example.net",
+		},
+		{
+			name: "hostname not verified in certificate mode",
+			cfg: mapstr.M{
+				"hosts":                       addr,
+				"server_name":                 "other.example.net",
+				"ssl.certificate_authorities": caFile,
+				"ssl.verification_mode":       "certificate",
+				"check.expiry.min_days":       1000000,
+			},
+			code:    ecserr.CODE_TLS_CERT_EXPIRING,
+			message: "less than the required 1000000 days",
+		},
+		{
+			name:    "expired",
+			cfg:     mapstr.M{"hosts": expiredAddr},
+			code:    ecserr.CODE_TLS_CERT_EXPIRED,
+			message: "expired at",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cfg["timeout"] = "1s"
+			event := testTLSCheck(t, tt.cfg)
+
+			testslike.Test(
+				t,
+				lookslike.Compose(
+					hbtest.BaseChecks("127.0.0.1", "down", "tls"),
+					hbtest.SummaryStateChecks(0, 1),
+					hbtest.ECSErrCodeChecks(tt.code, tt.message),
+				),
+				event.Fields,
+			)
+		})
+	}
+
+	// the chain is reported even if the certificate is not trusted
+	event := testTLSCheck(t, mapstr.M{"hosts": addr, "timeout": "1s"})
+	testslike.Test(t, lookslike.Compose(
+		tlsChecks(x509Cert),
+		lookslike.MustCompile(map[string]interface{}{"tls.verified": false}),
+	), event.Fields)
+}
+
+func TestWeakParameters(t *testing.T) {
+	_, x509Cert, _ := testCert(t)
+
+	state := &cryptoTLS.ConnectionState{
+		Version:          cryptoTLS.VersionTLS11,
+		CipherSuite:      cryptoTLS.TLS_RSA_WITH_RC4_128_SHA,
+		PeerCertificates: []*x509.Certificate{x509Cert},
+	}
+	weak := weakParameters(state)
+	require.Contains(t, weak, "protocol TLS 1.1")
+	require.Contains(t, weak, "cipher TLS_RSA_WITH_RC4_128_SHA")
+
+	state.Version = cryptoTLS.VersionTLS13
+	state.CipherSuite = cryptoTLS.TLS_AES_128_GCM_SHA256
+	require.Empty(t, weakParameters(state))
+}
+
+func TestMakeEndpoint(t *testing.T) {
+	tests := []struct {
+		host     string
+		startTLS string
+		want     endpoint
+		wantErr  bool
+	}{
+		{host: "example.com:8443", want: endpoint{scheme: "tls", host: "example.com", port: "8443"}},
+		{host: "example.com", want: endpoint{scheme: "tls", host: "example.com", port: "443"}},
+		{host: "example.com", startTLS: "postgres", want: endpoint{scheme: "postgres", host: "example.com", port: "5432"}},
+		{host: "smtp://mail.example.com:587", want: endpoint{scheme: "smtp", host: "mail.example.com", port: "587"}},
+		{host: "ldap://ldap.example.com", want: endpoint{scheme: "ldap", host: "ldap.example.com", port: "389"}},
+		{host: "http://example.com", wantErr: true},
+		{host: "example.com:http", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			got, err := makeEndpoint(tt.host, tt.startTLS)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
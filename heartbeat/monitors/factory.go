@@ -242,6 +242,24 @@ func getLocation(beatLocation *config.LocationWithID, sf stdfields.StdMonitorFie
 	return loc
 }
 
+// ProcessingConfig returns the processing settings applied by the runners
+// created by the RunnerFactory to the client of the monitor configured by c.
+func ProcessingConfig(info beat.Info, beatLocation *config.LocationWithID, c *conf.C) (beat.ProcessingConfig, error) {
+	c, err := stdfields.UnnestStream(c)
+	if err != nil {
+		return beat.ProcessingConfig{}, err
+	}
+	configEditor, err := newCommonPublishConfigs(info, beatLocation, c)
+	if err != nil {
+		return beat.ProcessingConfig{}, err
+	}
+	clientCfg, err := configEditor(beat.ClientConfig{})
+	if err != nil {
+		return beat.ProcessingConfig{}, err
+	}
+	return clientCfg.Processing, nil
+}
+
 func newCommonPublishConfigs(info beat.Info, beatLocation *config.LocationWithID, cfg *conf.C) (pipetool.ConfigEditor, error) {
 	var settings publishSettings
 	if err := cfg.Unpack(&settings); err != nil {
@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package push
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+)
+
+type config struct {
+	ID       string             `config:"id"`
+	Schedule *schedule.Schedule `config:"schedule"`
+	CheckIn  checkInConfig      `config:"check_in"`
+}
+
+type checkInConfig struct {
+	// Schedule is when the job is expected to check in. Defaults to the
+	// monitor schedule.
+	Schedule *schedule.Schedule `config:"schedule"`
+	// Grace is how late a check-in may arrive before the monitor is down.
+	Grace time.Duration `config:"grace" validate:"min=0"`
+	// MaxDuration, if set, is how long a started job may run.
+	MaxDuration time.Duration `config:"max_duration" validate:"min=0"`
+}
+
+func defaultConfig() config {
+	return config{
+		CheckIn: checkInConfig{
+			Grace: time.Minute,
+		},
+	}
+}
+
+func (c *config) Validate() error {
+	if c.ID == "" {
+		return errors.New("push monitors require an explicit id, which is part of the check-in URL")
+	}
+	if c.CheckIn.Schedule == nil && c.Schedule == nil {
+		return fmt.Errorf("push monitor '%s' requires a schedule", c.ID)
+	}
+	return nil
+}
+
+// expected returns the schedule of the job checking in.
+func (c *config) expected() *schedule.Schedule {
+	if c.CheckIn.Schedule != nil {
+		return c.CheckIn.Schedule
+	}
+	return c.Schedule
+}
@@ -0,0 +1,141 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package push
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/look"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/plugin"
+	"github.com/elastic/beats/v7/heartbeat/reason"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func init() {
+	plugin.Register("push", create, "synthetics/push")
+}
+
+func create(
+	name string,
+	cfg *conf.C,
+) (p plugin.Plugin, err error) {
+	return createWithRegistry(cfg, DefaultRegistry)
+}
+
+func createWithRegistry(cfg *conf.C, registry *Registry) (plugin.Plugin, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return plugin.Plugin{}, err
+	}
+	if !registry.isServing() {
+		return plugin.Plugin{}, fmt.Errorf("push monitor '%s' requires the push server, set heartbeat.push.enabled to true", config.ID)
+	}
+
+	checkIns := registry.register(config.ID, time.Now())
+	e := &evaluator{
+		checkIns:    checkIns,
+		expected:    config.expected(),
+		grace:       config.CheckIn.Grace,
+		maxDuration: config.CheckIn.MaxDuration,
+	}
+
+	job := jobs.MakeSimpleJob(func(event *beat.Event) error {
+		fields, err := e.evaluate(time.Now())
+		eventext.MergeEventFields(event, mapstr.M{"push": fields})
+		return err
+	})
+
+	return plugin.Plugin{
+		Jobs:      []jobs.Job{job},
+		Endpoints: 1,
+		DoClose: func() error {
+			registry.unregister(config.ID)
+			return nil
+		},
+	}, nil
+}
+
+// evaluator decides if a monitor is up from its check-ins.
+type evaluator struct {
+	checkIns    *checkIns
+	expected    *schedule.Schedule
+	grace       time.Duration
+	maxDuration time.Duration
+}
+
+// evaluate returns the push fields and an error if the job is overdue, ran
+// for too long or reported a failure.
+func (e *evaluator) evaluate(now time.Time) (mapstr.M, error) {
+	s := e.checkIns.snapshot()
+
+	fields := mapstr.M{
+		"check_ins": s.count,
+		"running":   !s.startedAt.IsZero(),
+	}
+	if last := latest(s.lastSuccess, s.lastFailure); !last.IsZero() {
+		fields["last_check_in"] = last
+	}
+	if s.lastDuration > 0 {
+		fields["duration"] = look.RTT(s.lastDuration)
+	}
+
+	// A running job is not overdue, but may run for too long.
+	if !s.startedAt.IsZero() {
+		fields["started_at"] = s.startedAt
+		if e.maxDuration > 0 && now.Sub(s.startedAt) > e.maxDuration {
+			return fields, reason.ValidateFailed(fmt.Errorf("job started at %s has not finished after %s", s.startedAt.UTC().Format(time.RFC3339), e.maxDuration))
+		}
+		return fields, nil
+	}
+
+	if s.lastFailure.After(s.lastSuccess) {
+		fields["message"] = s.message
+		msg := fmt.Sprintf("job reported a failure at %s", s.lastFailure.UTC().Format(time.RFC3339))
+		if s.message != "" {
+			msg += ": " + s.message
+		}
+		return fields, reason.ValidateFailed(errors.New(msg))
+	}
+
+	// Until the first check-in, the job is expected after the monitor started.
+	since := latest(s.lastSuccess, s.registeredAt)
+	deadline := e.expected.Next(since).Add(e.grace)
+	fields["deadline"] = deadline
+	if now.After(deadline) {
+		if s.lastSuccess.IsZero() {
+			return fields, reason.ValidateFailed(fmt.Errorf("no check-in received, expected by %s", deadline.UTC().Format(time.RFC3339)))
+		}
+		return fields, reason.ValidateFailed(fmt.Errorf("no check-in since %s, expected by %s", s.lastSuccess.UTC().Format(time.RFC3339), deadline.UTC().Format(time.RFC3339)))
+	}
+
+	return fields, nil
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,265 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package push
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/testslike"
+
+	hbconfig "github.com/elastic/beats/v7/heartbeat/config"
+	"github.com/elastic/beats/v7/heartbeat/hbtest"
+	"github.com/elastic/beats/v7/heartbeat/hbtestllext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers"
+	"github.com/elastic/beats/v7/heartbeat/scheduler/schedule"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestEvaluate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	hourly := schedule.MustParse("@every 1h")
+	nightly := schedule.MustParse("0 2 * * *")
+
+	type checkIn struct {
+		kind string
+		at   time.Duration
+	}
+	tests := []struct {
+		name        string
+		expected    *schedule.Schedule
+		maxDuration time.Duration
+		checkIns    []checkIn
+		at          time.Duration
+		wantErr     string
+	}{
+		{name: "before first deadline", expected: hourly, at: 30 * time.Minute},
+		{name: "no check-in", expected: hourly, at: 70 * time.Minute, wantErr: "no check-in received, expected by 2026-01-01T01:05:00Z"},
+		{name: "within grace", expected: hourly, checkIns: []checkIn{{kindPing, time.Hour}}, at: 2*time.Hour + 4*time.Minute},
+		{name: "overdue", expected: hourly, checkIns: []checkIn{{kindPing, time.Hour}}, at: 2*time.Hour + 6*time.Minute, wantErr: "no check-in since 2026-01-01T01:00:00Z"},
+		{name: "cron deadline", expected: nightly, checkIns: []checkIn{{kindFinish, 2 * time.Hour}}, at: 25 * time.Hour},
+		{name: "cron overdue", expected: nightly, checkIns: []checkIn{{kindFinish, 2 * time.Hour}}, at: 26*time.Hour + 10*time.Minute, wantErr: "expected by 2026-01-02T02:05:00Z"},
+		{name: "running", expected: hourly, checkIns: []checkIn{{kindStart, 10 * time.Minute}}, at: 5 * time.Hour},
+		{
+			name:        "running too long",
+			expected:    hourly,
+			maxDuration: 30 * time.Minute,
+			checkIns:    []checkIn{{kindStart, 10 * time.Minute}},
+			at:          time.Hour,
+			wantErr:     "has not finished after 30m0s",
+		},
+		{name: "failed", expected: hourly, checkIns: []checkIn{{kindFail, 10 * time.Minute}}, at: 20 * time.Minute, wantErr: "job reported a failure at 2026-01-01T00:10:00Z: disk full"},
+		{name: "recovered", expected: hourly, checkIns: []checkIn{{kindFail, 10 * time.Minute}, {kindPing, 15 * time.Minute}}, at: 20 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &checkIns{registeredAt: start}
+			for _, ci := range tt.checkIns {
+				require.NoError(t, c.record(ci.kind, start.Add(ci.at), 0, "disk full"))
+			}
+			e := &evaluator{checkIns: c, expected: tt.expected, grace: 5 * time.Minute, maxDuration: tt.maxDuration}
+
+			_, err := e.evaluate(start.Add(tt.at))
+			if tt.wantErr != "" {
+				require.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRecordDuration(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &checkIns{registeredAt: start}
+
+	require.NoError(t, c.record(kindStart, start, 0, ""))
+	require.NoError(t, c.record(kindFinish, start.Add(90*time.Second), 0, ""))
+	s := c.snapshot()
+	require.Equal(t, 90*time.Second, s.lastDuration)
+	require.True(t, s.startedAt.IsZero())
+	require.Equal(t, int64(2), s.count)
+
+	require.NoError(t, c.record(kindPing, start.Add(time.Hour), 5*time.Second, ""))
+	require.Equal(t, 5*time.Second, c.snapshot().lastDuration)
+
+	require.Error(t, c.record("restart", start, 0, ""))
+}
+
+func TestRegistryRefs(t *testing.T) {
+	r := NewRegistry()
+	now := time.Now()
+
+	a := r.register("job", now)
+	b := r.register("job", now.Add(time.Minute))
+	require.Same(t, a, b)
+
+	r.unregister("job")
+	require.Same(t, a, r.get("job"))
+	r.unregister("job")
+	require.Nil(t, r.get("job"))
+}
+
+func startTestServer(t *testing.T, registry *Registry, token string) string {
+	s, err := NewServer(hbconfig.Push{Enabled: true, Host: "127.0.0.1", Port: 0, Token: token}, registry, logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	s.Start()
+	t.Cleanup(s.Stop)
+	return fmt.Sprintf("http://%s", s.Addr())
+}
+
+func checkIn(t *testing.T, method, url string, body string) (int, string) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp.StatusCode, string(respBody)
+}
+
+func TestServer(t *testing.T) {
+	registry := NewRegistry()
+	base := startTestServer(t, registry, "secret")
+	c := registry.register("backup", time.Now())
+
+	status, _ := checkIn(t, http.MethodGet, base+"/push/backup", "")
+	require.Equal(t, http.StatusUnauthorized, status)
+
+	status, _ = checkIn(t, http.MethodGet, base+"/push/backup?token=secret", "")
+	require.Equal(t, http.StatusOK, status)
+	require.False(t, c.snapshot().lastSuccess.IsZero())
+
+	status, _ = checkIn(t, http.MethodPost, base+"/push/backup/fail?token=secret", "disk full\n")
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, "disk full", c.snapshot().message)
+
+	status, _ = checkIn(t, http.MethodPost, base+"/push/backup/finish?token=secret&duration=2m", "")
+	require.Equal(t, http.StatusOK, status)
+	require.Equal(t, 2*time.Minute, c.snapshot().lastDuration)
+
+	status, body := checkIn(t, http.MethodPost, base+"/push/backup/restart?token=secret", "")
+	require.Equal(t, http.StatusBadRequest, status)
+	require.Contains(t, body, "unknown check-in 'restart'")
+
+	status, _ = checkIn(t, http.MethodPost, base+"/push/backup/finish?token=secret&duration=soon", "")
+	require.Equal(t, http.StatusBadRequest, status)
+
+	status, _ = checkIn(t, http.MethodPost, base+"/push/other?token=secret", "")
+	require.Equal(t, http.StatusNotFound, status)
+
+	status, _ = checkIn(t, http.MethodDelete, base+"/push/backup?token=secret", "")
+	require.Equal(t, http.StatusMethodNotAllowed, status)
+}
+
+func TestPushMonitor(t *testing.T) {
+	registry := NewRegistry()
+
+	cfg, err := conf.NewConfigFrom(mapstr.M{"id": "backup", "schedule": "@every 1h"})
+	require.NoError(t, err)
+
+	_, err = createWithRegistry(cfg, registry)
+	require.ErrorContains(t, err, "heartbeat.push.enabled")
+
+	base := startTestServer(t, registry, "")
+	p, err := createWithRegistry(cfg, registry)
+	require.NoError(t, err)
+	defer p.Close()
+
+	sched := schedule.MustParse("@every 1h")
+	job := wrappers.WrapCommon(p.Jobs, stdfields.StdMonitorFields{ID: "backup", Type: "push", Schedule: sched, Timeout: 1}, nil)[0]
+
+	status, _ := checkIn(t, http.MethodPost, base+"/push/backup/start", "")
+	require.Equal(t, http.StatusOK, status)
+	status, _ = checkIn(t, http.MethodPost, base+"/push/backup/finish", "")
+	require.Equal(t, http.StatusOK, status)
+
+	event := &beat.Event{}
+	_, err = job(event)
+	require.NoError(t, err)
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.SummaryStateChecks(1, 0),
+			lookslike.MustCompile(map[string]interface{}{
+				"monitor.status": "up",
+				"push": map[string]interface{}{
+					"check_ins":     int64(2),
+					"running":       false,
+					"last_check_in": isdef.KeyPresent,
+					"deadline":      isdef.KeyPresent,
+					"duration.us":   hbtestllext.IsInt64,
+				},
+			}),
+		),
+		event.Fields,
+	)
+
+	status, _ = checkIn(t, http.MethodPost, base+"/push/backup/fail?message=exit+code+2", "")
+	require.Equal(t, http.StatusOK, status)
+
+	event = &beat.Event{}
+	_, err = job(event)
+	require.NoError(t, err)
+
+	testslike.Test(
+		t,
+		lookslike.Compose(
+			hbtest.SummaryStateChecks(0, 1),
+			hbtest.ErrorChecks("exit code 2", "validate"),
+			lookslike.MustCompile(map[string]interface{}{
+				"monitor.status": "down",
+				"push.message":   "exit code 2",
+			}),
+		),
+		event.Fields,
+	)
+}
+
+func TestConfigValidation(t *testing.T) {
+	registry := NewRegistry()
+	registry.setServing(true)
+
+	for name, cfg := range map[string]mapstr.M{
+		"no id":         {"schedule": "@every 1h"},
+		"no schedule":   {"id": "a"},
+		"invalid grace": {"id": "a", "schedule": "@every 1h", "check_in.grace": "-1m"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c, err := conf.NewConfigFrom(cfg)
+			require.NoError(t, err)
+			_, err = createWithRegistry(c, registry)
+			require.Error(t, err)
+		})
+	}
+}
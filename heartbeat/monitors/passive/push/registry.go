@@ -0,0 +1,180 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package push
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultRegistry holds the check-ins of all push monitors and is served by
+// the push server started by the beater.
+var DefaultRegistry = NewRegistry()
+
+// Registry tracks the check-ins received for each push monitor ID.
+type Registry struct {
+	mu       sync.Mutex
+	monitors map[string]*registration
+	serving  bool
+}
+
+type registration struct {
+	refs     int
+	checkIns *checkIns
+}
+
+func NewRegistry() *Registry {
+	return &Registry{monitors: map[string]*registration{}}
+}
+
+// setServing records whether a server accepts check-ins for this registry.
+func (r *Registry) setServing(serving bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.serving = serving
+}
+
+func (r *Registry) isServing() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.serving
+}
+
+// register returns the check-ins for id, creating them if required. The
+// check-ins are shared, and kept, while a monitor with the same ID is
+// replaced on a config reload.
+func (r *Registry) register(id string, now time.Time) *checkIns {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reg, ok := r.monitors[id]
+	if !ok {
+		reg = &registration{checkIns: &checkIns{registeredAt: now}}
+		r.monitors[id] = reg
+	}
+	reg.refs++
+	return reg.checkIns
+}
+
+func (r *Registry) unregister(id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reg, ok := r.monitors[id]
+	if !ok {
+		return
+	}
+	reg.refs--
+	if reg.refs <= 0 {
+		delete(r.monitors, id)
+	}
+}
+
+func (r *Registry) get(id string) *checkIns {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if reg, ok := r.monitors[id]; ok {
+		return reg.checkIns
+	}
+	return nil
+}
+
+// Check-in kinds accepted by the push endpoint.
+const (
+	kindPing   = "ping"
+	kindStart  = "start"
+	kindFinish = "finish"
+	kindFail   = "fail"
+)
+
+// checkIns is the check-in history of a single monitor.
+type checkIns struct {
+	mu sync.Mutex
+
+	registeredAt time.Time
+	count        int64
+
+	lastSuccess time.Time
+	lastFailure time.Time
+	message     string
+
+	// startedAt is set while a job is running, between start and finish.
+	startedAt    time.Time
+	lastDuration time.Duration
+}
+
+// record applies a check-in of the given kind. duration, if non-zero,
+// overrides the duration measured from the start check-in.
+func (c *checkIns) record(kind string, now time.Time, duration time.Duration, message string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch kind {
+	case kindPing:
+		c.lastSuccess = now
+	case kindStart:
+		c.startedAt = now
+	case kindFinish, kindFail:
+		if duration == 0 && !c.startedAt.IsZero() {
+			duration = now.Sub(c.startedAt)
+		}
+		c.startedAt = time.Time{}
+		if kind == kindFinish {
+			c.lastSuccess = now
+		} else {
+			c.lastFailure = now
+			c.message = message
+		}
+	default:
+		return fmt.Errorf("unknown check-in '%s', expected one of %s, %s, %s or %s", kind, kindPing, kindStart, kindFinish, kindFail)
+	}
+
+	if duration > 0 {
+		c.lastDuration = duration
+	}
+	c.count++
+	return nil
+}
+
+// checkInSnapshot is a copy of the check-in history at a point in time.
+type checkInSnapshot struct {
+	registeredAt time.Time
+	count        int64
+	lastSuccess  time.Time
+	lastFailure  time.Time
+	message      string
+	startedAt    time.Time
+	lastDuration time.Duration
+}
+
+func (c *checkIns) snapshot() checkInSnapshot {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return checkInSnapshot{
+		registeredAt: c.registeredAt,
+		count:        c.count,
+		lastSuccess:  c.lastSuccess,
+		lastFailure:  c.lastFailure,
+		message:      c.message,
+		startedAt:    c.startedAt,
+		lastDuration: c.lastDuration,
+	}
+}
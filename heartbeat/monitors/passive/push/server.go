@@ -0,0 +1,157 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package push
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	hbconfig "github.com/elastic/beats/v7/heartbeat/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// maxMessageBytes limits the failure message read from a request body.
+const maxMessageBytes = 1024
+
+// Server receives check-ins over HTTP at /push/{id} and /push/{id}/{kind}.
+type Server struct {
+	srv      *http.Server
+	listener net.Listener
+	registry *Registry
+	logger   *logp.Logger
+}
+
+// NewServer listens on the configured address. Call Start to serve requests.
+func NewServer(cfg hbconfig.Push, registry *Registry, logger *logp.Logger) (*Server, error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(cfg.Host, strconv.Itoa(cfg.Port)))
+	if err != nil {
+		return nil, fmt.Errorf("could not start push server: %w", err)
+	}
+
+	s := &Server{
+		listener: l,
+		registry: registry,
+		logger:   logger.Named("push"),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/push/{id}", s.withToken(cfg.Token, s.handleCheckIn))
+	mux.Handle("/push/{id}/{kind}", s.withToken(cfg.Token, s.handleCheckIn))
+	s.srv = &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+
+	return s, nil
+}
+
+// Addr returns the address the server listens on.
+func (s *Server) Addr() net.Addr {
+	return s.listener.Addr()
+}
+
+// Start serves check-ins until Stop is called.
+func (s *Server) Start() {
+	s.registry.setServing(true)
+	s.logger.Infof("Push server listening on %s", s.listener.Addr())
+	go func() {
+		if err := s.srv.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.logger.Errorf("Push server stopped: %v", err)
+		}
+	}()
+}
+
+// Stop shuts the server down.
+func (s *Server) Stop() {
+	s.registry.setServing(false)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.srv.Shutdown(ctx); err != nil {
+		s.logger.Warnf("Error stopping push server: %v", err)
+	}
+}
+
+func (s *Server) withToken(token string, next http.HandlerFunc) http.Handler {
+	if token == "" {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got := r.URL.Query().Get("token")
+		if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, "Bearer ") {
+			got = strings.TrimPrefix(auth, "Bearer ")
+		}
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	})
+}
+
+func (s *Server) handleCheckIn(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	id := r.PathValue("id")
+	checkIns := s.registry.get(id)
+	if checkIns == nil {
+		http.Error(w, fmt.Sprintf("unknown push monitor '%s'", id), http.StatusNotFound)
+		return
+	}
+
+	kind := r.PathValue("kind")
+	if kind == "" {
+		kind = kindPing
+	}
+
+	var duration time.Duration
+	if d := r.URL.Query().Get("duration"); d != "" {
+		var err error
+		duration, err = time.ParseDuration(d)
+		if err != nil || duration < 0 {
+			http.Error(w, fmt.Sprintf("invalid duration '%s'", d), http.StatusBadRequest)
+			return
+		}
+	}
+
+	message := r.URL.Query().Get("message")
+	if message == "" && r.Body != nil {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxMessageBytes))
+		if err != nil {
+			http.Error(w, "could not read request body", http.StatusBadRequest)
+			return
+		}
+		message = strings.TrimSpace(string(body))
+	}
+
+	if err := checkIns.record(kind, time.Now(), duration, message); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.logger.Debugf("Received %s check-in for push monitor '%s'", kind, id)
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, "OK\n")
+}
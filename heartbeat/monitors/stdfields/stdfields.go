@@ -19,6 +19,7 @@ package stdfields
 
 import (
 	"fmt"
+	"slices"
 	"time"
 
 	hbconfig "github.com/elastic/beats/v7/heartbeat/config"
@@ -31,6 +32,34 @@ type ServiceFields struct {
 	Name string `config:"name"`
 }
 
+// RollupConfig configures the periodic uptime rollup events of a monitor.
+// Windows defaults to 1h, 24h and 30d when empty.
+type RollupConfig struct {
+	Enabled  bool            `config:"enabled"`
+	Windows  []time.Duration `config:"windows"`
+	Interval time.Duration   `config:"interval"`
+}
+
+var defaultRollupWindows = []time.Duration{time.Hour, 24 * time.Hour, 30 * 24 * time.Hour}
+
+func (c *RollupConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Interval <= 0 {
+		return fmt.Errorf("rollup.interval must be positive, got %s", c.Interval)
+	}
+	for i, w := range c.Windows {
+		if w <= 0 {
+			return fmt.Errorf("rollup.windows must be positive, got %s", w)
+		}
+		if slices.Contains(c.Windows[:i], w) {
+			return fmt.Errorf("rollup.windows contains %s more than once", w)
+		}
+	}
+	return nil
+}
+
 // StdMonitorFields represents the generic configuration options around a monitor plugin.
 type StdMonitorFields struct {
 	ID                 string              `config:"id"`
@@ -44,6 +73,7 @@ type StdMonitorFields struct {
 	Origin             string        `config:"origin"`
 	LegacyServiceName  string        `config:"service_name"`
 	MaxAttempts        uint16        `config:"max_attempts"`
+	Rollup             RollupConfig  `config:"rollup"`
 	// Used by zip_url and local monitors
 	// kibana originating monitors only run one journey at a time
 	// and just use the `fields` syntax / manually set monitor IDs
@@ -61,12 +91,16 @@ type StdMonitorFields struct {
 }
 
 func ConfigToStdMonitorFields(conf *config.C) (StdMonitorFields, error) {
-	sFields := StdMonitorFields{Enabled: true, MaxAttempts: 1}
+	sFields := StdMonitorFields{Enabled: true, MaxAttempts: 1, Rollup: RollupConfig{Interval: 5 * time.Minute}}
 
 	if err := conf.Unpack(&sFields); err != nil {
 		return sFields, fmt.Errorf("error unpacking monitor plugin config: %w", err)
 	}
 
+	if len(sFields.Rollup.Windows) == 0 {
+		sFields.Rollup.Windows = defaultRollupWindows
+	}
+
 	// Use `service_name` if `service.name` is unspecified
 	// `service_name` was only document in the 7.10.0 release.
 	if sFields.LegacyServiceName != "" {
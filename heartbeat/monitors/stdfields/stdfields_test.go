@@ -20,6 +20,7 @@ package stdfields
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -65,3 +66,37 @@ func TestLegacyServiceNameConfig(t *testing.T) {
 	}
 
 }
+
+func TestRollupConfig(t *testing.T) {
+	base := func(rollup mapstr.M) mapstr.M {
+		return mapstr.M{
+			"type":     "http",
+			"id":       "myId",
+			"schedule": "@every 1s",
+			"rollup":   rollup,
+		}
+	}
+
+	unpack := func(cm mapstr.M) (StdMonitorFields, error) {
+		c, err := conf.NewConfigFrom(cm)
+		require.NoError(t, err)
+		return ConfigToStdMonitorFields(c)
+	}
+
+	f, err := unpack(base(mapstr.M{"enabled": true}))
+	require.NoError(t, err)
+	require.True(t, f.Rollup.Enabled)
+	require.Equal(t, 5*time.Minute, f.Rollup.Interval)
+	require.Equal(t, []time.Duration{time.Hour, 24 * time.Hour, 720 * time.Hour}, f.Rollup.Windows)
+
+	f, err = unpack(base(mapstr.M{"enabled": true, "windows": []string{"2h"}, "interval": "1m"}))
+	require.NoError(t, err)
+	require.Equal(t, []time.Duration{2 * time.Hour}, f.Rollup.Windows)
+	require.Equal(t, time.Minute, f.Rollup.Interval)
+
+	_, err = unpack(base(mapstr.M{"enabled": true, "windows": []string{"1h", "1h"}}))
+	require.Error(t, err)
+
+	_, err = unpack(base(mapstr.M{"enabled": true, "interval": "0s"}))
+	require.Error(t, err)
+}
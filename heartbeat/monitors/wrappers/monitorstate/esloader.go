@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/mapstr"
@@ -114,10 +115,84 @@ func MakeESLoader(esc *eslegclient.Connection, indexPattern string, beatLocation
 
 		state := &sh.Hits.Hits[0].Source.State
 
+		if sf.Rollup.Enabled {
+			rollup, err := loadESRollup(esc, indexPattern, sf, runFromID)
+			if err != nil {
+				// Rollups are best effort, they restart from scratch rather than block the state
+				logp.L().Warnf("could not load previous rollups for monitor %s: %v", sf.ID, err)
+			}
+			state.Rollup = rollup
+		}
+
 		return state, nil
 	}
 }
 
+// loadESRollup restores the rollup of a monitor from its most recent rollup event for each window.
+func loadESRollup(esc *eslegclient.Connection, indexPattern string, sf stdfields.StdMonitorFields, runFromID string) (*Rollup, error) {
+	var maxWindow time.Duration
+	for _, w := range sf.Rollup.Windows {
+		maxWindow = max(maxWindow, w)
+	}
+
+	queryMustClauses := []mapstr.M{
+		{
+			"match": mapstr.M{"monitor.id": sf.ID},
+		},
+		{
+			"match": mapstr.M{"monitor.type": sf.Type},
+		},
+		{
+			"match": mapstr.M{"event.type": RollupEventType},
+		},
+		{
+			"range": mapstr.M{"@timestamp": mapstr.M{"gt": fmt.Sprintf("now-%ds", int64(maxWindow.Seconds()))}},
+		},
+	}
+	if runFromID != "" {
+		queryMustClauses = append(queryMustClauses, mapstr.M{
+			"match": mapstr.M{"observer.name": runFromID},
+		})
+	}
+	reqBody := mapstr.M{
+		"sort": mapstr.M{"@timestamp": "desc"},
+		"query": mapstr.M{
+			"bool": mapstr.M{
+				"must": queryMustClauses,
+			},
+		},
+		// Only keep the latest event of each window
+		"collapse": mapstr.M{"field": "rollup.window"},
+	}
+	status, body, err := esc.Request("POST", fmt.Sprintf("/%s/_search?size=%d", indexPattern, len(sf.Rollup.Windows)), "", nil, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("error executing rollup search: %w", err)
+	}
+	if status > 299 {
+		return nil, fmt.Errorf("rollup search returned status %d", status)
+	}
+
+	type rollupHits struct {
+		Hits struct {
+			Hits []struct {
+				Source struct {
+					Rollup rollupDoc `json:"rollup"`
+				} `json:"_source"`
+			} `json:"hits"`
+		} `json:"hits"`
+	}
+
+	rh := rollupHits{}
+	if err := json.Unmarshal(body, &rh); err != nil {
+		return nil, fmt.Errorf("could not unmarshal rollup hits: %w", err)
+	}
+	docs := make([]rollupDoc, 0, len(rh.Hits.Hits))
+	for _, h := range rh.Hits.Hits {
+		docs = append(docs, h.Source.Rollup)
+	}
+	return rollupFromDocs(docs), nil
+}
+
 func shouldRetry(status int) bool {
 	return status >= 500
 }
@@ -75,6 +75,8 @@ type State struct {
 	Ends            *State `json:"ends"`
 	flappingEnabled bool
 	ctr             int
+	// Rollup is carried across states and persisted separately in rollup events
+	Rollup *Rollup `json:"-"`
 }
 
 func (s *State) String() string {
@@ -159,8 +161,10 @@ func (s *State) transitionTo(sf stdfields.StdMonitorFields, newStatus StateStatu
 	// flapping threshold
 	oldState := *s
 	*s = *newMonitorState(sf, newStatus, s.ctr, s.flappingEnabled)
+	s.Rollup = oldState.Rollup
 	// We don't need to retain extra data when transitioning
 	oldState.FlapHistory = nil
+	oldState.Rollup = nil
 	// W edon't want an infinite linked list!
 	oldState.Ends = nil
 	s.Ends = &oldState
@@ -169,6 +173,7 @@ func (s *State) transitionTo(sf stdfields.StdMonitorFields, newStatus StateStatu
 // copy returns a threadsafe copy since the instance used in the tracker is frequently mutated
 func (s *State) copy() *State {
 	copied := *s
+	copied.Rollup = nil
 	copied.FlapHistory = make([]StateStatus, len(s.FlapHistory))
 	copy(copied.FlapHistory, s.FlapHistory)
 	return &copied
@@ -0,0 +1,303 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitorstate
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// RollupEventType is the event.type of rollup events.
+const RollupEventType = "heartbeat/rollup"
+
+// rollupBuckets is the number of buckets each rollup window is divided into.
+// Windows slide one bucket at a time, so the reported figures may include up to
+// one bucket of data older than the window.
+const rollupBuckets = 30
+
+// Rollup keeps rolling uptime, downtime and repair counters for a monitor over
+// one or more windows. It is carried across state transitions and persisted
+// in the monitor's rollup events rather than in the state field of summaries.
+type Rollup struct {
+	LastCheckAt time.Time
+	LastStatus  StateStatus
+	// DownSince is the time of the first down check of the ongoing incident, if any.
+	DownSince time.Time
+	// EmittedAt is the last time rollup events were emitted.
+	EmittedAt time.Time
+	Windows   []*RollupWindow
+}
+
+// RollupWindow holds the buckets for a single window.
+type RollupWindow struct {
+	Window  time.Duration
+	Buckets []RollupBucket
+}
+
+// RollupBucket holds the counters of a slice of a window starting at Start (unix ms).
+type RollupBucket struct {
+	Start      int64 `json:"start"`
+	UpMs       int64 `json:"up_ms"`
+	DownMs     int64 `json:"down_ms"`
+	Checks     int   `json:"checks"`
+	DownChecks int   `json:"down_checks"`
+	Incidents  int   `json:"incidents"`
+	Recoveries int   `json:"recoveries"`
+	RepairMs   int64 `json:"repair_ms"`
+}
+
+// RollupStats are the figures computed for a window at a given time.
+type RollupStats struct {
+	Window      time.Duration
+	UpMs        int64
+	DownMs      int64
+	UptimePct   float64
+	Checks      int
+	DownChecks  int
+	Incidents   int
+	Recoveries  int
+	MTTRMs      int64
+	LastCheckAt time.Time
+	LastStatus  StateStatus
+	DownSince   time.Time
+	Buckets     []RollupBucket
+}
+
+// NewRollup creates an empty Rollup tracking the given windows.
+func NewRollup(windows []time.Duration) *Rollup {
+	r := &Rollup{}
+	r.EnsureWindows(windows)
+	return r
+}
+
+// EnsureWindows makes r track exactly the given windows, keeping the buckets of
+// windows it already tracks.
+func (r *Rollup) EnsureWindows(windows []time.Duration) {
+	res := make([]*RollupWindow, 0, len(windows))
+	for _, w := range windows {
+		idx := slices.IndexFunc(r.Windows, func(rw *RollupWindow) bool { return rw.Window == w })
+		if idx >= 0 {
+			res = append(res, r.Windows[idx])
+		} else {
+			res = append(res, &RollupWindow{Window: w})
+		}
+	}
+	r.Windows = res
+}
+
+// Record accounts for a check with the given status completed at the given
+// time. The time since the previous check is attributed to the previous status.
+func (r *Rollup) Record(status StateStatus, at time.Time) {
+	for _, w := range r.Windows {
+		w.prune(at)
+		if !r.LastCheckAt.IsZero() && at.After(r.LastCheckAt) {
+			w.addDuration(r.LastCheckAt, at, r.LastStatus)
+		}
+
+		b := w.bucketAt(at)
+		b.Checks++
+		if status == StatusDown {
+			b.DownChecks++
+			if r.LastStatus != StatusDown {
+				b.Incidents++
+			}
+		} else if r.LastStatus == StatusDown && !r.DownSince.IsZero() {
+			b.Recoveries++
+			b.RepairMs += at.Sub(r.DownSince).Milliseconds()
+		}
+	}
+
+	if status == StatusDown {
+		if r.LastStatus != StatusDown {
+			r.DownSince = at
+		}
+	} else {
+		r.DownSince = time.Time{}
+	}
+	r.LastCheckAt = at
+	r.LastStatus = status
+}
+
+// Stats computes the figures of every window at the given time.
+func (r *Rollup) Stats(at time.Time) []RollupStats {
+	res := make([]RollupStats, 0, len(r.Windows))
+	for _, w := range r.Windows {
+		w.prune(at)
+		s := RollupStats{
+			Window:      w.Window,
+			LastCheckAt: r.LastCheckAt,
+			LastStatus:  r.LastStatus,
+			DownSince:   r.DownSince,
+			Buckets:     slices.Clone(w.Buckets),
+		}
+		var repairMs int64
+		for _, b := range w.Buckets {
+			s.UpMs += b.UpMs
+			s.DownMs += b.DownMs
+			s.Checks += b.Checks
+			s.DownChecks += b.DownChecks
+			s.Incidents += b.Incidents
+			s.Recoveries += b.Recoveries
+			repairMs += b.RepairMs
+		}
+		switch {
+		case s.UpMs+s.DownMs > 0:
+			s.UptimePct = float64(s.UpMs) * 100 / float64(s.UpMs+s.DownMs)
+		case s.Checks > 0:
+			s.UptimePct = float64(s.Checks-s.DownChecks) * 100 / float64(s.Checks)
+		default:
+			s.UptimePct = 100
+		}
+		if s.Recoveries > 0 {
+			s.MTTRMs = repairMs / int64(s.Recoveries)
+		}
+		res = append(res, s)
+	}
+	return res
+}
+
+// Fields renders the stats as the rollup field of a rollup event. The buckets
+// are included so the rollup can be restored by the ES loader.
+func (s RollupStats) Fields() mapstr.M {
+	fields := mapstr.M{
+		"window":        FormatRollupWindow(s.Window),
+		"up_ms":         s.UpMs,
+		"down_ms":       s.DownMs,
+		"uptime_pct":    s.UptimePct,
+		"checks":        s.Checks,
+		"down_checks":   s.DownChecks,
+		"incidents":     s.Incidents,
+		"recoveries":    s.Recoveries,
+		"mttr_ms":       s.MTTRMs,
+		"last_status":   string(s.LastStatus),
+		"last_check_at": s.LastCheckAt,
+		"buckets":       s.Buckets,
+	}
+	if !s.DownSince.IsZero() {
+		fields["down_since"] = s.DownSince
+	}
+	return fields
+}
+
+// rollupDoc is the subset of the rollup field needed to restore a Rollup.
+type rollupDoc struct {
+	Window      string         `json:"window"`
+	LastCheckAt time.Time      `json:"last_check_at"`
+	LastStatus  StateStatus    `json:"last_status"`
+	DownSince   *time.Time     `json:"down_since"`
+	Buckets     []RollupBucket `json:"buckets"`
+}
+
+// rollupFromDocs restores a Rollup from the latest rollup document of each
+// window, the first document being the most recent one.
+func rollupFromDocs(docs []rollupDoc) *Rollup {
+	if len(docs) == 0 {
+		return nil
+	}
+	r := &Rollup{
+		LastCheckAt: docs[0].LastCheckAt,
+		LastStatus:  docs[0].LastStatus,
+	}
+	if docs[0].DownSince != nil {
+		r.DownSince = *docs[0].DownSince
+	}
+	for _, d := range docs {
+		w, err := time.ParseDuration(d.Window)
+		if err != nil {
+			continue
+		}
+		r.Windows = append(r.Windows, &RollupWindow{Window: w, Buckets: d.Buckets})
+	}
+	return r
+}
+
+func (w *RollupWindow) bucketWidth() time.Duration {
+	width := w.Window / rollupBuckets
+	if width < time.Millisecond {
+		width = time.Millisecond
+	}
+	return width
+}
+
+// bucketAt returns the bucket containing the given time, creating it if needed.
+func (w *RollupWindow) bucketAt(at time.Time) *RollupBucket {
+	start := at.Truncate(w.bucketWidth()).UnixMilli()
+	idx, found := sort.Find(len(w.Buckets), func(i int) int {
+		switch {
+		case start < w.Buckets[i].Start:
+			return -1
+		case start > w.Buckets[i].Start:
+			return 1
+		}
+		return 0
+	})
+	if !found {
+		w.Buckets = slices.Insert(w.Buckets, idx, RollupBucket{Start: start})
+	}
+	return &w.Buckets[idx]
+}
+
+// addDuration attributes the time between from and to to the given status,
+// splitting it over the buckets it spans and ignoring any part outside the window.
+func (w *RollupWindow) addDuration(from, to time.Time, status StateStatus) {
+	if lower := to.Add(-w.Window); from.Before(lower) {
+		from = lower
+	}
+	width := w.bucketWidth()
+	for cur := from; cur.Before(to); {
+		end := cur.Truncate(width).Add(width)
+		if end.After(to) {
+			end = to
+		}
+		b := w.bucketAt(cur)
+		if status == StatusDown {
+			b.DownMs += end.Sub(cur).Milliseconds()
+		} else {
+			b.UpMs += end.Sub(cur).Milliseconds()
+		}
+		cur = end
+	}
+}
+
+// prune drops buckets that ended before the window starting at at-Window.
+func (w *RollupWindow) prune(at time.Time) {
+	oldest := at.Add(-w.Window).UnixMilli()
+	width := w.bucketWidth().Milliseconds()
+	drop := 0
+	for drop < len(w.Buckets) && w.Buckets[drop].Start+width <= oldest {
+		drop++
+	}
+	w.Buckets = w.Buckets[drop:]
+}
+
+// FormatRollupWindow renders a window compactly, e.g. 24h rather than 24h0m0s,
+// in a form time.ParseDuration accepts.
+func FormatRollupWindow(d time.Duration) string {
+	s := d.String()
+	if strings.HasSuffix(s, "m0s") {
+		s = s[:len(s)-2]
+	}
+	if strings.HasSuffix(s, "h0m") {
+		s = s[:len(s)-2]
+	}
+	return s
+}
@@ -0,0 +1,175 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package monitorstate
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+)
+
+func TestRollupRecord(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	r := NewRollup([]time.Duration{time.Hour, 24 * time.Hour})
+
+	r.Record(StatusUp, t0)
+	r.Record(StatusDown, t0.Add(time.Minute))
+	r.Record(StatusDown, t0.Add(2*time.Minute))
+	r.Record(StatusUp, t0.Add(5*time.Minute))
+
+	stats := r.Stats(t0.Add(5 * time.Minute))
+	require.Len(t, stats, 2)
+	for _, s := range stats {
+		require.Equal(t, time.Minute.Milliseconds(), s.UpMs)
+		require.Equal(t, (4 * time.Minute).Milliseconds(), s.DownMs)
+		require.InDelta(t, 20.0, s.UptimePct, 0.001)
+		require.Equal(t, 4, s.Checks)
+		require.Equal(t, 2, s.DownChecks)
+		require.Equal(t, 1, s.Incidents)
+		require.Equal(t, 1, s.Recoveries)
+		require.Equal(t, (4 * time.Minute).Milliseconds(), s.MTTRMs)
+		require.Equal(t, StatusUp, s.LastStatus)
+		require.True(t, s.DownSince.IsZero())
+	}
+}
+
+func TestRollupWindowsSlide(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	r := NewRollup([]time.Duration{time.Hour, 24 * time.Hour})
+
+	r.Record(StatusDown, t0)
+	r.Record(StatusDown, t0.Add(10*time.Minute))
+	at := t0.Add(3 * time.Hour)
+	for ts := t0.Add(2 * time.Hour); !ts.After(at); ts = ts.Add(time.Minute) {
+		r.Record(StatusUp, ts)
+	}
+
+	stats := r.Stats(at)
+	hour, day := stats[0], stats[1]
+
+	// The outage is out of the hourly window, only up time remains
+	require.Equal(t, 0, hour.DownChecks)
+	require.Equal(t, int64(0), hour.DownMs)
+	require.InDelta(t, 100.0, hour.UptimePct, 0.001)
+	require.LessOrEqual(t, hour.UpMs, (time.Hour + time.Hour/rollupBuckets).Milliseconds())
+
+	// The daily window still sees the whole outage, from t0 to the first up check
+	require.Equal(t, 2, day.DownChecks)
+	require.Equal(t, (2 * time.Hour).Milliseconds(), day.DownMs)
+	require.Equal(t, time.Hour.Milliseconds(), day.UpMs)
+	require.Equal(t, 1, day.Incidents)
+	require.Equal(t, (2 * time.Hour).Milliseconds(), day.MTTRMs)
+	require.LessOrEqual(t, len(r.Windows[0].Buckets), rollupBuckets+1)
+}
+
+func TestRollupOngoingIncident(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	r := NewRollup([]time.Duration{time.Hour})
+
+	r.Record(StatusUp, t0)
+	r.Record(StatusDown, t0.Add(time.Minute))
+	r.Record(StatusDown, t0.Add(2*time.Minute))
+
+	s := r.Stats(t0.Add(2 * time.Minute))[0]
+	require.Equal(t, 1, s.Incidents)
+	require.Equal(t, 0, s.Recoveries)
+	require.Equal(t, int64(0), s.MTTRMs)
+	require.Equal(t, t0.Add(time.Minute), s.DownSince)
+}
+
+func TestRollupRoundTrip(t *testing.T) {
+	t0 := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	r := NewRollup([]time.Duration{time.Hour, 30 * 24 * time.Hour})
+	r.Record(StatusUp, t0)
+	r.Record(StatusDown, t0.Add(time.Minute))
+
+	var docs []rollupDoc
+	for _, s := range r.Stats(t0.Add(time.Minute)) {
+		raw, err := json.Marshal(s.Fields())
+		require.NoError(t, err)
+		var d rollupDoc
+		require.NoError(t, json.Unmarshal(raw, &d))
+		docs = append(docs, d)
+	}
+
+	restored := rollupFromDocs(docs)
+	require.Equal(t, r.LastStatus, restored.LastStatus)
+	require.True(t, r.LastCheckAt.Equal(restored.LastCheckAt))
+	require.True(t, r.DownSince.Equal(restored.DownSince))
+	require.Len(t, restored.Windows, 2)
+	for i, w := range restored.Windows {
+		require.Equal(t, r.Windows[i].Window, w.Window)
+		require.Equal(t, r.Windows[i].Buckets, w.Buckets)
+	}
+}
+
+func TestFormatRollupWindow(t *testing.T) {
+	for d, expected := range map[time.Duration]string{
+		time.Hour:               "1h",
+		720 * time.Hour:         "720h",
+		90 * time.Minute:        "1h30m",
+		15 * time.Minute:        "15m",
+		45 * time.Second:        "45s",
+		time.Hour + time.Second: "1h0m1s",
+	} {
+		require.Equal(t, expected, FormatRollupWindow(d))
+		parsed, err := time.ParseDuration(expected)
+		require.NoError(t, err)
+		require.Equal(t, d, parsed)
+	}
+}
+
+func TestTrackerDueRollups(t *testing.T) {
+	sf := stdfields.StdMonitorFields{
+		ID:   "rollup-test",
+		Type: "http",
+		Rollup: stdfields.RollupConfig{
+			Enabled:  true,
+			Windows:  []time.Duration{time.Hour},
+			Interval: time.Minute,
+		},
+	}
+	mst := NewTracker(NilStateLoader, false)
+
+	for i := 0; i < FlappingThreshold+1; i++ {
+		mst.RecordStatus(sf, StatusUp, true)
+	}
+	// Non final attempts don't count as checks
+	mst.RecordStatus(sf, StatusDown, false)
+	for i := 0; i < FlappingThreshold+1; i++ {
+		mst.RecordStatus(sf, StatusDown, true)
+	}
+
+	now := time.Now()
+	rollups := mst.DueRollups(sf, now)
+	require.Len(t, rollups, 1)
+	// The rollup survived the transition from up to down
+	require.Equal(t, 2*(FlappingThreshold+1), rollups[0].Checks)
+	require.Equal(t, FlappingThreshold+1, rollups[0].DownChecks)
+	require.Equal(t, 1, rollups[0].Incidents)
+
+	require.Empty(t, mst.DueRollups(sf, now.Add(30*time.Second)))
+	require.Len(t, mst.DueRollups(sf, now.Add(time.Minute)), 1)
+
+	sf.Rollup.Enabled = false
+	require.Empty(t, mst.DueRollups(sf, now.Add(time.Hour)))
+}
@@ -70,10 +70,41 @@ func (t *Tracker) RecordStatus(sf stdfields.StdMonitorFields, newStatus StateSta
 	} else {
 		state.recordCheck(sf, newStatus, isFinalAttempt)
 	}
+
+	// Retries are not separate checks as far as rollups are concerned
+	if sf.Rollup.Enabled && isFinalAttempt {
+		if state.Rollup == nil {
+			state.Rollup = NewRollup(sf.Rollup.Windows)
+		} else {
+			state.Rollup.EnsureWindows(sf.Rollup.Windows)
+		}
+		state.Rollup.Record(newStatus, time.Now())
+	}
 	// return a copy since the state itself is a pointer that is frequently mutated
 	return state.copy()
 }
 
+// DueRollups returns the rollup figures of the monitor if rollups are enabled
+// and the rollup interval has elapsed since they were last returned.
+func (t *Tracker) DueRollups(sf stdfields.StdMonitorFields, now time.Time) []RollupStats {
+	if !sf.Rollup.Enabled {
+		return nil
+	}
+
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	state, ok := t.states[sf.ID]
+	if !ok || state.Rollup == nil {
+		return nil
+	}
+	if now.Sub(state.Rollup.EmittedAt) < sf.Rollup.Interval {
+		return nil
+	}
+	state.Rollup.EmittedAt = now
+	return state.Rollup.Stats(now)
+}
+
 func (t *Tracker) GetCurrentStatus(sf stdfields.StdMonitorFields) StateStatus {
 	s := t.GetCurrentState(sf, RetryConfig{})
 	if s == nil {
@@ -0,0 +1,52 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package summarizer
+
+import (
+	"github.com/elastic/beats/v7/heartbeat/eventext"
+	"github.com/elastic/beats/v7/heartbeat/monitors/jobs"
+	"github.com/elastic/beats/v7/heartbeat/monitors/stdfields"
+	"github.com/elastic/beats/v7/heartbeat/monitors/wrappers/monitorstate"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// rollupJobs returns one job per rollup window, each emitting a rollup event
+// with the monitor's uptime figures over that window.
+func rollupJobs(sf stdfields.StdMonitorFields, rollups []monitorstate.RollupStats) []jobs.Job {
+	js := make([]jobs.Job, 0, len(rollups))
+	for _, r := range rollups {
+		js = append(js, func(event *beat.Event) ([]jobs.Job, error) {
+			monitor := mapstr.M{
+				"id":   sf.ID,
+				"name": sf.Name,
+				"type": sf.Type,
+			}
+			if sf.Origin != "" {
+				monitor["origin"] = sf.Origin
+			}
+			eventext.MergeEventFields(event, mapstr.M{
+				"event":   mapstr.M{"type": monitorstate.RollupEventType},
+				"monitor": monitor,
+				"rollup":  r.Fields(),
+			})
+			return nil, nil
+		})
+	}
+	return js
+}
@@ -148,6 +148,11 @@ func (s *Summarizer) Wrap(j jobs.Job) jobs.Job {
 			if !retry {
 				// on final run emits a metric for the service when summary events are complete
 				logger.LogRun(event)
+
+				// Rollup events follow the summary but aren't part of the check, so they aren't wrapped
+				if rollups := s.mst.DueRollups(s.sf, time.Now()); len(rollups) > 0 {
+					return rollupJobs(s.sf, rollups), eventErr
+				}
 			} else {
 				// Bump the job summary for the next attempt
 				s.contsRemaining = 1
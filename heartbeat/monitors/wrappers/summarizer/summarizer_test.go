@@ -376,6 +376,58 @@ func TestRetryLightweightMonitorDuration(t *testing.T) {
 	require.GreaterOrEqual(t, look.RTTMS(retryElapsed), rcvdDuration)
 }
 
+func TestSummarizerRollups(t *testing.T) {
+	t.Parallel()
+
+	tracker := monitorstate.NewTracker(monitorstate.NilStateLoader, false)
+	sf := stdfields.StdMonitorFields{
+		ID:          "testmon",
+		Name:        "testmon",
+		Type:        "http",
+		MaxAttempts: 1,
+		Rollup: stdfields.RollupConfig{
+			Enabled:  true,
+			Windows:  []time.Duration{time.Hour, 24 * time.Hour},
+			Interval: time.Hour,
+		},
+	}
+	job := func(event *beat.Event) (j []jobs.Job, retErr error) {
+		event.Fields = mapstr.M{
+			"monitor": mapstr.M{
+				"id":     "test",
+				"status": string(monitorstate.StatusUp),
+			},
+		}
+		return nil, nil
+	}
+
+	s := NewSummarizer(job, sf, tracker)
+	events, _ := jobs.ExecJobAndConts(t, s.Wrap(job))
+	require.Len(t, events, 3)
+
+	summary, _ := events[0].GetValue("summary")
+	require.NotNil(t, summary)
+	var windows []string
+	for _, e := range events[1:] {
+		evType, _ := e.GetValue("event.type")
+		require.Equal(t, monitorstate.RollupEventType, evType)
+		monID, _ := e.GetValue("monitor.id")
+		require.Equal(t, sf.ID, monID)
+		window, _ := e.GetValue("rollup.window")
+		windows = append(windows, window.(string))
+		checks, _ := e.GetValue("rollup.checks")
+		require.Equal(t, 1, checks)
+		_, err := e.GetValue("summary")
+		require.Error(t, err)
+	}
+	require.Equal(t, []string{"1h", "24h"}, windows)
+
+	// The interval hasn't elapsed, so only the summary is emitted
+	s = NewSummarizer(job, sf, tracker)
+	events, _ = jobs.ExecJobAndConts(t, s.Wrap(job))
+	require.Len(t, events, 1)
+}
+
 type MockPlugin struct {
 	eachEvent       func(e *beat.Event, err error)
 	beforeSummary   func(e *beat.Event)
@@ -0,0 +1,216 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package coordination lets several heartbeat instances running the same
+// configuration share their monitors. Each instance periodically renews a
+// lease in a shared backend, and every monitor is assigned to exactly one
+// live instance using rendezvous hashing. When an instance stops renewing its
+// lease, its monitors are picked up by the remaining instances once the lease
+// expires.
+package coordination
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/heartbeat/config"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// Backend stores the leases of the cooperating instances.
+type Backend interface {
+	// Renew creates or refreshes the lease of the given instance.
+	Renew(instanceID string) error
+	// Members returns the IDs of all instances whose lease was renewed within ttl.
+	Members(ttl time.Duration) ([]string, error)
+	// Release removes the lease of the given instance.
+	Release(instanceID string) error
+	// Close releases any resources held by the backend.
+	Close() error
+}
+
+// Coordinator assigns jobs to live instances. It implements scheduler.Coordinator.
+type Coordinator struct {
+	instanceID string
+	backend    Backend
+	ttl        time.Duration
+	log        *logp.Logger
+
+	mx          sync.RWMutex
+	members     []string
+	refreshedAt time.Time
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewFromConfig creates a Coordinator using the backend selected in cfg.
+// esOutput is used as the elasticsearch backend connection if none is configured.
+func NewFromConfig(cfg config.Coordination, instanceID string, esOutput *conf.C, log *logp.Logger) (*Coordinator, error) {
+	if cfg.InstanceID != "" {
+		instanceID = cfg.InstanceID
+	}
+	if instanceID == "" {
+		return nil, errors.New("scheduler.coordination requires an instance_id")
+	}
+
+	var backend Backend
+	var err error
+	switch cfg.Type {
+	case "file":
+		backend, err = NewFileBackend(cfg.Path)
+	case "elasticsearch":
+		esCfg := cfg.Elasticsearch
+		if esCfg == nil {
+			esCfg = esOutput
+		}
+		if esCfg == nil {
+			return nil, errors.New("scheduler.coordination.elasticsearch must be set when not using the elasticsearch output")
+		}
+		backend, err = NewESBackend(esCfg, log)
+	default:
+		err = fmt.Errorf("unknown coordination backend '%s'", cfg.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := cfg.LeaseTTL
+	if ttl <= 0 {
+		ttl = config.DefaultCoordinationLeaseTTL
+	}
+	return New(instanceID, backend, ttl, log), nil
+}
+
+// New creates a Coordinator for the given instance. Leases are renewed every third of ttl.
+func New(instanceID string, backend Backend, ttl time.Duration, log *logp.Logger) *Coordinator {
+	return &Coordinator{
+		instanceID: instanceID,
+		backend:    backend,
+		ttl:        ttl,
+		log:        log.Named("coordination").With("instance_id", instanceID),
+	}
+}
+
+// Start registers this instance and keeps its lease renewed until Stop is called.
+func (c *Coordinator) Start() {
+	c.refresh()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		t := time.NewTicker(c.ttl / 3)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				c.refresh()
+			}
+		}
+	}()
+}
+
+// Stop releases this instance's lease so that its jobs are rebalanced immediately.
+func (c *Coordinator) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.wg.Wait()
+
+	if err := c.backend.Release(c.instanceID); err != nil {
+		c.log.Warnf("could not release coordination lease: %v", err)
+	}
+	if err := c.backend.Close(); err != nil {
+		c.log.Warnf("could not close coordination backend: %v", err)
+	}
+}
+
+// Owns returns true if the job with the given id is assigned to this instance.
+// If the member list could not be refreshed within the lease TTL, every job is
+// considered owned: running a check twice is preferable to not running it at all.
+func (c *Coordinator) Owns(id string) bool {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	if time.Since(c.refreshedAt) > c.ttl {
+		return true
+	}
+	return assign(c.members, id) == c.instanceID
+}
+
+func (c *Coordinator) refresh() {
+	if err := c.backend.Renew(c.instanceID); err != nil {
+		c.log.Warnf("could not renew coordination lease: %v", err)
+		return
+	}
+	members, err := c.backend.Members(c.ttl)
+	if err != nil {
+		c.log.Warnf("could not list coordinated instances: %v", err)
+		return
+	}
+	if !slices.Contains(members, c.instanceID) {
+		members = append(members, c.instanceID)
+	}
+	slices.Sort(members)
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if !slices.Equal(members, c.members) {
+		c.log.Infof("coordinating monitors between %d instances: %v", len(members), members)
+	}
+	c.members = members
+	c.refreshedAt = time.Now()
+}
+
+// assign returns the member a job is assigned to using rendezvous hashing, so
+// that only the jobs of a departed member move when the member list changes.
+func assign(members []string, id string) string {
+	var owner string
+	var best uint64
+	for _, m := range members {
+		score := score(m, id)
+		if owner == "" || score > best || (score == best && m < owner) {
+			owner, best = m, score
+		}
+	}
+	return owner
+}
+
+func score(member, id string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(member))
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write([]byte(id))
+	// FNV alone distributes similar inputs poorly, finalize with splitmix64.
+	x := h.Sum64()
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,184 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package coordination
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/heartbeat/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+)
+
+func jobIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("monitor-%d", i)
+	}
+	return ids
+}
+
+func TestFileBackendExactlyOneOwner(t *testing.T) {
+	dir := t.TempDir()
+	var coords []*Coordinator
+	for _, id := range []string{"a", "b", "c"} {
+		fb, err := NewFileBackend(dir)
+		require.NoError(t, err)
+		c := New(id, fb, time.Minute, logptest.NewTestingLogger(t, ""))
+		coords = append(coords, c)
+	}
+	// Start in order, then refresh so every instance sees the full member list.
+	for _, c := range coords {
+		c.Start()
+	}
+	for _, c := range coords {
+		c.refresh()
+		defer c.Stop()
+	}
+
+	owned := map[string]int{}
+	for _, job := range jobIDs(300) {
+		owners := 0
+		for _, c := range coords {
+			if c.Owns(job) {
+				owners++
+				owned[c.instanceID]++
+			}
+		}
+		require.Equal(t, 1, owners, "job %s", job)
+	}
+	for _, c := range coords {
+		assert.Greater(t, owned[c.instanceID], 50, "instance %s should get a fair share", c.instanceID)
+	}
+}
+
+func TestRebalanceOnDeparture(t *testing.T) {
+	dir := t.TempDir()
+	ttl := time.Minute
+	newCoord := func(id string) *Coordinator {
+		fb, err := NewFileBackend(dir)
+		require.NoError(t, err)
+		return New(id, fb, ttl, logptest.NewTestingLogger(t, ""))
+	}
+	a, b := newCoord("a"), newCoord("b")
+	a.Start()
+	b.Start()
+	a.refresh()
+	defer a.Stop()
+
+	jobs := jobIDs(100)
+	ownedByA := map[string]bool{}
+	for _, job := range jobs {
+		ownedByA[job] = a.Owns(job)
+	}
+
+	// b goes away without releasing its lease, simulating a crash.
+	b.cancel()
+	b.wg.Wait()
+	old := time.Now().Add(-2 * ttl)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "b"+leaseSuffix), old, old))
+
+	a.refresh()
+	for _, job := range jobs {
+		assert.True(t, a.Owns(job), "job %s should move to a", job)
+	}
+
+	// While both were alive, b never claimed the jobs assigned to a.
+	for job, owned := range ownedByA {
+		if owned {
+			assert.False(t, b.Owns(job))
+		}
+	}
+}
+
+func TestReleaseOnStop(t *testing.T) {
+	dir := t.TempDir()
+	fb, err := NewFileBackend(dir)
+	require.NoError(t, err)
+
+	c := New("inst/1", fb, time.Minute, logptest.NewTestingLogger(t, ""))
+	c.Start()
+	members, err := fb.Members(time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, []string{"inst/1"}, members)
+
+	c.Stop()
+	members, err = fb.Members(time.Minute)
+	require.NoError(t, err)
+	require.Empty(t, members)
+}
+
+type failingBackend struct{}
+
+func (failingBackend) Renew(string) error                      { return fmt.Errorf("unavailable") }
+func (failingBackend) Members(time.Duration) ([]string, error) { return nil, fmt.Errorf("unavailable") }
+func (failingBackend) Release(string) error                    { return nil }
+func (failingBackend) Close() error                            { return nil }
+
+func TestOwnsAllWhenBackendUnavailable(t *testing.T) {
+	c := New("a", failingBackend{}, time.Minute, logptest.NewTestingLogger(t, ""))
+	c.Start()
+	defer c.Stop()
+
+	for _, job := range jobIDs(10) {
+		require.True(t, c.Owns(job))
+	}
+}
+
+func TestAssignStable(t *testing.T) {
+	members := []string{"a", "b", "c", "d"}
+	for _, job := range jobIDs(200) {
+		owner := assign(members, job)
+		var remaining []string
+		for _, m := range members {
+			if m != owner {
+				remaining = append(remaining, m)
+			}
+		}
+		// Removing any other member never moves the job.
+		for _, m := range remaining {
+			var without []string
+			for _, o := range members {
+				if o != m {
+					without = append(without, o)
+				}
+			}
+			require.Equal(t, owner, assign(without, job))
+		}
+	}
+}
+
+func TestNewFromConfig(t *testing.T) {
+	_, err := NewFromConfig(config.Coordination{Type: "elasticsearch"}, "a", nil, logptest.NewTestingLogger(t, ""))
+	require.Error(t, err)
+
+	c, err := NewFromConfig(config.Coordination{Type: "file", Path: t.TempDir()}, "", nil, logptest.NewTestingLogger(t, ""))
+	require.Error(t, err)
+	require.Nil(t, c)
+
+	c, err = NewFromConfig(config.Coordination{Type: "file", Path: t.TempDir(), InstanceID: "x"}, "a", nil, logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	require.Equal(t, "x", c.instanceID)
+	require.Equal(t, config.DefaultCoordinationLeaseTTL, c.ttl)
+}
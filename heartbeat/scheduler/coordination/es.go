@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package coordination
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/statestore/backend"
+	"github.com/elastic/beats/v7/libbeat/statestore/backend/es"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+const esStoreName = "heartbeat-coordination"
+
+type esLease struct {
+	LastSeen int64 `struct:"last_seen"`
+}
+
+// ESBackend keeps the instance leases as documents in an Elasticsearch index
+// using the statestore elasticsearch backend. Lease ages are computed from the
+// renewing instance's clock, so instances should keep their clocks in sync.
+type ESBackend struct {
+	cancel context.CancelFunc
+	store  backend.Store
+}
+
+// NewESBackend creates an ESBackend connecting with the given elasticsearch configuration.
+func NewESBackend(esCfg *conf.C, log *logp.Logger) (*ESBackend, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	notifier := es.NewNotifier()
+	store, err := es.New(ctx, log, notifier).Access(esStoreName)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("could not open coordination store: %w", err)
+	}
+	notifier.Notify(esCfg)
+	return &ESBackend{cancel: cancel, store: store}, nil
+}
+
+func (b *ESBackend) Renew(instanceID string) error {
+	return b.store.Set(instanceID, esLease{LastSeen: time.Now().UnixMilli()})
+}
+
+func (b *ESBackend) Members(ttl time.Duration) ([]string, error) {
+	var members []string
+	oldest := time.Now().Add(-ttl).UnixMilli()
+	err := b.store.Each(func(key string, dec backend.ValueDecoder) (bool, error) {
+		var l esLease
+		if err := dec.Decode(&l); err != nil {
+			return false, err
+		}
+		if l.LastSeen >= oldest {
+			members = append(members, key)
+		}
+		return true, nil
+	})
+	return members, err
+}
+
+func (b *ESBackend) Release(instanceID string) error {
+	return b.store.Remove(instanceID)
+}
+
+func (b *ESBackend) Close() error {
+	defer b.cancel()
+	return b.store.Close()
+}
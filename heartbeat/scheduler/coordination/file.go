@@ -0,0 +1,100 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package coordination
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const leaseSuffix = ".lease"
+
+// FileBackend keeps one lease file per instance in a directory shared by all
+// instances, for instance over NFS. A lease is renewed by rewriting its file,
+// and its age is taken from the file's modification time.
+type FileBackend struct {
+	dir string
+}
+
+// NewFileBackend creates a FileBackend using dir, creating it if needed.
+func NewFileBackend(dir string) (*FileBackend, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("could not create coordination directory '%s': %w", dir, err)
+	}
+	return &FileBackend{dir: dir}, nil
+}
+
+func (b *FileBackend) leasePath(instanceID string) string {
+	return filepath.Join(b.dir, url.PathEscape(instanceID)+leaseSuffix)
+}
+
+func (b *FileBackend) Renew(instanceID string) error {
+	path := b.leasePath(instanceID)
+	if err := os.WriteFile(path, []byte(instanceID), 0o640); err != nil {
+		return err
+	}
+	// WriteFile of identical content is not guaranteed to bump the mtime on every filesystem.
+	now := time.Now()
+	return os.Chtimes(path, now, now)
+}
+
+func (b *FileBackend) Members(ttl time.Duration) ([]string, error) {
+	entries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []string
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), leaseSuffix)
+		if !ok || e.IsDir() {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			// The lease was released while listing.
+			continue
+		}
+		if time.Since(info.ModTime()) > ttl {
+			continue
+		}
+		id, err := url.PathUnescape(name)
+		if err != nil {
+			continue
+		}
+		members = append(members, id)
+	}
+	return members, nil
+}
+
+func (b *FileBackend) Release(instanceID string) error {
+	err := os.Remove(b.leasePath(instanceID))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (b *FileBackend) Close() error {
+	return nil
+}
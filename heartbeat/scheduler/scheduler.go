@@ -51,6 +51,13 @@ type Scheduler struct {
 	jobLimitSem map[string]*semaphore.Weighted
 	runOnce     bool
 	runOnceWg   *sync.WaitGroup
+	coordinator Coordinator
+}
+
+// Coordinator decides which of several cooperating heartbeat instances runs a job.
+type Coordinator interface {
+	// Owns returns true if the job with the given id should run on this instance.
+	Owns(id string) bool
 }
 
 type schedulerStats struct {
@@ -58,6 +65,7 @@ type schedulerStats struct {
 	activeTasks        *monitoring.Uint // gauge showing number of active tasks
 	waitingTasks       *monitoring.Uint // number of tasks waiting to run, but constrained by scheduler limit
 	jobsMissedDeadline *monitoring.Uint // counter for number of jobs that missed start deadline
+	jobsNotOwned       *monitoring.Uint // counter for number of job runs skipped because another instance owns them
 }
 
 // TaskFunc represents a single task in a job. Optionally returns continuation of tasks to
@@ -113,6 +121,7 @@ func Create(limit int64, registry *monitoring.Registry, location *time.Location,
 			activeTasks:        activeTasksGauge,
 			waitingTasks:       waitingTasksGauge,
 			jobsMissedDeadline: jobsMissedDeadlineCounter,
+			jobsNotOwned:       monitoring.NewUint(registry, "jobs.not_owned"),
 		},
 	}
 
@@ -145,6 +154,12 @@ func (s *Scheduler) missedDeadlineReporter() {
 	}
 }
 
+// SetCoordinator makes the scheduler only run jobs owned by this instance according
+// to the given Coordinator. It must be called before any job is added.
+func (s *Scheduler) SetCoordinator(c Coordinator) {
+	s.coordinator = c
+}
+
 // Stop all executing tasks in the scheduler. Cannot be restarted after Stop.
 func (s *Scheduler) Stop() {
 	s.cancelCtx()
@@ -200,11 +215,16 @@ func (s *Scheduler) Add(sched Schedule, pmws []maintwin.ParsedMaintWin, id strin
 		}
 
 		var lastRanAt time.Time
-		if activeMainWin == nil {
-			lastRanAt = sj.run()
-		} else {
+		switch {
+		case activeMainWin != nil:
 			logp.L().Infof("Job '%s' is in maintenance window '%s' , skipping", id, activeMainWin.Rule)
 			lastRanAt = now
+		case !s.runOnce && s.coordinator != nil && !s.coordinator.Owns(id):
+			debugf("Job '%s' is owned by another instance, skipping", id)
+			s.stats.jobsNotOwned.Inc()
+			lastRanAt = now
+		default:
+			lastRanAt = sj.run()
 		}
 		s.stats.activeJobs.Dec()
 
@@ -200,6 +200,35 @@ func TestScheduler_Stop(t *testing.T) {
 	assert.Equal(t, ErrAlreadyStopped, err)
 }
 
+type ownsOnly string
+
+func (o ownsOnly) Owns(id string) bool {
+	return id == string(o)
+}
+
+func TestScheduler_Coordinator(t *testing.T) {
+	s := Create(10, monitoring.NewRegistry(), tarawaTime(), nil, false)
+	defer s.Stop()
+	s.SetCoordinator(ownsOnly("owned"))
+
+	var ownedRuns, otherRuns uint32
+	_, err := s.Add(testSchedule{time.Millisecond}, nil, "owned", func(_ context.Context) []TaskFunc {
+		atomic.AddUint32(&ownedRuns, 1)
+		return nil
+	}, "http")
+	require.NoError(t, err)
+	_, err = s.Add(testSchedule{time.Millisecond}, nil, "other", func(_ context.Context) []TaskFunc {
+		atomic.AddUint32(&otherRuns, 1)
+		return nil
+	}, "http")
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return atomic.LoadUint32(&ownedRuns) > 2 && s.stats.jobsNotOwned.Get() > 2
+	}, 5*time.Second, time.Millisecond)
+	require.Zero(t, atomic.LoadUint32(&otherRuns))
+}
+
 func makeTasks(num int, callback func()) TaskFunc {
 	return func(ctx context.Context) []TaskFunc {
 		callback()
@@ -152,6 +152,10 @@ type ProcessingConfig struct {
 	// Private contains additional information to be passed to the processing
 	// pipeline builder.
 	Private interface{}
+
+	// InputID identifies the input publishing events through the client.
+	// It is used to filter events when tapping the pipeline.
+	InputID string
 }
 
 // ClientListener provides access to internal client events.
@@ -136,34 +136,47 @@ func (rl *Reloader) Check(runnerFactory RunnerFactory) error {
 		return nil
 	}
 
+	configs, err := rl.Configs()
+	if err != nil {
+		return err
+	}
+
+	// Initialize modules
+	for _, c := range configs {
+		if err = runnerFactory.CheckConfig(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Configs returns the enabled configs currently found in the files matching
+// the path of the reloader.
+func (rl *Reloader) Configs() ([]*config.C, error) {
 	rl.logger.Debugf("Checking module configs from: %s", rl.path)
 	gw := NewGlobWatcher(rl.path, rl.logger)
 
 	files, _, err := gw.Scan()
 	if err != nil {
-		return fmt.Errorf("fetching config files: %w", err)
+		return nil, fmt.Errorf("fetching config files: %w", err)
 	}
 
 	// Load all config objects
 	configs, err := rl.loadConfigs(files)
 	if err != nil {
-		return fmt.Errorf("loading configs: %w", err)
+		return nil, fmt.Errorf("loading configs: %w", err)
 	}
 
 	rl.logger.Debugf("Number of module configs found: %v", len(configs))
 
-	// Initialize modules
+	enabled := make([]*config.C, 0, len(configs))
 	for _, c := range configs {
-		// Only add configs to startList which are enabled
-		if !c.Config.Enabled() {
-			continue
-		}
-
-		if err = runnerFactory.CheckConfig(c.Config); err != nil {
-			return err
+		// Only return configs which are enabled
+		if c.Config.Enabled() {
+			enabled = append(enabled, c.Config)
 		}
 	}
-	return nil
+	return enabled, nil
 }
 
 // Run runs the reloader
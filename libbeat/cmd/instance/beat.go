@@ -65,6 +65,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/publisher/pipeline"
 	"github.com/elastic/beats/v7/libbeat/publisher/processing"
 	"github.com/elastic/beats/v7/libbeat/publisher/queue/diskqueue"
+	"github.com/elastic/beats/v7/libbeat/publisher/tap"
 	"github.com/elastic/beats/v7/libbeat/version"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/file"
@@ -139,6 +140,7 @@ type beatConfig struct {
 	HTTP            *config.C              `config:"http"`
 	HTTPPprof       *pprof.Config          `config:"http.pprof"`
 	BufferConfig    *config.C              `config:"http.buffer"`
+	HTTPTap         *config.C              `config:"http.debug.tap"`
 	Path            paths.Path             `config:"path"`
 	Logging         *config.C              `config:"logging"`
 	EventLogging    *config.C              `config:"logging.event_data"`
@@ -384,7 +386,11 @@ func (b *Beat) createBeater(bt beat.Creator) (beat.Beater, error) {
 
 	log.Debug("Initializing output plugins")
 	outputEnabled := b.Config.Output.IsSet() && b.Config.Output.Config().Enabled()
-	if !outputEnabled {
+	routedOutputs := len(b.Config.Pipeline.Outputs) > 0
+	if outputEnabled && routedOutputs {
+		return nil, errors.New("output and outputs cannot be configured together, please use only one of them")
+	}
+	if !outputEnabled && !routedOutputs {
 		if b.Manager.Enabled() {
 			b.Info.Logger.Info("Output is configured through Central Management")
 		} else {
@@ -401,7 +407,6 @@ func (b *Beat) createBeater(bt beat.Creator) (beat.Beater, error) {
 		Logger:    b.Info.Logger.Named("publisher"),
 		Tracer:    b.Instrumentation.Tracer(),
 	}
-	outputFactory := b.MakeOutputFactory(b.Config.Output)
 	settings := pipeline.Settings{
 		// Since now publisher is closed on Stop, we want to give some
 		// time to ack any pending events by default to avoid
@@ -410,7 +415,12 @@ func (b *Beat) createBeater(bt beat.Creator) (beat.Beater, error) {
 		Processors:     b.processors,
 		InputQueueSize: b.InputQueueSize,
 	}
-	publisher, err = pipeline.LoadWithSettings(b.Info, monitors, b.Config.Pipeline, outputFactory, settings)
+	if routedOutputs {
+		publisher, err = pipeline.LoadRoutedWithSettings(b.Info, monitors, b.Config.Pipeline, b.createOutput, settings)
+	} else {
+		outputFactory := b.MakeOutputFactory(b.Config.Output)
+		publisher, err = pipeline.LoadWithSettings(b.Info, monitors, b.Config.Pipeline, outputFactory, settings)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error initializing publisher: %w", err)
 	}
@@ -488,6 +498,9 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 		if err := b.API.AttachStateInspector(); err != nil {
 			return fmt.Errorf("failed to attach state inspector: %w", err)
 		}
+		if err := tap.HttpAttach(b.Config.HTTPTap, b.API, logger); err != nil {
+			return fmt.Errorf("failed to attach pipeline tap: %w", err)
+		}
 	}
 
 	// Do not load seccomp for osquerybeat, it was disabled before V2 in the configuration file
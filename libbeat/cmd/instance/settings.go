@@ -20,6 +20,7 @@ package instance
 import (
 	"github.com/spf13/pflag"
 
+	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/cfgfile"
 	"github.com/elastic/beats/v7/libbeat/idxmgmt"
 	"github.com/elastic/beats/v7/libbeat/idxmgmt/lifecycle"
@@ -46,6 +47,11 @@ type Settings struct {
 
 	Processing processing.SupportFactory
 
+	// InputProcessing returns the processing settings of the input with the
+	// given ID, as applied by the beat to the clients of the input. It is used
+	// by the test processors command. Leave nil if inputs can't be selected.
+	InputProcessing func(b *beat.Beat, id string) (beat.ProcessingConfig, error)
+
 	// InputQueueSize is the size for the internal publisher queue in the
 	// publisher pipeline. This is only useful when the Beat plans to use
 	// beat.DropIfFull PublishMode. Leave as zero for default.
@@ -0,0 +1,303 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cmd/instance"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/publisher/processing"
+	"github.com/elastic/beats/v7/libbeat/publisher/tap"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// maxTracedProcessors is the number of processor records kept per event.
+const maxTracedProcessors = 4096
+
+// processorsResult is the outcome of running one event through the
+// processing pipeline.
+type processorsResult struct {
+	Line    int      `json:"line"`
+	Event   mapstr.M `json:"event,omitempty"`
+	Dropped bool     `json:"dropped,omitempty"`
+	Reason  string   `json:"reason,omitempty"`
+	Errors  []string `json:"errors,omitempty"`
+	Diff    []string `json:"diff,omitempty"`
+}
+
+func GenTestProcessorsCmd(settings instance.Settings) *cobra.Command {
+	var (
+		inputID  string
+		file     string
+		showDiff bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "processors",
+		Short: "Run sample events through the configured processors",
+		Long: `Reads events as newline delimited JSON from stdin or a file, runs them
+through the global processors, and the processors of the input selected with
+--input, and prints the resulting event, or the reason it was dropped, for
+each of them.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			b, err := instance.NewInitializedBeat(settings)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+
+			processingCfg, err := inputProcessing(settings, b, inputID)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading input configuration: %s\n", err)
+				os.Exit(1)
+			}
+
+			in := io.Reader(os.Stdin)
+			if file != "" && file != "-" {
+				f, err := os.Open(file)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Error opening events file: %s\n", err)
+					os.Exit(1)
+				}
+				defer f.Close()
+				in = f
+			}
+
+			if err := runProcessors(b.GetProcessors(), processingCfg, in, os.Stdout, showDiff); err != nil {
+				fmt.Fprintf(os.Stderr, "Error running processors: %s\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&inputID, "input", "", "ID of the input whose processors are applied")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "File to read NDJSON events from, defaults to stdin")
+	cmd.Flags().BoolVar(&showDiff, "diff", false, "Print the changes made to each event")
+
+	return cmd
+}
+
+// inputProcessing returns the processing settings of the input with the given
+// ID, as applied by the beat.
+func inputProcessing(settings instance.Settings, b *instance.Beat, inputID string) (beat.ProcessingConfig, error) {
+	if inputID == "" {
+		return beat.ProcessingConfig{}, nil
+	}
+	if settings.InputProcessing == nil {
+		return beat.ProcessingConfig{}, fmt.Errorf("selecting an input is not supported by %s", b.Info.Beat)
+	}
+	return settings.InputProcessing(&b.Beat, inputID)
+}
+
+// runProcessors runs each event read from in through the processing pipeline
+// created by supporter and writes one result per event to out.
+func runProcessors(
+	supporter processing.Supporter,
+	processingCfg beat.ProcessingConfig,
+	in io.Reader,
+	out io.Writer,
+	showDiff bool,
+) error {
+	// Follow the event through the processors to report which one
+	// dropped it, or failed. The processing pipeline reports to a hub of
+	// its own, so the records of other pipelines and subscribers don't
+	// interfere.
+	tapper, ok := supporter.(processing.Tapper)
+	if !ok {
+		return errors.New("processing pipeline can't be traced")
+	}
+	hub := tap.NewHub()
+	tapper.SetTap(hub)
+	// The records of an event are captured while it's processed and
+	// read before the next one, the buffer only needs to hold the records
+	// of one event. Only this pipeline reports to the hub, so records are
+	// not rate limited.
+	sub, err := hub.Subscribe(
+		tap.Filter{Point: tap.PointProcessor},
+		tap.Limits{Rate: math.Inf(1), Buffer: maxTracedProcessors},
+		1,
+	)
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+
+	processor, err := supporter.Create(processingCfg, false)
+	if err != nil {
+		return fmt.Errorf("error creating processing pipeline: %w", err)
+	}
+	defer processors.Close(processor) //nolint:errcheck // best effort on exit
+
+	enc := json.NewEncoder(out)
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(nil, 10*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		event, err := decodeEvent(scanner.Bytes())
+		if err != nil {
+			return fmt.Errorf("invalid event on line %d: %w", line, err)
+		}
+		original := eventToMap(event.Clone())
+
+		result := processorsResult{Line: line}
+		dropped := sub.Dropped()
+		processed, _ := processor.Run(event)
+
+	records:
+		for {
+			select {
+			case record := <-sub.Records():
+				if record.Error != "" {
+					result.Errors = append(result.Errors, record.Processor+": "+record.Error)
+				}
+				if record.Dropped {
+					result.Reason = "dropped by " + record.Processor
+				}
+			default:
+				break records
+			}
+		}
+		if sub.Dropped() != dropped {
+			result.Errors = append(result.Errors, fmt.Sprintf("more than %d processors ran, the trace is incomplete", maxTracedProcessors))
+		}
+
+		if processed == nil {
+			result.Dropped = true
+			if result.Reason == "" {
+				result.Reason = "dropped"
+			}
+		} else {
+			result.Event = eventToMap(processed)
+			if showDiff {
+				result.Diff = diffEvents(original, result.Event)
+			}
+		}
+
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// decodeEvent parses a JSON event, using the @timestamp and @metadata fields
+// for the event timestamp and metadata.
+func decodeEvent(data []byte) (*beat.Event, error) {
+	var fields mapstr.M
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	event := &beat.Event{Fields: fields}
+	if ts, ok := fields["@timestamp"]; ok {
+		s, ok := ts.(string)
+		if !ok {
+			return nil, errors.New("@timestamp must be a string")
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid @timestamp: %w", err)
+		}
+		event.Timestamp = t
+		delete(fields, "@timestamp")
+	}
+	if meta, ok := fields["@metadata"]; ok {
+		m, ok := meta.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("@metadata must be an object")
+		}
+		event.Meta = m
+		delete(fields, "@metadata")
+	}
+	return event, nil
+}
+
+// eventToMap returns the event in the form it's encoded by the outputs.
+func eventToMap(event *beat.Event) mapstr.M {
+	m := event.Fields.Clone()
+	if m == nil {
+		m = mapstr.M{}
+	}
+	if !event.Timestamp.IsZero() {
+		m["@timestamp"] = event.Timestamp.UTC().Format(time.RFC3339Nano)
+	}
+	if len(event.Meta) > 0 {
+		m["@metadata"] = event.Meta.Clone()
+	}
+	return m
+}
+
+// diffEvents lists the fields added (+), removed (-) and changed (~) between
+// two events.
+func diffEvents(before, after mapstr.M) []string {
+	from, to := before.Flatten(), after.Flatten()
+
+	keys := make([]string, 0, len(from)+len(to))
+	for k := range from {
+		keys = append(keys, k)
+	}
+	for k := range to {
+		if _, ok := from[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var diff []string
+	for _, k := range keys {
+		oldValue, inOld := from[k]
+		newValue, inNew := to[k]
+		switch {
+		case !inOld:
+			diff = append(diff, fmt.Sprintf("+ %s: %s", k, jsonValue(newValue)))
+		case !inNew:
+			diff = append(diff, fmt.Sprintf("- %s: %s", k, jsonValue(oldValue)))
+		default:
+			o, n := jsonValue(oldValue), jsonValue(newValue)
+			if o != n {
+				diff = append(diff, fmt.Sprintf("~ %s: %s => %s", k, o, n))
+			}
+		}
+	}
+	return diff
+}
+
+func jsonValue(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(b)
+}
@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/publisher/processing"
+	"github.com/elastic/beats/v7/libbeat/publisher/tap"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+
+	_ "github.com/elastic/beats/v7/libbeat/processors/actions"
+)
+
+func TestRunProcessors(t *testing.T) {
+	logger := logptest.NewTestingLogger(t, "")
+	cfg := config.MustNewConfigFrom(`
+processors:
+  - drop_event.when.equals.level: debug
+  - add_fields:
+      target: ""
+      fields.env: test
+`)
+	supporter, err := processing.MakeDefaultSupport(true, nil)(beat.Info{Beat: "testbeat", Logger: logger}, logger, cfg)
+	require.NoError(t, err)
+	defer supporter.Close()
+
+	inputProcessors, err := processors.New(processors.PluginConfig{
+		config.MustNewConfigFrom(`rename.fields: [{from: msg, to: message}]`),
+	}, logger)
+	require.NoError(t, err)
+
+	in := strings.NewReader(`{"@timestamp":"2024-01-02T03:04:05Z","level":"info","msg":"hello"}
+
+{"@timestamp":"2024-01-02T03:04:05Z","level":"debug","msg":"noise"}
+`)
+	var out bytes.Buffer
+	err = runProcessors(supporter, beat.ProcessingConfig{Processor: inputProcessors, InputID: "my-input"}, in, &out, true)
+	require.NoError(t, err)
+	assert.Zero(t, tap.DefaultHub.Subscribers(), "the default tap hub must not be used")
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var kept processorsResult
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &kept))
+	assert.Equal(t, 1, kept.Line)
+	assert.False(t, kept.Dropped)
+	assert.Equal(t, mapstr.M{
+		"@timestamp": "2024-01-02T03:04:05Z",
+		"level":      "info",
+		"message":    "hello",
+		"env":        "test",
+	}, kept.Event)
+	assert.Equal(t, []string{
+		`+ env: "test"`,
+		`+ message: "hello"`,
+		`- msg: "hello"`,
+	}, kept.Diff)
+
+	var dropped processorsResult
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &dropped))
+	assert.Equal(t, 3, dropped.Line)
+	assert.True(t, dropped.Dropped)
+	assert.Contains(t, dropped.Reason, "dropped by drop_event")
+	assert.Nil(t, dropped.Event)
+}
+
+func TestRunProcessorsInvalidEvent(t *testing.T) {
+	logger := logptest.NewTestingLogger(t, "")
+	supporter, err := processing.MakeDefaultSupport(true, nil)(beat.Info{Beat: "testbeat", Logger: logger}, logger, config.NewConfig())
+	require.NoError(t, err)
+	defer supporter.Close()
+
+	for _, in := range []string{
+		`not json`,
+		`{"@timestamp": 1}`,
+		`{"@timestamp": "yesterday"}`,
+		`{"@metadata": "x"}`,
+	} {
+		err := runProcessors(supporter, beat.ProcessingConfig{}, strings.NewReader(in), &bytes.Buffer{}, false)
+		assert.ErrorContains(t, err, "invalid event on line 1", in)
+	}
+}
+
+func TestDiffEvents(t *testing.T) {
+	before := mapstr.M{"a": 1, "b": mapstr.M{"c": "x", "d": true}}
+	after := mapstr.M{"a": 2, "b": mapstr.M{"c": "x"}, "e": []string{"y"}}
+	assert.Equal(t, []string{
+		`~ a: 1 => 2`,
+		`- b.d: true`,
+		`+ e: ["y"]`,
+	}, diffEvents(before, after))
+}
@@ -281,3 +281,11 @@ func TestIsEmpty(t *testing.T) {
 	})
 
 }
+
+func TestReferencedFields(t *testing.T) {
+	fs := MustCompileEvent("%{[tenant.id]}-%{[trace.id]:none}-%{[tenant][id]}")
+	assert.Equal(t, []string{"tenant.id"}, fs.Fields())
+	assert.Equal(t, []string{"tenant.id", "trace.id"}, fs.ReferencedFields())
+
+	assert.Empty(t, MustCompileEvent("static").ReferencedFields())
+}
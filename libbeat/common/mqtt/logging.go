@@ -43,7 +43,10 @@ var (
 	_ libmqtt.Logger = new(warnLogger)
 )
 
-func setupLibraryLogging(logger *logp.Logger) {
+// SetupLibraryLogging redirects the logs of the MQTT library. The library
+// loggers are global and shared by the MQTT input and output, so they are
+// only set the first time.
+func SetupLibraryLogging(logger *logp.Logger) {
 	setupLoggingOnce.Do(func() {
 		logger = logger.Named("libmqtt")
 		libmqtt.CRITICAL = &errorLogger{log: logger}
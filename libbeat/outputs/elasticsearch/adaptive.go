@@ -0,0 +1,295 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// adaptiveConfig configures the adaptive sizing of bulk requests and of the
+// number of bulk requests in flight.
+type adaptiveConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Bounds for the number of events per bulk request. A MaxBulkSize of 0
+	// uses bulk_max_size.
+	MinBulkSize int `config:"min_bulk_size"`
+	MaxBulkSize int `config:"max_bulk_size"`
+
+	// Bounds for the number of concurrent bulk requests. A MaxConcurrency
+	// of 0 uses the number of output workers.
+	MinConcurrency int `config:"min_concurrency"`
+	MaxConcurrency int `config:"max_concurrency"`
+
+	// Additive increase applied to the bulk size after a healthy response
+	// and multiplicative factor applied when Elasticsearch is overloaded.
+	IncreaseStep   int     `config:"increase_step"`
+	DecreaseFactor float64 `config:"decrease_factor"`
+
+	// Signals used to detect an overloaded cluster.
+	TargetLatency   time.Duration    `config:"target_latency"`
+	TooManyRatio    float64          `config:"too_many_ratio"`
+	MaxResponseSize cfgtype.ByteSize `config:"max_response_size"`
+}
+
+var defaultAdaptiveConfig = adaptiveConfig{
+	Enabled:         false,
+	MinBulkSize:     50,
+	MinConcurrency:  1,
+	IncreaseStep:    50,
+	DecreaseFactor:  0.5,
+	TargetLatency:   5 * time.Second,
+	TooManyRatio:    0.05,
+	MaxResponseSize: 1024 * 1024,
+}
+
+func (c *adaptiveConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.MinBulkSize < 1 || c.MinConcurrency < 1 || c.IncreaseStep < 1 {
+		return fmt.Errorf("adaptive.min_bulk_size, adaptive.min_concurrency and adaptive.increase_step must be at least 1")
+	}
+	if c.TargetLatency <= 0 {
+		return fmt.Errorf("adaptive.target_latency must be positive, got %v", c.TargetLatency)
+	}
+	if c.DecreaseFactor <= 0 || c.DecreaseFactor >= 1 {
+		return fmt.Errorf("adaptive.decrease_factor must be between 0 and 1, got %v", c.DecreaseFactor)
+	}
+	if c.TooManyRatio < 0 || c.TooManyRatio > 1 {
+		return fmt.Errorf("adaptive.too_many_ratio must be between 0 and 1, got %v", c.TooManyRatio)
+	}
+	if c.MaxBulkSize > 0 && c.MaxBulkSize < c.MinBulkSize {
+		return fmt.Errorf("adaptive.max_bulk_size (%d) must not be less than adaptive.min_bulk_size (%d)", c.MaxBulkSize, c.MinBulkSize)
+	}
+	if c.MaxConcurrency > 0 && c.MaxConcurrency < c.MinConcurrency {
+		return fmt.Errorf("adaptive.max_concurrency (%d) must not be less than adaptive.min_concurrency (%d)", c.MaxConcurrency, c.MinConcurrency)
+	}
+	return nil
+}
+
+// adaptiveController adjusts the bulk size and the number of in-flight bulk
+// requests shared by all clients of an output using additive increase and
+// multiplicative decrease: every healthy response grows the limits a little,
+// while throttling, slow or oversized responses shrink them quickly.
+//
+// All methods are safe to call on a nil controller, in which case they do
+// nothing.
+type adaptiveController struct {
+	config   adaptiveConfig
+	observer outputs.AdaptiveObserver // nil if the output's observer doesn't report the limits
+	log      *logp.Logger
+
+	minBulkSize, maxBulkSize       int
+	minConcurrency, maxConcurrency int
+
+	mu           sync.Mutex
+	bulkSize     int
+	concurrency  int
+	inFlight     int
+	lastDecrease time.Time
+	// wake is closed and replaced whenever a slot may have become available.
+	wake chan struct{}
+}
+
+// newAdaptiveController creates a controller starting at the upper bounds.
+// bulkMaxSize is the output's bulk_max_size and workers the number of clients
+// that can publish concurrently.
+func newAdaptiveController(
+	cfg adaptiveConfig,
+	bulkMaxSize int,
+	workers int,
+	observer outputs.Observer,
+	log *logp.Logger,
+) *adaptiveController {
+	adaptiveObserver, _ := observer.(outputs.AdaptiveObserver)
+
+	maxBulkSize := cfg.MaxBulkSize
+	if maxBulkSize <= 0 {
+		maxBulkSize = bulkMaxSize
+	}
+	maxConcurrency := cfg.MaxConcurrency
+	if maxConcurrency <= 0 || maxConcurrency > workers {
+		maxConcurrency = workers
+	}
+	maxConcurrency = max(maxConcurrency, 1)
+
+	a := &adaptiveController{
+		config:         cfg,
+		observer:       adaptiveObserver,
+		log:            log,
+		minBulkSize:    min(cfg.MinBulkSize, maxBulkSize),
+		maxBulkSize:    maxBulkSize,
+		minConcurrency: min(cfg.MinConcurrency, maxConcurrency),
+		maxConcurrency: maxConcurrency,
+		bulkSize:       maxBulkSize,
+		concurrency:    maxConcurrency,
+		wake:           make(chan struct{}),
+	}
+	a.report()
+	return a
+}
+
+// shouldSplit reports whether a batch with n events is larger than the
+// current bulk size.
+func (a *adaptiveController) shouldSplit(n int) bool {
+	if a == nil {
+		return false
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return n > a.bulkSize
+}
+
+// reportSplit reports that a batch was split for being larger than the
+// current bulk size.
+func (a *adaptiveController) reportSplit() {
+	if a != nil && a.observer != nil {
+		a.observer.AdaptiveBatchSplit()
+	}
+}
+
+// acquire blocks until the number of in-flight bulk requests is below the
+// current concurrency limit, or until ctx is done.
+func (a *adaptiveController) acquire(ctx context.Context) error {
+	if a == nil {
+		return nil
+	}
+	for {
+		a.mu.Lock()
+		if a.inFlight < a.concurrency {
+			a.inFlight++
+			a.mu.Unlock()
+			return nil
+		}
+		wake := a.wake
+		a.mu.Unlock()
+
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// release frees a slot taken by acquire.
+func (a *adaptiveController) release() {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.inFlight--
+	a.broadcast()
+}
+
+// observe updates the limits based on the outcome of a bulk request. tooMany
+// is the number of events rejected with 429 Too Many Requests.
+func (a *adaptiveController) observe(result bulkResult, tooMany int) {
+	if a == nil || len(result.events) == 0 {
+		return
+	}
+
+	throttled := result.status == http.StatusTooManyRequests ||
+		float64(tooMany) > a.config.TooManyRatio*float64(len(result.events))
+	switch {
+	case throttled:
+		a.decrease(true, "too many requests")
+	case result.status == http.StatusRequestEntityTooLarge:
+		a.decrease(false, "payload too large")
+	case result.connErr != nil:
+		// Connection errors say nothing about the cluster load, they are
+		// handled by the output backoff.
+	case result.duration > a.config.TargetLatency:
+		a.decrease(false, fmt.Sprintf("latency %v above target", result.duration))
+	case a.config.MaxResponseSize > 0 && len(result.response) > int(a.config.MaxResponseSize):
+		a.decrease(false, fmt.Sprintf("response of %d bytes above limit", len(result.response)))
+	default:
+		a.increase()
+	}
+}
+
+func (a *adaptiveController) increase() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	bulkSize := min(a.bulkSize+a.config.IncreaseStep, a.maxBulkSize)
+	concurrency := min(a.concurrency+1, a.maxConcurrency)
+	if bulkSize == a.bulkSize && concurrency == a.concurrency {
+		return
+	}
+	a.bulkSize, a.concurrency = bulkSize, concurrency
+	a.broadcast()
+	a.report()
+}
+
+// decrease shrinks the bulk size, and the concurrency if withConcurrency is
+// set. Decreases are applied at most once per target latency, so that the
+// responses of requests that were already in flight don't shrink the limits
+// several times for the same overload.
+func (a *adaptiveController) decrease(withConcurrency bool, reason string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(a.lastDecrease) < a.config.TargetLatency {
+		return
+	}
+	a.lastDecrease = now
+
+	a.bulkSize = max(int(float64(a.bulkSize)*a.config.DecreaseFactor), a.minBulkSize)
+	if withConcurrency {
+		a.concurrency = max(int(float64(a.concurrency)*a.config.DecreaseFactor), a.minConcurrency)
+	}
+	a.log.Debugf("Adaptive batching reduced bulk size to %d and concurrency to %d: %s",
+		a.bulkSize, a.concurrency, reason)
+	a.report()
+}
+
+// limits returns the current bulk size and concurrency.
+func (a *adaptiveController) limits() (bulkSize, concurrency int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.bulkSize, a.concurrency
+}
+
+// broadcast wakes up all goroutines waiting in acquire. Must be called with
+// mu held.
+func (a *adaptiveController) broadcast() {
+	close(a.wake)
+	a.wake = make(chan struct{})
+}
+
+// report exports the current limits to the output metrics. Must be called
+// with mu held, or before the controller is shared.
+func (a *adaptiveController) report() {
+	if a.observer == nil {
+		return
+	}
+	a.observer.EffectiveBatchSize(a.bulkSize)
+	a.observer.EffectiveConcurrency(a.concurrency)
+}
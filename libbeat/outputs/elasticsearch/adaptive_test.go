@@ -0,0 +1,278 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+
+package elasticsearch
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/esleg/eslegclient"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+func testAdaptiveConfig() adaptiveConfig {
+	cfg := defaultAdaptiveConfig
+	cfg.Enabled = true
+	cfg.MinBulkSize = 10
+	cfg.IncreaseStep = 10
+	cfg.TargetLatency = time.Second
+	return cfg
+}
+
+func testBulkResult(events int) bulkResult {
+	return bulkResult{
+		events:   make([]publisher.Event, events),
+		status:   http.StatusOK,
+		duration: time.Millisecond,
+	}
+}
+
+func TestAdaptiveControllerLimits(t *testing.T) {
+	logger := logptest.NewTestingLogger(t, "")
+	reg := monitoring.NewRegistry()
+	a := newAdaptiveController(testAdaptiveConfig(), 100, 4, outputs.NewStats(reg, logp.NewNopLogger()), logger)
+
+	bulkSize, concurrency := a.limits()
+	assert.Equal(t, 100, bulkSize, "controller should start at bulk_max_size")
+	assert.Equal(t, 4, concurrency, "controller should start at the number of workers")
+	assertRegistryUint(t, reg, "batches.effective_size", 100, "initial bulk size should be reported")
+	assertRegistryUint(t, reg, "batches.effective_concurrency", 4, "initial concurrency should be reported")
+
+	throttled := testBulkResult(100)
+	throttled.status = http.StatusTooManyRequests
+	a.observe(throttled, 0)
+	bulkSize, concurrency = a.limits()
+	assert.Equal(t, 50, bulkSize, "throttling should halve the bulk size")
+	assert.Equal(t, 2, concurrency, "throttling should halve the concurrency")
+	assertRegistryUint(t, reg, "batches.effective_size", 50, "decreased bulk size should be reported")
+	assertRegistryUint(t, reg, "batches.effective_concurrency", 2, "decreased concurrency should be reported")
+
+	a.observe(throttled, 0)
+	bulkSize, _ = a.limits()
+	assert.Equal(t, 50, bulkSize, "decreases should be limited to one per target latency")
+
+	for i := 0; i < 10; i++ {
+		a.observe(testBulkResult(50), 0)
+	}
+	bulkSize, concurrency = a.limits()
+	assert.Equal(t, 100, bulkSize, "healthy responses should grow the bulk size up to its maximum")
+	assert.Equal(t, 4, concurrency, "healthy responses should grow the concurrency up to its maximum")
+	assertRegistryUint(t, reg, "batches.effective_size", 100, "increased bulk size should be reported")
+}
+
+func TestAdaptiveControllerSignals(t *testing.T) {
+	tests := map[string]struct {
+		result          func() bulkResult
+		tooMany         int
+		wantBulkSize    int
+		wantConcurrency int
+	}{
+		"healthy response": {
+			result:          func() bulkResult { return testBulkResult(100) },
+			wantBulkSize:    100,
+			wantConcurrency: 4,
+		},
+		"429 status": {
+			result: func() bulkResult {
+				r := testBulkResult(100)
+				r.status = http.StatusTooManyRequests
+				r.connErr = errors.New("429 Too Many Requests")
+				return r
+			},
+			wantBulkSize:    50,
+			wantConcurrency: 2,
+		},
+		"429 ratio above limit": {
+			result:          func() bulkResult { return testBulkResult(100) },
+			tooMany:         10,
+			wantBulkSize:    50,
+			wantConcurrency: 2,
+		},
+		"429 ratio below limit": {
+			result:          func() bulkResult { return testBulkResult(100) },
+			tooMany:         1,
+			wantBulkSize:    100,
+			wantConcurrency: 4,
+		},
+		"413 status": {
+			result: func() bulkResult {
+				r := testBulkResult(100)
+				r.status = http.StatusRequestEntityTooLarge
+				r.connErr = errors.New("413 Request Entity Too Large")
+				return r
+			},
+			wantBulkSize:    50,
+			wantConcurrency: 4,
+		},
+		"latency above target": {
+			result: func() bulkResult {
+				r := testBulkResult(100)
+				r.duration = 2 * time.Second
+				return r
+			},
+			wantBulkSize:    50,
+			wantConcurrency: 4,
+		},
+		"large response": {
+			result: func() bulkResult {
+				r := testBulkResult(100)
+				r.response = make(eslegclient.BulkResponse, 2*1024*1024)
+				return r
+			},
+			wantBulkSize:    50,
+			wantConcurrency: 4,
+		},
+		"connection error": {
+			result: func() bulkResult {
+				r := testBulkResult(100)
+				r.status = 0
+				r.connErr = errors.New("connection refused")
+				return r
+			},
+			wantBulkSize:    100,
+			wantConcurrency: 4,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := newAdaptiveController(testAdaptiveConfig(), 100, 4, nil, logptest.NewTestingLogger(t, ""))
+			a.observe(test.result(), test.tooMany)
+			bulkSize, concurrency := a.limits()
+			assert.Equal(t, test.wantBulkSize, bulkSize)
+			assert.Equal(t, test.wantConcurrency, concurrency)
+		})
+	}
+}
+
+func TestAdaptiveControllerBounds(t *testing.T) {
+	cfg := testAdaptiveConfig()
+	cfg.MinBulkSize = 40
+	cfg.MaxBulkSize = 60
+	cfg.MinConcurrency = 2
+	cfg.MaxConcurrency = 8
+	cfg.TargetLatency = time.Nanosecond
+	a := newAdaptiveController(cfg, 100, 4, nil, logptest.NewTestingLogger(t, ""))
+
+	bulkSize, concurrency := a.limits()
+	assert.Equal(t, 60, bulkSize, "max_bulk_size should override bulk_max_size")
+	assert.Equal(t, 4, concurrency, "concurrency should not exceed the number of workers")
+
+	throttled := testBulkResult(60)
+	throttled.status = http.StatusTooManyRequests
+	for i := 0; i < 5; i++ {
+		a.observe(throttled, 0)
+		time.Sleep(time.Millisecond)
+	}
+	bulkSize, concurrency = a.limits()
+	assert.Equal(t, 40, bulkSize, "bulk size should not drop below min_bulk_size")
+	assert.Equal(t, 2, concurrency, "concurrency should not drop below min_concurrency")
+}
+
+func TestAdaptiveControllerAcquire(t *testing.T) {
+	cfg := testAdaptiveConfig()
+	cfg.MaxConcurrency = 1
+	a := newAdaptiveController(cfg, 100, 2, nil, logptest.NewTestingLogger(t, ""))
+
+	require.NoError(t, a.acquire(context.Background()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, a.acquire(ctx), context.DeadlineExceeded, "acquire should block while the limit is reached")
+
+	acquired := make(chan error)
+	go func() { acquired <- a.acquire(context.Background()) }()
+	a.release()
+	select {
+	case err := <-acquired:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("release should unblock a waiting acquire")
+	}
+	a.release()
+}
+
+func TestAdaptiveControllerNil(t *testing.T) {
+	var a *adaptiveController
+	assert.False(t, a.shouldSplit(1000000))
+	assert.NoError(t, a.acquire(context.Background()))
+	a.release()
+	a.observe(testBulkResult(10), 10)
+}
+
+func TestPublishAdaptive(t *testing.T) {
+	logger := logptest.NewTestingLogger(t, "")
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	esMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer esMock.Close()
+
+	reg := monitoring.NewRegistry()
+	observer := outputs.NewStats(reg, logp.NewNopLogger())
+	cfg := testAdaptiveConfig()
+	cfg.MinBulkSize = 1
+	client, err := NewClient(
+		clientSettings{
+			observer:      observer,
+			connection:    eslegclient.ConnectionSettings{URL: esMock.URL},
+			indexSelector: testIndexSelector{},
+			adaptive:      newAdaptiveController(cfg, 2, 1, observer, logger),
+		},
+		nil,
+		logger,
+	)
+	require.NoError(t, err)
+
+	event := publisher.Event{Content: beat.Event{Fields: mapstr.M{"field": 1}}}
+
+	// Batches up to the current bulk size are sent.
+	batch := encodeBatch(client, &batchMock{events: []publisher.Event{event, event}, canSplit: true})
+	err = client.Publish(ctx, batch)
+	assert.Error(t, err, "a 429 response should be returned as an error")
+	assert.False(t, batch.didSplit, "batch within the bulk size should not be split")
+	assert.Len(t, batch.retryEvents, 2, "throttled events should be retried")
+	assertRegistryUint(t, reg, "batches.effective_size", 1, "throttling should reduce the bulk size")
+
+	// Larger batches are split before being sent.
+	batch = encodeBatch(client, &batchMock{events: []publisher.Event{event, event}, canSplit: true})
+	err = client.Publish(ctx, batch)
+	assert.NoError(t, err)
+	assert.True(t, batch.didSplit, "batch larger than the bulk size should be split")
+	assert.Nil(t, batch.retryEvents, "split batch should not be sent")
+	assertRegistryUint(t, reg, "batches.adaptive_split", 1, "split should be reported")
+	assertRegistryUint(t, reg, "batches.split", 0, "adaptive split should not be reported as oversized batch split")
+	assertRegistryUint(t, reg, "events.total", 2, "split batch should not be counted as published")
+}
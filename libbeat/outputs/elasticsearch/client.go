@@ -64,6 +64,10 @@ type Client struct {
 	// forwarded to this index. Otherwise, they will be dropped.
 	deadLetterIndex string
 
+	// adaptive is shared by all clients of the output when adaptive batch
+	// sizing is enabled, nil otherwise.
+	adaptive *adaptiveController
+
 	log                    *logp.Logger
 	pLogIndex              *periodic.Doer
 	pLogIndexTryDeadLetter *periodic.Doer
@@ -84,6 +88,10 @@ type clientSettings struct {
 	// If deadLetterIndex is set, events with bulk-ingest errors will be
 	// forwarded to this index. Otherwise, they will be dropped.
 	deadLetterIndex string
+
+	// Optional controller adapting the bulk size and the number of
+	// concurrent bulk requests to the cluster load.
+	adaptive *adaptiveController
 }
 
 type bulkResultStats struct {
@@ -112,6 +120,9 @@ type bulkResult struct {
 
 	// The API response from Elasticsearch.
 	response eslegclient.BulkResponse
+
+	// The time it took to send the request and read the response.
+	duration time.Duration
 }
 
 const (
@@ -196,6 +207,7 @@ func NewClient(
 		pipelineSelector: pipeline,
 		observer:         observer,
 		deadLetterIndex:  s.deadLetterIndex,
+		adaptive:         s.adaptive,
 
 		log:                    logger,
 		pLogDeadLetter:         pLogDeadLetter,
@@ -250,6 +262,19 @@ func (client *Client) Publish(ctx context.Context, batch publisher.Batch) error
 	span, ctx := apm.StartSpan(ctx, "publishEvents", "output")
 	defer span.End()
 	span.Context.SetLabel("events_original", len(batch.Events()))
+
+	if client.adaptive.shouldSplit(len(batch.Events())) && batch.SplitRetry() {
+		// The batch is larger than the current adaptive bulk size, let the
+		// pipeline retry it in smaller pieces.
+		client.adaptive.reportSplit()
+		return nil
+	}
+	if err := client.adaptive.acquire(ctx); err != nil {
+		batch.Cancelled()
+		return err
+	}
+	defer client.adaptive.release()
+
 	client.observer.NewBatch(len(batch.Events()))
 
 	// Create and send the bulk request.
@@ -258,6 +283,7 @@ func (client *Client) Publish(ctx context.Context, batch publisher.Batch) error
 	if bulkResult.connErr != nil {
 		// If there was a connection-level error there is no per-item response,
 		// handle it and return.
+		client.adaptive.observe(bulkResult, 0)
 		return client.handleBulkResultError(ctx, batch, bulkResult)
 	}
 	span.Context.SetLabel("events_published", len(bulkResult.events))
@@ -266,6 +292,7 @@ func (client *Client) Publish(ctx context.Context, batch publisher.Batch) error
 	// check and report the per-item results.
 	eventsToRetry, stats := client.bulkCollectPublishFails(bulkResult)
 	stats.reportToObserver(client.observer)
+	client.adaptive.observe(bulkResult, stats.tooMany)
 
 	if len(eventsToRetry) > 0 {
 		span.Context.SetLabel("events_failed", len(eventsToRetry))
@@ -312,12 +339,12 @@ func (client *Client) doBulkRequest(
 		h.Set(HeaderEventCount, strconv.Itoa(len(result.events)))
 		result.status, result.response, result.connErr =
 			client.conn.Bulk(ctx, "", "", h, bulkRequestParams, bulkItems)
+		result.duration = time.Since(begin)
 		if result.connErr == nil {
-			duration := time.Since(begin)
-			client.observer.ReportLatency(duration)
+			client.observer.ReportLatency(result.duration)
 			client.log.Debugf(
 				"doBulkRequest: %d events have been sent to elasticsearch in %v.",
-				len(result.events), duration)
+				len(result.events), result.duration)
 		}
 	}
 
@@ -44,6 +44,8 @@ type ElasticsearchConfig struct {
 	NonIndexablePolicy *config.Namespace `config:"non_indexable_policy"`
 	AllowOlderVersion  bool              `config:"allow_older_versions"`
 	Queue              config.Namespace  `config:"queue"`
+	Adaptive           adaptiveConfig    `config:"adaptive"`
+	Fingerprint        *config.C         `config:"fingerprint"`
 
 	Transport httpcommon.HTTPTransportSettings `config:",inline"`
 }
@@ -75,6 +77,7 @@ var (
 			Max:  60 * time.Second,
 		},
 		BulkMaxSize: defaultBulkSize,
+		Adaptive:    defaultAdaptiveConfig,
 		Transport:   ESDefaultTransportSettings(),
 	}
 )
@@ -95,6 +98,9 @@ func (c *ElasticsearchConfig) Validate() error {
 	if c.APIKey != "" && (c.Username != "" || c.Password != "") {
 		return fmt.Errorf("cannot set both api_key and username/password")
 	}
+	if c.Adaptive.Enabled && c.BulkMaxSize <= 0 && c.Adaptive.MaxBulkSize <= 0 {
+		return fmt.Errorf("adaptive batching requires bulk_max_size or adaptive.max_bulk_size to be greater than 0")
+	}
 
 	return nil
 }
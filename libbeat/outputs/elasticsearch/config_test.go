@@ -19,8 +19,10 @@ package elasticsearch
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	conf "github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
@@ -128,3 +130,53 @@ func readConfig(cfg *conf.C) (*ElasticsearchConfig, error) {
 	}
 	return &c, nil
 }
+
+func TestAdaptiveConfig(t *testing.T) {
+	valid := `
+adaptive:
+  enabled: true
+  min_bulk_size: 100
+  max_bulk_size: 3200
+  target_latency: 2s
+  max_response_size: 512KiB
+`
+	esConfig, err := readConfig(conf.MustNewConfigFrom(valid))
+	require.NoError(t, err)
+	assert.True(t, esConfig.Adaptive.Enabled)
+	assert.Equal(t, 100, esConfig.Adaptive.MinBulkSize)
+	assert.Equal(t, 3200, esConfig.Adaptive.MaxBulkSize)
+	assert.Equal(t, 2*time.Second, esConfig.Adaptive.TargetLatency)
+	assert.EqualValues(t, 512*1024, esConfig.Adaptive.MaxResponseSize)
+	assert.Equal(t, 0.5, esConfig.Adaptive.DecreaseFactor, "unset options should keep their defaults")
+
+	invalid := map[string]string{
+		"decrease factor out of range": `
+adaptive.enabled: true
+adaptive.decrease_factor: 1.5
+`,
+		"too many ratio out of range": `
+adaptive.enabled: true
+adaptive.too_many_ratio: -1
+`,
+		"max bulk size below min": `
+adaptive.enabled: true
+adaptive.min_bulk_size: 100
+adaptive.max_bulk_size: 10
+`,
+		"max concurrency below min": `
+adaptive.enabled: true
+adaptive.min_concurrency: 4
+adaptive.max_concurrency: 2
+`,
+		"no bulk size bound": `
+bulk_max_size: 0
+adaptive.enabled: true
+`,
+	}
+	for name, test := range invalid {
+		t.Run(name, func(t *testing.T) {
+			_, err := readConfig(conf.MustNewConfigFrom(test))
+			assert.Error(t, err)
+		})
+	}
+}
@@ -88,8 +88,29 @@ func makeES(
 		params = nil
 	}
 
+	fingerprint, err := newDocumentFingerprint(esConfig.Fingerprint, log)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
 	encoderFactory := newEventEncoderFactory(
-		esConfig.EscapeHTML, indexSelector, pipelineSelector)
+		esConfig.EscapeHTML, indexSelector, pipelineSelector, fingerprint)
+
+	batchSize := esConfig.BulkMaxSize
+	workers := outputs.NumofWorker(cfg)
+	var adaptive *adaptiveController
+	if esConfig.Adaptive.Enabled {
+		// Hosts are repeated once per worker when load balancing, otherwise
+		// only one host per worker is active at a time.
+		concurrency := workers
+		if esConfig.LoadBalance {
+			concurrency = len(hosts)
+		}
+		adaptive = newAdaptiveController(esConfig.Adaptive, esConfig.BulkMaxSize, concurrency, observer, log)
+		batchSize, concurrency = adaptive.limits()
+		log.Infof("Adaptive batching enabled with up to %d events per bulk request and %d concurrent requests",
+			batchSize, concurrency)
+	}
 
 	clients := make([]outputs.NetworkClient, len(hosts))
 	for i, host := range hosts {
@@ -121,6 +142,7 @@ func makeES(
 			pipelineSelector: pipelineSelector,
 			observer:         observer,
 			deadLetterIndex:  deadLetterIndex,
+			adaptive:         adaptive,
 		}, &connectCallbackRegistry, log)
 		if err != nil {
 			return outputs.Fail(err)
@@ -132,12 +154,12 @@ func makeES(
 
 	return outputs.SuccessNet(esConfig.Queue,
 		esConfig.LoadBalance,
-		esConfig.BulkMaxSize,
+		batchSize,
 		esConfig.MaxRetries,
 		encoderFactory,
 		beatInfo.Logger,
 		beatInfo.Paths,
-		outputs.NumofWorker(cfg),
+		workers,
 		clients)
 }
 
@@ -37,6 +37,7 @@ type eventEncoder struct {
 	enc              eslegclient.BodyEncoder
 	pipelineSelector *outil.Selector
 	indexSelector    outputs.IndexSelector
+	fingerprint      *documentFingerprint
 }
 
 type encodedEvent struct {
@@ -70,15 +71,17 @@ func newEventEncoderFactory(
 	escapeHTML bool,
 	indexSelector outputs.IndexSelector,
 	pipelineSelector *outil.Selector,
+	fingerprint *documentFingerprint,
 ) queue.EncoderFactory[publisher.Event] {
 	return func() queue.Encoder[publisher.Event] {
-		return newEventEncoder(escapeHTML, indexSelector, pipelineSelector)
+		return newEventEncoder(escapeHTML, indexSelector, pipelineSelector, fingerprint)
 	}
 }
 
 func newEventEncoder(escapeHTML bool,
 	indexSelector outputs.IndexSelector,
 	pipelineSelector *outil.Selector,
+	fingerprint *documentFingerprint,
 ) queue.Encoder[publisher.Event] {
 	buf := bytes.NewBuffer(nil)
 	enc := eslegclient.NewJSONEncoder(buf, escapeHTML)
@@ -87,6 +90,7 @@ func newEventEncoder(escapeHTML bool,
 		enc:              enc,
 		pipelineSelector: pipelineSelector,
 		indexSelector:    indexSelector,
+		fingerprint:      fingerprint,
 	}
 }
 
@@ -118,6 +122,16 @@ func (pe *eventEncoder) encodeRawEvent(e *beat.Event) *encodedEvent {
 	}
 
 	id, _ := events.GetMetaStringValue(*e, events.FieldMetaID)
+	if id == "" && pe.fingerprint != nil && opType != events.OpTypeDelete {
+		// Derive the _id from the event contents and only create the
+		// document, so a resend after a partial failure is answered with a
+		// 409 conflict and counted as a duplicate.
+		id, err = pe.fingerprint.id(e)
+		if err != nil {
+			return &encodedEvent{err: fmt.Errorf("failed to compute event fingerprint: %w", err)}
+		}
+		opType = events.OpTypeCreate
+	}
 
 	err = pe.enc.Marshal(e)
 	if err != nil {
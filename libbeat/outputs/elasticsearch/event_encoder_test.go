@@ -28,6 +28,8 @@ import (
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/beat/events"
 	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
 	"github.com/elastic/elastic-agent-libs/mapstr"
 )
 
@@ -40,7 +42,7 @@ func (testIndexSelector) Select(event *beat.Event) (string, error) {
 func TestEncodeEntry(t *testing.T) {
 	indexSelector := testIndexSelector{}
 
-	encoder := newEventEncoder(true, indexSelector, nil)
+	encoder := newEventEncoder(true, indexSelector, nil, nil)
 
 	metaFields := mapstr.M{
 		events.FieldMetaOpType:   "create",
@@ -103,6 +105,73 @@ func TestEncodeEntry(t *testing.T) {
 	assert.Contains(t, encBeatEvent.String(), `"pipeline":"TEST_PIPELINE"`, "String representation of encoded event should include the original event's meta fields")
 }
 
+func TestEncodeEntryFingerprint(t *testing.T) {
+	fingerprint, err := newDocumentFingerprint(config.MustNewConfigFrom(mapstr.M{
+		"fields": []string{"message", "@timestamp"},
+	}), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	require.NotNil(t, fingerprint)
+
+	encoder := newEventEncoder(true, testIndexSelector{}, nil, fingerprint)
+	timestamp := time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+	encode := func(fields, meta mapstr.M) *encodedEvent {
+		t.Helper()
+		pubEvent, _ := encoder.EncodeEntry(publisher.Event{
+			Content: beat.Event{Timestamp: timestamp, Fields: fields, Meta: meta},
+		})
+		return pubEvent.EncodedEvent.(*encodedEvent)
+	}
+
+	first := encode(mapstr.M{"message": "hello", "other": 1}, nil)
+	require.NoError(t, first.err)
+	assert.NotEmpty(t, first.id, "fingerprint should set the document id")
+	assert.Equal(t, events.OpTypeCreate, first.opType, "fingerprinted events should use create")
+	assert.Nil(t, first.meta, "fingerprint must not modify the event metadata")
+
+	second := encode(mapstr.M{"message": "hello", "other": 2}, mapstr.M{events.FieldMetaOpType: "index"})
+	require.NoError(t, second.err)
+	assert.Equal(t, first.id, second.id, "events with identical fingerprinted fields should share an id")
+	assert.Equal(t, events.OpTypeCreate, second.opType)
+	assert.NotContains(t, second.meta, events.FieldMetaID)
+
+	other := encode(mapstr.M{"message": "bye"}, nil)
+	require.NoError(t, other.err)
+	assert.NotEqual(t, first.id, other.id)
+
+	explicit := encode(mapstr.M{"message": "hello"}, mapstr.M{events.FieldMetaID: "my-id"})
+	require.NoError(t, explicit.err)
+	assert.Equal(t, "my-id", explicit.id, "an explicit _id takes precedence over the fingerprint")
+	assert.Equal(t, events.OpTypeDefault, explicit.opType)
+
+	deleted := encode(mapstr.M{"message": "hello"}, mapstr.M{events.FieldMetaOpType: "delete"})
+	require.NoError(t, deleted.err)
+	assert.Empty(t, deleted.id, "delete operations are not fingerprinted")
+
+	missing := encode(mapstr.M{"other": 1}, nil)
+	assert.ErrorContains(t, missing.err, "failed to compute event fingerprint")
+}
+
+func TestNewDocumentFingerprintDisabled(t *testing.T) {
+	logger := logptest.NewTestingLogger(t, "")
+
+	fingerprint, err := newDocumentFingerprint(nil, logger)
+	require.NoError(t, err)
+	assert.Nil(t, fingerprint)
+
+	fingerprint, err = newDocumentFingerprint(config.MustNewConfigFrom(mapstr.M{
+		"enabled": false,
+		"fields":  []string{"message"},
+	}), logger)
+	require.NoError(t, err)
+	assert.Nil(t, fingerprint)
+
+	_, err = newDocumentFingerprint(config.MustNewConfigFrom(mapstr.M{
+		"method": "no-such-hash",
+		"fields": []string{"message"},
+	}), logger)
+	assert.Error(t, err)
+}
+
 // encodeBatch encodes a publisher.Batch so it can be provided to
 // Client.Publish and other helpers.
 // This modifies the batch in place, but also returns its input batch
@@ -123,6 +192,7 @@ func encodeEvents(client *Client, events []publisher.Event) []publisher.Event {
 		client.conn.EscapeHTML,
 		client.indexSelector,
 		client.pipelineSelector,
+		nil,
 	)
 	for i := range events {
 		// Skip encoding if there's already encoded data present
@@ -140,6 +210,7 @@ func encodeEvent(client *Client, event publisher.Event) publisher.Event {
 		client.conn.EscapeHTML,
 		client.indexSelector,
 		client.pipelineSelector,
+		nil,
 	)
 	encoded, _ := encoder.EncodeEntry(event)
 	return encoded
@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/beat/events"
+	"github.com/elastic/beats/v7/libbeat/processors/fingerprint"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// fingerprintIDField is the target the fingerprint is written to. It is only
+// ever set on a scratch copy of the event metadata.
+const fingerprintIDField = "@metadata." + events.FieldMetaID
+
+// documentFingerprint derives document IDs from a hash of configured event
+// fields, so that resending an event after a partial bulk failure is
+// rejected by Elasticsearch as a conflict instead of being indexed twice.
+type documentFingerprint struct {
+	processor beat.Processor
+}
+
+// newDocumentFingerprint creates a documentFingerprint from the output's
+// fingerprint settings. It returns nil if the settings are missing or
+// disabled. The settings are the same as for the fingerprint processor,
+// except that target_field is ignored.
+func newDocumentFingerprint(cfg *config.C, log *logp.Logger) (*documentFingerprint, error) {
+	if cfg == nil || !cfg.Enabled() {
+		return nil, nil
+	}
+
+	settings, err := config.NewConfigFrom(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := settings.SetString("target_field", -1, fingerprintIDField); err != nil {
+		return nil, err
+	}
+	processor, err := fingerprint.New(settings, log)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fingerprint settings: %w", err)
+	}
+	return &documentFingerprint{processor: processor}, nil
+}
+
+// id computes the document ID for the event without modifying it.
+func (f *documentFingerprint) id(e *beat.Event) (string, error) {
+	scratch := &beat.Event{
+		Timestamp: e.Timestamp,
+		Fields:    e.Fields,
+		Meta:      maps.Clone(e.Meta),
+	}
+	scratch, err := f.processor.Run(scratch)
+	if err != nil {
+		return "", err
+	}
+	id, err := scratch.Meta.GetValue(events.FieldMetaID)
+	if err != nil {
+		return "", err
+	}
+	idStr, ok := id.(string)
+	if !ok {
+		return "", fmt.Errorf("fingerprint is not a string: %T", id)
+	}
+	return idStr, nil
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -30,12 +31,14 @@ import (
 
 	"github.com/elastic/sarama"
 
+	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common/fmtstr"
 	"github.com/elastic/beats/v7/libbeat/outputs"
 	"github.com/elastic/beats/v7/libbeat/outputs/codec"
 	"github.com/elastic/beats/v7/libbeat/outputs/outil"
 	"github.com/elastic/beats/v7/libbeat/publisher"
 	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
 	"github.com/elastic/elastic-agent-libs/testing"
 	"github.com/elastic/elastic-agent-libs/transport"
 )
@@ -55,7 +58,13 @@ type client struct {
 	producerMux sync.RWMutex
 	producer    sarama.AsyncProducer
 
-	recordHeaders []sarama.RecordHeader
+	// txnMux serializes transactions, and lets Close wait for the
+	// transaction in progress to be finished.
+	txnMux sync.Mutex
+
+	headers       []header
+	recordHeaders []sarama.RecordHeader // set if all header values are constant
+	removeFields  []string
 
 	wg sync.WaitGroup
 }
@@ -67,6 +76,11 @@ type msgRef struct {
 	failed []publisher.Event
 	batch  publisher.Batch
 
+	// succeeded and finished are only set in transactional mode, where the
+	// batch is acknowledged by the publisher once the transaction is over.
+	succeeded []publisher.Event
+	finished  chan struct{}
+
 	err error
 }
 
@@ -112,19 +126,23 @@ func newKafkaClient(
 	}
 
 	if len(headers) != 0 {
-		recordHeaders := make([]sarama.RecordHeader, 0, len(headers))
+		constant := true
 		for _, h := range headers {
 			if h.Key == "" {
 				continue
 			}
-			recordHeader := sarama.RecordHeader{
-				Key:   []byte(h.Key),
-				Value: []byte(h.Value),
+			if h.ValueFormat != nil && !h.ValueFormat.IsConst() {
+				constant = false
 			}
+			if h.RemoveFields && h.ValueFormat != nil {
+				c.removeFields = append(c.removeFields, h.ValueFormat.ReferencedFields()...)
+			}
+			c.headers = append(c.headers, h)
+		}
 
-			recordHeaders = append(recordHeaders, recordHeader)
+		if constant {
+			c.recordHeaders = c.eventHeaders(nil)
 		}
-		c.recordHeaders = recordHeaders
 	}
 
 	return c, nil
@@ -165,6 +183,14 @@ func (c *client) Close() error {
 	// Releases any Publish goroutine blocked on a channel send.
 	close(c.done)
 
+	// Wait for the transaction in progress, if any, to be aborted. The
+	// producer is reset if the transaction failed fatally.
+	c.txnMux.Lock()
+	defer c.txnMux.Unlock()
+	if c.producer == nil {
+		return nil
+	}
+
 	// Take the write lock so AsyncClose, which closes the input channel, waits
 	// for in-flight sends to finish instead of racing with them; see send.
 	c.producerMux.Lock()
@@ -177,6 +203,10 @@ func (c *client) Close() error {
 }
 
 func (c *client) Publish(_ context.Context, batch publisher.Batch) error {
+	if c.transactional() {
+		return c.publishTransaction(batch)
+	}
+
 	events := batch.Events()
 	c.observer.NewBatch(len(events))
 
@@ -274,7 +304,19 @@ func (c *client) getEventMessage(data *publisher.Event) (*message, error) {
 		}
 	}
 
-	serializedEvent, err := c.codec.Encode(c.index, event)
+	msg.headers = c.recordHeaders
+	if msg.headers == nil {
+		msg.headers = c.eventHeaders(event)
+	}
+
+	// Fields moved to the headers are removed from a copy of the event, so
+	// the headers can still be computed if the event is retried.
+	payload := event
+	if len(c.removeFields) != 0 {
+		payload = withoutFields(event, c.removeFields)
+	}
+
+	serializedEvent, err := c.codec.Encode(c.index, payload)
 	if err != nil {
 		if c.log.IsDebug() {
 			c.log.Debug("failed event logged to event log file")
@@ -301,6 +343,86 @@ func (c *client) getEventMessage(data *publisher.Event) (*message, error) {
 	return msg, nil
 }
 
+// withoutFields returns a copy of event without fields. Only the maps on
+// the paths to the removed fields are copied, the rest of the copy shares its
+// values with event.
+func withoutFields(event *beat.Event, fields []string) *beat.Event {
+	payload := *event
+	payload.Fields = maps.Clone(event.Fields)
+	payload.Meta = maps.Clone(event.Meta)
+	for _, field := range fields {
+		if meta, ok := strings.CutPrefix(field, beat.MetadataFieldKey+"."); ok {
+			deleteCopied(payload.Meta, meta)
+		} else {
+			deleteCopied(payload.Fields, field)
+		}
+	}
+	return &payload
+}
+
+// deleteCopied deletes the dotted key from m, which must be a copy owned by
+// the caller. The maps it walks through are replaced by copies before the
+// key is deleted from them. Keys are looked up like mapstr.M.Delete does.
+func deleteCopied(m mapstr.M, key string) {
+	for m != nil {
+		if _, ok := m[key]; ok {
+			delete(m, key)
+			return
+		}
+		head, rest, ok := strings.Cut(key, ".")
+		if !ok {
+			return
+		}
+		var sub mapstr.M
+		switch v := m[head].(type) {
+		case mapstr.M:
+			sub = maps.Clone(v)
+		case map[string]interface{}:
+			sub = maps.Clone(v)
+		default:
+			return
+		}
+		m[head] = sub
+		m, key = sub, rest
+	}
+}
+
+// eventHeaders returns the record headers of the message for event. Headers
+// whose value can not be computed for the event are omitted.
+func (c *client) eventHeaders(event *beat.Event) []sarama.RecordHeader {
+	if len(c.headers) == 0 {
+		return nil
+	}
+
+	recordHeaders := make([]sarama.RecordHeader, 0, len(c.headers))
+	for _, h := range c.headers {
+		var value []byte
+		if h.Value != "" {
+			value = []byte(h.Value)
+		}
+		if h.ValueFormat != nil {
+			var err error
+			if h.ValueFormat.IsConst() {
+				value, err = h.ValueFormat.RunBytes(nil)
+			} else {
+				value, err = h.ValueFormat.RunBytes(event)
+			}
+			if err != nil {
+				if c.log.IsDebug() {
+					c.log.Debugf("omitting kafka header %q: %v", h.Key, err)
+				}
+				continue
+			}
+		}
+
+		recordHeaders = append(recordHeaders, sarama.RecordHeader{
+			Key:   []byte(h.Key),
+			Value: value,
+		})
+	}
+	return recordHeaders
+}
+
 func (c *client) successWorker(ch <-chan *sarama.ProducerMessage) {
 	defer c.wg.Done()
 	defer c.log.Debug("Stop kafka ack worker")
@@ -311,7 +433,7 @@ func (c *client) successWorker(ch <-chan *sarama.ProducerMessage) {
 			c.log.Debug("Failed to assert libMsg.Metadata to *message")
 			return
 		}
-		msg.ref.done()
+		msg.ref.succeed(msg)
 	}
 }
 
@@ -413,6 +535,13 @@ func (r *msgRef) done() {
 	r.dec()
 }
 
+func (r *msgRef) succeed(msg *message) {
+	if r.finished != nil {
+		r.succeeded = append(r.succeeded, msg.data)
+	}
+	r.dec()
+}
+
 func (r *msgRef) fail(msg *message, err error) {
 	switch {
 	case errors.Is(err, sarama.ErrInvalidMessage):
@@ -456,6 +585,14 @@ func (r *msgRef) dec() {
 		return
 	}
 
+	if r.finished != nil {
+		close(r.finished)
+		return
+	}
+	r.complete()
+}
+
+func (r *msgRef) complete() {
 	r.client.log.Debug("finished kafka batch")
 	stats := r.client.observer
 
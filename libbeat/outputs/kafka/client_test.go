@@ -30,8 +30,11 @@ import (
 	"github.com/elastic/beats/v7/libbeat/outputs"
 	_ "github.com/elastic/beats/v7/libbeat/outputs/codec/json"
 	"github.com/elastic/beats/v7/libbeat/outputs/outest"
+	"github.com/elastic/beats/v7/libbeat/publisher"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
 	"github.com/elastic/elastic-agent-libs/monitoring"
 	"github.com/elastic/elastic-agent-libs/paths"
 	"github.com/elastic/sarama"
@@ -110,6 +113,127 @@ func TestClientShutdownPanic(t *testing.T) {
 		"event dropped log not found")
 }
 
+func TestEventMessageHeaders(t *testing.T) {
+	newClient := func(t *testing.T, headers []map[string]any) *client {
+		cfg, err := config.NewConfigFrom(map[string]any{
+			"hosts":   []string{"localhost:9094"},
+			"topic":   "testTopic",
+			"headers": headers,
+		})
+		require.NoError(t, err)
+
+		logger := logptest.NewTestingLogger(t, "")
+		outGroup, err := makeKafka(nil,
+			beat.Info{Beat: "libbeat", Logger: logger, Paths: paths.New()},
+			outputs.NewStats(monitoring.NewRegistry(), logger), cfg)
+		require.NoError(t, err)
+
+		c, ok := outGroup.Clients[0].(*client)
+		require.True(t, ok)
+		return c
+	}
+
+	newEvent := func() *publisher.Event {
+		return &publisher.Event{Content: beat.Event{
+			Fields: mapstr.M{
+				"message": "hello",
+				"tenant":  mapstr.M{"id": "acme", "name": "ACME"},
+				"data_stream": mapstr.M{
+					"dataset": "nginx.access",
+				},
+			},
+		}}
+	}
+
+	t.Run("static headers are shared", func(t *testing.T) {
+		c := newClient(t, []map[string]any{
+			{"key": "origin", "value": "edge"},
+			{"key": "literal", "value": "%{[tenant.id]}"},
+			{"key": "empty"},
+		})
+		require.NotNil(t, c.recordHeaders)
+
+		msg, err := c.getEventMessage(newEvent())
+		require.NoError(t, err)
+		assert.Equal(t, []sarama.RecordHeader{
+			{Key: []byte("origin"), Value: []byte("edge")},
+			{Key: []byte("literal"), Value: []byte("%{[tenant.id]}")},
+			{Key: []byte("empty")},
+		}, msg.headers)
+	})
+
+	t.Run("dynamic headers", func(t *testing.T) {
+		c := newClient(t, []map[string]any{
+			{"key": "tenant", "value_format": "%{[tenant.id]}"},
+			{"key": "dataset", "value_format": "%{[data_stream.dataset]}"},
+			{"key": "trace", "value_format": "%{[trace.id]}"},
+			{"key": "span", "value_format": "%{[span.id]:none}"},
+		})
+		assert.Nil(t, c.recordHeaders)
+
+		event := newEvent()
+		msg, err := c.getEventMessage(event)
+		require.NoError(t, err)
+		assert.Equal(t, []sarama.RecordHeader{
+			{Key: []byte("tenant"), Value: []byte("acme")},
+			{Key: []byte("dataset"), Value: []byte("nginx.access")},
+			{Key: []byte("span"), Value: []byte("none")},
+		}, msg.headers, "headers of missing fields without default must be omitted")
+		assert.Contains(t, string(msg.value), `"id":"acme"`)
+	})
+
+	t.Run("remove fields moves values to the headers", func(t *testing.T) {
+		c := newClient(t, []map[string]any{
+			{"key": "tenant", "value_format": "%{[tenant.id]}", "remove_fields": true},
+		})
+
+		event := newEvent()
+		msg, err := c.getEventMessage(event)
+		require.NoError(t, err)
+		assert.Equal(t, []sarama.RecordHeader{
+			{Key: []byte("tenant"), Value: []byte("acme")},
+		}, msg.headers)
+		assert.NotContains(t, string(msg.value), `"id":"acme"`)
+		assert.Contains(t, string(msg.value), `"name":"ACME"`)
+
+		// The published event is left untouched so retries get the same headers.
+		v, err := event.Content.GetValue("tenant.id")
+		require.NoError(t, err)
+		assert.Equal(t, "acme", v)
+	})
+}
+
+func TestWithoutFields(t *testing.T) {
+	labels := mapstr.M{"env": "prod"}
+	event := &beat.Event{
+		Meta: mapstr.M{"tenant": "acme", "pipeline": "logs"},
+		Fields: mapstr.M{
+			"tenant":    map[string]interface{}{"id": "acme", "name": "ACME"},
+			"trace.id":  "abc",
+			"labels":    labels,
+			"message":   "hello",
+			"not_a_map": "value",
+		},
+	}
+
+	payload := withoutFields(event, []string{"tenant.id", "trace.id", "@metadata.tenant", "not_a_map.x", "missing.field"})
+	assert.Equal(t, mapstr.M{
+		"tenant":    mapstr.M{"name": "ACME"},
+		"labels":    mapstr.M{"env": "prod"},
+		"message":   "hello",
+		"not_a_map": "value",
+	}, payload.Fields)
+	assert.Equal(t, mapstr.M{"pipeline": "logs"}, payload.Meta)
+
+	// The event is left untouched, and maps that were not on the path of a
+	// removed field are shared.
+	assert.Equal(t, map[string]interface{}{"id": "acme", "name": "ACME"}, event.Fields["tenant"])
+	assert.Equal(t, "abc", event.Fields["trace.id"])
+	assert.Equal(t, "acme", event.Meta["tenant"])
+	labels["env"] = "test"
+	assert.Equal(t, mapstr.M{"env": "test"}, payload.Fields["labels"])
+}
+
 type producerMock struct {
 	input chan *sarama.ProducerMessage
 }
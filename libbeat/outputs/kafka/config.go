@@ -25,11 +25,13 @@ import (
 	"strings"
 	"time"
 
+	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/v7/libbeat/common/fmtstr"
 	"github.com/elastic/beats/v7/libbeat/common/kafka"
 	"github.com/elastic/beats/v7/libbeat/common/transport/kerberos"
 	"github.com/elastic/beats/v7/libbeat/management"
+	"github.com/elastic/beats/v7/libbeat/outputs"
 	"github.com/elastic/beats/v7/libbeat/outputs/codec"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
@@ -45,8 +47,20 @@ type backoffConfig struct {
 }
 
 type header struct {
-	Key   string `config:"key"`
-	Value string `config:"value"`
+	Key          string                    `config:"key"`
+	Value        string                    `config:"value"`
+	ValueFormat  *fmtstr.EventFormatString `config:"value_format"`
+	RemoveFields bool                      `config:"remove_fields"`
+}
+
+func (h *header) Validate() error {
+	if h.Value != "" && h.ValueFormat != nil {
+		return fmt.Errorf("header '%v' can not set both value and value_format", h.Key)
+	}
+	if h.RemoveFields && h.ValueFormat == nil {
+		return fmt.Errorf("header '%v' can only set remove_fields with value_format", h.Key)
+	}
+	return nil
 }
 
 type KafkaConfig struct {
@@ -77,6 +91,8 @@ type KafkaConfig struct {
 	Sasl               kafka.SaslConfig          `config:"sasl"`
 	EnableFAST         bool                      `config:"enable_krb5_fast"`
 	Queue              config.Namespace          `config:"queue"`
+	Idempotent         bool                      `config:"idempotent"`
+	Transactional      transactionalConfig       `config:"transactional"`
 
 	// Currently only used for validation. Those values are later
 	// unpacked into temporary structs whenever they're necessary.
@@ -84,6 +100,12 @@ type KafkaConfig struct {
 	Topics []any  `config:"topics"`
 }
 
+type transactionalConfig struct {
+	Enabled  bool          `config:"enabled"`
+	IDPrefix string        `config:"id_prefix"`
+	Timeout  time.Duration `config:"timeout" validate:"min=1"`
+}
+
 type metaConfig struct {
 	Retry       metaRetryConfig `config:"retry"`
 	RefreshFreq time.Duration   `config:"refresh_frequency" validate:"min=0"`
@@ -146,6 +168,9 @@ func defaultConfig() KafkaConfig {
 		ChanBufferSize: 256,
 		Username:       "",
 		Password:       "",
+		Transactional: transactionalConfig{
+			Timeout: 1 * time.Minute,
+		},
 	}
 }
 
@@ -189,6 +214,18 @@ func (c *KafkaConfig) Validate() error {
 		return errors.New("including headers is not supported for kafka versions < 0.11")
 	}
 
+	if c.idempotent() {
+		if c.Version < kafka.Version("0.11") {
+			return errors.New("idempotent and transactional producers are not supported for kafka versions < 0.11")
+		}
+		if c.RequiredACKs != nil && *c.RequiredACKs != int(sarama.WaitForAll) {
+			return errors.New("idempotent and transactional producers require required_acks to be -1")
+		}
+		if c.MaxRetries == 0 {
+			return errors.New("idempotent and transactional producers require max_retries to be at least 1")
+		}
+	}
+
 	// When running under Elastic-Agent we do not support dynamic topic
 	// selection, so `topics` is not supported and `topic` is treated as an
 	// plain string
@@ -201,6 +238,49 @@ func (c *KafkaConfig) Validate() error {
 	return nil
 }
 
+// idempotent reports whether the producer must be idempotent, which is
+// always the case for transactional producers.
+func (c *KafkaConfig) idempotent() bool {
+	return c.Idempotent || c.Transactional.Enabled
+}
+
+// transactionalID returns the transactional.id used by the producer of the
+// given beat instance. The ID must be stable across restarts so that Kafka
+// can fence the producers of previous runs of the same instance.
+func (c *KafkaConfig) transactionalID(info beat.Info) string {
+	prefix := c.Transactional.IDPrefix
+	if prefix == "" {
+		prefix = info.Beat
+	}
+	return prefix + "-" + info.ID.String()
+}
+
+// validateTransactionalIDs checks that no two named Kafka outputs with
+// transactional producers use the same transactional.id_prefix. The
+// transactional.id is built from the prefix and the Beat's ID, so such
+// outputs would fence each other's producers.
+func validateTransactionalIDs(cfgs []outputs.NamedConfig) error {
+	prefixes := map[string]string{}
+	for _, c := range cfgs {
+		kafka := struct {
+			Transactional transactionalConfig `config:"transactional"`
+		}{defaultConfig().Transactional}
+		if err := c.Config.Unpack(&kafka); err != nil {
+			return fmt.Errorf("output '%v': %w", c.Name, err)
+		}
+		if !kafka.Transactional.Enabled {
+			continue
+		}
+		prefix := kafka.Transactional.IDPrefix
+		if other, exists := prefixes[prefix]; exists {
+			return fmt.Errorf("outputs '%v' and '%v' use the same Kafka transactional.id_prefix '%v', "+
+				"set a different transactional.id_prefix for each", other, c.Name, prefix)
+		}
+		prefixes[prefix] = c.Name
+	}
+	return nil
+}
+
 func newSaramaConfig(log *logp.Logger, config *KafkaConfig) (*sarama.Config, error) {
 	partitioner, err := makePartitioner(log, config.Partition)
 	if err != nil {
@@ -286,6 +366,18 @@ func newSaramaConfig(log *logp.Logger, config *KafkaConfig) (*sarama.Config, err
 	k.Producer.Return.Successes = true // enable return channel for signaling
 	k.Producer.Return.Errors = true
 
+	// The idempotent producer relies on the broker tracking sequence numbers,
+	// which requires all replicas to acknowledge writes and a single request
+	// in flight per broker.
+	if config.idempotent() {
+		k.Producer.Idempotent = true
+		k.Producer.RequiredAcks = sarama.WaitForAll
+		k.Net.MaxOpenRequests = 1
+	}
+	if config.Transactional.Enabled {
+		k.Producer.Transaction.Timeout = config.Transactional.Timeout
+	}
+
 	// have retries being handled by libbeat, disable retries in sarama library
 	retryMax := config.MaxRetries
 	if retryMax < 0 {
@@ -23,14 +23,17 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gofrs/uuid/v5"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/internal/testutil"
 	"github.com/elastic/beats/v7/libbeat/management"
+	"github.com/elastic/beats/v7/libbeat/outputs"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp/logptest"
 	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/sarama"
 )
 
 func TestConfigAcceptValid(t *testing.T) {
@@ -45,6 +48,16 @@ func TestConfigAcceptValid(t *testing.T) {
 			"version":     "1.0.0",
 			"topic":       "foo",
 		},
+		"idempotent": mapstr.M{
+			"idempotent":    true,
+			"required_acks": -1,
+			"topic":         "foo",
+		},
+		"transactional": mapstr.M{
+			"transactional.enabled":   true,
+			"transactional.id_prefix": "shipper",
+			"topic":                   "foo",
+		},
 	}
 
 	for name, test := range tests {
@@ -78,6 +91,29 @@ func TestConfigInvalid(t *testing.T) {
 		},
 		// The default config does not set `topic` nor `topics`.
 		"No topics or topic provided": mapstr.M{},
+		"idempotent with 0.10": mapstr.M{
+			"idempotent": true,
+			"version":    "0.10.2",
+			"topic":      "foo",
+		},
+		"transactional without waiting for all replicas": mapstr.M{
+			"transactional.enabled": true,
+			"required_acks":         1,
+			"topic":                 "foo",
+		},
+		"header with value and value_format": mapstr.M{
+			"headers": []mapstr.M{{"key": "k", "value": "v", "value_format": "%{[v]}"}},
+			"topic":   "foo",
+		},
+		"header removing fields of a literal value": mapstr.M{
+			"headers": []mapstr.M{{"key": "k", "value": "v", "remove_fields": true}},
+			"topic":   "foo",
+		},
+		"idempotent without retries": mapstr.M{
+			"idempotent":  true,
+			"max_retries": 0,
+			"topic":       "foo",
+		},
 	}
 
 	for name, test := range tests {
@@ -200,6 +236,63 @@ topic: foo`)
 	})
 }
 
+func TestConfigTransactional(t *testing.T) {
+	c := config.MustNewConfigFrom(`
+hosts: localhost
+topic: foo
+transactional:
+  enabled: true
+  id_prefix: shipper
+  timeout: 30s`)
+	logger := logptest.NewTestingLogger(t, "")
+
+	cfg, err := ReadConfig(c)
+	if err != nil {
+		t.Fatalf("Can not create test configuration: %v", err)
+	}
+
+	sc, err := newSaramaConfig(logger, cfg)
+	if err != nil {
+		t.Fatalf("Failure creating sarama config: %v", err)
+	}
+
+	assert.True(t, sc.Producer.Idempotent)
+	assert.Equal(t, sarama.WaitForAll, sc.Producer.RequiredAcks)
+	assert.Equal(t, 1, sc.Net.MaxOpenRequests)
+	assert.Equal(t, 30*time.Second, sc.Producer.Transaction.Timeout)
+
+	id := uuid.Must(uuid.FromString("4b7ec16a-6d1e-4bb5-9d1c-0c5f3ef1d2a1"))
+	assert.Equal(t, "shipper-4b7ec16a-6d1e-4bb5-9d1c-0c5f3ef1d2a1",
+		cfg.transactionalID(beat.Info{Beat: "filebeat", ID: id}))
+
+	cfg.Transactional.IDPrefix = ""
+	assert.Equal(t, "filebeat-4b7ec16a-6d1e-4bb5-9d1c-0c5f3ef1d2a1",
+		cfg.transactionalID(beat.Info{Beat: "filebeat", ID: id}))
+
+	// The producer must be allowed to retry.
+	cfg.MaxRetries = 0
+	assert.ErrorContains(t, cfg.Validate(), "require max_retries to be at least 1")
+}
+
+func TestValidateTransactionalIDs(t *testing.T) {
+	named := func(name string, cfg map[string]interface{}) outputs.NamedConfig {
+		return outputs.NamedConfig{Name: name, Config: config.MustNewConfigFrom(cfg)}
+	}
+
+	err := outputs.ValidateNamed("kafka", []outputs.NamedConfig{
+		named("a", map[string]interface{}{"transactional.enabled": true, "transactional.id_prefix": "a"}),
+		named("b", map[string]interface{}{"transactional.enabled": true, "transactional.id_prefix": "b"}),
+		named("c", map[string]interface{}{"transactional.id_prefix": "a"}),
+	})
+	assert.NoError(t, err)
+
+	err = outputs.ValidateNamed("kafka", []outputs.NamedConfig{
+		named("a", map[string]interface{}{"transactional.enabled": true}),
+		named("b", map[string]interface{}{"transactional.enabled": true, "idempotent": true}),
+	})
+	assert.ErrorContains(t, err, "outputs 'a' and 'b' use the same Kafka transactional.id_prefix ''")
+}
+
 func TestBackoffFunc(t *testing.T) {
 	testutil.SeedPRNG(t)
 	tests := map[int]backoffConfig{
@@ -35,6 +35,7 @@ const (
 
 func init() {
 	outputs.RegisterType("kafka", makeKafka)
+	outputs.RegisterNamedValidator("kafka", validateTransactionalIDs)
 }
 
 func makeKafka(
@@ -63,6 +64,11 @@ func makeKafka(
 		return outputs.Fail(err)
 	}
 
+	if kConfig.Transactional.Enabled {
+		libCfg.Producer.Transaction.ID = kConfig.transactionalID(beat)
+		log.Infof("Kafka transactional producer enabled with transactional.id %q", libCfg.Producer.Transaction.ID)
+	}
+
 	hosts, err := outputs.ReadHostList(cfg)
 	if err != nil {
 		return outputs.Fail(err)
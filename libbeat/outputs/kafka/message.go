@@ -27,11 +27,12 @@ import (
 type message struct {
 	msg sarama.ProducerMessage
 
-	topic string
-	key   []byte
-	value []byte
-	ref   *msgRef
-	ts    time.Time
+	topic   string
+	key     []byte
+	value   []byte
+	headers []sarama.RecordHeader
+	ref     *msgRef
+	ts      time.Time
 
 	hash      uint32
 	partition int32
@@ -46,9 +47,6 @@ func (m *message) initProducerMessage() {
 		Key:       sarama.ByteEncoder(m.key),
 		Value:     sarama.ByteEncoder(m.value),
 		Timestamp: m.ts,
-	}
-
-	if m.ref != nil {
-		m.msg.Headers = m.ref.client.recordHeaders
+		Headers:   m.headers,
 	}
 }
@@ -0,0 +1,149 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/elastic/sarama"
+
+	"github.com/elastic/beats/v7/libbeat/publisher"
+)
+
+// transactional reports whether batches are published in Kafka transactions.
+func (c *client) transactional() bool {
+	return c.config.Producer.Transaction.ID != ""
+}
+
+// publishTransaction publishes all events of the batch in a single Kafka
+// transaction. The batch is only acknowledged once the transaction is
+// committed. If the transaction is aborted, all events but the ones that
+// were dropped are retried, so that consumers reading committed messages
+// only never see a partial batch.
+func (c *client) publishTransaction(batch publisher.Batch) error {
+	c.txnMux.Lock()
+	defer c.txnMux.Unlock()
+
+	events := batch.Events()
+	c.observer.NewBatch(len(events))
+
+	if err := c.producer.BeginTxn(); err != nil {
+		batch.RetryEvents(events)
+		c.observer.RetryableErrors(len(events))
+		return c.failTransaction(fmt.Errorf("failed to begin kafka transaction: %w", err))
+	}
+
+	ref := &msgRef{
+		client:   c,
+		count:    int32(len(events)), //nolint:gosec //keep old behavior
+		total:    len(events),
+		batch:    batch,
+		finished: make(chan struct{}),
+	}
+
+	var unsent []publisher.Event
+	ch := c.producer.Input()
+	for i := range events {
+		d := &events[i]
+		if unsent != nil {
+			unsent = append(unsent, *d)
+			ref.done()
+			continue
+		}
+
+		msg, err := c.getEventMessage(d)
+		if err != nil {
+			c.log.Errorf("Dropping event: %+v", err)
+			ref.done()
+			c.observer.PermanentErrors(1)
+			continue
+		}
+
+		msg.ref = ref
+		msg.initProducerMessage()
+		if !c.send(ch, &msg.msg) {
+			// The output is closing, the transaction is aborted.
+			unsent = append(make([]publisher.Event, 0, len(events)-i), *d)
+			ref.done()
+		}
+	}
+
+	var err error
+	if unsent == nil {
+		err = c.producer.CommitTxn()
+	} else {
+		err = errors.New("output closing")
+	}
+
+	// Committing or aborting flushes the messages in flight, so all of them
+	// have been reported once the transaction is over.
+	if err == nil {
+		<-ref.finished
+		ref.complete()
+		return nil
+	}
+
+	c.log.Errorf("Kafka transaction failed, aborting it: %v", err)
+	var abortErr error
+	if !c.transactionFatal() {
+		abortErr = c.producer.AbortTxn()
+	}
+	<-ref.finished
+
+	retry := make([]publisher.Event, 0, len(ref.failed)+len(ref.succeeded)+len(unsent))
+	retry = append(retry, ref.failed...)
+	retry = append(retry, ref.succeeded...)
+	retry = append(retry, unsent...)
+	batch.RetryEvents(retry)
+	c.observer.RetryableErrors(len(retry))
+
+	if abortErr != nil {
+		return c.failTransaction(fmt.Errorf("failed to abort kafka transaction: %w", abortErr))
+	}
+	if c.transactionFatal() {
+		return c.failTransaction(err)
+	}
+	return nil
+}
+
+// transactionFatal reports whether the transaction manager of the producer
+// is in a state it cannot recover from.
+func (c *client) transactionFatal() bool {
+	return c.producer.TxnStatus()&sarama.ProducerTxnFlagFatalError != 0
+}
+
+// failTransaction closes the producer after a transaction failed in a way
+// that leaves it unusable. The error returned makes the output reconnect,
+// creating a new producer that bumps the producer epoch for the
+// transactional ID.
+func (c *client) failTransaction(err error) error {
+	if errors.Is(err, sarama.ErrProducerFenced) || errors.Is(err, sarama.ErrInvalidProducerEpoch) {
+		c.log.Errorf("Kafka producer with transactional.id %q has been fenced by another producer. "+
+			"Make sure that each Beat instance and output uses a unique transactional.id_prefix.",
+			c.config.Producer.Transaction.ID)
+	}
+
+	c.producerMux.Lock()
+	c.producer.AsyncClose()
+	c.producerMux.Unlock()
+
+	c.wg.Wait()
+	c.producer = nil
+	return err
+}
@@ -0,0 +1,185 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	_ "github.com/elastic/beats/v7/libbeat/outputs/codec/format"
+	_ "github.com/elastic/beats/v7/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/v7/libbeat/outputs/outest"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+	"github.com/elastic/elastic-agent-libs/paths"
+	"github.com/elastic/sarama"
+)
+
+// txnProducerMock is a transactional producer acknowledging every message,
+// or failing the ones whose value is listed in failValues.
+type txnProducerMock struct {
+	producerMock
+	successes chan *sarama.ProducerMessage
+	errors    chan *sarama.ProducerError
+
+	failValues map[string]bool
+	commitErr  error
+	status     sarama.ProducerTxnStatusFlag
+
+	began, committed, aborted, closed int
+}
+
+func newTxnProducerMock() *txnProducerMock {
+	p := &txnProducerMock{
+		producerMock: producerMock{input: make(chan *sarama.ProducerMessage)},
+		successes:    make(chan *sarama.ProducerMessage),
+		errors:       make(chan *sarama.ProducerError),
+		failValues:   map[string]bool{},
+		status:       sarama.ProducerTxnFlagReady,
+	}
+	go func() {
+		defer close(p.successes)
+		defer close(p.errors)
+		for msg := range p.input {
+			value, _ := msg.Value.Encode()
+			if p.failValues[string(value)] {
+				p.errors <- &sarama.ProducerError{Msg: msg, Err: sarama.ErrNotEnoughReplicas}
+				continue
+			}
+			p.successes <- msg
+		}
+	}()
+	return p
+}
+
+func (p *txnProducerMock) AsyncClose() {
+	p.closed++
+	close(p.input)
+}
+
+func (p *txnProducerMock) Successes() <-chan *sarama.ProducerMessage { return p.successes }
+func (p *txnProducerMock) Errors() <-chan *sarama.ProducerError      { return p.errors }
+func (p *txnProducerMock) IsTransactional() bool                     { return true }
+func (p *txnProducerMock) TxnStatus() sarama.ProducerTxnStatusFlag   { return p.status }
+
+func (p *txnProducerMock) BeginTxn() error {
+	p.began++
+	return nil
+}
+
+func (p *txnProducerMock) CommitTxn() error {
+	p.committed++
+	return p.commitErr
+}
+
+func (p *txnProducerMock) AbortTxn() error {
+	p.aborted++
+	return nil
+}
+
+func newTransactionalTestClient(t *testing.T, producer *txnProducerMock) *client {
+	cfg := config.MustNewConfigFrom(map[string]interface{}{
+		"hosts":                 []string{"localhost:9094"},
+		"topic":                 "testTopic",
+		"transactional.enabled": true,
+		"codec.format.string":   "%{[msg]}",
+	})
+	logger := logptest.NewTestingLogger(t, "")
+	outGroup, err := makeKafka(nil,
+		beat.Info{Beat: "libbeat", Logger: logger, Paths: paths.New()},
+		outputs.NewStats(monitoring.NewRegistry(), logger), cfg)
+	require.NoError(t, err)
+
+	c, ok := outGroup.Clients[0].(*client)
+	require.True(t, ok)
+	require.True(t, c.transactional())
+
+	c.producer = producer
+	c.wg.Add(2)
+	go c.successWorker(producer.Successes())
+	go c.errorWorker(producer.Errors())
+	return c
+}
+
+func newTransactionalTestBatch(msgs ...string) *outest.Batch {
+	events := make([]beat.Event, len(msgs))
+	for i, msg := range msgs {
+		events[i] = beat.Event{Timestamp: time.Now(), Fields: map[string]any{"msg": msg}}
+	}
+	return outest.NewBatch(events...)
+}
+
+func TestPublishTransaction(t *testing.T) {
+	t.Run("committed batch is acknowledged", func(t *testing.T) {
+		producer := newTxnProducerMock()
+		c := newTransactionalTestClient(t, producer)
+
+		batch := newTransactionalTestBatch("a", "b", "c")
+		require.NoError(t, c.Publish(context.Background(), batch))
+
+		assert.Equal(t, 1, producer.began)
+		assert.Equal(t, 1, producer.committed)
+		assert.Zero(t, producer.aborted)
+		require.Len(t, batch.Signals, 1)
+		assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+		require.NoError(t, c.Close())
+	})
+
+	t.Run("failed message aborts the whole batch", func(t *testing.T) {
+		producer := newTxnProducerMock()
+		producer.failValues["b"] = true
+		producer.commitErr = sarama.ErrNotEnoughReplicas
+		producer.status = sarama.ProducerTxnFlagAbortableError
+		c := newTransactionalTestClient(t, producer)
+
+		batch := newTransactionalTestBatch("a", "b", "c")
+		require.NoError(t, c.Publish(context.Background(), batch))
+
+		assert.Equal(t, 1, producer.aborted)
+		require.Len(t, batch.Signals, 1)
+		assert.Equal(t, outest.BatchRetryEvents, batch.Signals[0].Tag)
+		assert.Len(t, batch.Signals[0].Events, 3)
+		require.NoError(t, c.Close())
+	})
+
+	t.Run("fenced producer is closed", func(t *testing.T) {
+		producer := newTxnProducerMock()
+		producer.commitErr = sarama.ErrProducerFenced
+		producer.status = sarama.ProducerTxnFlagInError | sarama.ProducerTxnFlagFatalError
+		c := newTransactionalTestClient(t, producer)
+
+		batch := newTransactionalTestBatch("a", "b")
+		err := c.Publish(context.Background(), batch)
+		require.ErrorIs(t, err, sarama.ErrProducerFenced)
+
+		assert.Zero(t, producer.aborted, "fatal transactions can not be aborted")
+		assert.Equal(t, 1, producer.closed)
+		assert.Nil(t, c.producer)
+		require.Len(t, batch.Signals, 1)
+		assert.Equal(t, outest.BatchRetryEvents, batch.Signals[0].Tag)
+		assert.Len(t, batch.Signals[0].Events, 2)
+		require.NoError(t, c.Close())
+	})
+}
@@ -72,6 +72,18 @@ type Stats struct {
 	// Number of times a batch was split for being too large
 	batchesSplit *monitoring.Uint
 
+	// Number of times a batch was split for being larger than the current
+	// batch size of an output adapting it to the receiver load.
+	batchesAdaptiveSplit *monitoring.Uint
+
+	// (Gauge) Current maximum number of events per request, for outputs
+	// adapting their batch size to the receiver load.
+	batchesEffectiveSize *monitoring.Uint
+
+	// (Gauge) Current maximum number of concurrent requests, for outputs
+	// adapting their concurrency to the receiver load.
+	batchesEffectiveConcurrency *monitoring.Uint
+
 	//
 	// Output network connection stats
 	//
@@ -101,7 +113,10 @@ func NewStats(reg *monitoring.Registry, logger *logp.Logger) *Stats {
 		eventsTooMany:      monitoring.NewUint(reg, "events.toomany"),
 		eventsFailureStore: monitoring.NewUint(reg, "events.failure_store"),
 
-		batchesSplit: monitoring.NewUint(reg, "batches.split"),
+		batchesSplit:                monitoring.NewUint(reg, "batches.split"),
+		batchesAdaptiveSplit:        monitoring.NewUint(reg, "batches.adaptive_split"),
+		batchesEffectiveSize:        monitoring.NewUint(reg, "batches.effective_size"),
+		batchesEffectiveConcurrency: monitoring.NewUint(reg, "batches.effective_concurrency"),
 
 		writeBytes:  monitoring.NewUint(reg, "write.bytes"),
 		writeErrors: monitoring.NewUint(reg, "write.errors"),
@@ -180,6 +195,28 @@ func (s *Stats) BatchSplit() {
 	}
 }
 
+// AdaptiveBatchSplit increases the number of batches split for being larger
+// than the current batch size.
+func (s *Stats) AdaptiveBatchSplit() {
+	if s != nil {
+		s.batchesAdaptiveSplit.Inc()
+	}
+}
+
+// EffectiveBatchSize updates the current maximum number of events per request.
+func (s *Stats) EffectiveBatchSize(n int) {
+	if s != nil {
+		s.batchesEffectiveSize.Set(uint64(n)) //nolint:gosec //batch size is never negative
+	}
+}
+
+// EffectiveConcurrency updates the current maximum number of concurrent requests.
+func (s *Stats) EffectiveConcurrency(n int) {
+	if s != nil {
+		s.batchesEffectiveConcurrency.Set(uint64(n)) //nolint:gosec //concurrency is never negative
+	}
+}
+
 // ErrTooMany updates the number of Too Many Requests responses reported by the output.
 func (s *Stats) ErrTooMany(n int) {
 	if s != nil {
@@ -0,0 +1,219 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	libmqtt "github.com/eclipse/paho.mqtt.golang"
+
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/outputs/codec"
+	"github.com/elastic/beats/v7/libbeat/outputs/outil"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/testing"
+)
+
+type client struct {
+	log      *logp.Logger
+	observer outputs.Observer
+	hosts    []string
+	qos      byte
+	retained bool
+	timeout  time.Duration
+	topic    outil.Selector
+	index    string
+	codec    codec.Codec
+
+	options       *libmqtt.ClientOptions
+	newMqttClient func(options *libmqtt.ClientOptions) libmqtt.Client
+	mqttClient    libmqtt.Client
+}
+
+var (
+	errNoTopicSelected = errors.New("no topic could be selected")
+	errNotConnected    = errors.New("mqtt client is not connected")
+	errAckTimeout      = errors.New("timeout waiting for the broker to acknowledge the message")
+)
+
+func newClient(
+	log *logp.Logger,
+	observer outputs.Observer,
+	config *mqttConfig,
+	options *libmqtt.ClientOptions,
+	newMqttClient func(options *libmqtt.ClientOptions) libmqtt.Client,
+	index string,
+	topic outil.Selector,
+	enc codec.Codec,
+) *client {
+	return &client{
+		log:           log,
+		observer:      observer,
+		hosts:         config.Hosts,
+		qos:           byte(config.QoS), //nolint:gosec // validated to be between 0 and 2
+		retained:      config.Retained,
+		timeout:       config.Timeout,
+		topic:         topic,
+		index:         strings.ToLower(index),
+		codec:         enc,
+		options:       options,
+		newMqttClient: newMqttClient,
+	}
+}
+
+func (c *client) Connect(_ context.Context) error {
+	c.log.Debugf("connect: %v", c.hosts)
+
+	mqttClient := c.newMqttClient(c.options)
+	token := mqttClient.Connect()
+	if !token.WaitTimeout(c.timeout) {
+		mqttClient.Disconnect(0)
+		return fmt.Errorf("timeout connecting to mqtt broker after %v", c.timeout)
+	}
+	if err := token.Error(); err != nil {
+		return fmt.Errorf("failed to connect to mqtt broker: %w", err)
+	}
+
+	c.mqttClient = mqttClient
+	return nil
+}
+
+func (c *client) Close() error {
+	c.log.Debug("closed mqtt client")
+	if c.mqttClient == nil {
+		return nil
+	}
+
+	// Give the messages in flight some time to be acknowledged.
+	c.mqttClient.Disconnect(uint(c.timeout / time.Millisecond))
+	c.mqttClient = nil
+	return nil
+}
+
+func (c *client) String() string {
+	return "mqtt(" + strings.Join(c.hosts, ",") + ")"
+}
+
+// Publish publishes the events of the batch, and waits for the broker to
+// acknowledge them according to the QoS level. With QoS 0 events are
+// acknowledged once written to the connection, with QoS 1 once the broker
+// sent PUBACK, and with QoS 2 once it sent PUBCOMP.
+func (c *client) Publish(_ context.Context, batch publisher.Batch) error {
+	if c.mqttClient == nil || !c.mqttClient.IsConnectionOpen() {
+		batch.Retry()
+		return errNotConnected
+	}
+
+	events := batch.Events()
+	c.observer.NewBatch(len(events))
+
+	type pending struct {
+		event *publisher.Event
+		token libmqtt.Token
+	}
+
+	start := time.Now()
+	tokens := make([]pending, 0, len(events))
+	dropped := 0
+	for i := range events {
+		topic, payload, err := c.makeMessage(&events[i])
+		if err != nil {
+			c.log.Errorf("Dropping event: %+v", err)
+			dropped++
+			continue
+		}
+
+		token := c.mqttClient.Publish(topic, c.qos, c.retained, payload)
+		tokens = append(tokens, pending{event: &events[i], token: token})
+	}
+	c.observer.PermanentErrors(dropped)
+
+	deadline := time.Now().Add(c.timeout)
+	var failed []publisher.Event
+	var firstErr error
+	for _, p := range tokens {
+		err := errAckTimeout
+		if p.token.WaitTimeout(time.Until(deadline)) {
+			err = p.token.Error()
+		}
+		if err != nil {
+			failed = append(failed, *p.event)
+			if firstErr == nil {
+				// Report the first error seen in the batch.
+				firstErr = err
+			}
+		}
+	}
+	c.observer.ReportLatency(time.Since(start))
+	c.observer.AckedEvents(len(tokens) - len(failed))
+
+	if len(failed) == 0 {
+		batch.ACK()
+		return nil
+	}
+
+	c.observer.RetryableErrors(len(failed))
+	batch.RetryEvents(failed)
+	c.log.Errorf("MQTT publish failed with: %v", firstErr)
+	if !c.mqttClient.IsConnectionOpen() {
+		return fmt.Errorf("mqtt connection lost: %w", firstErr)
+	}
+	return nil
+}
+
+func (c *client) makeMessage(data *publisher.Event) (string, []byte, error) {
+	event := &data.Content
+
+	topic, err := c.topic.Select(event)
+	if err != nil {
+		return "", nil, fmt.Errorf("setting mqtt topic failed with %w", err)
+	}
+	if topic == "" {
+		return "", nil, errNoTopicSelected
+	}
+
+	serializedEvent, err := c.codec.Encode(c.index, event)
+	if err != nil {
+		if c.log.IsDebug() {
+			c.log.Debug("failed event logged to event log file")
+			c.log.Debugw(fmt.Sprintf("failed event: %v", event), logp.TypeKey, logp.EventType)
+		}
+		return "", nil, err
+	}
+
+	buf := make([]byte, len(serializedEvent))
+	copy(buf, serializedEvent)
+	return topic, buf, nil
+}
+
+func (c *client) Test(d testing.Driver) {
+	d.Run("MQTT: "+strings.Join(c.hosts, ","), func(d testing.Driver) {
+		mqttClient := c.newMqttClient(c.options)
+		token := mqttClient.Connect()
+		if !token.WaitTimeout(c.timeout) {
+			d.Fatal("connect", errors.New("timeout"))
+		}
+		d.Fatal("connect", token.Error())
+		mqttClient.Disconnect(0)
+	})
+}
@@ -0,0 +1,103 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+import (
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/elastic/beats/v7/libbeat/outputs/codec"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+type mqttConfig struct {
+	Hosts        []string          `config:"hosts"         validate:"required,min=1"`
+	QoS          int               `config:"qos"           validate:"min=0,max=2"`
+	Retained     bool              `config:"retained"`
+	ClientID     string            `config:"client_id"`
+	Username     string            `config:"username"`
+	Password     string            `config:"password"`
+	CleanSession bool              `config:"clean_session"`
+	TLS          *tlscommon.Config `config:"ssl"`
+	Timeout      time.Duration     `config:"timeout"       validate:"min=1"`
+	KeepAlive    time.Duration     `config:"keep_alive"    validate:"min=0"`
+	BulkMaxSize  int               `config:"bulk_max_size"`
+	MaxRetries   int               `config:"max_retries"   validate:"min=-1"`
+	Backoff      backoffConfig     `config:"backoff"`
+	Codec        codec.Config      `config:"codec"`
+	Queue        config.Namespace  `config:"queue"`
+
+	// Currently only used for validation. The topic selector is built
+	// from the raw configuration.
+	Topic  string `config:"topic"`
+	Topics []any  `config:"topics"`
+}
+
+type backoffConfig struct {
+	Init time.Duration `config:"init"`
+	Max  time.Duration `config:"max"`
+}
+
+func defaultConfig() mqttConfig {
+	return mqttConfig{
+		QoS:          1,
+		CleanSession: true,
+		Timeout:      30 * time.Second,
+		KeepAlive:    30 * time.Second,
+		BulkMaxSize:  256,
+		MaxRetries:   3,
+		Backoff: backoffConfig{
+			Init: 1 * time.Second,
+			Max:  60 * time.Second,
+		},
+	}
+}
+
+func readConfig(cfg *config.C) (*mqttConfig, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+// defaultClientID returns the client identifier used if none is configured.
+// It is derived from the ID of the Beat instance, so that Beats connected
+// to the same broker don't take over each other's connection, and so that
+// persistent sessions are resumed after a restart.
+func defaultClientID(id uuid.UUID) string {
+	// Brokers are only required to accept identifiers of up to 23
+	// characters.
+	return "beats-" + hex.EncodeToString(id.Bytes())[:17]
+}
+
+func (c *mqttConfig) Validate() error {
+	if c.Topic == "" && len(c.Topics) == 0 {
+		return errors.New("either 'topic' or 'topics' must be defined")
+	}
+
+	if c.Username == "" && c.Password != "" {
+		return errors.New("username must be set when password is configured")
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+import (
+	"fmt"
+	"path/filepath"
+
+	libmqtt "github.com/eclipse/paho.mqtt.golang"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	commonmqtt "github.com/elastic/beats/v7/libbeat/common/mqtt"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/outputs/codec"
+	"github.com/elastic/beats/v7/libbeat/outputs/outil"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/paths"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+const logSelector = "mqtt"
+
+func init() {
+	outputs.RegisterType("mqtt", makeMQTT)
+}
+
+func makeMQTT(
+	_ outputs.IndexManager,
+	beat beat.Info,
+	observer outputs.Observer,
+	cfg *config.C,
+) (outputs.Group, error) {
+	log := beat.Logger.Named(logSelector)
+	log.Debug("initialize mqtt output")
+	commonmqtt.SetupLibraryLogging(beat.Logger)
+
+	mqttCfg, err := readConfig(cfg)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+	if mqttCfg.ClientID == "" {
+		mqttCfg.ClientID = defaultClientID(beat.ID)
+	}
+
+	topic, err := buildTopicSelector(cfg, log)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	options, err := createClientOptions(mqttCfg, beat.Paths, log)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	enc, err := codec.CreateEncoder(beat, mqttCfg.Codec)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	client := newClient(log, observer, mqttCfg, options, libmqtt.NewClient, beat.IndexPrefix, topic, enc)
+	return outputs.Success(
+		mqttCfg.Queue,
+		mqttCfg.BulkMaxSize,
+		mqttCfg.MaxRetries,
+		nil,
+		beat.Logger,
+		beat.Paths,
+		outputs.WithBackoff(client, mqttCfg.Backoff.Init, mqttCfg.Backoff.Max))
+}
+
+func createClientOptions(config *mqttConfig, beatPaths *paths.Path, logger *logp.Logger) (*libmqtt.ClientOptions, error) {
+	// Reconnects are handled by the publisher pipeline, which retries the
+	// events that were not acknowledged.
+	clientOptions := libmqtt.NewClientOptions().
+		SetClientID(config.ClientID).
+		SetUsername(config.Username).
+		SetPassword(config.Password).
+		SetCleanSession(config.CleanSession).
+		SetAutoReconnect(false).
+		SetConnectTimeout(config.Timeout).
+		SetWriteTimeout(config.Timeout).
+		SetKeepAlive(config.KeepAlive)
+
+	for _, host := range config.Hosts {
+		clientOptions.AddBroker(host)
+	}
+
+	// Messages in flight of persistent sessions are stored on disk, so the
+	// library resends them when the session is resumed after a restart.
+	if !config.CleanSession && config.QoS > 0 {
+		dir := beatPaths.Resolve(paths.Data, filepath.Join("mqtt", config.ClientID))
+		clientOptions.SetStore(libmqtt.NewFileStore(dir))
+	}
+
+	if config.TLS != nil {
+		tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS, logger)
+		if err != nil {
+			return nil, err
+		}
+		clientOptions.SetTLSConfig(tlsConfig.BuildModuleClientConfig(""))
+	}
+	return clientOptions, nil
+}
+
+func buildTopicSelector(cfg *config.C, logger *logp.Logger) (outil.Selector, error) {
+	if cfg == nil {
+		return outil.Selector{}, fmt.Errorf("mqtt config cannot be nil")
+	}
+
+	return outil.BuildSelectorFromConfig(cfg, outil.Settings{
+		Key:              "topic",
+		MultiKey:         "topics",
+		EnableSingleOnly: true,
+		FailEmpty:        true,
+		Case:             outil.SelectorKeepCase,
+	}, logger)
+}
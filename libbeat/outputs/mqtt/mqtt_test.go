@@ -0,0 +1,316 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package mqtt
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	libmqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/outputs/codec"
+	_ "github.com/elastic/beats/v7/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/v7/libbeat/outputs/outest"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+type mockedToken struct {
+	timeout bool
+	err     error
+}
+
+var _ libmqtt.Token = new(mockedToken)
+
+func (m *mockedToken) Wait() bool {
+	return !m.timeout
+}
+
+func (m *mockedToken) WaitTimeout(time.Duration) bool {
+	return !m.timeout
+}
+
+func (m *mockedToken) Done() <-chan struct{} {
+	ch := make(chan struct{})
+	if !m.timeout {
+		close(ch)
+	}
+	return ch
+}
+
+func (m *mockedToken) Error() error {
+	return m.err
+}
+
+type publishedMessage struct {
+	topic    string
+	qos      byte
+	retained bool
+	payload  []byte
+}
+
+type mockedClient struct {
+	libmqtt.Client
+
+	connectToken libmqtt.Token
+	connected    bool
+
+	// publishTokens returns the token of each published message, all
+	// messages are acknowledged if nil.
+	publishTokens func(msg publishedMessage) libmqtt.Token
+	published     []publishedMessage
+
+	disconnectCount int
+}
+
+func (m *mockedClient) Connect() libmqtt.Token {
+	if m.connectToken != nil {
+		return m.connectToken
+	}
+	m.connected = true
+	return &mockedToken{}
+}
+
+func (m *mockedClient) IsConnectionOpen() bool {
+	return m.connected
+}
+
+func (m *mockedClient) Disconnect(uint) {
+	m.disconnectCount++
+	m.connected = false
+}
+
+func (m *mockedClient) Publish(topic string, qos byte, retained bool, payload interface{}) libmqtt.Token {
+	msg := publishedMessage{topic: topic, qos: qos, retained: retained, payload: payload.([]byte)} //nolint:errcheck // the output always publishes bytes
+	m.published = append(m.published, msg)
+	if m.publishTokens != nil {
+		return m.publishTokens(msg)
+	}
+	return &mockedToken{}
+}
+
+func newTestingClient(t *testing.T, cfg map[string]any, mock *mockedClient) *client {
+	t.Helper()
+
+	c := config.MustNewConfigFrom(cfg)
+	logger := logptest.NewTestingLogger(t, "")
+	info := beat.Info{Beat: "libbeat", IndexPrefix: "testbeat", Logger: logger, Paths: paths.New()}
+
+	mqttCfg, err := readConfig(c)
+	require.NoError(t, err)
+	topic, err := buildTopicSelector(c, logger)
+	require.NoError(t, err)
+	enc, err := codec.CreateEncoder(info, mqttCfg.Codec)
+	require.NoError(t, err)
+
+	return newClient(logger, outputs.NewNilObserver(), mqttCfg, libmqtt.NewClientOptions(),
+		func(*libmqtt.ClientOptions) libmqtt.Client { return mock },
+		info.IndexPrefix, topic, enc)
+}
+
+func TestMakeMQTT(t *testing.T) {
+	c := config.MustNewConfigFrom(map[string]any{
+		"hosts": []string{"tcp://localhost:1883"},
+		"topic": "sensors",
+	})
+	logger := logptest.NewTestingLogger(t, "")
+	group, err := makeMQTT(nil,
+		beat.Info{Beat: "libbeat", Logger: logger, Paths: paths.New()},
+		outputs.NewNilObserver(), c)
+	require.NoError(t, err)
+	assert.Len(t, group.Clients, 1)
+	assert.Equal(t, 256, group.BatchSize)
+	assert.Equal(t, 3, group.Retry)
+}
+
+func newTestBatch(sensors ...string) *outest.Batch {
+	events := make([]beat.Event, len(sensors))
+	for i, sensor := range sensors {
+		events[i] = beat.Event{
+			Timestamp: time.Now(),
+			Fields:    mapstr.M{"sensor": sensor, "value": i},
+		}
+	}
+	return outest.NewBatch(events...)
+}
+
+func TestPublish(t *testing.T) {
+	cfg := map[string]any{
+		"hosts":    []string{"tcp://localhost:1883"},
+		"topic":    "sensors/%{[sensor]}",
+		"qos":      2,
+		"retained": true,
+	}
+
+	t.Run("acknowledged messages ack the batch", func(t *testing.T) {
+		mock := &mockedClient{}
+		c := newTestingClient(t, cfg, mock)
+		require.NoError(t, c.Connect(context.Background()))
+
+		batch := newTestBatch("a", "b")
+		require.NoError(t, c.Publish(context.Background(), batch))
+
+		require.Len(t, batch.Signals, 1)
+		assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+		require.Len(t, mock.published, 2)
+		assert.Equal(t, "sensors/a", mock.published[0].topic)
+		assert.Equal(t, "sensors/b", mock.published[1].topic)
+		assert.Equal(t, byte(2), mock.published[0].qos)
+		assert.True(t, mock.published[0].retained)
+		assert.Contains(t, string(mock.published[0].payload), `"sensor":"a"`)
+
+		require.NoError(t, c.Close())
+		assert.Equal(t, 1, mock.disconnectCount)
+	})
+
+	t.Run("failed messages are retried", func(t *testing.T) {
+		mock := &mockedClient{
+			publishTokens: func(msg publishedMessage) libmqtt.Token {
+				if msg.topic == "sensors/b" {
+					return &mockedToken{err: errors.New("not authorized")}
+				}
+				return &mockedToken{}
+			},
+		}
+		c := newTestingClient(t, cfg, mock)
+		require.NoError(t, c.Connect(context.Background()))
+
+		batch := newTestBatch("a", "b", "c")
+		require.NoError(t, c.Publish(context.Background(), batch))
+
+		require.Len(t, batch.Signals, 1)
+		assert.Equal(t, outest.BatchRetryEvents, batch.Signals[0].Tag)
+		require.Len(t, batch.Signals[0].Events, 1)
+		assert.Equal(t, "b", batch.Signals[0].Events[0].Content.Fields["sensor"])
+	})
+
+	t.Run("lost connection fails the batch", func(t *testing.T) {
+		mock := &mockedClient{}
+		mock.publishTokens = func(publishedMessage) libmqtt.Token {
+			mock.connected = false
+			return &mockedToken{timeout: true}
+		}
+		c := newTestingClient(t, cfg, mock)
+		require.NoError(t, c.Connect(context.Background()))
+
+		batch := newTestBatch("a", "b")
+		require.Error(t, c.Publish(context.Background(), batch))
+		require.Len(t, batch.Signals, 1)
+		assert.Equal(t, outest.BatchRetryEvents, batch.Signals[0].Tag)
+		assert.Len(t, batch.Signals[0].Events, 2)
+
+		// The next batch is retried until the client reconnects.
+		batch = newTestBatch("c")
+		require.ErrorIs(t, c.Publish(context.Background(), batch), errNotConnected)
+		assert.Equal(t, outest.BatchRetry, batch.Signals[0].Tag)
+	})
+}
+
+func TestConnect(t *testing.T) {
+	cfg := map[string]any{
+		"hosts": []string{"tcp://localhost:1883"},
+		"topic": "sensors",
+	}
+
+	mock := &mockedClient{connectToken: &mockedToken{err: errors.New("bad user name or password")}}
+	c := newTestingClient(t, cfg, mock)
+	assert.ErrorContains(t, c.Connect(context.Background()), "bad user name or password")
+
+	mock = &mockedClient{connectToken: &mockedToken{timeout: true}}
+	c = newTestingClient(t, cfg, mock)
+	assert.ErrorContains(t, c.Connect(context.Background()), "timeout")
+	assert.Equal(t, 1, mock.disconnectCount)
+}
+
+func TestCreateClientOptions(t *testing.T) {
+	beatPaths := paths.New()
+	beatPaths.Data = t.TempDir()
+
+	read := func(t *testing.T, cfg map[string]any) *mqttConfig {
+		c, err := readConfig(config.MustNewConfigFrom(cfg))
+		require.NoError(t, err)
+		return c
+	}
+
+	options, err := createClientOptions(read(t, map[string]any{
+		"hosts":     []string{"tcp://broker1:1883", "ssl://broker2:8883"},
+		"topic":     "sensors",
+		"client_id": "gateway-1",
+		"username":  "beats",
+		"password":  "secret",
+	}), beatPaths, logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	assert.Len(t, options.Servers, 2)
+	assert.Equal(t, "gateway-1", options.ClientID)
+	assert.True(t, options.CleanSession)
+	assert.False(t, options.AutoReconnect)
+	assert.Nil(t, options.Store)
+
+	options, err = createClientOptions(read(t, map[string]any{
+		"hosts":         []string{"tcp://broker1:1883"},
+		"topic":         "sensors",
+		"client_id":     "gateway-1",
+		"clean_session": false,
+	}), beatPaths, logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	assert.False(t, options.CleanSession)
+	assert.IsType(t, &libmqtt.FileStore{}, options.Store)
+}
+
+func TestDefaultClientID(t *testing.T) {
+	id := uuid.Must(uuid.FromString("4b7ec16a-6d1e-4bb5-9d1c-0c5f3ef1d2a1"))
+	clientID := defaultClientID(id)
+	assert.Equal(t, "beats-4b7ec16a6d1e4bb59", clientID)
+	assert.Len(t, clientID, 23)
+	assert.NotEqual(t, clientID, defaultClientID(uuid.Must(uuid.NewV4())))
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		cfg   mapstr.M
+		valid bool
+	}{
+		"topic":                     {cfg: mapstr.M{"topic": "sensors"}, valid: true},
+		"no topic":                  {cfg: mapstr.M{}},
+		"qos out of range":          {cfg: mapstr.M{"topic": "sensors", "qos": 3}},
+		"long client_id":            {cfg: mapstr.M{"topic": "sensors", "client_id": "abcdefghijklmnopqrstuvwxyz"}, valid: true},
+		"password without username": {cfg: mapstr.M{"topic": "sensors", "password": "secret"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := config.MustNewConfigFrom(test.cfg)
+			require.NoError(t, c.SetString("hosts", 0, "tcp://localhost:1883"))
+			_, err := readConfig(c)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/outputs/codec"
+	"github.com/elastic/beats/v7/libbeat/outputs/outil"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/testing"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+type client struct {
+	log      *logp.Logger
+	observer outputs.Observer
+	servers  string
+	options  []nats.Option
+	timeout  time.Duration
+	subject  outil.Selector
+	index    string
+	codec    codec.Codec
+
+	jetStream   bool
+	maxPending  int
+	publishOpts []jetstream.PublishOpt
+
+	conn *nats.Conn
+	js   jetstream.JetStream
+}
+
+var errNoSubjectSelected = errors.New("no subject could be selected")
+
+func newClient(
+	log *logp.Logger,
+	observer outputs.Observer,
+	config *natsConfig,
+	tls *tlscommon.TLSConfig,
+	name string,
+	index string,
+	subject outil.Selector,
+	enc codec.Codec,
+) (*client, error) {
+	// Reconnects are handled by the publisher pipeline, so messages are
+	// never buffered by the library while it is disconnected.
+	options := []nats.Option{
+		nats.Name(name),
+		nats.Timeout(config.Timeout),
+		nats.NoReconnect(),
+	}
+
+	if tls != nil {
+		options = append(options, nats.Secure(tls.BuildModuleClientConfig("")))
+	}
+
+	switch {
+	case config.Username != "":
+		options = append(options, nats.UserInfo(config.Username, config.Password))
+	case config.Token != "":
+		options = append(options, nats.Token(config.Token))
+	case config.NKeySeedFile != "":
+		opt, err := nats.NkeyOptionFromSeed(config.NKeySeedFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load nkey seed file: %w", err)
+		}
+		options = append(options, opt)
+	case config.CredentialsFile != "":
+		options = append(options, nats.UserCredentials(config.CredentialsFile))
+	}
+
+	c := &client{
+		log:        log,
+		observer:   observer,
+		servers:    strings.Join(config.Hosts, ","),
+		options:    options,
+		timeout:    config.Timeout,
+		subject:    subject,
+		index:      strings.ToLower(index),
+		codec:      enc,
+		jetStream:  config.JetStream.Enabled,
+		maxPending: config.JetStream.MaxPending,
+	}
+	if config.JetStream.Stream != "" {
+		c.publishOpts = append(c.publishOpts, jetstream.WithExpectStream(config.JetStream.Stream))
+	}
+	return c, nil
+}
+
+func (c *client) Connect(_ context.Context) error {
+	c.log.Debugf("connect: %v", c.servers)
+
+	conn, err := nats.Connect(c.servers, c.options...)
+	if err != nil {
+		return fmt.Errorf("failed to connect to nats: %w", err)
+	}
+
+	if c.jetStream {
+		js, err := jetstream.New(conn,
+			jetstream.WithPublishAsyncMaxPending(c.maxPending),
+			jetstream.WithPublishAsyncTimeout(c.timeout),
+		)
+		if err != nil {
+			conn.Close()
+			return fmt.Errorf("failed to create jetstream context: %w", err)
+		}
+		c.js = js
+	}
+
+	c.conn = conn
+	return nil
+}
+
+func (c *client) Close() error {
+	c.log.Debug("closed nats client")
+	if c.conn == nil {
+		return nil
+	}
+
+	c.conn.Close()
+	c.conn = nil
+	c.js = nil
+	return nil
+}
+
+func (c *client) String() string {
+	return "nats(" + c.servers + ")"
+}
+
+func (c *client) Publish(ctx context.Context, batch publisher.Batch) error {
+	if c.conn == nil {
+		return errors.New("nats client is not connected")
+	}
+
+	events := batch.Events()
+	c.observer.NewBatch(len(events))
+
+	var (
+		failed []publisher.Event
+		err    error
+	)
+	if c.js != nil {
+		failed, err = c.publishJetStream(ctx, events)
+	} else {
+		failed, err = c.publishCore(events)
+	}
+
+	if len(failed) == 0 {
+		batch.ACK()
+	} else {
+		batch.RetryEvents(failed)
+	}
+	return err
+}
+
+// publishCore publishes the events with core NATS, which provides
+// at-most-once delivery. The connection is flushed before acknowledging
+// the batch to make sure the server received all messages.
+func (c *client) publishCore(events []publisher.Event) ([]publisher.Event, error) {
+	start := time.Now()
+	sent := make([]publisher.Event, 0, len(events))
+	dropped := 0
+	for i := range events {
+		msg, err := c.makeMessage(&events[i])
+		if err != nil {
+			c.log.Errorf("Dropping event: %+v", err)
+			dropped++
+			continue
+		}
+
+		if err := c.conn.PublishMsg(msg); err != nil {
+			if errors.Is(err, nats.ErrMaxPayload) {
+				c.log.Errorf("Dropping event (subject=%v): %v", msg.Subject, err)
+				dropped++
+				continue
+			}
+
+			// Messages already published are retried as well, since the
+			// server might not have received them.
+			failed := append(sent, events[i:]...)
+			c.observer.PermanentErrors(dropped)
+			c.observer.RetryableErrors(len(failed))
+			return failed, fmt.Errorf("failed to publish to nats: %w", err)
+		}
+		sent = append(sent, events[i])
+	}
+	c.observer.PermanentErrors(dropped)
+
+	if err := c.conn.FlushTimeout(c.timeout); err != nil {
+		c.observer.RetryableErrors(len(sent))
+		return sent, fmt.Errorf("failed to flush nats connection: %w", err)
+	}
+	c.observer.ReportLatency(time.Since(start))
+	c.observer.AckedEvents(len(sent))
+	return nil, nil
+}
+
+// publishJetStream publishes the events to JetStream, waiting for the
+// publish acknowledgement of every message. Events whose message is not
+// acknowledged are retried.
+func (c *client) publishJetStream(ctx context.Context, events []publisher.Event) ([]publisher.Event, error) {
+	type pending struct {
+		event  *publisher.Event
+		future jetstream.PubAckFuture
+	}
+
+	start := time.Now()
+	futures := make([]pending, 0, len(events))
+	var failed []publisher.Event
+	var pubErr error
+	dropped := 0
+	for i := range events {
+		msg, err := c.makeMessage(&events[i])
+		if err != nil {
+			c.log.Errorf("Dropping event: %+v", err)
+			dropped++
+			continue
+		}
+
+		future, err := c.js.PublishMsgAsync(msg, c.publishOpts...)
+		if err != nil {
+			if errors.Is(err, nats.ErrMaxPayload) {
+				c.log.Errorf("Dropping event (subject=%v): %v", msg.Subject, err)
+				dropped++
+				continue
+			}
+
+			failed = append(failed, events[i:]...)
+			pubErr = fmt.Errorf("failed to publish to jetstream: %w", err)
+			break
+		}
+		futures = append(futures, pending{event: &events[i], future: future})
+	}
+	c.observer.PermanentErrors(dropped)
+
+	acked := 0
+	var ackErr error
+	for _, p := range futures {
+		select {
+		case <-p.future.Ok():
+			acked++
+		case err := <-p.future.Err():
+			failed = append(failed, *p.event)
+			if ackErr == nil {
+				// Report the first error seen in the batch.
+				ackErr = err
+			}
+		case <-ctx.Done():
+			failed = append(failed, *p.event)
+			if ackErr == nil {
+				ackErr = ctx.Err()
+			}
+		}
+	}
+	c.observer.ReportLatency(time.Since(start))
+
+	if ackErr != nil {
+		c.log.Errorf("JetStream publish failed with: %v", ackErr)
+	}
+	c.observer.AckedEvents(acked)
+	c.observer.RetryableErrors(len(failed))
+
+	// Errors of individual messages are retried without reconnecting, but a
+	// failure to publish means the connection is broken.
+	return failed, pubErr
+}
+
+func (c *client) makeMessage(data *publisher.Event) (*nats.Msg, error) {
+	event := &data.Content
+
+	subject, err := c.subject.Select(event)
+	if err != nil {
+		return nil, fmt.Errorf("setting nats subject failed with %w", err)
+	}
+	if subject == "" {
+		return nil, errNoSubjectSelected
+	}
+
+	serializedEvent, err := c.codec.Encode(c.index, event)
+	if err != nil {
+		if c.log.IsDebug() {
+			c.log.Debug("failed event logged to event log file")
+			c.log.Debugw(fmt.Sprintf("failed event: %v", event), logp.TypeKey, logp.EventType)
+		}
+		return nil, err
+	}
+
+	buf := make([]byte, len(serializedEvent))
+	copy(buf, serializedEvent)
+	return &nats.Msg{Subject: subject, Data: buf}, nil
+}
+
+func (c *client) Test(d testing.Driver) {
+	d.Run("NATS: "+c.servers, func(d testing.Driver) {
+		conn, err := nats.Connect(c.servers, c.options...)
+		d.Fatal("connect", err)
+		d.Info("server", conn.ConnectedUrlRedacted())
+		conn.Close()
+	})
+}
@@ -0,0 +1,117 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nats
+
+import (
+	"errors"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/outputs/codec"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+type natsConfig struct {
+	Hosts           []string          `config:"hosts"            validate:"required"`
+	Username        string            `config:"username"`
+	Password        string            `config:"password"`
+	Token           string            `config:"token"`
+	NKeySeedFile    string            `config:"nkey_seed_file"`
+	CredentialsFile string            `config:"credentials_file"`
+	TLS             *tlscommon.Config `config:"ssl"`
+	Timeout         time.Duration     `config:"timeout"          validate:"min=1"`
+	JetStream       jetStreamConfig   `config:"jetstream"`
+	BulkMaxSize     int               `config:"bulk_max_size"`
+	MaxRetries      int               `config:"max_retries"      validate:"min=-1"`
+	Backoff         backoffConfig     `config:"backoff"`
+	Codec           codec.Config      `config:"codec"`
+	Queue           config.Namespace  `config:"queue"`
+
+	// Currently only used for validation. The subject selector is built
+	// from the raw configuration.
+	Subject  string `config:"subject"`
+	Subjects []any  `config:"subjects"`
+}
+
+// jetStreamConfig enables publishing to JetStream streams, where every
+// message is acknowledged by the server once it is stored.
+type jetStreamConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Stream is the name of the stream the subjects are expected to be
+	// bound to. Messages stored in another stream fail.
+	Stream string `config:"stream"`
+
+	// MaxPending is the maximum number of messages waiting for an
+	// acknowledgement.
+	MaxPending int `config:"max_pending" validate:"min=1"`
+}
+
+type backoffConfig struct {
+	Init time.Duration `config:"init"`
+	Max  time.Duration `config:"max"`
+}
+
+func defaultConfig() natsConfig {
+	return natsConfig{
+		Timeout:     30 * time.Second,
+		BulkMaxSize: 2048,
+		MaxRetries:  3,
+		JetStream: jetStreamConfig{
+			MaxPending: 4000,
+		},
+		Backoff: backoffConfig{
+			Init: 1 * time.Second,
+			Max:  60 * time.Second,
+		},
+	}
+}
+
+func readConfig(cfg *config.C) (*natsConfig, error) {
+	c := defaultConfig()
+	if err := cfg.Unpack(&c); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (c *natsConfig) Validate() error {
+	if c.Subject == "" && len(c.Subjects) == 0 {
+		return errors.New("either 'subject' or 'subjects' must be defined")
+	}
+
+	if c.Username != "" && c.Password == "" {
+		return errors.New("password must be set when username is configured")
+	}
+
+	methods := 0
+	for _, set := range []bool{c.Username != "", c.Token != "", c.NKeySeedFile != "", c.CredentialsFile != ""} {
+		if set {
+			methods++
+		}
+	}
+	if methods > 1 {
+		return errors.New("only one of username, token, nkey_seed_file and credentials_file can be configured")
+	}
+
+	if c.JetStream.Enabled && c.JetStream.MaxPending < c.BulkMaxSize {
+		return errors.New("jetstream.max_pending must not be lower than bulk_max_size")
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nats
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		cfg   mapstr.M
+		valid bool
+	}{
+		"subject": {
+			cfg:   mapstr.M{"subject": "events"},
+			valid: true,
+		},
+		"subjects": {
+			cfg:   mapstr.M{"subjects": []mapstr.M{{"subject": "events"}}},
+			valid: true,
+		},
+		"no subject": {
+			cfg: mapstr.M{},
+		},
+		"username without password": {
+			cfg: mapstr.M{"subject": "events", "username": "beats"},
+		},
+		"username and token": {
+			cfg: mapstr.M{"subject": "events", "username": "beats", "password": "secret", "token": "s3cr3t"},
+		},
+		"nkey and credentials": {
+			cfg: mapstr.M{"subject": "events", "nkey_seed_file": "user.nk", "credentials_file": "user.creds"},
+		},
+		"jetstream": {
+			cfg:   mapstr.M{"subject": "events", "jetstream.enabled": true},
+			valid: true,
+		},
+		"jetstream max_pending lower than bulk_max_size": {
+			cfg: mapstr.M{"subject": "events", "jetstream.enabled": true, "jetstream.max_pending": 10},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := config.MustNewConfigFrom(test.cfg)
+			if err := c.SetString("hosts", 0, "nats://localhost:4222"); err != nil {
+				t.Fatalf("could not set 'hosts' on config: %s", err)
+			}
+
+			_, err := readConfig(c)
+			if test.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nats
+
+import (
+	"fmt"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/outputs/codec"
+	"github.com/elastic/beats/v7/libbeat/outputs/outil"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/transport/tlscommon"
+)
+
+const logSelector = "nats"
+
+func init() {
+	outputs.RegisterType("nats", makeNATS)
+}
+
+func makeNATS(
+	_ outputs.IndexManager,
+	beat beat.Info,
+	observer outputs.Observer,
+	cfg *config.C,
+) (outputs.Group, error) {
+	log := beat.Logger.Named(logSelector)
+	log.Debug("initialize nats output")
+
+	natsCfg, err := readConfig(cfg)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	subject, err := buildSubjectSelector(cfg, log)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	tls, err := tlscommon.LoadTLSConfig(natsCfg.TLS, log)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	enc, err := codec.CreateEncoder(beat, natsCfg.Codec)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	client, err := newClient(log, observer, natsCfg, tls, beat.Beat, beat.IndexPrefix, subject, enc)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
+	return outputs.Success(
+		natsCfg.Queue,
+		natsCfg.BulkMaxSize,
+		natsCfg.MaxRetries,
+		nil,
+		beat.Logger,
+		beat.Paths,
+		outputs.WithBackoff(client, natsCfg.Backoff.Init, natsCfg.Backoff.Max))
+}
+
+func buildSubjectSelector(cfg *config.C, logger *logp.Logger) (outil.Selector, error) {
+	if cfg == nil {
+		return outil.Selector{}, fmt.Errorf("nats config cannot be nil")
+	}
+
+	return outil.BuildSelectorFromConfig(cfg, outil.Settings{
+		Key:              "subject",
+		MultiKey:         "subjects",
+		EnableSingleOnly: true,
+		FailEmpty:        true,
+		Case:             outil.SelectorKeepCase,
+	}, logger)
+}
@@ -0,0 +1,240 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package nats
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	_ "github.com/elastic/beats/v7/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/v7/libbeat/outputs/outest"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+func startServer(t *testing.T, opts *server.Options) *server.Server {
+	t.Helper()
+
+	opts.Host = "127.0.0.1"
+	opts.Port = -1
+	opts.NoLog = true
+	opts.NoSigs = true
+	if opts.JetStream {
+		opts.StoreDir = t.TempDir()
+	}
+
+	s, err := server.NewServer(opts)
+	require.NoError(t, err)
+	go s.Start()
+	if !s.ReadyForConnections(10 * time.Second) {
+		t.Fatal("nats server not ready")
+	}
+	t.Cleanup(s.Shutdown)
+	return s
+}
+
+func newTestingOutput(t *testing.T, cfg map[string]any) outputs.NetworkClient {
+	t.Helper()
+
+	c, err := config.NewConfigFrom(cfg)
+	require.NoError(t, err)
+
+	logger := logptest.NewTestingLogger(t, "")
+	group, err := makeNATS(nil,
+		beat.Info{Beat: "libbeat", IndexPrefix: "testbeat", Logger: logger, Paths: paths.New()},
+		outputs.NewNilObserver(), c)
+	require.NoError(t, err)
+	require.Len(t, group.Clients, 1)
+
+	client, ok := group.Clients[0].(outputs.NetworkClient)
+	require.True(t, ok)
+	return client
+}
+
+func connectOutput(t *testing.T, client outputs.NetworkClient) {
+	t.Helper()
+	require.NoError(t, client.Connect(context.Background()))
+	t.Cleanup(func() { client.Close() })
+}
+
+func newTestBatch(kinds ...string) *outest.Batch {
+	events := make([]beat.Event, len(kinds))
+	for i, kind := range kinds {
+		events[i] = beat.Event{
+			Timestamp: time.Now(),
+			Fields:    mapstr.M{"kind": kind, "message": fmt.Sprintf("event %d", i)},
+		}
+	}
+	return outest.NewBatch(events...)
+}
+
+func requireSignal(t *testing.T, batch *outest.Batch, tag outest.BatchSignalTag) outest.BatchSignal {
+	t.Helper()
+	require.Len(t, batch.Signals, 1)
+	require.Equal(t, tag, batch.Signals[0].Tag)
+	return batch.Signals[0]
+}
+
+func TestPublishCore(t *testing.T) {
+	s := startServer(t, &server.Options{})
+
+	nc, err := nats.Connect(s.ClientURL())
+	require.NoError(t, err)
+	defer nc.Close()
+	sub, err := nc.SubscribeSync("events.>")
+	require.NoError(t, err)
+	require.NoError(t, nc.Flush())
+
+	client := newTestingOutput(t, map[string]any{
+		"hosts":   []string{s.ClientURL()},
+		"subject": "events.%{[kind]}",
+	})
+	connectOutput(t, client)
+
+	batch := newTestBatch("a", "b", "a")
+	require.NoError(t, client.Publish(context.Background(), batch))
+	requireSignal(t, batch, outest.BatchACK)
+
+	var subjects []string
+	for range 3 {
+		msg, err := sub.NextMsg(5 * time.Second)
+		require.NoError(t, err)
+		subjects = append(subjects, msg.Subject)
+		assert.Contains(t, string(msg.Data), `"message":"event`)
+	}
+	assert.Equal(t, []string{"events.a", "events.b", "events.a"}, subjects)
+}
+
+func TestPublishCoreDropsTooLargeEvents(t *testing.T) {
+	s := startServer(t, &server.Options{MaxPayload: 512})
+
+	client := newTestingOutput(t, map[string]any{
+		"hosts":   []string{s.ClientURL()},
+		"subject": "events",
+	})
+	connectOutput(t, client)
+
+	batch := outest.NewBatch(
+		beat.Event{Fields: mapstr.M{"message": "small"}},
+		beat.Event{Fields: mapstr.M{"message": strings.Repeat("x", 1024)}},
+	)
+	require.NoError(t, client.Publish(context.Background(), batch))
+	requireSignal(t, batch, outest.BatchACK)
+}
+
+func TestPublishJetStream(t *testing.T) {
+	s := startServer(t, &server.Options{JetStream: true})
+
+	nc, err := nats.Connect(s.ClientURL())
+	require.NoError(t, err)
+	defer nc.Close()
+	js, err := jetstream.New(nc)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	stream, err := js.CreateStream(ctx, jetstream.StreamConfig{
+		Name:     "EVENTS",
+		Subjects: []string{"events.>"},
+	})
+	require.NoError(t, err)
+
+	t.Run("acknowledged messages ack the batch", func(t *testing.T) {
+		client := newTestingOutput(t, map[string]any{
+			"hosts":             []string{s.ClientURL()},
+			"subject":           "events.%{[kind]}",
+			"jetstream.enabled": true,
+			"jetstream.stream":  "EVENTS",
+		})
+		connectOutput(t, client)
+
+		batch := newTestBatch("a", "b", "c", "d")
+		require.NoError(t, client.Publish(ctx, batch))
+		requireSignal(t, batch, outest.BatchACK)
+
+		info, err := stream.Info(ctx)
+		require.NoError(t, err)
+		assert.EqualValues(t, 4, info.State.Msgs)
+	})
+
+	t.Run("unacknowledged messages are retried", func(t *testing.T) {
+		client := newTestingOutput(t, map[string]any{
+			"hosts":             []string{s.ClientURL()},
+			"subjects":          []map[string]any{{"subject": "other.%{[kind]}", "when.equals.kind": "b"}},
+			"subject":           "events.%{[kind]}",
+			"jetstream.enabled": true,
+			"timeout":           "2s",
+		})
+		connectOutput(t, client)
+
+		batch := newTestBatch("a", "b", "c")
+		require.NoError(t, client.Publish(ctx, batch))
+		sig := requireSignal(t, batch, outest.BatchRetryEvents)
+		require.Len(t, sig.Events, 1)
+		assert.Equal(t, "b", sig.Events[0].Content.Fields["kind"])
+	})
+
+	t.Run("messages stored in another stream are retried", func(t *testing.T) {
+		client := newTestingOutput(t, map[string]any{
+			"hosts":             []string{s.ClientURL()},
+			"subject":           "events.%{[kind]}",
+			"jetstream.enabled": true,
+			"jetstream.stream":  "OTHER",
+		})
+		connectOutput(t, client)
+
+		batch := newTestBatch("a", "b")
+		require.NoError(t, client.Publish(ctx, batch))
+		sig := requireSignal(t, batch, outest.BatchRetryEvents)
+		assert.Len(t, sig.Events, 2)
+	})
+}
+
+func TestConnectAuthentication(t *testing.T) {
+	s := startServer(t, &server.Options{Authorization: "s3cr3t"})
+
+	client := newTestingOutput(t, map[string]any{
+		"hosts":   []string{s.ClientURL()},
+		"subject": "events",
+		"token":   "wrong",
+	})
+	require.Error(t, client.Connect(context.Background()))
+
+	client = newTestingOutput(t, map[string]any{
+		"hosts":   []string{s.ClientURL()},
+		"subject": "events",
+		"token":   "s3cr3t",
+	})
+	connectOutput(t, client)
+
+	batch := newTestBatch("a")
+	require.NoError(t, client.Publish(context.Background(), batch))
+	requireSignal(t, batch, outest.BatchACK)
+}
@@ -42,6 +42,15 @@ type Observer interface {
 	ReportLatency(time.Duration) // report the duration a send to the output takes
 }
 
+// AdaptiveObserver is implemented by observers that report the limits of
+// outputs adapting their batch size and concurrency to the receiver load.
+// Outputs check for it with a type assertion on their Observer.
+type AdaptiveObserver interface {
+	AdaptiveBatchSplit()      // report a batch was split for being larger than the current batch size
+	EffectiveBatchSize(int)   // report the current upper bound on events per request
+	EffectiveConcurrency(int) // report the current upper bound on concurrent requests
+}
+
 type emptyObserver struct{}
 
 var nilObserver = (*emptyObserver)(nil)
@@ -28,6 +28,8 @@ import (
 
 var outputReg = map[string]Factory{}
 
+var namedValidatorReg = map[string]NamedValidator{}
+
 // Factory is used by output plugins to build an output instance
 type Factory func(
 	im IndexManager,
@@ -87,6 +89,34 @@ func RegisterType(name string, f Factory) {
 	outputReg[name] = f
 }
 
+// NamedConfig is the configuration of one of several named outputs.
+type NamedConfig struct {
+	Name   string
+	Config *config.C
+}
+
+// NamedValidator checks that several named outputs of the same type can be
+// used together, e.g. that they don't share an identity that must be unique.
+type NamedValidator func(cfgs []NamedConfig) error
+
+// RegisterNamedValidator registers the validator of the named outputs of
+// an output type.
+func RegisterNamedValidator(name string, v NamedValidator) {
+	if namedValidatorReg[name] != nil {
+		panic(fmt.Errorf("validator for output type '%v' exists already", name))
+	}
+	namedValidatorReg[name] = v
+}
+
+// ValidateNamed checks the configurations of several named outputs of the
+// given type with the validator registered for the type, if any.
+func ValidateNamed(name string, cfgs []NamedConfig) error {
+	if v := namedValidatorReg[name]; v != nil {
+		return v(cfgs)
+	}
+	return nil
+}
+
 // FindFactory finds an output type its factory if available.
 func FindFactory(name string) Factory {
 	return outputReg[name]
@@ -53,6 +53,7 @@ type client struct {
 	observer outputs.Observer
 	index    string
 	dataType redisDataType
+	stream   streamConfig
 	db       int
 	key      outil.Selector
 	password string
@@ -66,6 +67,7 @@ type redisDataType uint16
 const (
 	redisListType redisDataType = iota
 	redisChannelType
+	redisStreamType
 )
 
 func newClient(
@@ -73,7 +75,7 @@ func newClient(
 	observer outputs.Observer,
 	timeout time.Duration,
 	pass string,
-	db int, key outil.Selector, dt redisDataType,
+	db int, key outil.Selector, dt redisDataType, stream streamConfig,
 	index string, codec codec.Codec,
 	logger *logp.Logger,
 ) *client {
@@ -86,6 +88,7 @@ func newClient(
 		index:    strings.ToLower(index),
 		db:       db,
 		dataType: dt,
+		stream:   stream,
 		key:      key,
 		codec:    codec,
 	}
@@ -165,10 +168,14 @@ func (c *client) String() string {
 func (c *client) makePublish(
 	conn redis.Conn,
 ) (publishFn, error) {
-	if c.dataType == redisChannelType {
+	switch c.dataType {
+	case redisChannelType:
 		return c.makePublishPUBLISH(conn)
+	case redisStreamType:
+		return c.publishEventsStream(conn), nil
+	default:
+		return c.makePublishRPUSH(conn)
 	}
-	return c.makePublishRPUSH(conn)
 }
 
 func (c *client) makePublishRPUSH(conn redis.Conn) (publishFn, error) {
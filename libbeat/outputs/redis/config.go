@@ -18,6 +18,7 @@
 package redis
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -40,10 +41,29 @@ type redisConfig struct {
 	Codec       codec.Config          `config:"codec"`
 	Db          int                   `config:"db"`
 	DataType    string                `config:"datatype"`
+	Stream      streamConfig          `config:"stream"`
 	Backoff     backoff               `config:"backoff"`
 	Queue       config.Namespace      `config:"queue"`
 }
 
+// streamConfig configures how events are added to streams when the data
+// type is stream.
+type streamConfig struct {
+	// Mapping selects how events are mapped to stream entries. With
+	// "event" the encoded event is stored in the entry field named by
+	// Field. With "fields" each top-level event field is an entry field.
+	Mapping string `config:"mapping"`
+	Field   string `config:"field"`
+
+	// MaxLen and MinID trim the stream on every XADD. At most one of
+	// them can be set.
+	MaxLen int64  `config:"max_len" validate:"min=0"`
+	MinID  string `config:"min_id"`
+
+	// Approximate enables efficient approximate trimming.
+	Approximate bool `config:"approximate"`
+}
+
 type backoff struct {
 	Init time.Duration
 	Max  time.Duration
@@ -58,6 +78,11 @@ var (
 		TLS:         nil,
 		Db:          0,
 		DataType:    "list",
+		Stream: streamConfig{
+			Mapping:     "event",
+			Field:       "event",
+			Approximate: true,
+		},
 		Backoff: backoff{
 			Init: 1 * time.Second,
 			Max:  60 * time.Second,
@@ -68,9 +93,27 @@ var (
 func (c *redisConfig) Validate() error {
 	switch c.DataType {
 	case "", "list", "channel":
+	case "stream":
+		return c.Stream.Validate()
 	default:
 		return fmt.Errorf("redis data type %v not supported", c.DataType)
 	}
 
 	return nil
 }
+
+func (c *streamConfig) Validate() error {
+	switch c.Mapping {
+	case "", "event":
+		if c.Field == "" {
+			return errors.New("redis stream field must be set when the mapping is event")
+		}
+	case "fields":
+	default:
+		return fmt.Errorf("redis stream mapping %v not supported", c.Mapping)
+	}
+	if c.MaxLen != 0 && c.MinID != "" {
+		return errors.New("redis stream max_len and min_id cannot be used together")
+	}
+	return nil
+}
@@ -37,6 +37,11 @@ func TestValidate(t *testing.T) {
 		{"Invalid Datatype", redisConfig{Key: "test", DataType: "something"}, false},
 		{"List Datatype", redisConfig{Key: "test", DataType: "list"}, true},
 		{"Channel Datatype", redisConfig{Key: "test", DataType: "channel"}, true},
+		{"Stream Datatype", redisConfig{Key: "test", DataType: "stream", Stream: streamConfig{Mapping: "event", Field: "event"}}, true},
+		{"Stream Fields Mapping", redisConfig{Key: "test", DataType: "stream", Stream: streamConfig{Mapping: "fields"}}, true},
+		{"Stream Without Field", redisConfig{Key: "test", DataType: "stream", Stream: streamConfig{Mapping: "event"}}, false},
+		{"Stream Invalid Mapping", redisConfig{Key: "test", DataType: "stream", Stream: streamConfig{Mapping: "other", Field: "event"}}, false},
+		{"Stream MaxLen And MinID", redisConfig{Key: "test", DataType: "stream", Stream: streamConfig{Mapping: "event", Field: "event", MaxLen: 10, MinID: "0-1"}}, false},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,162 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs/outil"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// publishEventsStream returns a publishFn adding each event as an entry
+// to the stream selected by its key, using pipelined XADD commands.
+func (c *client) publishEventsStream(conn redis.Conn) publishFn {
+	trim := c.stream.trimArgs()
+	return func(key outil.Selector, data []publisher.Event) ([]publisher.Event, error) {
+		sent := data[:0]
+		dropped := 0
+		for i := range data {
+			event := &data[i].Content
+			eventKey, err := key.Select(event)
+			if err != nil {
+				c.log.Errorf("Failed to set redis key: %+v", err)
+				dropped++
+				continue
+			}
+
+			entry, err := c.streamEntry(event)
+			if err != nil {
+				c.log.Errorf("Encoding event failed with error: %+v. Check the event_data log (configured by logging.event_data.files.path) to view the event", err)
+				c.log.Errorw(fmt.Sprintf("Failed event: %v", *event), logp.TypeKey, logp.EventType)
+				dropped++
+				continue
+			}
+
+			args := make([]interface{}, 0, 1+len(trim)+1+len(entry))
+			args = append(args, eventKey)
+			args = append(args, trim...)
+			args = append(args, "*")
+			args = append(args, entry...)
+			if err := conn.Send("XADD", args...); err != nil {
+				c.log.Errorf("Failed to execute XADD: %+v", err)
+				c.observer.PermanentErrors(dropped)
+				return append(sent, data[i:]...), err
+			}
+			sent = append(sent, data[i])
+		}
+		c.observer.PermanentErrors(dropped)
+		if len(sent) == 0 {
+			return nil, nil
+		}
+
+		start := time.Now()
+		if err := conn.Flush(); err != nil {
+			return sent, err
+		}
+
+		failed := sent[:0]
+		var lastErr error
+		for i := range sent {
+			_, err := conn.Receive()
+			if err != nil {
+				if _, ok := err.(redis.Error); ok { //nolint:errorlint //this line checks against a type, not an instance of an error
+					c.log.Errorf("Failed to XADD event to stream with %+v", err)
+					failed = append(failed, sent[i])
+					lastErr = err
+				} else {
+					c.log.Errorf("Failed to XADD multiple events to stream with %+v", err)
+					failed = append(failed, sent[i:]...)
+					lastErr = err
+					break
+				}
+			}
+		}
+		c.observer.ReportLatency(time.Since(start))
+
+		c.observer.AckedEvents(len(sent) - len(failed))
+		return failed, lastErr
+	}
+}
+
+// trimArgs returns the XADD arguments trimming the stream, if any.
+func (s streamConfig) trimArgs() []interface{} {
+	var strategy string
+	var threshold interface{}
+	switch {
+	case s.MaxLen > 0:
+		strategy, threshold = "MAXLEN", s.MaxLen
+	case s.MinID != "":
+		strategy, threshold = "MINID", s.MinID
+	default:
+		return nil
+	}
+	if s.Approximate {
+		return []interface{}{strategy, "~", threshold}
+	}
+	return []interface{}{strategy, threshold}
+}
+
+// streamEntry returns the field and value pairs of the stream entry for
+// the event.
+func (c *client) streamEntry(event *beat.Event) ([]interface{}, error) {
+	if c.stream.Mapping == "fields" {
+		return fieldsEntry(event)
+	}
+
+	serialized, err := c.codec.Encode(c.index, event)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, len(serialized))
+	copy(buf, serialized)
+	return []interface{}{c.stream.Field, buf}, nil
+}
+
+// fieldsEntry maps the top-level fields of the event to entry fields.
+// Strings are stored as is, and other values are JSON encoded.
+func fieldsEntry(event *beat.Event) ([]interface{}, error) {
+	keys := make([]string, 0, len(event.Fields))
+	for k := range event.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	entry := make([]interface{}, 0, 2*(len(keys)+1))
+	entry = append(entry, "@timestamp", event.Timestamp.UTC().Format(time.RFC3339Nano))
+	for _, k := range keys {
+		switch v := event.Fields[k].(type) {
+		case string:
+			entry = append(entry, k, v)
+		default:
+			b, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode field %v: %w", k, err)
+			}
+			entry = append(entry, k, b)
+		}
+	}
+	return entry, nil
+}
@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redis
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	jsoncodec "github.com/elastic/beats/v7/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/v7/libbeat/outputs/outil"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// recordingConn is a redis.Conn recording the commands sent to it and
+// replying OK to each of them.
+type recordingConn struct {
+	sent    [][]interface{}
+	pending int
+}
+
+func (c *recordingConn) Close() error { return nil }
+func (c *recordingConn) Err() error   { return nil }
+func (c *recordingConn) Flush() error { return nil }
+
+func (c *recordingConn) Do(cmd string, args ...interface{}) (interface{}, error) {
+	return "OK", nil
+}
+
+func (c *recordingConn) Send(cmd string, args ...interface{}) error {
+	c.sent = append(c.sent, append([]interface{}{cmd}, args...))
+	c.pending++
+	return nil
+}
+
+func (c *recordingConn) Receive() (interface{}, error) {
+	if c.pending == 0 {
+		return nil, redis.ErrNil
+	}
+	c.pending--
+	return "1-0", nil
+}
+
+func newStreamTestClient(t *testing.T, stream streamConfig) *client {
+	key := outil.MakeSelector(outil.ConstSelectorExpr("events", outil.SelectorKeepCase))
+	codec := jsoncodec.New("1.2.3", jsoncodec.Config{})
+	return newClient(nil, outputs.NewNilObserver(), time.Second, "", 0, key,
+		redisStreamType, stream, "test", codec, logptest.NewTestingLogger(t, ""))
+}
+
+func TestPublishEventsStream(t *testing.T) {
+	ts := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	events := []publisher.Event{{Content: beat.Event{
+		Timestamp: ts,
+		Fields: mapstr.M{
+			"message": "hello",
+			"count":   3,
+			"host":    mapstr.M{"name": "a"},
+		},
+	}}}
+
+	t.Run("event mapping with max_len", func(t *testing.T) {
+		c := newStreamTestClient(t, streamConfig{Mapping: "event", Field: "event", MaxLen: 100, Approximate: true})
+		conn := &recordingConn{}
+
+		failed, err := c.publishEventsStream(conn)(c.key, events)
+		require.NoError(t, err)
+		assert.Empty(t, failed)
+		require.Len(t, conn.sent, 1)
+
+		cmd := conn.sent[0]
+		assert.Equal(t, []interface{}{"XADD", "events", "MAXLEN", "~", int64(100), "*", "event"}, cmd[:7])
+		require.Len(t, cmd, 8)
+		var doc map[string]interface{}
+		require.NoError(t, json.Unmarshal(cmd[7].([]byte), &doc))
+		assert.Equal(t, "hello", doc["message"])
+	})
+
+	t.Run("fields mapping with exact min_id", func(t *testing.T) {
+		c := newStreamTestClient(t, streamConfig{Mapping: "fields", MinID: "1700000000000-0"})
+		conn := &recordingConn{}
+
+		failed, err := c.publishEventsStream(conn)(c.key, events)
+		require.NoError(t, err)
+		assert.Empty(t, failed)
+		require.Len(t, conn.sent, 1)
+
+		assert.Equal(t, []interface{}{
+			"XADD", "events", "MINID", "1700000000000-0", "*",
+			"@timestamp", "2024-05-01T12:00:00Z",
+			"count", []byte("3"),
+			"host", []byte(`{"name":"a"}`),
+			"message", "hello",
+		}, conn.sent[0])
+	})
+
+	t.Run("no trimming", func(t *testing.T) {
+		c := newStreamTestClient(t, streamConfig{Mapping: "event", Field: "data", Approximate: true})
+		conn := &recordingConn{}
+
+		_, err := c.publishEventsStream(conn)(c.key, events)
+		require.NoError(t, err)
+		require.Len(t, conn.sent, 1)
+		assert.Equal(t, []interface{}{"XADD", "events", "*", "data"}, conn.sent[0][:4])
+	})
+}
@@ -0,0 +1,263 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregate
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	c "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// instanceID is used to assign each instance a unique monitoring namespace.
+var instanceID atomic.Uint32
+
+const processorName = "aggregate"
+const logName = "processor." + processorName
+
+func init() {
+	// We cannot use this as a JS plugin as it is stateful and includes a Close method.
+	processors.RegisterPlugin(processorName, new)
+}
+
+// defaultFlushTimeout bounds the time Flush and Close wait for the final
+// summaries to be published.
+const defaultFlushTimeout = time.Second
+
+// errNoPublisher is returned when the processor is not connected to a
+// pipeline client.
+var errNoPublisher = errors.New("aggregate processor can only be used in input processors")
+
+type metrics struct {
+	Groups    *monitoring.Int
+	Summaries *monitoring.Int
+	Overflow  *monitoring.Int
+}
+
+// aggregate groups events over tumbling windows and publishes one summary
+// event per group and window. Each pipeline client has its own instance, so
+// groups are not merged across the clients of an input.
+type aggregate struct {
+	config config
+
+	mu      sync.Mutex
+	groups  map[string]*group
+	start   time.Time
+	publish func(beat.Event)
+
+	done         chan struct{}
+	stopOnce     sync.Once
+	wg           sync.WaitGroup
+	flushTimeout time.Duration
+
+	clock   clockwork.Clock
+	logger  *logp.Logger
+	metrics metrics
+}
+
+// new constructs a new aggregate processor.
+func new(cfg *c.C, log *logp.Logger) (beat.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("could not unpack processor configuration: %w", err)
+	}
+
+	// Logging and metrics (each processor instance has a unique ID).
+	var (
+		id  = int(instanceID.Add(1))
+		reg = monitoring.Default.GetOrCreateRegistry(logName+"."+strconv.Itoa(id), monitoring.DoNotReport)
+	)
+
+	return newAggregate(config, clockwork.NewRealClock(), log.Named(logName).With("instance_id", id), metrics{
+		Groups:    monitoring.NewInt(reg, "groups"),
+		Summaries: monitoring.NewInt(reg, "summaries"),
+		Overflow:  monitoring.NewInt(reg, "overflow"),
+	}), nil
+}
+
+func newAggregate(config config, clock clockwork.Clock, log *logp.Logger, m metrics) *aggregate {
+	return &aggregate{
+		config:  config,
+		groups:  make(map[string]*group),
+		start:   clock.Now().Truncate(config.Window),
+		done:    make(chan struct{}),
+		clock:   clock,
+		logger:  log,
+		metrics: m,
+
+		flushTimeout: defaultFlushTimeout,
+	}
+}
+
+// SetPublisher sets the function used to publish summary events and starts
+// publishing them at the end of each window.
+func (p *aggregate) SetPublisher(publish func(beat.Event)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.publish != nil {
+		return
+	}
+	p.publish = publish
+	p.wg.Add(1)
+	go p.run()
+}
+
+// run publishes the summaries of each window when it ends. When the
+// processor is stopped, it publishes the summaries of the current partial
+// window and returns.
+func (p *aggregate) run() {
+	defer p.wg.Done()
+	for {
+		p.mu.Lock()
+		end := p.start.Add(p.config.Window)
+		p.mu.Unlock()
+
+		select {
+		case <-p.clock.After(end.Sub(p.clock.Now())):
+			p.flush(p.clock.Now().Truncate(p.config.Window))
+		case <-p.done:
+			p.flush(p.clock.Now())
+			return
+		}
+	}
+}
+
+// stop makes run publish the final summaries and return.
+func (p *aggregate) stop() {
+	p.stopOnce.Do(func() { close(p.done) })
+}
+
+// Run adds the event to its group. The event is dropped unless keep_raw is
+// set, or its group could not be created because max_groups was reached.
+func (p *aggregate) Run(event *beat.Event) (*beat.Event, error) {
+	values := make([]any, len(p.config.GroupBy))
+	for i, field := range p.config.GroupBy {
+		// Missing fields group under a nil value.
+		values[i], _ = event.GetValue(field)
+	}
+	key := makeKey(values)
+
+	p.mu.Lock()
+	if p.publish == nil {
+		p.mu.Unlock()
+		return event, errNoPublisher
+	}
+	g, ok := p.groups[key]
+	if !ok {
+		if len(p.groups) >= p.config.MaxGroups {
+			p.mu.Unlock()
+			p.metrics.Overflow.Inc()
+			return event, nil
+		}
+		g = newGroup(values, event.Meta.Clone(), p.config.Metrics)
+		p.groups[key] = g
+		p.metrics.Groups.Inc()
+	}
+	g.add(event)
+	p.mu.Unlock()
+
+	if p.config.KeepRaw {
+		return event, nil
+	}
+	return nil, nil
+}
+
+// flush publishes the summaries of the current window and starts a new
+// window at next.
+func (p *aggregate) flush(next time.Time) {
+	p.mu.Lock()
+	groups, start := p.groups, p.start
+	p.groups = make(map[string]*group)
+	p.start = next
+	publish := p.publish
+	p.mu.Unlock()
+
+	if len(groups) == 0 {
+		return
+	}
+	end := start.Add(p.config.Window)
+	p.logger.Debugw("publishing summaries", "groups", len(groups), "window_start", start)
+	for _, g := range groups {
+		publish(g.summary(p.config, start, end))
+	}
+	p.metrics.Summaries.Add(int64(len(groups)))
+	p.metrics.Groups.Sub(int64(len(groups)))
+}
+
+// Flush stops the processor and publishes the summaries of the current
+// partial window.
+func (p *aggregate) Flush() {
+	p.stop()
+	p.wait()
+}
+
+// Close stops the processor, publishing the summaries of the current partial
+// window unless Flush was called before.
+func (p *aggregate) Close() error {
+	p.stop()
+	p.wait()
+	return nil
+}
+
+// wait waits a bounded time for run to return, so that a blocked publisher
+// can't block the pipeline client while it closes. Summaries published after
+// the client closed its producer are dropped by the client.
+func (p *aggregate) wait() {
+	stopped := make(chan struct{})
+	go func() {
+		p.wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(p.flushTimeout):
+		p.logger.Warn("timed out publishing the final summaries, the remaining summaries are dropped")
+	}
+}
+
+func (p *aggregate) String() string {
+	return fmt.Sprintf(
+		"%v=[group_by=[%v],window=[%v],metrics=[%v],keep_raw=[%v]]",
+		processorName, p.config.GroupBy, p.config.Window, p.config.Metrics, p.config.KeepRaw,
+	)
+}
+
+// makeKey returns the group key for the group_by values.
+func makeKey(values []any) string {
+	var buf strings.Builder
+	for i, v := range values {
+		if i != 0 {
+			buf.WriteByte(0)
+		}
+		if v != nil {
+			fmt.Fprint(&buf, v)
+		}
+	}
+	return buf.String()
+}
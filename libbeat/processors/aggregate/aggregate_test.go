@@ -0,0 +1,231 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregate
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+func TestNew(t *testing.T) {
+	cases := map[string]struct {
+		config mapstr.M
+		err    bool
+	}{
+		"default":        {config: mapstr.M{}},
+		"metrics":        {config: mapstr.M{"group_by": []string{"source.ip"}, "metrics": []mapstr.M{{"type": "sum", "field": "network.bytes"}}}},
+		"unknown_metric": {config: mapstr.M{"metrics": []mapstr.M{{"type": "avg", "field": "network.bytes"}}}, err: true},
+		"missing_field":  {config: mapstr.M{"metrics": []mapstr.M{{"type": "max"}}}, err: true},
+		"zero_window":    {config: mapstr.M{"window": "0s"}, err: true},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := new(conf.MustNewConfigFrom(test.config), logptest.NewTestingLogger(t, ""))
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// collector records published summary events.
+type collector struct {
+	mu     sync.Mutex
+	events []beat.Event
+}
+
+func (c *collector) publish(e beat.Event) {
+	c.mu.Lock()
+	c.events = append(c.events, e)
+	c.mu.Unlock()
+}
+
+func (c *collector) published() []beat.Event {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]beat.Event(nil), c.events...)
+}
+
+func newTestAggregate(t *testing.T, cfg mapstr.M, clock clockwork.Clock) *aggregate {
+	t.Helper()
+	config := defaultConfig()
+	require.NoError(t, conf.MustNewConfigFrom(cfg).Unpack(&config))
+	reg := monitoring.NewRegistry()
+	return newAggregate(config, clock, logptest.NewTestingLogger(t, ""), metrics{
+		Groups:    monitoring.NewInt(reg, "groups"),
+		Summaries: monitoring.NewInt(reg, "summaries"),
+		Overflow:  monitoring.NewInt(reg, "overflow"),
+	})
+}
+
+func TestAggregate(t *testing.T) {
+	start := time.Date(2026, 1, 2, 3, 4, 0, 0, time.UTC)
+	clock := clockwork.NewFakeClockAt(start.Add(10 * time.Second))
+	p := newTestAggregate(t, mapstr.M{
+		"group_by": []string{"source.ip", "destination.port"},
+		"window":   "1m",
+		"metrics": []mapstr.M{
+			{"type": "count"},
+			{"type": "sum", "field": "network.bytes"},
+			{"type": "min", "field": "network.bytes"},
+			{"type": "max", "field": "network.bytes"},
+			{"type": "cardinality", "field": "destination.ip"},
+		},
+	}, clock)
+	var c collector
+	p.SetPublisher(c.publish)
+
+	events := []mapstr.M{
+		{"source": mapstr.M{"ip": "10.0.0.1"}, "destination": mapstr.M{"ip": "10.1.0.1", "port": 443}, "network": mapstr.M{"bytes": 100}},
+		{"source": mapstr.M{"ip": "10.0.0.1"}, "destination": mapstr.M{"ip": "10.1.0.2", "port": 443}, "network": mapstr.M{"bytes": int64(50)}},
+		{"source": mapstr.M{"ip": "10.0.0.1"}, "destination": mapstr.M{"ip": "10.1.0.1", "port": 443}, "network": mapstr.M{"bytes": 25.5}},
+		{"source": mapstr.M{"ip": "10.0.0.2"}, "destination": mapstr.M{"ip": "10.1.0.1", "port": 443}, "network": mapstr.M{"bytes": "n/a"}},
+	}
+	for _, e := range events {
+		out, err := p.Run(&beat.Event{Fields: e, Meta: mapstr.M{"index": "firewall"}})
+		require.NoError(t, err)
+		assert.Nil(t, out, "raw events must be dropped")
+	}
+	assert.Empty(t, c.published())
+
+	// End of the window.
+	clock.BlockUntil(1)
+	clock.Advance(time.Minute)
+	require.Eventually(t, func() bool { return len(c.published()) == 2 }, 5*time.Second, time.Millisecond)
+
+	got := map[any]beat.Event{}
+	for _, e := range c.published() {
+		ip, err := e.GetValue("source.ip")
+		require.NoError(t, err)
+		got[ip] = e
+	}
+
+	want := beat.Event{
+		Timestamp: start,
+		Meta:      mapstr.M{"index": "firewall"},
+		Fields: mapstr.M{
+			"event":       mapstr.M{"kind": "metric", "start": start, "end": start.Add(time.Minute)},
+			"source":      mapstr.M{"ip": "10.0.0.1"},
+			"destination": mapstr.M{"port": 443},
+			"aggregate": mapstr.M{
+				"count": 3,
+				"network": mapstr.M{"bytes": mapstr.M{
+					"sum": 175.5,
+					"min": 25.5,
+					"max": 100.0,
+				}},
+				"destination": mapstr.M{"ip": mapstr.M{"cardinality": 2}},
+			},
+		},
+	}
+	assert.Equal(t, want, got["10.0.0.1"])
+
+	// Metrics without values are omitted.
+	assert.Equal(t, mapstr.M{
+		"count":       1,
+		"destination": mapstr.M{"ip": mapstr.M{"cardinality": 1}},
+	}, got["10.0.0.2"].Fields["aggregate"])
+
+	// The partial window is flushed on close.
+	_, err := p.Run(&beat.Event{Fields: mapstr.M{"source": mapstr.M{"ip": "10.0.0.3"}}})
+	require.NoError(t, err)
+	require.NoError(t, p.Close())
+	published := c.published()
+	require.Len(t, published, 3)
+	assert.Equal(t, start.Add(time.Minute), published[2].Timestamp)
+	assert.Equal(t, mapstr.M{
+		"count":       1,
+		"destination": mapstr.M{"ip": mapstr.M{"cardinality": 0}},
+	}, published[2].Fields["aggregate"])
+}
+
+func TestAggregateKeepRawAndOverflow(t *testing.T) {
+	p := newTestAggregate(t, mapstr.M{
+		"group_by":   []string{"host.name"},
+		"keep_raw":   true,
+		"max_groups": 1,
+	}, clockwork.NewFakeClock())
+	var c collector
+	p.SetPublisher(c.publish)
+
+	for _, host := range []string{"a", "a", "b"} {
+		e := &beat.Event{Fields: mapstr.M{"host": mapstr.M{"name": host}}}
+		out, err := p.Run(e)
+		require.NoError(t, err)
+		assert.Equal(t, e, out)
+	}
+	assert.Equal(t, int64(1), p.metrics.Overflow.Get())
+
+	require.NoError(t, p.Close())
+	published := c.published()
+	require.Len(t, published, 1)
+	assert.Equal(t, mapstr.M{"count": 2}, published[0].Fields["aggregate"])
+}
+
+func TestAggregateWithoutPublisher(t *testing.T) {
+	p := newTestAggregate(t, mapstr.M{}, clockwork.NewFakeClock())
+	e := &beat.Event{Fields: mapstr.M{"message": "hello"}}
+	out, err := p.Run(e)
+	assert.ErrorIs(t, err, errNoPublisher)
+	assert.Equal(t, e, out)
+	require.NoError(t, p.Close())
+}
+
+func TestAggregateFlush(t *testing.T) {
+	t.Run("publishes the partial window", func(t *testing.T) {
+		p := newTestAggregate(t, mapstr.M{}, clockwork.NewFakeClock())
+		var c collector
+		p.SetPublisher(c.publish)
+
+		_, err := p.Run(&beat.Event{Fields: mapstr.M{"message": "hello"}})
+		require.NoError(t, err)
+		p.Flush()
+		require.Len(t, c.published(), 1)
+
+		require.NoError(t, p.Close())
+		assert.Len(t, c.published(), 1, "summaries must not be published twice")
+	})
+
+	t.Run("does not wait for a blocked publisher", func(t *testing.T) {
+		p := newTestAggregate(t, mapstr.M{}, clockwork.NewFakeClock())
+		p.flushTimeout = 10 * time.Millisecond
+		unblock := make(chan struct{})
+		p.SetPublisher(func(beat.Event) { <-unblock })
+
+		_, err := p.Run(&beat.Event{Fields: mapstr.M{"message": "hello"}})
+		require.NoError(t, err)
+		p.Flush()
+
+		close(unblock)
+		require.NoError(t, p.Close())
+	})
+}
@@ -0,0 +1,102 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregate
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	metricCount       = "count"
+	metricSum         = "sum"
+	metricMin         = "min"
+	metricMax         = "max"
+	metricCardinality = "cardinality"
+)
+
+// config for aggregate processor.
+type config struct {
+	// GroupBy are the fields whose values identify a group.
+	GroupBy []string `config:"group_by"`
+
+	// Window is the duration of the tumbling windows.
+	Window time.Duration `config:"window" validate:"nonzero,positive"`
+
+	// Metrics are the aggregations computed for each group.
+	Metrics []metricConfig `config:"metrics"`
+
+	// TargetField is the field the computed metrics are
+	// written to in summary events.
+	TargetField string `config:"target_field"`
+
+	// KeepRaw: Publish the aggregated events as well as the
+	// summaries.
+	KeepRaw bool `config:"keep_raw"`
+
+	// MaxGroups is the maximum number of groups held in a
+	// window. Events for new groups beyond the limit are not
+	// aggregated and are passed through unchanged.
+	MaxGroups int `config:"max_groups" validate:"min=1"`
+}
+
+type metricConfig struct {
+	// Type is one of count, sum, min, max or cardinality.
+	Type string `config:"type" validate:"required"`
+
+	// Field is the field the metric is computed over. It is
+	// not used by count.
+	Field string `config:"field"`
+}
+
+func defaultConfig() config {
+	return config{
+		Window:      time.Minute,
+		Metrics:     []metricConfig{{Type: metricCount}},
+		TargetField: "aggregate",
+		MaxGroups:   10000,
+	}
+}
+
+func (c *config) Validate() error {
+	if len(c.Metrics) == 0 {
+		return errors.New("at least one metric must be configured")
+	}
+	for _, m := range c.Metrics {
+		switch m.Type {
+		case metricCount:
+		case metricSum, metricMin, metricMax, metricCardinality:
+			if m.Field == "" {
+				return fmt.Errorf("%s metric requires a field", m.Type)
+			}
+		default:
+			return fmt.Errorf("unknown metric type %q: must be one of count, sum, min, max or cardinality", m.Type)
+		}
+	}
+	return nil
+}
+
+// name returns the field, relative to the target field, that the
+// metric is written to in summary events.
+func (m metricConfig) name() string {
+	if m.Type == metricCount {
+		return metricCount
+	}
+	return m.Field + "." + m.Type
+}
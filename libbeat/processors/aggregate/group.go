@@ -0,0 +1,213 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package aggregate
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// group accumulates the metrics of the events sharing a set of group_by
+// values within a window.
+type group struct {
+	// values are the group_by field values, nil where the
+	// field was missing.
+	values []any
+	// meta is the metadata of the first event in the group.
+	meta mapstr.M
+
+	count   int
+	metrics []accumulator
+}
+
+func newGroup(values []any, meta mapstr.M, metrics []metricConfig) *group {
+	g := &group{
+		values:  values,
+		meta:    meta,
+		metrics: make([]accumulator, len(metrics)),
+	}
+	for i, m := range metrics {
+		g.metrics[i] = newAccumulator(m)
+	}
+	return g
+}
+
+// add adds the event to the group's metrics.
+func (g *group) add(event *beat.Event) {
+	g.count++
+	for _, m := range g.metrics {
+		m.add(event)
+	}
+}
+
+// summary returns the summary event for the group in the window
+// [start, end).
+func (g *group) summary(cfg config, start, end time.Time) beat.Event {
+	event := beat.Event{
+		Timestamp: start,
+		Meta:      g.meta,
+		Fields: mapstr.M{
+			"event": mapstr.M{
+				"kind":  "metric",
+				"start": start,
+				"end":   end,
+			},
+		},
+	}
+	for i, field := range cfg.GroupBy {
+		if g.values[i] != nil {
+			_, _ = event.PutValue(field, g.values[i])
+		}
+	}
+	for i, m := range cfg.Metrics {
+		v, ok := g.metrics[i].value(g.count)
+		if !ok {
+			continue
+		}
+		field := m.name()
+		if cfg.TargetField != "" {
+			field = cfg.TargetField + "." + field
+		}
+		_, _ = event.PutValue(field, v)
+	}
+	return event
+}
+
+// accumulator computes a single metric over the events of a group.
+type accumulator interface {
+	add(event *beat.Event)
+	// value returns the metric value for a group of count
+	// events, and false if there is no value.
+	value(count int) (any, bool)
+}
+
+func newAccumulator(m metricConfig) accumulator {
+	switch m.Type {
+	case metricSum:
+		return &sum{field: m.Field}
+	case metricMin:
+		return &extreme{field: m.Field, less: func(a, b float64) bool { return a < b }}
+	case metricMax:
+		return &extreme{field: m.Field, less: func(a, b float64) bool { return a > b }}
+	case metricCardinality:
+		return &cardinality{field: m.Field, seen: make(map[string]struct{})}
+	default:
+		return counter{}
+	}
+}
+
+// counter is the count metric. The count is held by the group.
+type counter struct{}
+
+func (counter) add(*beat.Event)             {}
+func (counter) value(count int) (any, bool) { return count, true }
+
+type sum struct {
+	field string
+	total float64
+	found bool
+}
+
+func (s *sum) add(event *beat.Event) {
+	if v, ok := numberValue(event, s.field); ok {
+		s.total += v
+		s.found = true
+	}
+}
+
+func (s *sum) value(int) (any, bool) { return s.total, s.found }
+
+// extreme is the min or max metric depending on less.
+type extreme struct {
+	field string
+	less  func(a, b float64) bool
+	val   float64
+	found bool
+}
+
+func (e *extreme) add(event *beat.Event) {
+	v, ok := numberValue(event, e.field)
+	if !ok {
+		return
+	}
+	if !e.found || e.less(v, e.val) {
+		e.val = v
+		e.found = true
+	}
+}
+
+func (e *extreme) value(int) (any, bool) { return e.val, e.found }
+
+// cardinality is the number of distinct values of a field.
+type cardinality struct {
+	field string
+	seen  map[string]struct{}
+}
+
+func (c *cardinality) add(event *beat.Event) {
+	v, err := event.GetValue(c.field)
+	if err != nil || v == nil {
+		return
+	}
+	c.seen[fmt.Sprint(v)] = struct{}{}
+}
+
+func (c *cardinality) value(int) (any, bool) { return len(c.seen), true }
+
+// numberValue returns the value of a numeric field of the event. It returns
+// false if the field is missing or not a number.
+func numberValue(event *beat.Event, field string) (float64, bool) {
+	v, err := event.GetValue(field)
+	if err != nil {
+		return 0, false
+	}
+	var f float64
+	switch v := v.(type) {
+	case int:
+		f = float64(v)
+	case int8:
+		f = float64(v)
+	case int16:
+		f = float64(v)
+	case int32:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case uint:
+		f = float64(v)
+	case uint8:
+		f = float64(v)
+	case uint16:
+		f = float64(v)
+	case uint32:
+		f = float64(v)
+	case uint64:
+		f = float64(v)
+	case float32:
+		f = float64(v)
+	case float64:
+		f = v
+	default:
+		return 0, false
+	}
+	return f, !math.IsNaN(f)
+}
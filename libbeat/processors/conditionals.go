@@ -90,6 +90,17 @@ func (r *WhenProcessor) SetPaths(paths *paths.Path) error {
 	return nil
 }
 
+// SetPublisher delegates to the underlying processor. Events published by the
+// processor are not subject to the condition.
+func (r *WhenProcessor) SetPublisher(publish func(beat.Event)) {
+	SetPublisher(r.p, publish)
+}
+
+// Flush delegates to the underlying processor.
+func (r *WhenProcessor) Flush() {
+	Flush(r.p)
+}
+
 func (r *WhenProcessor) String() string {
 	return fmt.Sprintf("%v, condition=%v", r.p.String(), r.condition.String())
 }
@@ -284,6 +295,34 @@ func (p *IfThenElseProcessor) SetPaths(paths *paths.Path) error {
 	return err
 }
 
+// SetPublisher sets the publisher of the processors attached to the then
+// statement and the else statement.
+func (p *IfThenElseProcessor) SetPublisher(publish func(beat.Event)) {
+	p.then.SetPublisher(publish)
+	if p.els != nil {
+		p.els.SetPublisher(publish)
+	}
+}
+
+// Flush flushes the processors attached to the then statement and the else
+// statement.
+func (p *IfThenElseProcessor) Flush() {
+	p.then.Flush()
+	if p.els != nil {
+		p.els.Flush()
+	}
+}
+
+// nested returns the processors attached to the then statement and the else
+// statement.
+func (p *IfThenElseProcessor) nested() []beat.Processor {
+	nested := []beat.Processor{p.then}
+	if p.els != nil {
+		nested = append(nested, p.els)
+	}
+	return nested
+}
+
 func (p *IfThenElseProcessor) String() string {
 	var sb strings.Builder
 	sb.WriteString("if ")
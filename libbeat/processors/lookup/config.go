@@ -0,0 +1,135 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	formatCSV  = "csv"
+	formatJSON = "json"
+)
+
+type config struct {
+	// File is the path to the table file. Relative paths are
+	// resolved against the beat's configuration directory.
+	File string `config:"file" validate:"required"`
+
+	// Format is the table file format, either "csv" or "json".
+	// If empty it is inferred from the file extension.
+	Format string `config:"format"`
+
+	// Match lists the event fields and the table columns that
+	// must be equal for a row to match the event.
+	Match []matchConfig `config:"match" validate:"required"`
+
+	// Fields lists the table columns to copy into the event.
+	Fields []fieldConfig `config:"fields" validate:"required"`
+
+	// ReloadInterval is the period between checks for changes
+	// to the table file. A zero value disables reloading.
+	ReloadInterval time.Duration `config:"reload_interval" validate:"min=0"`
+
+	// IgnoreMissing: Ignore errors if the event has no value
+	// for one of the match fields.
+	IgnoreMissing bool `config:"ignore_missing"`
+
+	// IgnoreFailure: Ignore errors writing fields into the event.
+	IgnoreFailure bool `config:"ignore_failure"`
+
+	// OverwriteKeys allow target fields to overwrite existing fields.
+	OverwriteKeys bool `config:"overwrite_keys"`
+}
+
+type matchConfig struct {
+	// Field is the event field holding the value to match.
+	Field string `config:"field" validate:"required"`
+
+	// Column is the table column compared with the field value.
+	Column string `config:"column" validate:"required"`
+}
+
+type fieldConfig struct {
+	// Column is the table column to copy into the event.
+	Column string `config:"column" validate:"required"`
+
+	// Target is the destination field. It defaults to the
+	// column name.
+	Target string `config:"target"`
+
+	// Default is the value written to Target when no row
+	// matches the event. If nil, nothing is written on a miss.
+	Default any `config:"default"`
+}
+
+func defaultConfig() config {
+	return config{
+		ReloadInterval: time.Minute,
+		IgnoreMissing:  true,
+		IgnoreFailure:  false,
+		OverwriteKeys:  false,
+	}
+}
+
+func (c *config) Validate() error {
+	if _, err := c.tableFormat(); err != nil {
+		return err
+	}
+	if len(c.Match) == 0 {
+		return errors.New("at least one match entry must be configured")
+	}
+	if len(c.Fields) == 0 {
+		return errors.New("at least one field entry must be configured")
+	}
+	return nil
+}
+
+// tableFormat returns the configured table format, inferring it from the
+// file extension when it is not set explicitly.
+func (c *config) tableFormat() (string, error) {
+	format := c.Format
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(c.File)) {
+		case ".csv":
+			format = formatCSV
+		case ".json", ".ndjson":
+			format = formatJSON
+		default:
+			return "", fmt.Errorf("cannot infer table format from file name %q: set format to csv or json", c.File)
+		}
+	}
+	switch format {
+	case formatCSV, formatJSON:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unsupported table format %q: must be csv or json", format)
+	}
+}
+
+// target returns the destination field for the column.
+func (f fieldConfig) target() string {
+	if f.Target == "" {
+		return f.Column
+	}
+	return f.Target
+}
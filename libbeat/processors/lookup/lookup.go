@@ -0,0 +1,246 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+const name = "lookup"
+
+func init() {
+	// We cannot use this as a JS plugin as it is stateful and includes a Close method.
+	processors.RegisterPlugin(name, New)
+}
+
+var instanceID atomic.Uint32
+
+// lookup is an enrichment processor that copies columns from the rows of
+// a table file into events with matching field values.
+type lookup struct {
+	config config
+	format string
+	path   string
+
+	// table holds the currently loaded table. It is nil
+	// until SetPaths has been called.
+	table atomic.Pointer[table]
+
+	cancel context.CancelFunc
+	log    *logp.Logger
+}
+
+// New returns a new lookup processor. The table is loaded when SetPaths is
+// called. The resulting processor implements Close() to stop reloading
+// the table file.
+func New(cfg *conf.C, log *logp.Logger) (beat.Processor, error) {
+	config := defaultConfig()
+	err := cfg.Unpack(&config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack the %s configuration: %w", name, err)
+	}
+	format, err := config.tableFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	// Logging (each processor instance has a unique ID).
+	id := int(instanceID.Add(1))
+	log = log.Named(name).With("instance_id", id)
+
+	return &lookup{
+		config: config,
+		format: format,
+		cancel: noop,
+		log:    log,
+	}, nil
+}
+
+// SetPaths loads the lookup table using the provided paths configuration
+// and starts watching the file for changes. This method must be called
+// before the processor can be used.
+func (p *lookup) SetPaths(path *paths.Path) error {
+	p.path = path.Resolve(paths.Config, p.config.File)
+	t, err := loadTable(p.path, p.format, p.keyColumns())
+	if err != nil {
+		return fmt.Errorf("%s processor could not load table: %w", name, err)
+	}
+	p.store(t)
+
+	if p.config.ReloadInterval > 0 {
+		var ctx context.Context
+		ctx, p.cancel = context.WithCancel(context.Background())
+		go p.watch(ctx, p.config.ReloadInterval)
+	}
+	return nil
+}
+
+// keyColumns returns the table columns used to match events.
+func (p *lookup) keyColumns() []string {
+	cols := make([]string, len(p.config.Match))
+	for i, m := range p.config.Match {
+		cols[i] = m.Column
+	}
+	return cols
+}
+
+// store replaces the current table with t.
+func (p *lookup) store(t *table) {
+	if t.duplicates != 0 {
+		p.log.Warnw("lookup table contains duplicate keys, only the first row for each key is used", "path", p.path, "duplicates", t.duplicates)
+	}
+	p.table.Store(t)
+	p.log.Infow("loaded lookup table", "path", p.path, "rows", len(t.rows))
+}
+
+// watch checks the table file for changes at the specified interval until
+// the context is cancelled, reloading it when it has changed. If the file
+// cannot be read the previously loaded table is retained.
+func (p *lookup) watch(ctx context.Context, every time.Duration) {
+	tick := time.NewTicker(every)
+	defer tick.Stop()
+	for {
+		select {
+		case <-tick.C:
+			p.reload()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reload loads the table file if it has changed since it was last loaded.
+func (p *lookup) reload() {
+	info, err := os.Stat(p.path)
+	if err != nil {
+		p.log.Errorw("failed to stat lookup table, keeping previous table", "path", p.path, "error", err)
+		return
+	}
+	if !p.table.Load().changed(info) {
+		return
+	}
+	t, err := loadTable(p.path, p.format, p.keyColumns())
+	if err != nil {
+		p.log.Errorw("failed to reload lookup table, keeping previous table", "path", p.path, "error", err)
+		return
+	}
+	p.store(t)
+}
+
+// Run enriches the given event with the columns of the matching table row,
+// or with the configured defaults if no row matches.
+func (p *lookup) Run(event *beat.Event) (*beat.Event, error) {
+	t := p.table.Load()
+	if t == nil {
+		return event, errors.New("lookup processor not initialized: SetPaths must be called")
+	}
+
+	values := make([]any, len(p.config.Match))
+	for i, m := range p.config.Match {
+		v, err := event.GetValue(m.Field)
+		if err != nil {
+			if p.config.IgnoreMissing && errors.Is(err, mapstr.ErrKeyNotFound) {
+				return event, nil
+			}
+			return event, fmt.Errorf("error applying %s processor: %w", name, err)
+		}
+		values[i] = v
+	}
+	row, found := t.rows[key(values)]
+
+	err := p.enrich(event, row, found)
+	if err != nil && !p.config.IgnoreFailure {
+		return event, fmt.Errorf("error applying %s processor: %w", name, err)
+	}
+	return event, nil
+}
+
+// enrich writes the configured columns of row into the event. If found is
+// false, only the configured defaults are written.
+func (p *lookup) enrich(event *beat.Event, row mapstr.M, found bool) error {
+	var errs []error
+	for _, f := range p.config.Fields {
+		val := f.Default
+		if found {
+			if v, ok := row[f.Column]; ok {
+				val = v
+			}
+		}
+		if val == nil {
+			continue
+		}
+
+		dst := f.target()
+		if !p.config.OverwriteKeys {
+			if _, err := event.GetValue(dst); err == nil {
+				errs = append(errs, fmt.Errorf("target field '%s' already exists and overwrite_keys is false", dst))
+				continue
+			}
+		}
+		// Table rows are shared between events, so they must
+		// not be aliased by the event.
+		if _, err := event.PutValue(dst, clone(val)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// clone returns a deep copy of composite values.
+func clone(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		return mapstr.M(v).Clone()
+	case mapstr.M:
+		return v.Clone()
+	case []any:
+		c := make([]any, len(v))
+		for i, e := range v {
+			c[i] = clone(e)
+		}
+		return c
+	default:
+		return v
+	}
+}
+
+func (p *lookup) Close() error {
+	p.cancel()
+	return nil
+}
+
+// noop is a no-op context.CancelFunc.
+func noop() {}
+
+// String returns the processor representation formatted as a string
+func (p *lookup) String() string {
+	return fmt.Sprintf("%s=[file=%s, format=%s, match=%v, fields=%v, reload_interval=%v, ignore_missing=%t, ignore_failure=%t, overwrite_keys=%t]",
+		name, p.config.File, p.format, p.config.Match, p.config.Fields, p.config.ReloadInterval, p.config.IgnoreMissing, p.config.IgnoreFailure, p.config.OverwriteKeys)
+}
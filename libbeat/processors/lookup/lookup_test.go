@@ -0,0 +1,268 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+const ownersCSV = `hostname,owner,team
+web-1,alice,frontend
+db-1,bob,storage
+db-1,carol,storage
+`
+
+const codesJSON = `[
+  {"provider": "Security", "code": 4624, "description": "An account was successfully logged on", "tags": ["logon"]},
+  {"provider": "Security", "code": 4625, "description": "An account failed to log on"},
+  {"provider": "System", "code": 4624, "description": "Not a logon"}
+]`
+
+const codesNDJSON = `{"provider": "Security", "code": 4624, "description": "An account was successfully logged on"}
+{"provider": "Security", "code": 4625, "description": "An account failed to log on"}
+`
+
+var lookupTests = []struct {
+	name    string
+	file    string
+	content string
+	cfg     mapstr.M
+	event   mapstr.M
+	want    mapstr.M
+	wantErr bool
+}{
+	{
+		name:    "csv_match",
+		file:    "owners.csv",
+		content: ownersCSV,
+		cfg: mapstr.M{
+			"match":  []mapstr.M{{"field": "host.name", "column": "hostname"}},
+			"fields": []mapstr.M{{"column": "owner", "target": "host.owner"}, {"column": "team"}},
+		},
+		event: mapstr.M{"host": mapstr.M{"name": "web-1"}},
+		want:  mapstr.M{"host": mapstr.M{"name": "web-1", "owner": "alice"}, "team": "frontend"},
+	},
+	{
+		name:    "csv_duplicate_first_wins",
+		file:    "owners.csv",
+		content: ownersCSV,
+		cfg: mapstr.M{
+			"match":  []mapstr.M{{"field": "host.name", "column": "hostname"}},
+			"fields": []mapstr.M{{"column": "owner", "target": "host.owner"}},
+		},
+		event: mapstr.M{"host": mapstr.M{"name": "db-1"}},
+		want:  mapstr.M{"host": mapstr.M{"name": "db-1", "owner": "bob"}},
+	},
+	{
+		name:    "csv_miss_default",
+		file:    "owners.csv",
+		content: ownersCSV,
+		cfg: mapstr.M{
+			"match": []mapstr.M{{"field": "host.name", "column": "hostname"}},
+			"fields": []mapstr.M{
+				{"column": "owner", "target": "host.owner", "default": "unknown"},
+				{"column": "team", "target": "host.team"},
+			},
+		},
+		event: mapstr.M{"host": mapstr.M{"name": "mail-1"}},
+		want:  mapstr.M{"host": mapstr.M{"name": "mail-1", "owner": "unknown"}},
+	},
+	{
+		name:    "csv_missing_match_column",
+		file:    "owners.csv",
+		content: ownersCSV,
+		cfg: mapstr.M{
+			"match":  []mapstr.M{{"field": "host.name", "column": "host"}},
+			"fields": []mapstr.M{{"column": "owner"}},
+		},
+		wantErr: true,
+	},
+	{
+		name:    "json_multi_field_match",
+		file:    "codes.json",
+		content: codesJSON,
+		cfg: mapstr.M{
+			"match": []mapstr.M{
+				{"field": "event.provider", "column": "provider"},
+				{"field": "event.code", "column": "code"},
+			},
+			"fields": []mapstr.M{
+				{"column": "description", "target": "event.description"},
+				{"column": "tags", "target": "tags"},
+			},
+		},
+		event: mapstr.M{"event": mapstr.M{"provider": "Security", "code": 4624}},
+		want: mapstr.M{
+			"event": mapstr.M{"provider": "Security", "code": 4624, "description": "An account was successfully logged on"},
+			"tags":  []any{"logon"},
+		},
+	},
+	{
+		name:    "json_string_value_matches_number",
+		file:    "codes.json",
+		content: codesJSON,
+		cfg: mapstr.M{
+			"match": []mapstr.M{
+				{"field": "event.provider", "column": "provider"},
+				{"field": "event.code", "column": "code"},
+			},
+			"fields": []mapstr.M{{"column": "description", "target": "event.description"}},
+		},
+		event: mapstr.M{"event": mapstr.M{"provider": "Security", "code": "4625"}},
+		want:  mapstr.M{"event": mapstr.M{"provider": "Security", "code": "4625", "description": "An account failed to log on"}},
+	},
+	{
+		name:    "ndjson",
+		file:    "codes.ndjson",
+		content: codesNDJSON,
+		cfg: mapstr.M{
+			"match":  []mapstr.M{{"field": "event.code", "column": "code"}},
+			"fields": []mapstr.M{{"column": "description", "target": "event.description"}},
+		},
+		event: mapstr.M{"event": mapstr.M{"code": 4625}},
+		want:  mapstr.M{"event": mapstr.M{"code": 4625, "description": "An account failed to log on"}},
+	},
+	{
+		name:    "ignore_missing",
+		file:    "owners.csv",
+		content: ownersCSV,
+		cfg: mapstr.M{
+			"match":  []mapstr.M{{"field": "host.name", "column": "hostname"}},
+			"fields": []mapstr.M{{"column": "owner", "default": "unknown"}},
+		},
+		event: mapstr.M{"message": "hello"},
+		want:  mapstr.M{"message": "hello"},
+	},
+	{
+		name:    "no_overwrite",
+		file:    "owners.csv",
+		content: ownersCSV,
+		cfg: mapstr.M{
+			"match":  []mapstr.M{{"field": "host.name", "column": "hostname"}},
+			"fields": []mapstr.M{{"column": "owner"}},
+		},
+		event:   mapstr.M{"host": mapstr.M{"name": "web-1"}, "owner": "dave"},
+		want:    mapstr.M{"host": mapstr.M{"name": "web-1"}, "owner": "dave"},
+		wantErr: true,
+	},
+	{
+		name:    "overwrite",
+		file:    "owners.csv",
+		content: ownersCSV,
+		cfg: mapstr.M{
+			"match":          []mapstr.M{{"field": "host.name", "column": "hostname"}},
+			"fields":         []mapstr.M{{"column": "owner"}},
+			"overwrite_keys": true,
+		},
+		event: mapstr.M{"host": mapstr.M{"name": "web-1"}, "owner": "dave"},
+		want:  mapstr.M{"host": mapstr.M{"name": "web-1"}, "owner": "alice"},
+	},
+}
+
+func TestLookup(t *testing.T) {
+	for _, test := range lookupTests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, test.file), []byte(test.content), 0o600)
+			require.NoError(t, err)
+
+			cfg := test.cfg.Clone()
+			cfg["file"] = test.file
+			cfg["reload_interval"] = 0
+			p := newTestLookup(t, cfg, dir)
+			if p == nil {
+				if !test.wantErr {
+					t.Fatal("unexpected error initializing processor")
+				}
+				return
+			}
+
+			got, err := p.Run(&beat.Event{Fields: test.event})
+			if test.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.want, got.Fields)
+		})
+	}
+}
+
+func TestLookupReload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "owners.csv")
+	err := os.WriteFile(path, []byte(ownersCSV), 0o600)
+	require.NoError(t, err)
+
+	p := newTestLookup(t, mapstr.M{
+		"file":            path,
+		"match":           []mapstr.M{{"field": "host.name", "column": "hostname"}},
+		"fields":          []mapstr.M{{"column": "owner", "default": "unknown"}},
+		"reload_interval": "10ms",
+	}, dir)
+	require.NotNil(t, p)
+
+	owner := func() any {
+		got, err := p.Run(&beat.Event{Fields: mapstr.M{"host": mapstr.M{"name": "mail-1"}}})
+		require.NoError(t, err)
+		v, _ := got.Fields.GetValue("owner")
+		return v
+	}
+	require.Equal(t, "unknown", owner())
+
+	err = os.WriteFile(path, []byte(ownersCSV+"mail-1,erin,messaging\n"), 0o600)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return owner() == "erin" }, 5*time.Second, 10*time.Millisecond)
+
+	// A broken table must not replace the loaded one.
+	err = os.WriteFile(path, []byte("owner,team\n"), 0o600)
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, "erin", owner())
+}
+
+// newTestLookup returns an initialized lookup processor for cfg with its
+// config path set to dir, or nil if construction or loading failed.
+func newTestLookup(t *testing.T, cfg mapstr.M, dir string) *lookup {
+	t.Helper()
+	c, err := conf.NewConfigFrom(cfg)
+	require.NoError(t, err)
+	p, err := New(c, logptest.NewTestingLogger(t, ""))
+	if err != nil {
+		return nil
+	}
+	l := p.(*lookup)
+	t.Cleanup(func() { l.Close() })
+	err = l.SetPaths(&paths.Path{Home: dir, Config: dir, Data: dir, Logs: dir})
+	if err != nil {
+		return nil
+	}
+	return l
+}
@@ -0,0 +1,210 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package lookup
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// table is an immutable in-memory lookup table indexed by the
+// values of its match columns.
+type table struct {
+	rows map[string]mapstr.M
+
+	// modTime and size identify the version of the file the
+	// table was loaded from.
+	modTime time.Time
+	size    int64
+
+	// duplicates is the number of rows that were ignored because
+	// an earlier row had the same key.
+	duplicates int
+}
+
+// loadTable reads the table file at path in the given format and indexes
+// its rows by the values of keyColumns.
+func loadTable(path, format string, keyColumns []string) (*table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lookup table: %w", err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat lookup table: %w", err)
+	}
+
+	var rows []mapstr.M
+	switch format {
+	case formatCSV:
+		rows, err = readCSV(f, keyColumns)
+	case formatJSON:
+		rows, err = readJSON(f)
+	default:
+		// This should have been caught by config validation.
+		err = fmt.Errorf("unsupported table format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read lookup table %s: %w", path, err)
+	}
+
+	t := &table{
+		rows:    make(map[string]mapstr.M, len(rows)),
+		modTime: info.ModTime(),
+		size:    info.Size(),
+	}
+	values := make([]any, len(keyColumns))
+rows:
+	for _, r := range rows {
+		for i, col := range keyColumns {
+			v, ok := r[col]
+			if !ok || v == nil {
+				// Rows without a complete key can never match.
+				continue rows
+			}
+			values[i] = v
+		}
+		k := key(values)
+		if _, ok := t.rows[k]; ok {
+			t.duplicates++
+			continue
+		}
+		t.rows[k] = r
+	}
+	return t, nil
+}
+
+// changed returns whether the file described by info differs from the
+// file the table was loaded from.
+func (t *table) changed(info os.FileInfo) bool {
+	return !info.ModTime().Equal(t.modTime) || info.Size() != t.size
+}
+
+// readCSV reads rows from a CSV document. The first record is the header
+// naming the columns.
+func readCSV(r io.Reader, keyColumns []string) ([]mapstr.M, error) {
+	cr := csv.NewReader(r)
+	header, err := cr.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("missing header")
+		}
+		return nil, err
+	}
+	for i, h := range header {
+		header[i] = strings.TrimSpace(h)
+	}
+	for _, col := range keyColumns {
+		if !slices.Contains(header, col) {
+			return nil, fmt.Errorf("match column %q not found in header", col)
+		}
+	}
+
+	var rows []mapstr.M
+	for {
+		rec, err := cr.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return rows, nil
+			}
+			return nil, err
+		}
+		row := make(mapstr.M, len(header))
+		for i, v := range rec {
+			row[header[i]] = v
+		}
+		rows = append(rows, row)
+	}
+}
+
+// readJSON reads rows from either a JSON array of objects or a stream of
+// newline-delimited JSON objects.
+func readJSON(r io.Reader) ([]mapstr.M, error) {
+	br := bufio.NewReader(r)
+	isArray, err := startsWithArray(br)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(br)
+	if isArray {
+		var rows []mapstr.M
+		err = dec.Decode(&rows)
+		if err != nil {
+			return nil, err
+		}
+		return rows, nil
+	}
+
+	var rows []mapstr.M
+	for {
+		var row mapstr.M
+		err = dec.Decode(&row)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return rows, nil
+			}
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+}
+
+// startsWithArray returns whether the first non-space byte in br opens
+// a JSON array. No data is consumed from br.
+func startsWithArray(br *bufio.Reader) (bool, error) {
+	for n := 1; ; n++ {
+		b, err := br.Peek(n)
+		if len(b) < n {
+			if errors.Is(err, io.EOF) {
+				return false, nil
+			}
+			return false, err
+		}
+		c := b[n-1]
+		if bytes.IndexByte([]byte(" \t\r\n"), c) >= 0 {
+			continue
+		}
+		return c == '[', nil
+	}
+}
+
+// key returns the table index for the given match values.
+func key(values []any) string {
+	var buf strings.Builder
+	for i, v := range values {
+		if i != 0 {
+			buf.WriteByte(0)
+		}
+		fmt.Fprint(&buf, v)
+	}
+	return buf.String()
+}
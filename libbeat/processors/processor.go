@@ -53,6 +53,28 @@ type PathSetter interface {
 	SetPaths(*paths.Path) error
 }
 
+// PublisherSetter is an interface for processors that publish events of their
+// own, such as periodic summaries, in addition to the events returned by Run.
+// SetPublisher is called by the pipeline client with a function that passes an
+// event through the processors following this one and publishes it. The
+// function may be called from any goroutine, events published after the
+// client closed its queue producer are dropped and counted as such. Such
+// processors can only be used in the processors of a client, not in the
+// global processors.
+type PublisherSetter interface {
+	SetPublisher(publish func(beat.Event))
+}
+
+// Flusher is an interface for processors that publish events of their own
+// and hold events that were not published yet. Flush is called by the
+// pipeline client when it is closed, before it stops accepting the events
+// published by its processors. From then on, events the queue can't accept
+// right away are dropped. Flush must return within a bounded time, even if
+// publishing blocks.
+type Flusher interface {
+	Flush()
+}
+
 // PdataProcessor is an optional interface that beat processors can implement to
 // operate directly on a pcommon.Map, avoiding the round-trip conversion to/from
 // mapstr.M. When all processors in a chain implement this interface, the
@@ -72,6 +94,54 @@ func Close(p beat.Processor) error {
 	return nil
 }
 
+// SetPublisher sets the publisher of a processor if it implements the
+// PublisherSetter interface
+func SetPublisher(p beat.Processor, publish func(beat.Event)) {
+	if setter, ok := p.(PublisherSetter); ok {
+		setter.SetPublisher(publish)
+	}
+}
+
+// Flush flushes a processor if it implements the Flusher interface
+func Flush(p beat.Processor) {
+	if flusher, ok := p.(Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// FindPublisher returns the first processor in p, including the processors
+// nested in conditions and lists, that publishes events of its own. It
+// returns nil if there is none.
+func FindPublisher(p beat.Processor) beat.Processor {
+	var nested []beat.Processor
+	switch p := p.(type) {
+	case *Processors:
+		nested = p.List
+	case *WhenProcessor:
+		nested = []beat.Processor{p.p}
+	case *ClosingWhenProcessor:
+		nested = []beat.Processor{p.p}
+	case *IfThenElseProcessor:
+		nested = p.nested()
+	case *ClosingIfThenElseProcessor:
+		nested = p.nested()
+	case *SafeProcessor:
+		nested = []beat.Processor{p.Processor}
+	case *safeProcessorWithClose:
+		nested = []beat.Processor{p.Processor}
+	default:
+		if _, ok := p.(PublisherSetter); ok {
+			return p
+		}
+	}
+	for _, sub := range nested {
+		if found := FindPublisher(sub); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
 // NewList creates a new empty processor list.
 // Additional processors can be added to the List field.
 func NewList(log *logp.Logger) *Processors {
@@ -177,6 +247,37 @@ func (procs *Processors) Close() error {
 	return errors.Join(errs...)
 }
 
+// SetPublisher sets the publisher of all processors in the list. Events
+// published by a processor are passed through the processors that follow
+// it in the list before being handed to publish.
+func (procs *Processors) SetPublisher(publish func(beat.Event)) {
+	for i, p := range procs.List {
+		if _, ok := p.(PublisherSetter); !ok {
+			continue
+		}
+		rest := &Processors{List: procs.List[i+1:], log: procs.log}
+		if rest.log == nil {
+			rest.log = logp.NewLogger(logName)
+		}
+		SetPublisher(p, func(e beat.Event) {
+			event, err := rest.Run(&e)
+			if err != nil {
+				rest.log.Errorf("Failed to process published event: %v", err)
+			}
+			if event != nil {
+				publish(*event)
+			}
+		})
+	}
+}
+
+// Flush flushes all processors in the list.
+func (procs *Processors) Flush() {
+	for _, p := range procs.List {
+		Flush(p)
+	}
+}
+
 // Run executes the all processors serially and returns the event and possibly
 // an error. If the event has been dropped (canceled) by a processor in the
 // list then a nil event is returned.
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/conditions"
 	"github.com/elastic/beats/v7/libbeat/processors"
 	_ "github.com/elastic/beats/v7/libbeat/processors/actions"
 	_ "github.com/elastic/beats/v7/libbeat/processors/add_cloud_metadata"
@@ -788,3 +789,60 @@ func generateFields(t require.TestingT, m mapstr.M, count, nesting int) {
 		require.NoError(t, err)
 	}
 }
+
+// publishingProcessor is a processor that publishes events of its own.
+type publishingProcessor struct {
+	publish func(beat.Event)
+}
+
+func (p *publishingProcessor) Run(event *beat.Event) (*beat.Event, error) { return event, nil }
+func (p *publishingProcessor) SetPublisher(publish func(beat.Event))      { p.publish = publish }
+func (p *publishingProcessor) String() string                             { return "publishing" }
+
+func TestSetPublisher(t *testing.T) {
+	following := GetProcessors(t, []map[string]interface{}{
+		{
+			"add_fields": map[string]interface{}{
+				"target": "",
+				"fields": map[string]interface{}{"processed": true},
+			},
+		},
+	})
+	pub := &publishingProcessor{}
+	list := processors.NewList(logptest.NewTestingLogger(t, ""))
+	list.AddProcessor(&publishingProcessor{})
+	list.AddProcessor(pub)
+	list.AddProcessors(*following)
+
+	var published []beat.Event
+	list.SetPublisher(func(e beat.Event) { published = append(published, e) })
+	require.NotNil(t, pub.publish)
+
+	// Published events pass through the processors that follow
+	// the publishing processor only.
+	pub.publish(beat.Event{Fields: mapstr.M{"message": "summary"}})
+	assert.Equal(t, []beat.Event{{Fields: mapstr.M{"message": "summary", "processed": true}}}, published)
+}
+
+func (p *publishingProcessor) Flush() { p.publish(beat.Event{Fields: mapstr.M{"message": "flushed"}}) }
+
+func TestFindPublisher(t *testing.T) {
+	list := GetProcessors(t, []map[string]interface{}{
+		{"add_fields": map[string]interface{}{"fields": map[string]interface{}{"a": 1}}},
+	})
+	assert.Nil(t, processors.FindPublisher(list))
+
+	var cond conditions.Config
+	require.NoError(t, conf.MustNewConfigFrom(map[string]interface{}{"has_fields": []string{"a"}}).Unpack(&cond))
+	pub := &publishingProcessor{}
+	when, err := processors.NewConditionRule(cond, pub, logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	list.AddProcessor(when)
+	assert.Same(t, pub, processors.FindPublisher(list), "publishing processors nested in conditions must be found")
+
+	var published []beat.Event
+	list.SetPublisher(func(e beat.Event) { published = append(published, e) })
+	list.Flush()
+	require.Len(t, published, 1)
+	assert.Equal(t, "flushed", published[0].Fields["message"])
+}
@@ -117,6 +117,34 @@ func (p *SafeProcessor) SetPaths(paths *paths.Path) error {
 	return fmt.Errorf("unknown state: %d", p.state)
 }
 
+// SetPublisher delegates to the underlying processor if it implements
+// PublisherSetter and has not been closed.
+func (p *SafeProcessor) SetPublisher(publish func(beat.Event)) {
+	if _, ok := p.Processor.(PublisherSetter); !ok {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.state == stateClosed {
+		return
+	}
+	SetPublisher(p.Processor, publish)
+}
+
+// Flush delegates to the underlying processor if it implements Flusher and
+// has not been closed.
+func (p *SafeProcessor) Flush() {
+	if _, ok := p.Processor.(Flusher); !ok {
+		return
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.state == stateClosed {
+		return
+	}
+	Flush(p.Processor)
+}
+
 // SafeWrap wraps a processor constructor to handle common edge cases:
 //
 //   - Multiple Close calls: Each processor might end up in multiple processor
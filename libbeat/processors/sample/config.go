@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sample
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// config for sample processor.
+type config struct {
+	// Rate is the fraction of events to keep in random and
+	// hash-based sampling.
+	Rate *float64 `config:"rate"`
+
+	// Fields are the fields whose values make up the sampling
+	// key. With Rate, events are sampled deterministically on
+	// the hash of the key. With Reservoir, a separate reservoir
+	// is kept for each key.
+	Fields []string `config:"fields"`
+
+	// Reservoir enables per-key reservoir sampling.
+	Reservoir *reservoirConfig `config:"reservoir"`
+
+	// SampleRateField is the field that kept events are annotated
+	// with. Its value is the number of events that each kept event
+	// represents. An empty value disables annotation.
+	SampleRateField string `config:"sample_rate_field"`
+}
+
+type reservoirConfig struct {
+	// Size is the number of events kept per key per window.
+	Size int `config:"size" validate:"required,min=1"`
+
+	// Window is the duration of each sampling window.
+	Window time.Duration `config:"window" validate:"required,nonzero,positive"`
+}
+
+func defaultConfig() config {
+	return config{
+		SampleRateField: "sample_rate",
+	}
+}
+
+func (c *config) Validate() error {
+	switch {
+	case c.Rate != nil && c.Reservoir != nil:
+		return errors.New("must specify only one of rate or reservoir")
+	case c.Rate != nil:
+		if *c.Rate <= 0 || *c.Rate > 1 {
+			return fmt.Errorf("rate must be greater than 0 and at most 1, got %v", *c.Rate)
+		}
+	case c.Reservoir != nil:
+	default:
+		return errors.New("must specify one of rate or reservoir")
+	}
+	return nil
+}
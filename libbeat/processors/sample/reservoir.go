@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sample
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+)
+
+// reservoirSampler keeps at most size events per key in each window.
+//
+// Events cannot be held back by a processor, so rather than filling a
+// reservoir and emitting it at the end of the window, each event is kept
+// with a probability derived from the number of events seen for its key
+// in the previous window. This spreads the kept events evenly over the
+// window while the key's event rate is stable. The first size events of
+// a key in a window are always eligible, and no more than size events are
+// kept per key and window. The sample rate of a key is not known until a
+// whole window of its events has been seen.
+type reservoirSampler struct {
+	mu sync.Mutex
+
+	size   int
+	window time.Duration
+	fields []string
+
+	windowEnd time.Time
+	buckets   map[string]*reservoir
+
+	clock clockwork.Clock
+}
+
+// reservoir holds the per-window state for a key.
+type reservoir struct {
+	seen     int // events seen in the current window
+	kept     int // events kept in the current window
+	prevSeen int // events seen in the previous window
+}
+
+func newReservoirSampler(fields []string, cfg reservoirConfig, clock clockwork.Clock) *reservoirSampler {
+	return &reservoirSampler{
+		size:      cfg.Size,
+		window:    cfg.Window,
+		fields:    fields,
+		windowEnd: clock.Now().Add(cfg.Window),
+		buckets:   make(map[string]*reservoir),
+		clock:     clock,
+	}
+}
+
+func (s *reservoirSampler) keep(event *beat.Event) (bool, float64) {
+	key, _ := makeKey(event, s.fields)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.advance()
+	r, ok := s.buckets[key]
+	if !ok {
+		r = &reservoir{}
+		s.buckets[key] = r
+	}
+	r.seen++
+
+	if r.kept >= s.size {
+		return false, 0
+	}
+	p := 1.0
+	if r.prevSeen > s.size {
+		p = float64(s.size) / float64(r.prevSeen)
+	}
+	if p < 1 && rand.Float64() >= p {
+		return false, 0
+	}
+	r.kept++
+	if r.prevSeen == 0 {
+		return true, 0
+	}
+	return true, 1 / p
+}
+
+// advance rotates the reservoirs if the current window has ended, dropping
+// keys that have not been seen for a whole window.
+func (s *reservoirSampler) advance() {
+	now := s.clock.Now()
+	if now.Before(s.windowEnd) {
+		return
+	}
+	// If more than one window has elapsed the keys were not
+	// seen in the previous window.
+	elapsed := now.Sub(s.windowEnd) >= s.window
+	for k, r := range s.buckets {
+		if r.seen == 0 || elapsed {
+			delete(s.buckets, k)
+			continue
+		}
+		*r = reservoir{prevSeen: r.seen}
+	}
+	s.windowEnd = now.Add(s.window - now.Sub(s.windowEnd)%s.window)
+}
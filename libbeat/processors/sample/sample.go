@@ -0,0 +1,179 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sample
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/jonboulle/clockwork"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	c "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// instanceID is used to assign each instance a unique monitoring namespace.
+var instanceID atomic.Uint32
+
+const processorName = "sample"
+const logName = "processor." + processorName
+
+func init() {
+	processors.RegisterPlugin(processorName, new)
+}
+
+type metrics struct {
+	Kept    *monitoring.Int
+	Dropped *monitoring.Int
+}
+
+// sampler decides whether events are kept.
+type sampler interface {
+	// keep returns whether the event should be kept and, if so, the
+	// number of events it represents, or 0 if that is not known yet.
+	keep(event *beat.Event) (bool, float64)
+}
+
+type sample struct {
+	config  config
+	sampler sampler
+
+	logger  *logp.Logger
+	metrics metrics
+}
+
+// new constructs a new sample processor.
+func new(cfg *c.C, log *logp.Logger) (beat.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("could not unpack processor configuration: %w", err)
+	}
+
+	// Logging and metrics (each processor instance has a unique ID).
+	var (
+		id  = int(instanceID.Add(1))
+		reg = monitoring.Default.GetOrCreateRegistry(logName+"."+strconv.Itoa(id), monitoring.DoNotReport)
+	)
+
+	p := &sample{
+		config: config,
+		logger: log.Named(logName).With("instance_id", id),
+		metrics: metrics{
+			Kept:    monitoring.NewInt(reg, "kept"),
+			Dropped: monitoring.NewInt(reg, "dropped"),
+		},
+	}
+
+	switch {
+	case config.Reservoir != nil:
+		p.sampler = newReservoirSampler(config.Fields, *config.Reservoir, clockwork.NewRealClock())
+	case len(config.Fields) != 0:
+		p.sampler = hashSampler{rate: *config.Rate, fields: config.Fields}
+	default:
+		p.sampler = randomSampler{rate: *config.Rate}
+	}
+
+	return p, nil
+}
+
+// Run applies the configured sampling to the given event. If the event is
+// sampled, it is returned annotated with its sample rate. If not, nil is
+// returned.
+func (p *sample) Run(event *beat.Event) (*beat.Event, error) {
+	keep, rate := p.sampler.keep(event)
+	if !keep {
+		p.metrics.Dropped.Inc()
+		return nil, nil
+	}
+
+	p.metrics.Kept.Inc()
+	if p.config.SampleRateField != "" && rate > 0 {
+		if _, err := event.PutValue(p.config.SampleRateField, rate); err != nil {
+			return event, fmt.Errorf("could not annotate event with sample rate: %w", err)
+		}
+	}
+	return event, nil
+}
+
+func (p *sample) String() string {
+	if p.config.Reservoir != nil {
+		return fmt.Sprintf(
+			"%v=[reservoir=[size=%v,window=%v],fields=[%v]]",
+			processorName, p.config.Reservoir.Size, p.config.Reservoir.Window, p.config.Fields,
+		)
+	}
+	return fmt.Sprintf(
+		"%v=[rate=[%v],fields=[%v]]",
+		processorName, *p.config.Rate, p.config.Fields,
+	)
+}
+
+// randomSampler keeps each event independently with a fixed probability.
+type randomSampler struct {
+	rate float64
+}
+
+func (s randomSampler) keep(*beat.Event) (bool, float64) {
+	return rand.Float64() < s.rate, 1 / s.rate
+}
+
+// hashSampler keeps events with a fixed probability decided by the hash of
+// their key, so that all events sharing a key are either kept or dropped
+// together. Events without any of the key fields are sampled randomly.
+type hashSampler struct {
+	rate   float64
+	fields []string
+}
+
+func (s hashSampler) keep(event *beat.Event) (bool, float64) {
+	key, ok := makeKey(event, s.fields)
+	if !ok {
+		return randomSampler{rate: s.rate}.keep(event)
+	}
+	// Map the top 53 bits of the hash onto [0, 1).
+	return float64(xxhash.Sum64String(key)>>11)/(1<<53) < s.rate, 1 / s.rate
+}
+
+// makeKey returns the sampling key for the event made from the values of
+// fields. It returns false if the event has none of the fields.
+func makeKey(event *beat.Event, fields []string) (string, bool) {
+	var (
+		buf   strings.Builder
+		found bool
+	)
+	for i, field := range fields {
+		if i != 0 {
+			buf.WriteByte(0)
+		}
+		value, err := event.GetValue(field)
+		if err != nil {
+			// Missing fields contribute an empty value.
+			continue
+		}
+		found = true
+		fmt.Fprint(&buf, value)
+	}
+	return buf.String(), found
+}
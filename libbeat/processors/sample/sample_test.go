@@ -0,0 +1,179 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sample
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+func TestNew(t *testing.T) {
+	cases := map[string]struct {
+		config mapstr.M
+		err    bool
+	}{
+		"rate":                  {config: mapstr.M{"rate": 0.5}},
+		"hash":                  {config: mapstr.M{"rate": 0.5, "fields": []string{"trace.id"}}},
+		"reservoir":             {config: mapstr.M{"reservoir": mapstr.M{"size": 10, "window": "1m"}}},
+		"empty":                 {config: mapstr.M{}, err: true},
+		"zero_rate":             {config: mapstr.M{"rate": 0}, err: true},
+		"large_rate":            {config: mapstr.M{"rate": 1.5}, err: true},
+		"rate_and_reservoir":    {config: mapstr.M{"rate": 0.5, "reservoir": mapstr.M{"size": 10, "window": "1m"}}, err: true},
+		"reservoir_no_size":     {config: mapstr.M{"reservoir": mapstr.M{"window": "1m"}}, err: true},
+		"reservoir_no_window":   {config: mapstr.M{"reservoir": mapstr.M{"size": 10}}, err: true},
+		"reservoir_zero_size":   {config: mapstr.M{"reservoir": mapstr.M{"size": 0, "window": "1m"}}, err: true},
+		"reservoir_neg_window":  {config: mapstr.M{"reservoir": mapstr.M{"size": 10, "window": "-1m"}}, err: true},
+		"reservoir_zero_window": {config: mapstr.M{"reservoir": mapstr.M{"size": 10, "window": "0s"}}, err: true},
+	}
+
+	for name, test := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := new(conf.MustNewConfigFrom(test.config), logptest.NewTestingLogger(t, ""))
+			if test.err {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRandomSampling(t *testing.T) {
+	p, err := new(conf.MustNewConfigFrom(mapstr.M{"rate": 0.25}), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+
+	const n = 10000
+	var kept int
+	for i := 0; i < n; i++ {
+		out, err := p.Run(&beat.Event{Fields: mapstr.M{"message": i}})
+		require.NoError(t, err)
+		if out == nil {
+			continue
+		}
+		kept++
+		assert.Equal(t, 4.0, out.Fields["sample_rate"])
+	}
+	assert.InDelta(t, n/4, kept, n/20)
+}
+
+func TestHashSampling(t *testing.T) {
+	cfg := mapstr.M{"rate": 0.3, "fields": []string{"trace.id"}, "sample_rate_field": "event.sample_rate"}
+	a, err := new(conf.MustNewConfigFrom(cfg), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	b, err := new(conf.MustNewConfigFrom(cfg), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+
+	const n = 1000
+	var kept int
+	for i := 0; i < n; i++ {
+		traceID := fmt.Sprintf("%032x", i)
+		var decisions []bool
+		// All spans of a trace, in any processor instance,
+		// must have the same decision.
+		for _, p := range []beat.Processor{a, b, a} {
+			out, err := p.Run(&beat.Event{Fields: mapstr.M{"trace": mapstr.M{"id": traceID}}})
+			require.NoError(t, err)
+			decisions = append(decisions, out != nil)
+			if out != nil {
+				rate, err := out.GetValue("event.sample_rate")
+				require.NoError(t, err)
+				assert.InDelta(t, 1/0.3, rate, 1e-9)
+			}
+		}
+		require.Equal(t, []bool{decisions[0], decisions[0], decisions[0]}, decisions, "trace %s", traceID)
+		if decisions[0] {
+			kept++
+		}
+	}
+	assert.InDelta(t, n*3/10, kept, n/10)
+}
+
+func TestReservoirSampling(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	s := newReservoirSampler([]string{"host.name"}, reservoirConfig{Size: 10, Window: time.Minute}, clock)
+	reg := monitoring.NewRegistry()
+	p := &sample{
+		config:  defaultConfig(),
+		sampler: s,
+		logger:  logptest.NewTestingLogger(t, ""),
+		metrics: metrics{
+			Kept:    monitoring.NewInt(reg, "kept"),
+			Dropped: monitoring.NewInt(reg, "dropped"),
+		},
+	}
+
+	run := func(host string, n int) (kept int, rates []any) {
+		for i := 0; i < n; i++ {
+			out, err := p.Run(&beat.Event{Fields: mapstr.M{"host": mapstr.M{"name": host}}})
+			require.NoError(t, err)
+			if out != nil {
+				kept++
+				rates = append(rates, out.Fields["sample_rate"])
+			}
+		}
+		return kept, rates
+	}
+
+	// First window: the first events of each key are kept up to the size,
+	// and their rate is unknown as a whole window hasn't been seen yet.
+	kept, rates := run("a", 100)
+	assert.Equal(t, 10, kept)
+	for _, r := range rates {
+		assert.Nil(t, r)
+	}
+	kept, rates = run("b", 5)
+	assert.Equal(t, 5, kept)
+	for _, r := range rates {
+		assert.Nil(t, r)
+	}
+
+	// Second window: a's events are sampled at the previous window's rate.
+	clock.Advance(time.Minute)
+	kept, rates = run("a", 100)
+	assert.LessOrEqual(t, kept, 10)
+	assert.Greater(t, kept, 0)
+	for _, r := range rates {
+		assert.Equal(t, 10.0, r)
+	}
+	kept, rates = run("b", 5)
+	assert.Equal(t, 5, kept)
+	for _, r := range rates {
+		assert.Equal(t, 1.0, r)
+	}
+
+	// After an idle window, keys are forgotten.
+	clock.Advance(3 * time.Minute)
+	s.mu.Lock()
+	s.advance()
+	assert.Empty(t, s.buckets)
+	s.mu.Unlock()
+	kept, rates = run("a", 20)
+	assert.Equal(t, 10, kept)
+	assert.Nil(t, rates[0])
+}
@@ -0,0 +1,71 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validate_schema
+
+import (
+	"fmt"
+)
+
+// Actions taken when an event does not match the schema.
+const (
+	actionTag   = "tag"
+	actionError = "error"
+	actionDrop  = "drop"
+)
+
+type config struct {
+	// File is the path to the JSON Schema file. Relative paths are
+	// resolved against the beat's configuration directory.
+	File string `config:"file" validate:"required"`
+
+	// Field is the event field validated against the schema. If
+	// empty the whole event is validated.
+	Field string `config:"field"`
+
+	// OnFailure is the action taken for events that do not match
+	// the schema: tag, error or drop.
+	OnFailure string `config:"on_failure"`
+
+	// TagOnFailure is the tag added to events by the tag action.
+	TagOnFailure string `config:"tag_on_failure"`
+
+	// IgnoreMissing: Pass events that do not have Field instead
+	// of treating them as invalid.
+	IgnoreMissing bool `config:"ignore_missing"`
+}
+
+func defaultConfig() config {
+	return config{
+		OnFailure:     actionTag,
+		TagOnFailure:  "_schema_validation_failure",
+		IgnoreMissing: false,
+	}
+}
+
+func (c *config) Validate() error {
+	switch c.OnFailure {
+	case actionTag:
+		if c.TagOnFailure == "" {
+			return fmt.Errorf("tag_on_failure must be set when on_failure is %q", actionTag)
+		}
+	case actionError, actionDrop:
+	default:
+		return fmt.Errorf("unsupported on_failure action %q: must be tag, error or drop", c.OnFailure)
+	}
+	return nil
+}
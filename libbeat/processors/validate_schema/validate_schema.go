@@ -0,0 +1,278 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validate_schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+// instanceID is used to assign each instance a unique monitoring namespace.
+var instanceID atomic.Uint32
+
+const (
+	processorName = "validate_schema"
+	logName       = "processor." + processorName
+)
+
+// maxViolations is the maximum number of violations listed in error.message.
+const maxViolations = 10
+
+func init() {
+	processors.RegisterPlugin(processorName, New)
+}
+
+type metrics struct {
+	Schema  *monitoring.String
+	Valid   *monitoring.Int
+	Invalid *monitoring.Int
+	Missing *monitoring.Int
+}
+
+type validateSchema struct {
+	config config
+
+	// schema is the compiled schema. It is nil until SetPaths has
+	// been called.
+	schema *jsonschema.Schema
+
+	log     *logp.Logger
+	metrics metrics
+}
+
+// New returns a new validate_schema processor. The schema is loaded when
+// SetPaths is called.
+func New(cfg *conf.C, log *logp.Logger) (beat.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("failed to unpack the %s configuration: %w", processorName, err)
+	}
+
+	// Logging and metrics (each processor instance has a unique ID).
+	var (
+		id  = int(instanceID.Add(1))
+		reg = monitoring.Default.GetOrCreateRegistry(logName+"."+strconv.Itoa(id), monitoring.DoNotReport)
+	)
+
+	return &validateSchema{
+		config: config,
+		log:    log.Named(logName).With("instance_id", id),
+		metrics: metrics{
+			Schema:  monitoring.NewString(reg, "schema"),
+			Valid:   monitoring.NewInt(reg, "valid"),
+			Invalid: monitoring.NewInt(reg, "invalid"),
+			Missing: monitoring.NewInt(reg, "missing"),
+		},
+	}, nil
+}
+
+// SetPaths loads and compiles the schema using the provided paths
+// configuration. This method must be called before the processor can be
+// used.
+func (p *validateSchema) SetPaths(path *paths.Path) error {
+	file := path.Resolve(paths.Config, p.config.File)
+	schema, err := jsonschema.NewCompiler().Compile(file)
+	if err != nil {
+		return fmt.Errorf("%s processor could not compile schema: %w", processorName, err)
+	}
+	p.schema = schema
+	p.metrics.Schema.Set(file)
+	p.log.Infow("loaded schema", "path", file)
+	return nil
+}
+
+func (p *validateSchema) Run(event *beat.Event) (*beat.Event, error) {
+	if p.schema == nil {
+		return event, fmt.Errorf("%s processor not initialized: SetPaths must be called", processorName)
+	}
+
+	var v any = event.Fields
+	if p.config.Field == "" && !event.Timestamp.IsZero() {
+		// Validate the @timestamp field as it is indexed.
+		fields := make(mapstr.M, len(event.Fields)+1)
+		for k, f := range event.Fields {
+			fields[k] = f
+		}
+		fields["@timestamp"] = common.Time(event.Timestamp)
+		v = fields
+	} else if p.config.Field != "" {
+		var err error
+		v, err = event.GetValue(p.config.Field)
+		if err != nil {
+			if errors.Is(err, mapstr.ErrKeyNotFound) {
+				p.metrics.Missing.Inc()
+				if p.config.IgnoreMissing {
+					return event, nil
+				}
+				return p.fail(event, fmt.Sprintf("schema validation failed: field %q not found", p.config.Field))
+			}
+			return event, err
+		}
+	}
+
+	doc, err := toJSON(v)
+	if err != nil {
+		return event, fmt.Errorf("%s processor could not encode event: %w", processorName, err)
+	}
+
+	err = p.schema.Validate(doc)
+	if err == nil {
+		p.metrics.Valid.Inc()
+		return event, nil
+	}
+	var verr *jsonschema.ValidationError
+	if !errors.As(err, &verr) {
+		return event, err
+	}
+	p.metrics.Invalid.Inc()
+	return p.fail(event, "schema validation failed: "+p.violations(verr))
+}
+
+// fail applies the configured on_failure action to an invalid event.
+func (p *validateSchema) fail(event *beat.Event, msg string) (*beat.Event, error) {
+	switch p.config.OnFailure {
+	case actionDrop:
+		p.log.Debugw("dropping invalid event", "error", msg)
+		return nil, nil
+	case actionError:
+		if event.Fields == nil {
+			event.Fields = mapstr.M{}
+		}
+		if err := appendStringField(event.Fields, "error.message", msg); err != nil {
+			return event, fmt.Errorf("failed to set error.message to %q: %w", msg, err)
+		}
+	default:
+		if event.Fields == nil {
+			event.Fields = mapstr.M{}
+		}
+		_ = mapstr.AddTags(event.Fields, []string{p.config.TagOnFailure})
+	}
+	return event, nil
+}
+
+// violations returns a description of the leaf errors of verr, each
+// prefixed by the path of the violating field.
+func (p *validateSchema) violations(verr *jsonschema.ValidationError) string {
+	var leaves []*jsonschema.ValidationError
+	var walk func(*jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) == 0 {
+			leaves = append(leaves, e)
+			return
+		}
+		for _, c := range e.Causes {
+			walk(c)
+		}
+	}
+	walk(verr)
+
+	var buf strings.Builder
+	for i, e := range leaves {
+		if i == maxViolations {
+			fmt.Fprintf(&buf, "; and %d more", len(leaves)-i)
+			break
+		}
+		if i != 0 {
+			buf.WriteString("; ")
+		}
+		buf.WriteString(p.fieldPath(e.InstanceLocation))
+		buf.WriteString(": ")
+		buf.WriteString(e.Message)
+	}
+	return buf.String()
+}
+
+// pointerEscaper unescapes the reference tokens of a JSON pointer.
+var pointerEscaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// fieldPath returns the dotted event field path for a location, given as
+// a JSON pointer, within the validated value.
+func (p *validateSchema) fieldPath(loc string) string {
+	var elems []string
+	if p.config.Field != "" {
+		elems = append(elems, p.config.Field)
+	}
+	if loc != "" {
+		for _, tok := range strings.Split(strings.TrimPrefix(loc, "/"), "/") {
+			elems = append(elems, pointerEscaper.Replace(tok))
+		}
+	}
+	if len(elems) == 0 {
+		return "(root)"
+	}
+	return strings.Join(elems, ".")
+}
+
+// toJSON converts v to the generic JSON representation used by the
+// validator.
+func toJSON(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// appendStringField appends value to the string or list of strings held
+// in field, creating it if needed. Any other value held in field is
+// converted to a string, so that neither value is lost.
+func appendStringField(m mapstr.M, field, value string) error {
+	v, _ := m.GetValue(field)
+	var list any
+	switch t := v.(type) {
+	case nil:
+		list = value
+	case string:
+		list = []string{t, value}
+	case []string:
+		list = append(t, value)
+	case []interface{}:
+		list = append(t, value)
+	default:
+		list = []string{fmt.Sprint(t), value}
+	}
+	_, err := m.Put(field, list)
+	return err
+}
+
+func (p *validateSchema) String() string {
+	return fmt.Sprintf("%s=[file=%s, field=%s, on_failure=%s]",
+		processorName, p.config.File, p.config.Field, p.config.OnFailure)
+}
@@ -0,0 +1,257 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package validate_schema
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+const userSchema = `{
+  "type": "object",
+  "required": ["id", "name"],
+  "properties": {
+    "id": {"type": "integer", "minimum": 1},
+    "name": {"type": "string"},
+    "roles": {"type": "array", "items": {"$ref": "roles.json"}}
+  }
+}`
+
+const rolesSchema = `{"enum": ["admin", "viewer"]}`
+
+var validateTests = []struct {
+	name  string
+	cfg   mapstr.M
+	event mapstr.M
+	want  mapstr.M // nil if the event is dropped
+}{
+	{
+		name:  "valid",
+		cfg:   mapstr.M{"field": "user"},
+		event: mapstr.M{"user": mapstr.M{"id": 1, "name": "alice", "roles": []string{"admin"}}},
+		want:  mapstr.M{"user": mapstr.M{"id": 1, "name": "alice", "roles": []string{"admin"}}},
+	},
+	{
+		name:  "tag",
+		cfg:   mapstr.M{"field": "user"},
+		event: mapstr.M{"user": mapstr.M{"id": 0, "name": "alice"}},
+		want:  mapstr.M{"user": mapstr.M{"id": 0, "name": "alice"}, "tags": []string{"_schema_validation_failure"}},
+	},
+	{
+		name:  "custom_tag",
+		cfg:   mapstr.M{"field": "user", "tag_on_failure": "bad_user"},
+		event: mapstr.M{"user": mapstr.M{"name": "alice"}},
+		want:  mapstr.M{"user": mapstr.M{"name": "alice"}, "tags": []string{"bad_user"}},
+	},
+	{
+		name:  "error",
+		cfg:   mapstr.M{"field": "user", "on_failure": "error"},
+		event: mapstr.M{"user": mapstr.M{"id": 0, "roles": []string{"admin", "owner"}}},
+		want: mapstr.M{
+			"user": mapstr.M{"id": 0, "roles": []string{"admin", "owner"}},
+			"error": mapstr.M{"message": "schema validation failed: " +
+				"user: missing properties: 'name'; " +
+				"user.id: must be >= 1 but found 0; " +
+				`user.roles.1: value must be one of "admin", "viewer"`},
+		},
+	},
+	{
+		name:  "append_error",
+		cfg:   mapstr.M{"field": "user", "on_failure": "error"},
+		event: mapstr.M{"user": mapstr.M{"id": 1}, "error": mapstr.M{"message": "earlier failure"}},
+		want: mapstr.M{
+			"user": mapstr.M{"id": 1},
+			"error": mapstr.M{"message": []string{
+				"earlier failure",
+				"schema validation failed: user: missing properties: 'name'",
+			}},
+		},
+	},
+	{
+		name:  "append_non_string_error",
+		cfg:   mapstr.M{"field": "user", "on_failure": "error"},
+		event: mapstr.M{"user": mapstr.M{"id": 1}, "error": mapstr.M{"message": mapstr.M{"code": 42}}},
+		want: mapstr.M{
+			"user": mapstr.M{"id": 1},
+			"error": mapstr.M{"message": []string{
+				`{"code":42}`,
+				"schema validation failed: user: missing properties: 'name'",
+			}},
+		},
+	},
+	{
+		name:  "drop",
+		cfg:   mapstr.M{"field": "user", "on_failure": "drop"},
+		event: mapstr.M{"user": "alice"},
+		want:  nil,
+	},
+	{
+		name:  "whole_event",
+		cfg:   mapstr.M{"on_failure": "error"},
+		event: mapstr.M{"id": 1},
+		want: mapstr.M{
+			"id":    1,
+			"error": mapstr.M{"message": "schema validation failed: (root): missing properties: 'name'"},
+		},
+	},
+	{
+		name:  "missing",
+		cfg:   mapstr.M{"field": "user", "on_failure": "error"},
+		event: mapstr.M{"message": "hello"},
+		want: mapstr.M{
+			"message": "hello",
+			"error":   mapstr.M{"message": `schema validation failed: field "user" not found`},
+		},
+	},
+	{
+		name:  "ignore_missing",
+		cfg:   mapstr.M{"field": "user", "ignore_missing": true},
+		event: mapstr.M{"message": "hello"},
+		want:  mapstr.M{"message": "hello"},
+	},
+}
+
+func writeSchemas(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "user.json"), []byte(userSchema), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "roles.json"), []byte(rolesSchema), 0o600))
+	return dir
+}
+
+func TestValidateSchema(t *testing.T) {
+	dir := writeSchemas(t)
+	for _, test := range validateTests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := mapstr.M{"file": "user.json"}
+			cfg.Update(test.cfg)
+			p, err := New(conf.MustNewConfigFrom(cfg), logptest.NewTestingLogger(t, ""))
+			require.NoError(t, err)
+			require.NoError(t, p.(*validateSchema).SetPaths(&paths.Path{Config: dir}))
+
+			got, err := p.Run(&beat.Event{Fields: test.event})
+			require.NoError(t, err)
+			if test.want == nil {
+				assert.Nil(t, got)
+				return
+			}
+			require.NotNil(t, got)
+			assert.Equal(t, test.want, got.Fields)
+		})
+	}
+}
+
+func TestValidateTimestamp(t *testing.T) {
+	dir := t.TempDir()
+	schema := `{"required": ["@timestamp"], "properties": {"@timestamp": {"type": "string", "pattern": "^2024-"}}}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "event.json"), []byte(schema), 0o600))
+	p, err := New(conf.MustNewConfigFrom(mapstr.M{"file": "event.json", "on_failure": "error"}), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	require.NoError(t, p.(*validateSchema).SetPaths(&paths.Path{Config: dir}))
+
+	got, err := p.Run(&beat.Event{
+		Timestamp: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		Fields:    mapstr.M{"message": "hello"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, mapstr.M{"message": "hello"}, got.Fields)
+
+	got, err = p.Run(&beat.Event{
+		Timestamp: time.Date(2025, 5, 1, 10, 0, 0, 0, time.UTC),
+		Fields:    mapstr.M{"message": "hello"},
+	})
+	require.NoError(t, err)
+	msg, _ := got.GetValue("error.message")
+	assert.Equal(t, `schema validation failed: @timestamp: does not match pattern '^2024-'`, msg)
+}
+
+func TestErrorNotObject(t *testing.T) {
+	p, err := New(conf.MustNewConfigFrom(mapstr.M{"file": "user.json", "field": "user", "on_failure": "error"}), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	require.NoError(t, p.(*validateSchema).SetPaths(&paths.Path{Config: writeSchemas(t)}))
+
+	got, err := p.Run(&beat.Event{Fields: mapstr.M{"user": mapstr.M{"id": 1}, "error": "earlier failure"}})
+	assert.ErrorContains(t, err, "schema validation failed: user: missing properties: 'name'")
+	require.NotNil(t, got)
+	assert.Equal(t, "earlier failure", got.Fields["error"])
+}
+
+func TestMetrics(t *testing.T) {
+	dir := writeSchemas(t)
+	p, err := New(conf.MustNewConfigFrom(mapstr.M{"file": "user.json", "field": "user"}), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	v := p.(*validateSchema)
+	require.NoError(t, v.SetPaths(&paths.Path{Config: dir}))
+
+	for _, fields := range []mapstr.M{
+		{"user": mapstr.M{"id": 1, "name": "alice"}},
+		{"user": mapstr.M{"id": 2, "name": "bob"}},
+		{"user": mapstr.M{"id": 3}},
+		{"message": "hello"},
+	} {
+		_, err := p.Run(&beat.Event{Fields: fields})
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, filepath.Join(dir, "user.json"), v.metrics.Schema.Get())
+	assert.EqualValues(t, 2, v.metrics.Valid.Get())
+	assert.EqualValues(t, 1, v.metrics.Invalid.Get())
+	assert.EqualValues(t, 1, v.metrics.Missing.Get())
+}
+
+func TestConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  mapstr.M
+		err  string
+	}{
+		{name: "missing_file", cfg: mapstr.M{}, err: "string value is not set accessing 'file'"},
+		{name: "bad_action", cfg: mapstr.M{"file": "a.json", "on_failure": "route"}, err: `unsupported on_failure action "route"`},
+		{name: "empty_tag", cfg: mapstr.M{"file": "a.json", "tag_on_failure": ""}, err: "tag_on_failure must be set"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New(conf.MustNewConfigFrom(test.cfg), logptest.NewTestingLogger(t, ""))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestInvalidSchema(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "bad.json"), []byte(`{"type": 1}`), 0o600))
+	p, err := New(conf.MustNewConfigFrom(mapstr.M{"file": "bad.json"}), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	err = p.(*validateSchema).SetPaths(&paths.Path{Config: dir})
+	assert.ErrorContains(t, err, "could not compile schema")
+
+	_, err = p.Run(&beat.Event{Fields: mapstr.M{}})
+	assert.ErrorContains(t, err, "SetPaths must be called")
+}
@@ -0,0 +1,288 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// hostModule is the name of the module providing the host functions
+// imported by processor modules.
+const hostModule = "beat"
+
+// Return codes of the host functions.
+const (
+	resultOK          = 0
+	resultNotFound    = -1
+	resultInvalidJSON = -2
+	resultFailed      = -3
+	resultBadMemory   = -4
+	resultNoEvent     = -5
+)
+
+// Log levels accepted by the log host function.
+const (
+	logDebug = iota
+	logInfo
+	logWarn
+	logError
+)
+
+// callKey is the context key holding the call state of an invocation
+// of the process function.
+type callKey struct{}
+
+// call is the state of an invocation of the process function.
+type call struct {
+	event   *beat.Event
+	dropped bool
+	log     *logp.Logger
+}
+
+func callFrom(ctx context.Context) *call {
+	c, _ := ctx.Value(callKey{}).(*call)
+	return c
+}
+
+// hostCall returns the call state and the memory of the module calling a
+// host function. The result code is non-zero if the function is called
+// outside of process, e.g. during initialization, or if the module has no
+// memory.
+func hostCall(ctx context.Context, m api.Module) (*call, api.Memory, int32) {
+	c := callFrom(ctx)
+	if c == nil {
+		return nil, nil, resultNoEvent
+	}
+	// The memory of a module without one is a typed nil.
+	mem := m.Memory()
+	if mem == nil || reflect.ValueOf(mem).IsNil() {
+		return nil, nil, resultBadMemory
+	}
+	return c, mem, resultOK
+}
+
+// instantiateHostModule instantiates the host functions into the runtime.
+//
+// The ABI exposed to processor modules is:
+//
+//	get_field(key_ptr, key_len, buf_ptr, buf_len i32) i32
+//	    Writes the JSON encoding of the field value to the buffer and
+//	    returns its length. If the length is larger than buf_len nothing
+//	    is written, and the call can be repeated with a larger buffer.
+//	    Returns -1 if the event has no such field.
+//	put_field(key_ptr, key_len, val_ptr, val_len i32) i32
+//	    Sets the field to the JSON encoded value. Returns 0 on success,
+//	    -2 if the value is not valid JSON, and -3 if the field could not
+//	    be set.
+//	delete_field(key_ptr, key_len i32) i32
+//	    Deletes the field. Returns 0 on success and -1 if the event has
+//	    no such field.
+//	add_tag(tag_ptr, tag_len i32) i32
+//	    Adds the tag to the event's tags. Returns 0 on success.
+//	drop()
+//	    Marks the event to be dropped.
+//	log(level, msg_ptr, msg_len i32)
+//	    Logs the message at the level: 0 debug, 1 info, 2 warn, 3 error.
+//
+// All functions return -4 if a pointer and length pair is out of range of
+// the module's memory, and -5 if they are called outside of process, e.g.
+// during initialization.
+func instantiateHostModule(ctx context.Context, rt wazero.Runtime) error {
+	_, err := rt.NewHostModuleBuilder(hostModule).
+		NewFunctionBuilder().WithFunc(getField).Export("get_field").
+		NewFunctionBuilder().WithFunc(putField).Export("put_field").
+		NewFunctionBuilder().WithFunc(deleteField).Export("delete_field").
+		NewFunctionBuilder().WithFunc(addTag).Export("add_tag").
+		NewFunctionBuilder().WithFunc(drop).Export("drop").
+		NewFunctionBuilder().WithFunc(log).Export("log").
+		Instantiate(ctx)
+	return err
+}
+
+func getField(ctx context.Context, m api.Module, keyPtr, keyLen, bufPtr, bufLen uint32) int32 {
+	c, mem, rc := hostCall(ctx, m)
+	if rc != resultOK {
+		return rc
+	}
+	key, ok := mem.Read(keyPtr, keyLen)
+	if !ok {
+		return resultBadMemory
+	}
+	v, err := c.event.GetValue(string(key))
+	if err != nil {
+		return resultNotFound
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		c.log.Debugw("failed to encode field value", "field", string(key), "error", err)
+		return resultFailed
+	}
+	if len(b) > int(bufLen) {
+		return int32(len(b)) //nolint:gosec // G115: values are much smaller than 2GiB
+	}
+	if !mem.Write(bufPtr, b) {
+		return resultBadMemory
+	}
+	return int32(len(b)) //nolint:gosec // G115: values are much smaller than 2GiB
+}
+
+func putField(ctx context.Context, m api.Module, keyPtr, keyLen, valPtr, valLen uint32) int32 {
+	c, mem, rc := hostCall(ctx, m)
+	if rc != resultOK {
+		return rc
+	}
+	key, ok := mem.Read(keyPtr, keyLen)
+	if !ok {
+		return resultBadMemory
+	}
+	val, ok := mem.Read(valPtr, valLen)
+	if !ok {
+		return resultBadMemory
+	}
+	v, err := decodeValue(val)
+	if err != nil {
+		return resultInvalidJSON
+	}
+	if _, err = c.event.PutValue(string(key), v); err != nil {
+		c.log.Debugw("failed to put field value", "field", string(key), "error", err)
+		return resultFailed
+	}
+	return resultOK
+}
+
+func deleteField(ctx context.Context, m api.Module, keyPtr, keyLen uint32) int32 {
+	c, mem, rc := hostCall(ctx, m)
+	if rc != resultOK {
+		return rc
+	}
+	key, ok := mem.Read(keyPtr, keyLen)
+	if !ok {
+		return resultBadMemory
+	}
+	if err := c.event.Delete(string(key)); err != nil {
+		return resultNotFound
+	}
+	return resultOK
+}
+
+func addTag(ctx context.Context, m api.Module, tagPtr, tagLen uint32) int32 {
+	c, mem, rc := hostCall(ctx, m)
+	if rc != resultOK {
+		return rc
+	}
+	tag, ok := mem.Read(tagPtr, tagLen)
+	if !ok {
+		return resultBadMemory
+	}
+	if c.event.Fields == nil {
+		c.event.Fields = mapstr.M{}
+	}
+	if err := mapstr.AddTags(c.event.Fields, []string{string(tag)}); err != nil {
+		return resultFailed
+	}
+	return resultOK
+}
+
+func drop(ctx context.Context) {
+	if c := callFrom(ctx); c != nil {
+		c.dropped = true
+	}
+}
+
+func log(ctx context.Context, m api.Module, level, msgPtr, msgLen uint32) {
+	c, mem, rc := hostCall(ctx, m)
+	if rc != resultOK {
+		return
+	}
+	msg, ok := mem.Read(msgPtr, msgLen)
+	if !ok {
+		return
+	}
+	switch level {
+	case logDebug:
+		c.log.Debug(string(msg))
+	case logInfo:
+		c.log.Info(string(msg))
+	case logWarn:
+		c.log.Warn(string(msg))
+	default:
+		c.log.Error(string(msg))
+	}
+}
+
+// decodeValue decodes a JSON value written by a module. Objects are decoded
+// as mapstr.M, and numbers as int64 where possible and float64 otherwise.
+func decodeValue(b []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("trailing data after JSON value")
+	}
+	return normalize(v)
+}
+
+func normalize(v any) (any, error) {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q: %w", v, err)
+		}
+		return f, nil
+	case map[string]any:
+		m := make(mapstr.M, len(v))
+		for k, e := range v {
+			n, err := normalize(e)
+			if err != nil {
+				return nil, err
+			}
+			m[k] = n
+		}
+		return m, nil
+	case []any:
+		for i, e := range v {
+			n, err := normalize(e)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = n
+		}
+		return v, nil
+	default:
+		return v, nil
+	}
+}
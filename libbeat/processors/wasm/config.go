@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"errors"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+)
+
+// wasmPageSize is the size of a WebAssembly memory page.
+const wasmPageSize = 64 * 1024
+
+// Config defines the WebAssembly module to use for the processor.
+type Config struct {
+	Tag                 string           `config:"tag"`                                   // Processor ID for debug and metrics.
+	File                string           `config:"file"`                                  // Module file.
+	Timeout             time.Duration    `config:"timeout" validate:"min=0"`              // Execution timeout.
+	MaxMemory           cfgtype.ByteSize `config:"max_memory"`                            // Max. linear memory of a module instance.
+	MaxFuel             uint64           `config:"max_fuel"`                              // Max. fuel consumed by a single call.
+	TagOnException      string           `config:"tag_on_exception"`                      // Tag to add to events when an exception happens.
+	MaxCachedInstances  int              `config:"max_cached_instances" validate:"min=0"` // Max. number of cached module instances.
+	OnlyCachedInstances bool             `config:"only_cached_instances"`                 // Only use cached module instances.
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	switch {
+	case c.File == "":
+		return errors.New("wasm module must be defined via 'file'")
+	case c.MaxMemory < wasmPageSize:
+		return errors.New("max_memory must be at least 64KiB")
+	case c.MaxMemory > 1<<32:
+		return errors.New("max_memory must be at most 4GiB")
+	case c.OnlyCachedInstances && c.MaxCachedInstances == 0:
+		return errors.New("max_cached_instances must be set when only_cached_instances is enabled")
+	}
+	return nil
+}
+
+// memoryLimitPages returns the memory limit in WebAssembly pages.
+func (c Config) memoryLimitPages() uint32 {
+	return uint32((uint64(c.MaxMemory) + wasmPageSize - 1) / wasmPageSize) //nolint:gosec // G115: bounded by Validate
+}
+
+func defaultConfig() Config {
+	return Config{
+		Timeout:             time.Second,
+		MaxMemory:           64 * 1024 * 1024,
+		TagOnException:      "_wasm_exception",
+		MaxCachedInstances:  4,
+		OnlyCachedInstances: false,
+	}
+}
@@ -0,0 +1,597 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// fuelGlobal is the name of the global exported by instrumented modules
+// that holds the remaining fuel.
+const fuelGlobal = "__beats_fuel"
+
+// Section IDs, opcodes and other values of the WebAssembly binary format.
+const (
+	sectionCustom  = 0
+	sectionImport  = 2
+	sectionGlobal  = 6
+	sectionExport  = 7
+	sectionCode    = 10
+	externKindGlob = 3
+	externKindTag  = 4
+	valTypeI64     = 0x7e
+	globalMutable  = 0x01
+	blockTypeEmpty = 0x40
+	limitsHasMax   = 0x01
+	limitsMemory64 = 0x04
+	wasmHeaderSize = 8
+
+	opUnreachable = 0x00
+	opLoop        = 0x03
+	opIf          = 0x04
+	opEnd         = 0x0b
+	opGlobalGet   = 0x23
+	opGlobalSet   = 0x24
+	opI64Const    = 0x42
+	opI64LtU      = 0x54
+	opI64Sub      = 0x7d
+	prefixMisc    = 0xfc
+	prefixSIMD    = 0xfd
+	prefixAtomic  = 0xfe
+	atomicFence   = 0x03
+
+	// memargHasMemory is set in the alignment of a memory argument that is
+	// followed by a memory index, which multi-memory modules use.
+	memargHasMemory = 0x40
+)
+
+// sectionOrder is the position of the known sections in a module, by ID.
+// The tag section comes before the global section, and the data count
+// section before the code section.
+var sectionOrder = map[byte]int{
+	1: 1, 2: 2, 3: 3, 4: 4, 5: 5, 13: 6, 6: 7, 7: 8, 8: 9, 9: 10, 12: 11, 10: 12, 11: 13,
+}
+
+var errMalformedModule = errors.New("malformed module")
+
+type section struct {
+	id   byte
+	data []byte
+}
+
+// instrumentFuel returns a copy of the module that consumes one unit of fuel
+// per instruction, and traps when it runs out of fuel. Function and loop
+// bodies are charged for all their instructions when they are entered, so
+// the fuel consumed is an upper bound of the instructions executed. The
+// remaining fuel is held by the exported fuelGlobal, which is initially
+// unlimited.
+//
+// Modules using instructions or encodings that are not known to the
+// instrumentation are rejected, as they could not be metered reliably.
+func instrumentFuel(code []byte) ([]byte, error) {
+	if len(code) < wasmHeaderSize || !bytes.Equal(code[:4], []byte("\x00asm")) {
+		return nil, errMalformedModule
+	}
+	sections, err := readSections(code[wasmHeaderSize:])
+	if err != nil {
+		return nil, err
+	}
+
+	var importedGlobals, definedGlobals uint32
+	for _, s := range sections {
+		switch s.id {
+		case sectionImport:
+			if importedGlobals, err = countImportedGlobals(s.data); err != nil {
+				return nil, fmt.Errorf("invalid import section: %w", err)
+			}
+		case sectionGlobal:
+			r := reader{data: s.data}
+			if definedGlobals, err = r.u32(); err != nil {
+				return nil, fmt.Errorf("invalid global section: %w", err)
+			}
+		}
+	}
+	fuel := importedGlobals + definedGlobals
+
+	// The fuel global starts at the maximum unsigned value, so that
+	// initialization isn't limited.
+	var global []byte
+	global = append(global, valTypeI64, globalMutable, opI64Const)
+	global = appendSLEB(global, -1)
+	global = append(global, opEnd)
+	if sections, err = appendToVector(sections, sectionGlobal, global); err != nil {
+		return nil, fmt.Errorf("invalid global section: %w", err)
+	}
+
+	var export []byte
+	export = appendULEB(export, uint64(len(fuelGlobal)))
+	export = append(export, fuelGlobal...)
+	export = append(export, externKindGlob)
+	export = appendULEB(export, uint64(fuel))
+	if sections, err = appendToVector(sections, sectionExport, export); err != nil {
+		return nil, fmt.Errorf("invalid export section: %w", err)
+	}
+
+	for i, s := range sections {
+		if s.id != sectionCode {
+			continue
+		}
+		if sections[i].data, err = instrumentCode(s.data, fuel); err != nil {
+			return nil, fmt.Errorf("invalid code section: %w", err)
+		}
+	}
+
+	out := append([]byte(nil), code[:wasmHeaderSize]...)
+	for _, s := range sections {
+		out = append(out, s.id)
+		out = appendULEB(out, uint64(len(s.data)))
+		out = append(out, s.data...)
+	}
+	return out, nil
+}
+
+// appendFuelCheck appends the instructions that consume cost units from the
+// fuel global. If less fuel is left, the fuel is set to zero and the
+// instructions trap.
+func appendFuelCheck(b []byte, fuel uint32, cost int) []byte {
+	b = append(b, opGlobalGet)
+	b = appendULEB(b, uint64(fuel))
+	b = append(b, opI64Const)
+	b = appendSLEB(b, int64(cost))
+	b = append(b, opI64LtU, opIf, blockTypeEmpty, opI64Const, 0, opGlobalSet)
+	b = appendULEB(b, uint64(fuel))
+	b = append(b, opUnreachable, opEnd, opGlobalGet)
+	b = appendULEB(b, uint64(fuel))
+	b = append(b, opI64Const)
+	b = appendSLEB(b, int64(cost))
+	b = append(b, opI64Sub, opGlobalSet)
+	return appendULEB(b, uint64(fuel))
+}
+
+func readSections(data []byte) ([]section, error) {
+	var sections []section
+	r := reader{data: data}
+	for r.pos < len(r.data) {
+		id, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		content, err := r.vec()
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, section{id: id, data: content})
+	}
+	return sections, nil
+}
+
+// appendToVector appends an entry to the vector held by the section with
+// the given ID, adding the section if the module has none.
+func appendToVector(sections []section, id byte, entry []byte) ([]section, error) {
+	for i, s := range sections {
+		if s.id != id {
+			continue
+		}
+		r := reader{data: s.data}
+		n, err := r.u32()
+		if err != nil {
+			return nil, err
+		}
+		data := appendULEB(nil, uint64(n)+1)
+		data = append(data, s.data[r.pos:]...)
+		sections[i].data = append(data, entry...)
+		return sections, nil
+	}
+
+	data := append(appendULEB(nil, 1), entry...)
+	pos := len(sections)
+	for i, s := range sections {
+		if s.id != sectionCustom && sectionOrder[s.id] > sectionOrder[id] {
+			pos = i
+			break
+		}
+	}
+	return append(sections[:pos], append([]section{{id: id, data: data}}, sections[pos:]...)...), nil
+}
+
+func countImportedGlobals(data []byte) (uint32, error) {
+	r := reader{data: data}
+	n, err := r.u32()
+	if err != nil {
+		return 0, err
+	}
+	var globals uint32
+	for range n {
+		// Module and field names.
+		if _, err = r.vec(); err != nil {
+			return 0, err
+		}
+		if _, err = r.vec(); err != nil {
+			return 0, err
+		}
+		kind, err := r.byte()
+		if err != nil {
+			return 0, err
+		}
+		switch kind {
+		case 0: // Function type index.
+			err = r.skipLEB(1)
+		case 1: // Table reference type and limits.
+			if _, err = r.byte(); err == nil {
+				err = r.skipLimits()
+			}
+		case 2: // Memory limits.
+			err = r.skipLimits()
+		case externKindGlob: // Value type and mutability.
+			globals++
+			err = r.skip(2)
+		case externKindTag: // Attribute and type index.
+			if err = r.skip(1); err == nil {
+				err = r.skipLEB(1)
+			}
+		default:
+			return 0, fmt.Errorf("unknown import kind 0x%x", kind)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return globals, nil
+}
+
+// instrumentCode inserts the fuel check at the start of each function body
+// and of each loop.
+func instrumentCode(data []byte, fuel uint32) ([]byte, error) {
+	r := reader{data: data}
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	out := appendULEB(nil, uint64(n))
+	for range n {
+		body, err := r.vec()
+		if err != nil {
+			return nil, err
+		}
+		body, err = instrumentBody(body, fuel)
+		if err != nil {
+			return nil, err
+		}
+		out = appendULEB(out, uint64(len(body)))
+		out = append(out, body...)
+	}
+	return out, nil
+}
+
+// meteredBlock is a function or loop body, which is charged for the
+// instructions it contains, excluding those of nested loops.
+type meteredBlock struct {
+	pos  int // Position of the fuel check in the body.
+	cost int
+}
+
+func instrumentBody(body []byte, fuel uint32) ([]byte, error) {
+	r := reader{data: body}
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	for range n {
+		// Number of locals and their type.
+		if err = r.skipLEB(1); err != nil {
+			return nil, err
+		}
+		if err = r.skip(1); err != nil {
+			return nil, err
+		}
+	}
+
+	// Instructions are executed at most once each time the innermost
+	// enclosing function or loop body is entered, as only branches to
+	// loops go backwards.
+	blocks := []meteredBlock{{pos: r.pos}}
+	var metered []int // Block of each open control instruction, -1 unless a loop.
+	current := 0
+	for r.pos < len(body) {
+		op, err := r.byte()
+		if err != nil {
+			return nil, err
+		}
+		if err = r.skipImmediates(op); err != nil {
+			return nil, fmt.Errorf("opcode 0x%x: %w", op, err)
+		}
+		switch op {
+		case 0x02, opIf: // block, if
+			metered = append(metered, -1)
+		case opLoop:
+			blocks[current].cost++
+			metered = append(metered, len(blocks))
+			current = len(blocks)
+			blocks = append(blocks, meteredBlock{pos: r.pos})
+			continue
+		case opEnd:
+			if len(metered) == 0 {
+				// End of the function.
+				if r.pos != len(body) {
+					return nil, errMalformedModule
+				}
+				break
+			}
+			if metered[len(metered)-1] >= 0 {
+				current = 0
+				for _, b := range slices.Backward(metered[:len(metered)-1]) {
+					if b >= 0 {
+						current = b
+						break
+					}
+				}
+			}
+			metered = metered[:len(metered)-1]
+		}
+		blocks[current].cost++
+	}
+
+	out := make([]byte, 0, len(body)+len(blocks)*32)
+	pos := 0
+	for _, b := range blocks {
+		out = append(out, body[pos:b.pos]...)
+		if b.cost > 0 {
+			out = appendFuelCheck(out, fuel, b.cost)
+		}
+		pos = b.pos
+	}
+	return append(out, body[pos:]...), nil
+}
+
+// reader decodes the WebAssembly binary format.
+type reader struct {
+	data []byte
+	pos  int
+}
+
+func (r *reader) byte() (byte, error) {
+	if r.pos >= len(r.data) {
+		return 0, errMalformedModule
+	}
+	b := r.data[r.pos]
+	r.pos++
+	return b, nil
+}
+
+func (r *reader) skip(n int) error {
+	if len(r.data)-r.pos < n {
+		return errMalformedModule
+	}
+	r.pos += n
+	return nil
+}
+
+func (r *reader) u32() (uint32, error) {
+	v, n := binary.Uvarint(r.data[r.pos:])
+	if n <= 0 || v > 1<<32-1 {
+		return 0, errMalformedModule
+	}
+	r.pos += n
+	return uint32(v), nil
+}
+
+// skipLEB skips n LEB128 encoded integers, signed or unsigned.
+func (r *reader) skipLEB(n int) error {
+	for range n {
+		for {
+			b, err := r.byte()
+			if err != nil {
+				return err
+			}
+			if b&0x80 == 0 {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// vec returns the content of a byte vector.
+func (r *reader) vec() ([]byte, error) {
+	n, err := r.u32()
+	if err != nil {
+		return nil, err
+	}
+	start := r.pos
+	if err = r.skip(int(n)); err != nil {
+		return nil, err
+	}
+	return r.data[start:r.pos], nil
+}
+
+func (r *reader) skipLimits() error {
+	flags, err := r.byte()
+	if err != nil {
+		return err
+	}
+	if flags&limitsMemory64 != 0 {
+		return errors.New("64-bit memories are not supported")
+	}
+	if flags&limitsHasMax != 0 {
+		return r.skipLEB(2)
+	}
+	return r.skipLEB(1)
+}
+
+// skipImmediates skips the immediate arguments of the instruction.
+func (r *reader) skipImmediates(op byte) error {
+	switch {
+	case op <= 0x01, op == 0x05, op == opEnd, op == 0x0f, op == 0x1a, op == 0x1b,
+		op >= 0x45 && op <= 0xc4, op == 0xd1:
+		// No immediates.
+		return nil
+	case op >= 0x02 && op <= 0x04: // block, loop, if: block type.
+		return r.skipLEB(1)
+	case op == 0x0c, op == 0x0d: // br, br_if: label.
+		return r.skipLEB(1)
+	case op == 0x0e: // br_table: labels and default label.
+		n, err := r.u32()
+		if err != nil {
+			return err
+		}
+		return r.skipLEB(int(n) + 1)
+	case op == 0x10: // call: function index.
+		return r.skipLEB(1)
+	case op == 0x11: // call_indirect: type and table index.
+		return r.skipLEB(2)
+	case op == 0x1c: // select: value types.
+		n, err := r.u32()
+		if err != nil {
+			return err
+		}
+		return r.skip(int(n))
+	case op >= 0x20 && op <= 0x26: // Local, global and table access: index.
+		return r.skipLEB(1)
+	case op >= 0x28 && op <= 0x3e: // Loads and stores.
+		return r.skipMemarg()
+	case op == 0x3f, op == 0x40: // memory.size, memory.grow: memory index.
+		return r.skipMemoryIndex(1)
+	case op == 0x41, op == opI64Const:
+		return r.skipLEB(1)
+	case op == 0x43: // f32.const
+		return r.skip(4)
+	case op == 0x44: // f64.const
+		return r.skip(8)
+	case op == 0xd0: // ref.null: reference type.
+		return r.skip(1)
+	case op == 0xd2: // ref.func: function index.
+		return r.skipLEB(1)
+	case op == prefixMisc:
+		return r.skipMisc()
+	case op == prefixSIMD:
+		return r.skipSIMD()
+	case op == prefixAtomic:
+		return r.skipAtomic()
+	}
+	return errors.New("unsupported instruction")
+}
+
+// skipMemarg skips the alignment and offset of a memory access.
+func (r *reader) skipMemarg() error {
+	align, err := r.u32()
+	if err != nil {
+		return err
+	}
+	if align&memargHasMemory != 0 {
+		return errors.New("multiple memories are not supported")
+	}
+	return r.skipLEB(1)
+}
+
+// skipMemoryIndex skips n memory indices, which must refer to the only memory.
+func (r *reader) skipMemoryIndex(n int) error {
+	for range n {
+		idx, err := r.byte()
+		if err != nil {
+			return err
+		}
+		if idx != 0 {
+			return errors.New("multiple memories are not supported")
+		}
+	}
+	return nil
+}
+
+func (r *reader) skipMisc() error {
+	sub, err := r.u32()
+	if err != nil {
+		return err
+	}
+	switch {
+	case sub <= 7: // Saturating truncations.
+		return nil
+	case sub == 8: // memory.init: data index and memory index.
+		if err = r.skipLEB(1); err != nil {
+			return err
+		}
+		return r.skipMemoryIndex(1)
+	case sub == 9, sub == 13: // data.drop, elem.drop: index.
+		return r.skipLEB(1)
+	case sub == 10: // memory.copy: destination and source memory indices.
+		return r.skipMemoryIndex(2)
+	case sub == 11: // memory.fill: memory index.
+		return r.skipMemoryIndex(1)
+	case sub == 12, sub == 14: // table.init, table.copy: two indices.
+		return r.skipLEB(2)
+	case sub >= 15 && sub <= 17: // table.grow, table.size, table.fill: table index.
+		return r.skipLEB(1)
+	}
+	return fmt.Errorf("unsupported instruction 0x%x %d", prefixMisc, sub)
+}
+
+func (r *reader) skipSIMD() error {
+	sub, err := r.u32()
+	if err != nil {
+		return err
+	}
+	switch {
+	case sub <= 11, sub == 92, sub == 93: // Loads and stores.
+		return r.skipMemarg()
+	case sub == 12, sub == 13: // v128.const, i8x16.shuffle.
+		return r.skip(16)
+	case sub >= 21 && sub <= 34: // Lane accesses: lane index.
+		return r.skip(1)
+	case sub >= 84 && sub <= 91: // Lane loads and stores.
+		if err = r.skipMemarg(); err != nil {
+			return err
+		}
+		return r.skip(1)
+	case sub <= 0xff:
+		// No immediates. Unassigned opcodes are rejected by validation.
+		return nil
+	}
+	return fmt.Errorf("unsupported instruction 0x%x %d", prefixSIMD, sub)
+}
+
+func (r *reader) skipAtomic() error {
+	sub, err := r.u32()
+	if err != nil {
+		return err
+	}
+	switch {
+	case sub == atomicFence: // Reserved byte.
+		return r.skip(1)
+	case sub <= 2, sub >= 0x10 && sub <= 0x4e: // notify, wait, loads, stores and rmw.
+		return r.skipMemarg()
+	}
+	return fmt.Errorf("unsupported instruction 0x%x %d", prefixAtomic, sub)
+}
+
+func appendULEB(b []byte, v uint64) []byte {
+	return binary.AppendUvarint(b, v)
+}
+
+func appendSLEB(b []byte, v int64) []byte {
+	for {
+		c := byte(v & 0x7f)
+		v >>= 7
+		if (v == 0 && c&0x40 == 0) || (v == -1 && c&0x40 != 0) {
+			return append(b, c)
+		}
+		b = append(b, c|0x80)
+	}
+}
@@ -0,0 +1,166 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// countdownModule returns a module exporting run(n i32) i32, which loops n
+// times. The module has a global section if withGlobal is set.
+func countdownModule(withGlobal bool) []byte {
+	return runModule(withGlobal, []byte{
+		0,            // No locals.
+		opLoop, 0x40, // loop
+		0x20, 0, 0x41, 1, 0x6b, // local.get 0, i32.const 1, i32.sub
+		0x22, 0, 0x0d, 0, // local.tee 0, br_if 0
+		opEnd,
+		0x20, 0, // local.get 0
+		opEnd,
+	})
+}
+
+// runModule returns a module exporting run(i32) i32 with the given body.
+func runModule(withGlobal bool, body []byte) []byte {
+	m := []byte("\x00asm\x01\x00\x00\x00")
+	m = append(m, 1, 6, 1, 0x60, 1, 0x7f, 1, 0x7f) // Type: (i32) -> i32.
+	m = append(m, 3, 2, 1, 0)                      // Function of type 0.
+	if withGlobal {
+		m = append(m, 6, 6, 1, 0x7f, 0, 0x41, 42, opEnd) // Global: i32 const 42.
+	}
+	m = append(m, 7, 7, 1, 3, 'r', 'u', 'n', 0, 0) // Export function 0 as run.
+	code := append([]byte{1, byte(len(body))}, body...)
+	m = append(m, sectionCode, byte(len(code)))
+	return append(m, code...)
+}
+
+func TestInstrumentFuel(t *testing.T) {
+	for name, withGlobal := range map[string]bool{"global_section": true, "no_global_section": false} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			code, err := instrumentFuel(countdownModule(withGlobal))
+			require.NoError(t, err)
+
+			rt := wazero.NewRuntime(ctx)
+			defer rt.Close(ctx)
+			mod, err := rt.Instantiate(ctx, code)
+			require.NoError(t, err)
+			fuel, ok := mod.ExportedGlobal(fuelGlobal).(api.MutableGlobal)
+			require.True(t, ok, "fuel global must be exported and mutable")
+			assert.Equal(t, ^uint64(0), fuel.Get(), "fuel must be unlimited initially")
+
+			// Four instructions outside of the loop, and five for each
+			// iteration.
+			fuel.Set(100)
+			_, err = mod.ExportedFunction("run").Call(ctx, 5)
+			require.NoError(t, err)
+			assert.EqualValues(t, 71, fuel.Get())
+
+			fuel.Set(100)
+			_, err = mod.ExportedFunction("run").Call(ctx, 100)
+			require.ErrorContains(t, err, "unreachable")
+			assert.Zero(t, fuel.Get())
+		})
+	}
+}
+
+func TestInstrumentFuelMalformed(t *testing.T) {
+	_, err := instrumentFuel([]byte("not wasm"))
+	require.ErrorIs(t, err, errMalformedModule)
+
+	module := countdownModule(false)
+	_, err = instrumentFuel(module[:len(module)-3])
+	require.ErrorIs(t, err, errMalformedModule)
+}
+
+func TestInstrumentFuelNestedLoops(t *testing.T) {
+	ctx := context.Background()
+	code, err := instrumentFuel(runModule(false, []byte{
+		1, 1, 0x7f, // One i32 local.
+		opLoop, 0x40, // outer loop
+		0x41, 3, 0x21, 1, // i32.const 3, local.set 1
+		opLoop, 0x40, // inner loop
+		0x20, 1, 0x41, 1, 0x6b, 0x22, 1, 0x0d, 0, // local.get 1, i32.const 1, i32.sub, local.tee 1, br_if 0
+		opEnd,
+		0x20, 0, 0x41, 1, 0x6b, 0x22, 0, 0x0d, 0, // local.get 0, i32.const 1, i32.sub, local.tee 0, br_if 0
+		opEnd,
+		0x20, 0, // local.get 0
+		opEnd,
+	}))
+	require.NoError(t, err)
+
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+	mod, err := rt.Instantiate(ctx, code)
+	require.NoError(t, err)
+	fuel, ok := mod.ExportedGlobal(fuelGlobal).(api.MutableGlobal)
+	require.True(t, ok)
+
+	// 4 instructions outside of the loops, 9 for each outer iteration and
+	// 5 for each inner iteration.
+	fuel.Set(1000)
+	_, err = mod.ExportedFunction("run").Call(ctx, 2)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1000-4-2*9-2*3*5, fuel.Get())
+}
+
+func TestInstrumentFuelUnsupported(t *testing.T) {
+	for name, instr := range map[string][]byte{
+		"exceptions":      {0x06, 0x40, opEnd},
+		"tail_call":       {0x12, 0},
+		"multi_memory":    {0x41, 0, 0x28, memargHasMemory | 2, 1, 0, 0x1a},
+		"memory_index":    {0x3f, 1, 0x1a},
+		"memory_fill":     {0x41, 0, 0x41, 0, 0x41, 0, prefixMisc, 11, 1},
+		"unknown_misc":    {prefixMisc, 18},
+		"relaxed_simd":    {prefixSIMD, 0x80, 0x02},
+		"unknown_atomic":  {prefixAtomic, 4},
+		"unknown_opcode":  {0x27},
+		"truncated_const": {0x44, 0, 0},
+	} {
+		t.Run(name, func(t *testing.T) {
+			body := append([]byte{0}, instr...)
+			body = append(body, 0x20, 0, opEnd)
+			_, err := instrumentFuel(runModule(false, body))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestFuelLimit(t *testing.T) {
+	p := newTestProcessor(t, buildTestModule(t), mapstr.M{"timeout": 0, "max_fuel": 10_000_000})
+
+	evt, err := p.Run(&beat.Event{Fields: mapstr.M{"loop": true}})
+	require.ErrorIs(t, err, errFuelExhausted)
+	assert.Equal(t, []string{"_wasm_exception"}, evt.Fields["tags"])
+
+	// The instance is replaced and fuel is refilled for each event.
+	for range 3 {
+		evt, err = p.Run(&beat.Event{Fields: mapstr.M{"message": "hello"}})
+		require.NoError(t, err)
+		assert.Equal(t, "hello", evt.Fields["copy"])
+	}
+}
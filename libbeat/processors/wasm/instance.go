@@ -0,0 +1,240 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"go.uber.org/zap"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+const (
+	entryPointFunction = "process"
+	initFunction       = "_initialize"
+)
+
+var (
+	errTimeout       = errors.New("wasm processor execution timeout")
+	errFuelExhausted = errors.New("wasm processor ran out of fuel")
+)
+
+// instance is an instantiation of the processor module. An instance
+// processes one event at a time.
+type instance struct {
+	mod            api.Module
+	processFunc    api.Function
+	fuel           api.MutableGlobal // nil unless max_fuel is set.
+	maxFuel        uint64
+	log            *logp.Logger
+	timeout        time.Duration
+	tagOnException string
+}
+
+func newInstance(rt wazero.Runtime, compiled wazero.CompiledModule, conf Config, logger *logp.Logger) (*instance, error) {
+	// Instances are anonymous so that several can be
+	// instantiated from the same compiled module.
+	modConfig := wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions(initFunction).
+		WithSysWalltime().
+		WithSysNanotime()
+	mod, err := rt.InstantiateModule(context.Background(), compiled, modConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instantiate module: %w", err)
+	}
+
+	processFunc := mod.ExportedFunction(entryPointFunction)
+	if processFunc == nil {
+		_ = mod.Close(context.Background())
+		return nil, errors.New("process function not exported by module")
+	}
+	def := processFunc.Definition()
+	if len(def.ParamTypes()) != 0 || len(def.ResultTypes()) != 1 || def.ResultTypes()[0] != api.ValueTypeI32 {
+		_ = mod.Close(context.Background())
+		return nil, errors.New("process function must have no parameters and return an i32")
+	}
+
+	var fuel api.MutableGlobal
+	if conf.MaxFuel > 0 {
+		var ok bool
+		fuel, ok = mod.ExportedGlobal(fuelGlobal).(api.MutableGlobal)
+		if !ok {
+			_ = mod.Close(context.Background())
+			return nil, errors.New("module is not instrumented for fuel metering")
+		}
+	}
+
+	return &instance{
+		mod:            mod,
+		processFunc:    processFunc,
+		fuel:           fuel,
+		maxFuel:        conf.MaxFuel,
+		log:            logger,
+		timeout:        conf.Timeout,
+		tagOnException: conf.TagOnException,
+	}, nil
+}
+
+// runProcessFunc executes process() from the module. If the returned
+// error is non-nil, the instance must not be reused.
+func (i *instance) runProcessFunc(b *beat.Event) (out *beat.Event, broken bool, err error) {
+	c := &call{event: b, log: i.log}
+	defer func() {
+		if r := recover(); r != nil {
+			i.log.Errorw("The wasm processor caused an unexpected panic "+
+				"while processing an event. Recovering, but please report this.",
+				"panic", r,
+				zap.Stack("stack"))
+			out, broken, err = b, true, fmt.Errorf("unexpected panic in wasm processor: %v", r)
+			i.annotateError(b, err)
+		}
+	}()
+
+	ctx := context.WithValue(context.Background(), callKey{}, c)
+	if i.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, i.timeout)
+		defer cancel()
+	}
+
+	if i.fuel != nil {
+		i.fuel.Set(i.maxFuel)
+	}
+	results, err := i.processFunc.Call(ctx)
+	if err != nil {
+		// A trapped or interrupted instance is left in an unknown
+		// state, so it is discarded.
+		switch {
+		case errors.Is(ctx.Err(), context.DeadlineExceeded):
+			err = errTimeout
+		case i.fuel != nil && i.fuel.Get() == 0:
+			err = errFuelExhausted
+		}
+		err = fmt.Errorf("failed in process function: %w", err)
+		i.annotateError(b, err)
+		return b, true, err
+	}
+	if rc := int32(results[0]); rc != 0 { //nolint:gosec // G115: i32 result
+		err = fmt.Errorf("process function returned error code %d", rc)
+		i.annotateError(b, err)
+		return b, false, err
+	}
+
+	if c.dropped {
+		return nil, false, nil
+	}
+	return b, false, nil
+}
+
+func (i *instance) annotateError(b *beat.Event, err error) {
+	if b.Fields == nil {
+		b.Fields = mapstr.M{}
+	}
+	if i.tagOnException != "" {
+		_ = mapstr.AddTags(b.Fields, []string{i.tagOnException})
+	}
+	_, _ = b.Fields.Put("error.message", err.Error())
+}
+
+func (i *instance) close() {
+	_ = i.mod.Close(context.Background())
+}
+
+type instancePool struct {
+	New                 func() (*instance, error)
+	C                   chan *instance
+	NewInstancesAllowed bool
+}
+
+func newInstancePool(rt wazero.Runtime, compiled wazero.CompiledModule, c Config, logger *logp.Logger) (*instancePool, error) {
+	// Instantiate once to validate the module.
+	i, err := newInstance(rt, compiled, c, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := instancePool{
+		New: func() (*instance, error) {
+			return newInstance(rt, compiled, c, logger)
+		},
+		C:                   make(chan *instance, c.MaxCachedInstances),
+		NewInstancesAllowed: !c.OnlyCachedInstances,
+	}
+	pool.Put(i)
+
+	// If we are not allowed to create new instances, pre-cache requested instances
+	if !pool.NewInstancesAllowed {
+		for n := 0; n < c.MaxCachedInstances-1; n++ {
+			i, err = pool.New()
+			if err != nil {
+				return nil, err
+			}
+			pool.Put(i)
+		}
+	}
+
+	return &pool, nil
+}
+
+func (p *instancePool) Get() (*instance, error) {
+	if !p.NewInstancesAllowed {
+		return <-p.C, nil
+	}
+
+	// Try to get an instance from the pool, if none is available, create a new one
+	select {
+	case i := <-p.C:
+		return i, nil
+	default:
+		return p.New()
+	}
+}
+
+func (p *instancePool) Put(i *instance) {
+	if i != nil {
+		select {
+		case p.C <- i:
+		default:
+			i.close()
+		}
+	}
+}
+
+// Discard replaces a broken instance. If new instances may not be created
+// on demand, a replacement is added to the pool.
+func (p *instancePool) Discard(i *instance) error {
+	i.close()
+	if p.NewInstancesAllowed {
+		return nil
+	}
+	n, err := p.New()
+	if err != nil {
+		return err
+	}
+	p.Put(n)
+	return nil
+}
@@ -0,0 +1,111 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build wasip1
+
+// This program is the test module for the wasm processor. Build it with:
+//
+//	GOOS=wasip1 GOARCH=wasm go build -buildmode=c-shared -o processor.wasm .
+package main
+
+import (
+	"unsafe"
+)
+
+//go:wasmimport beat get_field
+func getField(key unsafe.Pointer, keyLen uint32, buf unsafe.Pointer, bufLen uint32) int32
+
+//go:wasmimport beat put_field
+func putField(key unsafe.Pointer, keyLen uint32, val unsafe.Pointer, valLen uint32) int32
+
+//go:wasmimport beat delete_field
+func deleteField(key unsafe.Pointer, keyLen uint32) int32
+
+//go:wasmimport beat add_tag
+func addTag(tag unsafe.Pointer, tagLen uint32) int32
+
+//go:wasmimport beat drop
+func drop()
+
+//go:wasmimport beat log
+func log(level uint32, msg unsafe.Pointer, msgLen uint32)
+
+// get returns the JSON encoded value of the field, and false if the event
+// has no such field.
+func get(key string) ([]byte, bool) {
+	buf := make([]byte, 64)
+	for {
+		n := getField(unsafe.Pointer(unsafe.StringData(key)), uint32(len(key)), unsafe.Pointer(&buf[0]), uint32(len(buf)))
+		if n < 0 {
+			return nil, false
+		}
+		if int(n) <= len(buf) {
+			return buf[:n], true
+		}
+		buf = make([]byte, n)
+	}
+}
+
+func put(key string, val []byte) int32 {
+	return putField(unsafe.Pointer(unsafe.StringData(key)), uint32(len(key)), unsafe.Pointer(&val[0]), uint32(len(val)))
+}
+
+func str(s string) (unsafe.Pointer, uint32) {
+	return unsafe.Pointer(unsafe.StringData(s)), uint32(len(s))
+}
+
+var retained [][]byte
+
+//go:wasmexport process
+func process() int32 {
+	if _, ok := get("drop"); ok {
+		drop()
+		return 0
+	}
+	if _, ok := get("fail"); ok {
+		return 3
+	}
+	if _, ok := get("loop"); ok {
+		for {
+		}
+	}
+	if _, ok := get("grow"); ok {
+		for {
+			retained = append(retained, make([]byte, 1<<20))
+		}
+	}
+
+	msg, ok := get("message")
+	if !ok {
+		return 0
+	}
+	if put("copy", msg) != 0 {
+		return 1
+	}
+	if put("counts", []byte(`{"int":1,"float":1.5}`)) != 0 {
+		return 2
+	}
+	if _, ok := get("remove"); ok {
+		deleteField(str("remove"))
+	}
+	addTag(str("wasm"))
+	p, n := str("processed message")
+	log(0, p, n)
+	return 0
+}
+
+func main() {}
@@ -0,0 +1,251 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rcrowley/go-metrics"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+	"github.com/elastic/elastic-agent-libs/monitoring/adapter"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+const (
+	processorName = "wasm"
+	logName       = "processor." + processorName
+)
+
+func init() {
+	processors.RegisterPlugin(processorName, New)
+}
+
+type wasmProcessor struct {
+	Config
+	logger *logp.Logger
+	stats  *processorStats
+
+	mu         sync.Mutex
+	runtime    wazero.Runtime
+	pool       *instancePool
+	sourceFile string
+}
+
+// New constructs a new WebAssembly processor.
+func New(c *config.C, log *logp.Logger) (beat.Processor, error) {
+	conf := defaultConfig()
+	if err := c.Unpack(&conf); err != nil {
+		return nil, err
+	}
+
+	return NewFromConfig(conf, monitoring.Default, log)
+}
+
+// NewFromConfig constructs a new WebAssembly processor from the given config
+// object. The module is loaded and compiled when SetPaths is called.
+func NewFromConfig(c Config, reg *monitoring.Registry, logger *logp.Logger) (beat.Processor, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	logger = logger.Named(logName)
+	if c.Tag != "" {
+		logger = logger.With("id", c.Tag)
+	}
+
+	return &wasmProcessor{
+		Config: c,
+		logger: logger,
+		stats:  getStats(c.Tag, reg, logger),
+	}, nil
+}
+
+// SetPaths loads and compiles the module using the provided paths
+// configuration. It must be called before the processor can be used.
+func (p *wasmProcessor) SetPaths(path *paths.Path) error {
+	file := path.Resolve(paths.Config, p.File)
+	code, err := loadModule(file)
+	if err != nil {
+		return annotateError(p.Tag, err)
+	}
+	return annotateError(p.Tag, p.compile(file, code))
+}
+
+func loadModule(path string) ([]byte, error) {
+	if common.IsStrictPerms() {
+		if err := common.OwnerHasExclusiveWritePerms(path); err != nil {
+			return nil, err
+		}
+	}
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %v: %w", path, err)
+	}
+	return code, nil
+}
+
+func (p *wasmProcessor) compile(sourceFile string, code []byte) error {
+	ctx := context.Background()
+
+	if p.MaxFuel > 0 {
+		instrumented, err := instrumentFuel(code)
+		if err != nil {
+			return fmt.Errorf("failed to instrument module %v: %w", sourceFile, err)
+		}
+		code = instrumented
+	}
+
+	// Closing the module when the call context is done is what enforces
+	// the execution timeout.
+	rt := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(p.memoryLimitPages()).
+		WithCloseOnContextDone(true))
+
+	if _, err := wasi_snapshot_preview1.Instantiate(ctx, rt); err != nil {
+		_ = rt.Close(ctx)
+		return fmt.Errorf("failed to instantiate WASI: %w", err)
+	}
+	if err := instantiateHostModule(ctx, rt); err != nil {
+		_ = rt.Close(ctx)
+		return fmt.Errorf("failed to instantiate host module: %w", err)
+	}
+
+	compiled, err := rt.CompileModule(ctx, code)
+	if err != nil {
+		_ = rt.Close(ctx)
+		return fmt.Errorf("failed to compile module %v: %w", sourceFile, err)
+	}
+
+	pool, err := newInstancePool(rt, compiled, p.Config, p.logger)
+	if err != nil {
+		_ = rt.Close(ctx)
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.runtime != nil {
+		_ = p.runtime.Close(ctx)
+	}
+	p.runtime = rt
+	p.pool = pool
+	p.sourceFile = sourceFile
+	return nil
+}
+
+func annotateError(id string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if id != "" {
+		return fmt.Errorf("failed in processor.wasm with id=%v: %w", id, err)
+	}
+	return fmt.Errorf("failed in processor.wasm: %w", err)
+}
+
+// Run executes the processor on the given event. It invokes the process
+// function exported by the module.
+func (p *wasmProcessor) Run(event *beat.Event) (*beat.Event, error) {
+	p.mu.Lock()
+	pool := p.pool
+	p.mu.Unlock()
+	if pool == nil {
+		return event, fmt.Errorf("wasm processor not initialized: SetPaths must be called")
+	}
+
+	i, err := pool.Get()
+	if err != nil {
+		return event, annotateError(p.Tag, err)
+	}
+
+	start := time.Now()
+	rtn, broken, err := i.runProcessFunc(event)
+	if p.stats != nil {
+		p.stats.processTime.Update(int64(time.Since(start)))
+		if err != nil {
+			p.stats.exceptions.Inc()
+		}
+	}
+
+	if broken {
+		if dErr := pool.Discard(i); dErr != nil {
+			p.logger.Errorw("Failed to replace wasm module instance.", "error", dErr)
+		}
+	} else {
+		pool.Put(i)
+	}
+	return rtn, annotateError(p.Tag, err)
+}
+
+// Close releases the runtime and all module instances.
+func (p *wasmProcessor) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.runtime == nil {
+		return nil
+	}
+	err := p.runtime.Close(context.Background())
+	p.runtime = nil
+	p.pool = nil
+	return err
+}
+
+func (p *wasmProcessor) String() string {
+	return "wasm=[id=" + p.Tag + ", file=" + p.File + "]"
+}
+
+type processorStats struct {
+	exceptions  *monitoring.Int
+	processTime metrics.Sample
+}
+
+func getStats(id string, reg *monitoring.Registry, logger *logp.Logger) *processorStats {
+	if id == "" || reg == nil {
+		return nil
+	}
+
+	namespace := logName + "." + id
+	processorReg := reg.GetRegistry(namespace)
+	if processorReg != nil {
+		// If a module is reloaded then the namespace could already exist.
+		_ = processorReg.Clear()
+	} else {
+		processorReg = reg.GetOrCreateRegistry(namespace, monitoring.DoNotReport)
+	}
+
+	stats := &processorStats{
+		exceptions:  monitoring.NewInt(processorReg, "exceptions"),
+		processTime: metrics.NewUniformSample(2048),
+	}
+	_ = adapter.NewGoMetrics(processorReg, "histogram", logger, adapter.Accept).
+		Register("process_time", metrics.NewHistogram(stats.processTime))
+
+	return stats
+}
@@ -0,0 +1,238 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package wasm
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tetratelabs/wazero"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+// buildTestModule compiles the test module in testdata/processor. The
+// test is skipped if the toolchain cannot target wasip1.
+func buildTestModule(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	out := filepath.Join(dir, "processor.wasm")
+	cmd := exec.Command(filepath.Join(runtime.GOROOT(), "bin", "go"), "build", "-buildmode=c-shared", "-o", out, ".")
+	cmd.Dir = filepath.Join("testdata", "processor")
+	cmd.Env = append(os.Environ(), "GOOS=wasip1", "GOARCH=wasm", "CGO_ENABLED=0")
+	if b, err := cmd.CombinedOutput(); err != nil {
+		t.Skipf("failed to build test module: %v\n%s", err, b)
+	}
+	return out
+}
+
+func newTestProcessor(t *testing.T, file string, cfg mapstr.M) beat.Processor {
+	t.Helper()
+	c := mapstr.M{"file": file}
+	c.Update(cfg)
+	p, err := New(conf.MustNewConfigFrom(c), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	require.NoError(t, p.(*wasmProcessor).SetPaths(&paths.Path{Config: filepath.Dir(file)}))
+	t.Cleanup(func() { p.(*wasmProcessor).Close() })
+	return p
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  mapstr.M
+		err  string
+	}{
+		{name: "missing_file", cfg: mapstr.M{}, err: "wasm module must be defined via 'file'"},
+		{name: "small_memory", cfg: mapstr.M{"file": "a.wasm", "max_memory": "1KiB"}, err: "max_memory must be at least 64KiB"},
+		{name: "large_memory", cfg: mapstr.M{"file": "a.wasm", "max_memory": "5GiB"}, err: "max_memory must be at most 4GiB"},
+		{name: "only_cached", cfg: mapstr.M{"file": "a.wasm", "only_cached_instances": true, "max_cached_instances": 0}, err: "max_cached_instances must be set"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := New(conf.MustNewConfigFrom(test.cfg), logptest.NewTestingLogger(t, ""))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestNotInitialized(t *testing.T) {
+	p, err := New(conf.MustNewConfigFrom(mapstr.M{"file": "a.wasm"}), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	_, err = p.Run(&beat.Event{Fields: mapstr.M{}})
+	assert.ErrorContains(t, err, "SetPaths must be called")
+}
+
+// hostCallModule returns a module without memory, which calls get_field
+// from _initialize and from process. The result of the call during
+// initialization is exported as the init_rc global.
+func hostCallModule() []byte {
+	vec := func(entries ...[]byte) []byte {
+		b := []byte{byte(len(entries))}
+		for _, e := range entries {
+			b = append(b, e...)
+		}
+		return b
+	}
+	name := func(s string) []byte { return append([]byte{byte(len(s))}, s...) }
+	callGetField := []byte{0x41, 0, 0x41, 0, 0x41, 0, 0x41, 0, 0x10, 0}
+
+	sections := [][]byte{
+		{1}, vec( // Types: get_field, _initialize and process.
+			[]byte{0x60, 4, 0x7f, 0x7f, 0x7f, 0x7f, 1, 0x7f},
+			[]byte{0x60, 0, 0},
+			[]byte{0x60, 0, 1, 0x7f},
+		),
+		{2}, vec(append(append(name(hostModule), name("get_field")...), 0, 0)),
+		{3}, vec([]byte{1}, []byte{2}),
+		{6}, vec([]byte{0x7f, 1, 0x41, 0, opEnd}),
+		{7}, vec(
+			append(name(initFunction), 0, 1),
+			append(name(entryPointFunction), 0, 2),
+			append(name("init_rc"), externKindGlob, 0),
+		),
+		{sectionCode}, vec(
+			name(string(append(append([]byte{0}, callGetField...), opGlobalSet, 0, opEnd))),
+			name(string(append(append([]byte{0}, callGetField...), opEnd))),
+		),
+	}
+	m := []byte("\x00asm\x01\x00\x00\x00")
+	for i := 0; i < len(sections); i += 2 {
+		m = append(m, sections[i]...)
+		m = append(m, name(string(sections[i+1]))...)
+	}
+	return m
+}
+
+func TestHostCallOutsideProcess(t *testing.T) {
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+	require.NoError(t, instantiateHostModule(ctx, rt))
+
+	mod, err := rt.InstantiateWithConfig(ctx, hostCallModule(), wazero.NewModuleConfig().WithStartFunctions(initFunction))
+	require.NoError(t, err)
+	assert.EqualValues(t, int32(resultNoEvent), int32(mod.ExportedGlobal("init_rc").Get())) //nolint:gosec // G115: i32 global
+
+	c := &call{event: &beat.Event{Fields: mapstr.M{}}, log: logptest.NewTestingLogger(t, "")}
+	results, err := mod.ExportedFunction(entryPointFunction).Call(context.WithValue(ctx, callKey{}, c))
+	require.NoError(t, err)
+	assert.EqualValues(t, int32(resultBadMemory), int32(results[0])) //nolint:gosec // G115: i32 result
+}
+
+func TestInvalidModule(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "invalid.wasm")
+	require.NoError(t, os.WriteFile(file, []byte("not wasm"), 0o600))
+	p, err := New(conf.MustNewConfigFrom(mapstr.M{"file": file}), logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	err = p.(*wasmProcessor).SetPaths(&paths.Path{Config: filepath.Dir(file)})
+	assert.ErrorContains(t, err, "failed to compile module")
+}
+
+func TestProcessor(t *testing.T) {
+	p := newTestProcessor(t, buildTestModule(t), nil)
+
+	t.Run("modify", func(t *testing.T) {
+		evt, err := p.Run(&beat.Event{Fields: mapstr.M{"message": "hello", "remove": true}})
+		require.NoError(t, err)
+		require.NotNil(t, evt)
+		assert.Equal(t, mapstr.M{
+			"message": "hello",
+			"copy":    "hello",
+			"counts":  mapstr.M{"int": int64(1), "float": 1.5},
+			"tags":    []string{"wasm"},
+		}, evt.Fields)
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		evt, err := p.Run(&beat.Event{Fields: mapstr.M{"drop": true}})
+		require.NoError(t, err)
+		assert.Nil(t, evt)
+	})
+
+	t.Run("error_code", func(t *testing.T) {
+		evt, err := p.Run(&beat.Event{Fields: mapstr.M{"fail": true}})
+		require.ErrorContains(t, err, "process function returned error code 3")
+		require.NotNil(t, evt)
+		assert.Equal(t, []string{"_wasm_exception"}, evt.Fields["tags"])
+		msg, _ := evt.GetValue("error.message")
+		assert.Equal(t, "process function returned error code 3", msg)
+	})
+}
+
+func TestTimeout(t *testing.T) {
+	p := newTestProcessor(t, buildTestModule(t), mapstr.M{"timeout": "100ms"})
+
+	start := time.Now()
+	evt, err := p.Run(&beat.Event{Fields: mapstr.M{"loop": true}})
+	assert.Less(t, time.Since(start), 10*time.Second)
+	require.ErrorIs(t, err, errTimeout)
+	assert.Equal(t, []string{"_wasm_exception"}, evt.Fields["tags"])
+
+	// The interrupted instance is replaced.
+	evt, err = p.Run(&beat.Event{Fields: mapstr.M{"message": "hello"}})
+	require.NoError(t, err)
+	assert.Equal(t, "hello", evt.Fields["copy"])
+}
+
+func TestMemoryLimit(t *testing.T) {
+	p := newTestProcessor(t, buildTestModule(t), mapstr.M{"max_memory": "32MiB", "only_cached_instances": true, "max_cached_instances": 1})
+
+	evt, err := p.Run(&beat.Event{Fields: mapstr.M{"grow": true}})
+	require.Error(t, err)
+	assert.Equal(t, []string{"_wasm_exception"}, evt.Fields["tags"])
+
+	evt, err = p.Run(&beat.Event{Fields: mapstr.M{"message": "hello"}})
+	require.NoError(t, err)
+	assert.Equal(t, "hello", evt.Fields["copy"])
+}
+
+func TestStats(t *testing.T) {
+	file := buildTestModule(t)
+	reg := monitoring.NewRegistry()
+	p, err := NewFromConfig(Config{
+		Tag:                "stats",
+		File:               file,
+		Timeout:            time.Second,
+		MaxMemory:          64 * 1024 * 1024,
+		MaxCachedInstances: 1,
+	}, reg, logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+	require.NoError(t, p.(*wasmProcessor).SetPaths(&paths.Path{Config: filepath.Dir(file)}))
+	defer p.(*wasmProcessor).Close()
+
+	_, err = p.Run(&beat.Event{Fields: mapstr.M{"fail": true}})
+	require.Error(t, err)
+
+	snap := monitoring.CollectFlatSnapshot(reg, monitoring.Full, false)
+	assert.EqualValues(t, 1, snap.Ints[logName+".stats.exceptions"])
+	assert.EqualValues(t, 1, snap.Ints[logName+".stats.histogram.process_time.count"])
+}
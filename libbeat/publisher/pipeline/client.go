@@ -25,6 +25,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/processors"
 	"github.com/elastic/beats/v7/libbeat/publisher"
 	"github.com/elastic/beats/v7/libbeat/publisher/queue"
+	"github.com/elastic/beats/v7/libbeat/publisher/tap"
 	"github.com/elastic/elastic-agent-libs/logp"
 )
 
@@ -53,6 +54,10 @@ type client struct {
 	isOpen       atomic.Bool // set to false during shutdown, such that no new events will be accepted anymore.
 	disconnected atomic.Bool // set the first time disconnect runs, so the second stage is idempotent.
 
+	// producerClosed is set, with mutex held, before the producer is closed.
+	// Events published by processors after that are dropped.
+	producerClosed bool
+
 	// onRemove, if set, unregisters this client from its owning Pipeline. It is
 	// run once, from disconnect.
 	onRemove func()
@@ -65,6 +70,10 @@ type client struct {
 	observer       observer
 	eventListener  beat.EventListener
 	clientListener beat.ClientListener
+
+	// tap receives the events handed to the queue, tagged with inputID.
+	tap     *tap.Hub
+	inputID string
 }
 
 func (c *client) PublishAll(events []beat.Event) {
@@ -119,14 +128,48 @@ func (c *client) publish(e beat.Event) {
 		return
 	}
 
-	e = *event
+	c.enqueue(*event)
+}
+
+// connectProcessors lets processors that publish events of their own, such
+// as periodic summaries, publish them through this client.
+func (c *client) connectProcessors() {
+	if c.processors != nil {
+		processors.SetPublisher(c.processors, c.publishProcessed)
+	}
+}
+
+// publishProcessed publishes an event generated by one of the client's
+// processors, which has already passed through the processors following
+// it. Events are accepted until the client's producer is closed, and are
+// dropped afterwards. Once the client is closing, events are only accepted if
+// the queue has room right away, so that processors flushing their events
+// can't block Close.
+func (c *client) publishProcessed(e beat.Event) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.onNewEvent()
+	if c.producerClosed {
+		c.onDroppedOnPublish(e)
+		return
+	}
+	c.eventListener.AddEvent(e, true)
+	c.enqueue(e)
+}
+
+// enqueue hands the event to the queue producer. It must be called with
+// c.mutex held.
+func (c *client) enqueue(e beat.Event) {
+	c.tap.Capture(tap.PointQueue, c.inputID, "", &e, nil)
+
 	pubEvent := publisher.Event{
 		Content: e,
 		Flags:   c.eventFlags,
 	}
 
 	var published bool
-	if c.canDrop {
+	if c.canDrop || !c.isOpen.Load() {
 		_, published = c.producer.TryPublish(pubEvent)
 	} else {
 		_, published = c.producer.Publish(pubEvent)
@@ -143,6 +186,8 @@ func (c *client) publish(e beat.Event) {
 // events and closes the underlying queue producer, then returns immediately. It
 // does NOT wait for acknowledgments — acks for already-published events keep
 // flowing through the event listener until the owning Pipeline calls disconnect.
+// Processors publishing events of their own are flushed before the producer is
+// closed, which takes a bounded time.
 //
 // Note: unlike before, Close no longer blocks for ClientConfig.WaitClose. The
 // pipeline-level shutdown (Pipeline.Disconnect, bounded by its context) is now
@@ -157,6 +202,19 @@ func (c *client) Close() error {
 	c.onClosing()
 	c.mutex.Unlock()
 
+	// Let processors publish the events they hold, such as final summaries,
+	// while the producer is still open. Publishing doesn't block anymore, so
+	// this is bounded.
+	if c.processors != nil {
+		processors.Flush(c.processors)
+	}
+
+	// Processors that failed to flush in time may still publish events,
+	// which are dropped from now on.
+	c.mutex.Lock()
+	c.producerClosed = true
+	c.mutex.Unlock()
+
 	c.logger.Debug("client: close queue producer")
 	c.producer.Close()
 	c.logger.Debug("client: done producer close")
@@ -195,6 +195,89 @@ func TestClient(t *testing.T) {
 	})
 }
 
+// TestClientProcessorPublishesOnClose verifies that events flushed by a
+// processor when the client is closed, such as final summaries, reach the
+// queue before the producer is closed.
+func TestClientProcessorPublishesOnClose(t *testing.T) {
+	l := logptest.NewTestingLogger(t, "")
+	q := memqueue.NewQueue[publisher.Event](l, nil, memqueue.Settings{
+		Events:        5,
+		MaxGetRequest: 1,
+		FlushTimeout:  time.Millisecond,
+	}, 5, nil)
+
+	p := &summaryProcessor{}
+	pipeline := makePipeline(t, Settings{Processors: testProcessorSupporter{Processor: p}}, q)
+	client, err := pipeline.Connect()
+	require.NoError(t, err)
+	require.NotNil(t, p.publish, "processor publisher not set")
+
+	client.Publish(beat.Event{Fields: mapstr.M{"number": 1}})
+	client.Publish(beat.Event{Fields: mapstr.M{"number": 2}})
+	require.NoError(t, client.Close())
+	assert.True(t, p.closed)
+
+	batch, err := q.Get(5)
+	require.NoError(t, err)
+	require.Equal(t, 1, batch.Count())
+	assert.Equal(t, mapstr.M{"count": 2}, batch.Entry(0).Content.Fields)
+	batch.Done()
+	require.NoError(t, pipeline.Disconnect(t.Context()))
+}
+
+// TestClientProcessorPublishesAfterClose verifies that events published by a
+// processor after the client's producer is closed are dropped and counted.
+func TestClientProcessorPublishesAfterClose(t *testing.T) {
+	p := &summaryProcessor{}
+	q := memqueue.NewQueue[publisher.Event](logptest.NewTestingLogger(t, ""), nil, memqueue.Settings{
+		Events:        5,
+		MaxGetRequest: 1,
+		FlushTimeout:  time.Millisecond,
+	}, 5, nil)
+	pipeline := makePipeline(t, Settings{Processors: testProcessorSupporter{Processor: p}}, q)
+	listener := &mockClientListener{}
+	c, err := pipeline.ConnectWith(beat.ClientConfig{ClientListener: listener})
+	require.NoError(t, err)
+
+	var published int
+	c.(*client).producer = &testProducer{publish: func(bool, publisher.Event) (queue.EntryID, bool) {
+		published++
+		return queue.EntryID(published), true
+	}}
+	require.NoError(t, c.Close())
+	require.Equal(t, 1, published, "summary flushed on close")
+
+	p.publish(beat.Event{Fields: mapstr.M{"count": 0}})
+	assert.Equal(t, 1, published)
+	assert.Equal(t, 1, listener.eventsDroppedOnPublish)
+	require.NoError(t, pipeline.Disconnect(t.Context()))
+}
+
+// summaryProcessor drops all events and publishes their count when flushed.
+type summaryProcessor struct {
+	count   int
+	closed  bool
+	publish func(beat.Event)
+}
+
+func (p *summaryProcessor) String() string { return "summaryProcessor" }
+
+func (p *summaryProcessor) Run(*beat.Event) (*beat.Event, error) {
+	p.count++
+	return nil, nil
+}
+
+func (p *summaryProcessor) SetPublisher(publish func(beat.Event)) { p.publish = publish }
+
+func (p *summaryProcessor) Flush() {
+	p.publish(beat.Event{Fields: mapstr.M{"count": p.count}})
+}
+
+func (p *summaryProcessor) Close() error {
+	p.closed = true
+	return nil
+}
+
 // TestDisconnectIsIdempotent verifies that the second stage of client shutdown
 // runs its finalization exactly once, even if disconnect is called more than
 // once (e.g. by both a per-client path and the Pipeline).
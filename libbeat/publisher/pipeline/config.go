@@ -20,8 +20,11 @@ package pipeline
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"github.com/elastic/beats/v7/libbeat/outputs"
 	"github.com/elastic/beats/v7/libbeat/processors"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/mapstr"
@@ -35,6 +38,65 @@ type Config struct {
 
 	// Event queue
 	Queue config.Namespace `config:"queue"`
+
+	// Named outputs events are routed to, used instead of the
+	// single output. Each has its own queue.
+	Outputs []RouteConfig `config:"outputs"`
+}
+
+// RouteConfig configures one of several named outputs and the events
+// routed to it.
+type RouteConfig struct {
+	Name   string           `config:"name" validate:"required"`
+	Output config.Namespace `config:"output"`
+	Queue  config.Namespace `config:"queue"`
+
+	// When selects the events sent to the output. If unset, the
+	// output receives all events.
+	When *conditions.Config `config:"when"`
+
+	// Blocking makes publishing block when the output's queue is
+	// full. Otherwise events are dropped for this output only, and
+	// are acknowledged to the inputs as if the output had published
+	// them.
+	Blocking bool `config:"blocking"`
+}
+
+// Validate checks the output names are unique, and that the outputs of
+// each type can be used together.
+func (c *Config) Validate() error {
+	names := make(map[string]struct{}, len(c.Outputs))
+	var types []string
+	byType := map[string][]outputs.NamedConfig{}
+	for _, r := range c.Outputs {
+		if _, exists := names[r.Name]; exists {
+			return fmt.Errorf("duplicate output name '%v'", r.Name)
+		}
+		names[r.Name] = struct{}{}
+
+		typ := r.Output.Name()
+		if _, exists := byType[typ]; !exists {
+			types = append(types, typ)
+		}
+		byType[typ] = append(byType[typ], outputs.NamedConfig{Name: r.Name, Config: r.Output.Config()})
+	}
+	for _, typ := range types {
+		if err := outputs.ValidateNamed(typ, byType[typ]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the route has a valid name and an output.
+func (c *RouteConfig) Validate() error {
+	if strings.ContainsAny(c.Name, ". ") {
+		return fmt.Errorf("output name '%v' must not contain dots or spaces", c.Name)
+	}
+	if !c.Output.IsSet() {
+		return fmt.Errorf("output '%v' has no output configured", c.Name)
+	}
+	return nil
 }
 
 // validateClientConfig checks a ClientConfig can be used with (*Pipeline).ConnectWith.
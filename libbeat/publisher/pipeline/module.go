@@ -25,6 +25,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/outputs"
 	"github.com/elastic/beats/v7/libbeat/publisher/processing"
+	conf "github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/monitoring"
 )
@@ -107,6 +108,34 @@ func LoadWithSettings(
 	return p, err
 }
 
+// LoadRoutedWithSettings is the same as LoadWithSettings, but publishes
+// events to the named outputs configured in config.Outputs instead of a
+// single output. makeOutput creates the output of each route.
+func LoadRoutedWithSettings(
+	beatInfo beat.Info,
+	monitors Monitors,
+	config Config,
+	makeOutput func(outputs.Observer, conf.Namespace) (outputs.Group, error),
+	settings Settings,
+) (*Pipeline, error) {
+	log := monitors.Logger
+	if log == nil {
+		log = logp.L()
+	}
+
+	if publishDisabled {
+		log.Info("Dry run mode. All output types except the file based one are disabled.")
+	}
+
+	p, err := NewWithRoutes(beatInfo, monitors, config.Outputs, makeOutput, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Beat name: %s", beatInfo.Name)
+	return p, nil
+}
+
 func loadOutput(
 	monitors Monitors,
 	makeOutput outputFactory,
@@ -22,6 +22,7 @@ package pipeline
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -36,6 +37,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/publisher/queue/diskqueue"
 	"github.com/elastic/beats/v7/libbeat/publisher/queue/memqueue"
 	"github.com/elastic/beats/v7/libbeat/publisher/queue/slabqueue"
+	"github.com/elastic/beats/v7/libbeat/publisher/tap"
 	conf "github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/paths"
 )
@@ -173,24 +175,7 @@ func New(
 	out outputs.Group,
 	settings Settings,
 ) (*Pipeline, error) {
-	if monitors.Logger == nil {
-		monitors.Logger = beat.Logger.Named("publish")
-	}
-
-	p := &Pipeline{
-		beatInfo:         beat,
-		monitors:         monitors,
-		observer:         nilObserver,
-		waitCloseTimeout: settings.WaitClose,
-		processors:       settings.Processors,
-		clients:          make(map[*client]struct{}),
-	}
-
-	p.forceCloseQueue = settings.WaitCloseMode == WaitOnPipelineCloseThenForce
-
-	if monitors.Metrics != nil {
-		p.observer = newMetricsObserver(monitors.Metrics)
-	}
+	p := newPipeline(beat, monitors, settings)
 
 	// Convert the raw queue config to a parsed Settings object that will
 	// be used during queue creation. This lets us fail immediately on startup
@@ -204,7 +189,7 @@ func New(
 		return nil, err
 	}
 
-	outputController, err := newProcessOutputController(beat, monitors, p.observer, queueFactory, settings.InputQueueSize)
+	outputController, err := newProcessOutputController(beat, p.monitors, p.observer, queueFactory, settings.InputQueueSize)
 	if err != nil {
 		return nil, err
 	}
@@ -215,6 +200,56 @@ func New(
 	return p, nil
 }
 
+// NewWithRoutes creates a new Pipeline instance publishing events to several
+// named outputs. Each output gets its own queue, created from the route's
+// queue config, and receives the events selected by the route. The outputs
+// are created by makeOutput.
+func NewWithRoutes(
+	beat beat.Info,
+	monitors Monitors,
+	routes []RouteConfig,
+	makeOutput func(outputs.Observer, conf.Namespace) (outputs.Group, error),
+	settings Settings,
+) (*Pipeline, error) {
+	if len(routes) == 0 {
+		return nil, errors.New("no outputs configured")
+	}
+
+	p := newPipeline(beat, monitors, settings)
+
+	outputController, err := newRoutedOutputController(beat, p.monitors, p.observer, routes, makeOutput, settings.InputQueueSize)
+	if err != nil {
+		return nil, err
+	}
+	p.outputController = outputController
+
+	p.startReaper()
+	return p, nil
+}
+
+// newPipeline returns a Pipeline without an output controller.
+func newPipeline(beat beat.Info, monitors Monitors, settings Settings) *Pipeline {
+	if monitors.Logger == nil {
+		monitors.Logger = beat.Logger.Named("publish")
+	}
+
+	p := &Pipeline{
+		beatInfo:         beat,
+		monitors:         monitors,
+		observer:         nilObserver,
+		waitCloseTimeout: settings.WaitClose,
+		processors:       settings.Processors,
+		clients:          make(map[*client]struct{}),
+	}
+
+	p.forceCloseQueue = settings.WaitCloseMode == WaitOnPipelineCloseThenForce
+
+	if monitors.Metrics != nil {
+		p.observer = newMetricsObserver(monitors.Metrics)
+	}
+	return p
+}
+
 func NewForReceiver(
 	beatInfo beat.Info,
 	monitors Monitors,
@@ -458,6 +493,8 @@ func (p *Pipeline) ConnectWith(cfg beat.ClientConfig) (beat.Client, error) {
 		eventFlags:     eventFlags,
 		canDrop:        canDrop,
 		observer:       p.observer,
+		tap:            tap.DefaultHub,
+		inputID:        cfg.Processing.InputID,
 	}
 
 	client.isOpen.Store(true)
@@ -485,6 +522,8 @@ func (p *Pipeline) ConnectWith(cfg beat.ClientConfig) (beat.Client, error) {
 		return nil, fmt.Errorf("client failed to connect because the pipeline is shutting down")
 	}
 
+	client.connectProcessors()
+
 	// Register the client so the Pipeline can finalize it (stage two of
 	// shutdown) when the pipeline disconnects. The client removes itself from
 	// the registry when it is disconnected, and hands itself to the reaper on
@@ -0,0 +1,458 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/beats/v7/libbeat/publisher/queue"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+// routeBufferSize is the number of events buffered for a non-blocking
+// output before events are dropped for it.
+const routeBufferSize = 128
+
+// routesMetaKey is the event metadata field that, if set, names the outputs
+// an event is published to, overriding the outputs' conditions.
+const routesMetaKey = "outputs"
+
+var _ outputController = (*routedOutputController)(nil)
+
+// routedOutputController implements outputController for several named
+// outputs. Each output has its own queue and output workers, so a slow
+// output only affects publishing once its own queue is full.
+type routedOutputController struct {
+	logger *logp.Logger
+	routes []*outputRoute
+}
+
+type outputRoute struct {
+	name       string
+	condition  conditions.Condition
+	blocking   bool
+	controller *processOutputController
+
+	routed  *monitoring.Int
+	dropped *monitoring.Int
+}
+
+func newRoutedOutputController(
+	beat beat.Info,
+	monitors Monitors,
+	retryObserver retryObserver,
+	configs []RouteConfig,
+	makeOutput func(outputs.Observer, conf.Namespace) (outputs.Group, error),
+	inputQueueSize int,
+) (*routedOutputController, error) {
+	c := &routedOutputController{
+		logger: monitors.Logger,
+	}
+	for _, cfg := range configs {
+		r, err := newOutputRoute(beat, monitors, retryObserver, cfg, makeOutput, inputQueueSize)
+		if err != nil {
+			// Release the routes that were already set up.
+			_ = c.waitClose(context.Background(), true)
+			return nil, fmt.Errorf("failed to initialize output '%v': %w", cfg.Name, err)
+		}
+		c.routes = append(c.routes, r)
+	}
+	return c, nil
+}
+
+func newOutputRoute(
+	beat beat.Info,
+	monitors Monitors,
+	retryObserver retryObserver,
+	cfg RouteConfig,
+	makeOutput func(outputs.Observer, conf.Namespace) (outputs.Group, error),
+	inputQueueSize int,
+) (*outputRoute, error) {
+	r := &outputRoute{
+		name:     cfg.Name,
+		blocking: cfg.Blocking,
+	}
+	if !r.blocking {
+		monitors.Logger.Infof("Output '%v' is not blocking: events dropped because its queue is full "+
+			"are acknowledged to the inputs and will not be resent to it.", cfg.Name)
+	}
+	if cfg.When != nil {
+		var err error
+		r.condition, err = conditions.NewCondition(cfg.When, monitors.Logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Each output reports its own output and queue metrics under
+	// outputs.<name>.
+	routeMonitors := Monitors{
+		Logger: monitors.Logger.With("output", cfg.Name),
+		Tracer: monitors.Tracer,
+	}
+	var reg *monitoring.Registry
+	if monitors.Metrics != nil {
+		reg = monitors.Metrics.GetOrCreateRegistry("outputs." + cfg.Name)
+		routeMonitors.Metrics = reg
+	} else {
+		reg = monitoring.NewRegistry()
+	}
+	r.routed = monitoring.NewInt(reg, "events.routed")
+	r.dropped = monitoring.NewInt(reg, "events.dropped")
+
+	queueType := defaultQueueType
+	if name := cfg.Queue.Name(); name != "" {
+		queueType = name
+	}
+	queueFactory, _, err := queueFactoryForUserConfig(queueType, cfg.Queue.Config(), beat.Paths)
+	if err != nil {
+		return nil, err
+	}
+
+	r.controller, err = newProcessOutputController(beat, routeMonitors, retryObserver, queueFactory, inputQueueSize)
+	if err != nil {
+		return nil, err
+	}
+	out, err := loadOutput(routeMonitors, func(stats outputs.Observer) (string, outputs.Group, error) {
+		out, err := makeOutput(stats, cfg.Output)
+		return cfg.Output.Name(), out, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	r.controller.Set(out)
+	return r, nil
+}
+
+// selects reports whether the event is published to this output.
+func (r *outputRoute) selects(e *beat.Event, names []string) bool {
+	if names != nil {
+		for _, name := range names {
+			if name == r.name {
+				return true
+			}
+		}
+		return false
+	}
+	return r.condition == nil || r.condition.Check(e)
+}
+
+func (c *routedOutputController) queueProducer(config queue.ProducerConfig) queue.Producer[publisher.Event] {
+	p := &routedProducer{
+		routes:     c.routes,
+		producers:  make([]queue.Producer[publisher.Event], len(c.routes)),
+		forwarders: make([]*routeForwarder, len(c.routes)),
+		acks:       newRouteACKs(len(c.routes), config.ACK),
+		done:       make(chan struct{}),
+	}
+	for i, r := range c.routes {
+		p.producers[i] = r.controller.queueProducer(queue.ProducerConfig{
+			ACK: func(count int) { p.acks.ack(i, count) },
+		})
+		if p.producers[i] == nil {
+			// The pipeline is shutting down.
+			for _, prod := range p.producers[:i] {
+				prod.Close()
+			}
+			return nil
+		}
+	}
+	for i, r := range c.routes {
+		if !r.blocking {
+			p.forwarders[i] = p.startForwarder(i)
+		}
+	}
+	return p
+}
+
+func (c *routedOutputController) waitClose(ctx context.Context, force bool) error {
+	var wg sync.WaitGroup
+	for _, r := range c.routes {
+		wg.Add(1)
+		go func(r *outputRoute) {
+			defer wg.Done()
+			_ = r.controller.waitClose(ctx, force)
+		}(r)
+	}
+	wg.Wait()
+	return nil
+}
+
+// routedProducer publishes events to the queues of the outputs selected
+// for them, and ACKs events once all of those outputs ACKed them. Events
+// dropped for a non-blocking output count as ACKed by it: the inputs are
+// not told about the drop, so the events are lost for that output.
+type routedProducer struct {
+	routes    []*outputRoute
+	producers []queue.Producer[publisher.Event]
+	acks      *routeACKs
+
+	// forwarders publish the events of non-blocking outputs, so that
+	// events can be dropped for an output without waiting for its queue.
+	forwarders []*routeForwarder
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+func (p *routedProducer) Publish(entry publisher.Event) (queue.EntryID, bool) {
+	return p.publish(entry, false)
+}
+
+func (p *routedProducer) TryPublish(entry publisher.Event) (queue.EntryID, bool) {
+	return p.publish(entry, true)
+}
+
+func (p *routedProducer) publish(entry publisher.Event, try bool) (queue.EntryID, bool) {
+	names := routeNames(&entry.Content)
+	targets := make([]int, 0, len(p.routes))
+	for i, r := range p.routes {
+		if r.selects(&entry.Content, names) {
+			targets = append(targets, i)
+		}
+	}
+
+	seq := p.acks.add(len(targets))
+	if len(targets) == 0 {
+		// Events routed to no output are complete.
+		return queue.EntryID(seq), true
+	}
+
+	published := false
+	for n, i := range targets {
+		e := entry
+		if n > 0 {
+			// Outputs may modify the events they receive, so every
+			// output but the first gets a copy.
+			e.Content.Fields = entry.Content.Fields.Clone()
+			e.Content.Meta = entry.Content.Meta.Clone()
+		}
+
+		r := p.routes[i]
+		p.acks.track(i, seq)
+		var ok bool
+		switch {
+		case p.forwarders[i] != nil:
+			ok = p.forwarders[i].tryForward(e, seq)
+		case try:
+			_, ok = p.producers[i].TryPublish(e)
+		default:
+			_, ok = p.producers[i].Publish(e)
+		}
+		if !ok {
+			p.acks.untrack(i, seq)
+			r.dropped.Inc()
+			continue
+		}
+		r.routed.Inc()
+		published = true
+	}
+	return queue.EntryID(seq), published
+}
+
+// routeForwarder publishes events to the queue of a non-blocking output
+// from a buffer. Events are dropped when the buffer is full.
+type routeForwarder struct {
+	ch   chan forwardRequest
+	done chan struct{}
+}
+
+type forwardRequest struct {
+	event publisher.Event
+	seq   uint64
+}
+
+func (p *routedProducer) startForwarder(route int) *routeForwarder {
+	f := &routeForwarder{
+		ch:   make(chan forwardRequest, routeBufferSize),
+		done: make(chan struct{}),
+	}
+	go func() {
+		defer close(f.done)
+		for req := range f.ch {
+			if _, ok := p.producers[route].Publish(req.event); !ok {
+				p.acks.untrack(route, req.seq)
+				p.routes[route].dropped.Inc()
+			}
+		}
+	}()
+	return f
+}
+
+func (f *routeForwarder) tryForward(e publisher.Event, seq uint64) bool {
+	select {
+	case f.ch <- forwardRequest{event: e, seq: seq}:
+		return true
+	default:
+		return false
+	}
+}
+
+// routeNames returns the output names set in the event metadata, or nil if
+// there are none.
+func routeNames(e *beat.Event) []string {
+	v, err := e.Meta.GetValue(routesMetaKey)
+	if err != nil {
+		return nil
+	}
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		names := make([]string, 0, len(v))
+		for _, name := range v {
+			if s, ok := name.(string); ok {
+				names = append(names, s)
+			}
+		}
+		return names
+	default:
+		return nil
+	}
+}
+
+func (p *routedProducer) Close() {
+	p.closeOnce.Do(func() {
+		for i, prod := range p.producers {
+			if f := p.forwarders[i]; f != nil {
+				// The producer is closed once the buffered
+				// events are published.
+				close(f.ch)
+			} else {
+				prod.Close()
+			}
+		}
+		go func() {
+			for i, prod := range p.producers {
+				if f := p.forwarders[i]; f != nil {
+					<-f.done
+					prod.Close()
+				}
+			}
+			for _, prod := range p.producers {
+				<-prod.ACKWaitChan()
+			}
+			close(p.done)
+		}()
+	})
+}
+
+func (p *routedProducer) ACKWaitChan() <-chan struct{} {
+	return p.done
+}
+
+// routeACKs merges the ACKs of the outputs' queues, ACKing events in
+// publishing order once every output they were published to ACKed them.
+// Each queue ACKs the events of a producer in the order they were
+// published.
+type routeACKs struct {
+	mu sync.Mutex
+	fn func(count int)
+
+	// pending holds the number of outstanding output ACKs of the
+	// unACKed events, starting with the event numbered head.
+	pending []int
+	head    uint64
+
+	// published holds, for each output, the numbers of the events
+	// published to it that it has not ACKed yet.
+	published [][]uint64
+}
+
+func newRouteACKs(routes int, fn func(count int)) *routeACKs {
+	return &routeACKs{
+		fn:        fn,
+		published: make([][]uint64, routes),
+	}
+}
+
+// add registers an event to be published to n outputs and returns its
+// number.
+func (a *routeACKs) add(n int) uint64 {
+	a.mu.Lock()
+	seq := a.head + uint64(len(a.pending))
+	a.pending = append(a.pending, n)
+	count := a.advance()
+	a.mu.Unlock()
+	a.report(count)
+	return seq
+}
+
+// track records that the event is about to be published to the output.
+func (a *routeACKs) track(route int, seq uint64) {
+	a.mu.Lock()
+	a.published[route] = append(a.published[route], seq)
+	a.mu.Unlock()
+}
+
+// untrack records that the event could not be published to the output,
+// which counts as an ACK.
+func (a *routeACKs) untrack(route int, seq uint64) {
+	a.mu.Lock()
+	published := a.published[route]
+	for i := len(published) - 1; i >= 0; i-- {
+		if published[i] == seq {
+			a.published[route] = append(published[:i], published[i+1:]...)
+			break
+		}
+	}
+	a.pending[seq-a.head]--
+	count := a.advance()
+	a.mu.Unlock()
+	a.report(count)
+}
+
+// ack handles count ACKs from the output's queue.
+func (a *routeACKs) ack(route int, count int) {
+	a.mu.Lock()
+	for _, seq := range a.published[route][:count] {
+		a.pending[seq-a.head]--
+	}
+	a.published[route] = a.published[route][count:]
+	n := a.advance()
+	a.mu.Unlock()
+	a.report(n)
+}
+
+// advance drops the completed events at the head of pending and returns
+// their number. It must be called with a.mu held.
+func (a *routeACKs) advance() int {
+	n := 0
+	for n < len(a.pending) && a.pending[n] == 0 {
+		n++
+	}
+	a.pending = a.pending[n:]
+	a.head += uint64(n)
+	return n
+}
+
+func (a *routeACKs) report(count int) {
+	if count > 0 && a.fn != nil {
+		a.fn(count)
+	}
+}
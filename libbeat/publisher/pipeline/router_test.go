@@ -0,0 +1,264 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/acker"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+)
+
+func TestRouteACKs(t *testing.T) {
+	var acked []int
+	a := newRouteACKs(2, func(n int) { acked = append(acked, n) })
+
+	// Event 0 goes to both outputs, event 1 to output 1 only, event 2 to
+	// no output and event 3 to output 0 only.
+	e0 := a.add(2)
+	a.track(0, e0)
+	a.track(1, e0)
+	e1 := a.add(1)
+	a.track(1, e1)
+	a.add(0)
+	e3 := a.add(1)
+	a.track(0, e3)
+	assert.Empty(t, acked)
+
+	// Output 0 ACKs events 0 and 3, but event 0 still waits for output 1.
+	a.ack(0, 2)
+	assert.Empty(t, acked)
+
+	// Output 1 ACKs event 0, completing it.
+	a.ack(1, 1)
+	assert.Equal(t, []int{1}, acked)
+
+	// Output 1 ACKs event 1, completing events 1 to 3.
+	a.ack(1, 1)
+	assert.Equal(t, []int{1, 3}, acked)
+
+	// An event dropped by its only output is complete.
+	e4 := a.add(1)
+	a.track(0, e4)
+	a.untrack(0, e4)
+	assert.Equal(t, []int{1, 3, 1}, acked)
+}
+
+// routeOutputs records the events published to the test outputs.
+type routeOutputs struct {
+	mu     sync.Mutex
+	events map[string][]beat.Event
+
+	// block, if not nil, blocks the output named slow until closed.
+	block chan struct{}
+}
+
+func (o *routeOutputs) makeOutput(_ outputs.Observer, cfg conf.Namespace) (outputs.Group, error) {
+	var settings struct {
+		ID string `config:"id"`
+	}
+	if err := cfg.Config().Unpack(&settings); err != nil {
+		return outputs.Group{}, err
+	}
+	client := newMockClient(func(batch publisher.Batch) error {
+		if settings.ID == "slow" && o.block != nil {
+			<-o.block
+		}
+		o.mu.Lock()
+		for _, e := range batch.Events() {
+			o.events[settings.ID] = append(o.events[settings.ID], e.Content)
+		}
+		o.mu.Unlock()
+		batch.ACK()
+		return nil
+	})
+	return outputs.Group{BatchSize: 1, Clients: []outputs.Client{client}}, nil
+}
+
+func (o *routeOutputs) count(id string) int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.events[id])
+}
+
+func makeRoutedPipeline(t *testing.T, metrics *monitoring.Registry, outs *routeOutputs, routes []map[string]interface{}) *Pipeline {
+	t.Helper()
+	var config Config
+	err := conf.MustNewConfigFrom(map[string]interface{}{"outputs": routes}).Unpack(&config)
+	require.NoError(t, err)
+
+	logger := logptest.NewTestingLogger(t, "")
+	p, err := NewWithRoutes(beat.Info{Logger: logger}, Monitors{Metrics: metrics, Logger: logger}, config.Outputs, outs.makeOutput, Settings{})
+	require.NoError(t, err)
+	return p
+}
+
+func TestPipelineRoutes(t *testing.T) {
+	outs := &routeOutputs{events: map[string][]beat.Event{}}
+	metrics := monitoring.NewRegistry()
+	p := makeRoutedPipeline(t, metrics, outs, []map[string]interface{}{
+		{"name": "all", "output.test.id": "all"},
+		{"name": "security", "output.test.id": "security", "when.equals.event.category": "security"},
+	})
+	defer p.Disconnect(t.Context())
+
+	var acked atomic.Int64
+	client, err := p.ConnectWith(beat.ClientConfig{
+		EventListener: acker.RawCounting(func(n int) { acked.Add(int64(n)) }),
+	})
+	require.NoError(t, err)
+
+	client.Publish(beat.Event{Fields: mapstr.M{"message": "a"}})
+	client.Publish(beat.Event{Fields: mapstr.M{"message": "b", "event": mapstr.M{"category": "security"}}})
+	client.Publish(beat.Event{
+		Meta:   mapstr.M{"outputs": []string{"security"}},
+		Fields: mapstr.M{"message": "c"},
+	})
+	client.Publish(beat.Event{
+		Meta:   mapstr.M{"outputs": "none"},
+		Fields: mapstr.M{"message": "d"},
+	})
+	require.NoError(t, client.Close())
+
+	require.Eventually(t, func() bool { return acked.Load() == 4 }, 10*time.Second, 10*time.Millisecond)
+
+	messages := func(id string) []interface{} {
+		outs.mu.Lock()
+		defer outs.mu.Unlock()
+		var msgs []interface{}
+		for _, e := range outs.events[id] {
+			msgs = append(msgs, e.Fields["message"])
+		}
+		return msgs
+	}
+	assert.Equal(t, []interface{}{"a", "b"}, messages("all"))
+	assert.Equal(t, []interface{}{"b", "c"}, messages("security"))
+
+	snapshot := monitoring.CollectFlatSnapshot(metrics, monitoring.Full, false)
+	assert.Equal(t, int64(2), snapshot.Ints["outputs.all.events.routed"])
+	assert.Equal(t, int64(2), snapshot.Ints["outputs.security.events.routed"])
+	assert.Equal(t, "test", snapshot.Strings["outputs.security.output.type"])
+}
+
+func TestPipelineRoutesNonBlocking(t *testing.T) {
+	outs := &routeOutputs{events: map[string][]beat.Event{}, block: make(chan struct{})}
+	metrics := monitoring.NewRegistry()
+	p := makeRoutedPipeline(t, metrics, outs, []map[string]interface{}{
+		{"name": "fast", "output.test.id": "fast", "blocking": true},
+		{"name": "slow", "output.test.id": "slow", "queue.mem.events": 32, "queue.mem.flush.min_events": 1},
+	})
+
+	var acked atomic.Int64
+	client, err := p.ConnectWith(beat.ClientConfig{
+		EventListener: acker.RawCounting(func(n int) { acked.Add(int64(n)) }),
+	})
+	require.NoError(t, err)
+
+	// The slow output's queue fills up, but publishing continues.
+	const events = 500
+	for i := 0; i < events; i++ {
+		client.Publish(beat.Event{Fields: mapstr.M{"n": i}})
+	}
+	require.Eventually(t, func() bool { return outs.count("fast") == events }, 10*time.Second, 10*time.Millisecond)
+
+	snapshot := monitoring.CollectFlatSnapshot(metrics, monitoring.Full, false)
+	dropped := snapshot.Ints["outputs.slow.events.dropped"]
+	assert.Positive(t, dropped)
+	assert.Equal(t, int64(events), snapshot.Ints["outputs.slow.events.routed"]+dropped)
+
+	// Events are ACKed once the slow output has published its share.
+	close(outs.block)
+	require.NoError(t, client.Close())
+	require.Eventually(t, func() bool { return acked.Load() == events }, 10*time.Second, 10*time.Millisecond)
+	require.NoError(t, p.Disconnect(t.Context()))
+}
+
+func TestRouteConfigValidate(t *testing.T) {
+	tests := map[string]struct {
+		routes []map[string]interface{}
+		err    string
+	}{
+		"duplicate name": {
+			routes: []map[string]interface{}{
+				{"name": "a", "output.test.id": "a"},
+				{"name": "a", "output.test.id": "b"},
+			},
+			err: "duplicate output name 'a'",
+		},
+		"missing output": {
+			routes: []map[string]interface{}{{"name": "a"}},
+			err:    "output 'a' has no output configured",
+		},
+		"dotted name": {
+			routes: []map[string]interface{}{{"name": "a.b", "output.test.id": "a"}},
+			err:    "must not contain dots",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var config Config
+			err := conf.MustNewConfigFrom(map[string]interface{}{"outputs": test.routes}).Unpack(&config)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), test.err)
+		})
+	}
+}
+
+func TestRouteConfigNamedValidator(t *testing.T) {
+	var validated []string
+	outputs.RegisterNamedValidator("test_named", func(cfgs []outputs.NamedConfig) error {
+		for _, c := range cfgs {
+			validated = append(validated, c.Name)
+		}
+		return errors.New("invalid combination")
+	})
+
+	var config Config
+	err := conf.MustNewConfigFrom(map[string]interface{}{"outputs": []map[string]interface{}{
+		{"name": "a", "output.test_named.id": "a"},
+		{"name": "b", "output.test.id": "b"},
+		{"name": "c", "output.test_named.id": "c"},
+	}}).Unpack(&config)
+	assert.ErrorContains(t, err, "invalid combination")
+	assert.Equal(t, []string{"a", "c"}, validated)
+}
+
+func TestRouteConfigBlockingDefault(t *testing.T) {
+	var config Config
+	err := conf.MustNewConfigFrom(map[string]interface{}{"outputs": []map[string]interface{}{
+		{"name": "a", "output.test.id": "a"},
+		{"name": "b", "output.test.id": "b", "blocking": true},
+	}}).Unpack(&config)
+	require.NoError(t, err)
+	require.Len(t, config.Outputs, 2)
+	assert.False(t, config.Outputs[0].Blocking)
+	assert.True(t, config.Outputs[1].Blocking)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/processors"
 	"github.com/elastic/beats/v7/libbeat/processors/actions/addfields"
 	"github.com/elastic/beats/v7/libbeat/processors/timeseries"
+	"github.com/elastic/beats/v7/libbeat/publisher/tap"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/mapstr"
@@ -60,6 +61,9 @@ type builder struct {
 	processors *group
 
 	alwaysCopy bool
+
+	// tap receives the events of all processing chains for live debugging.
+	tap *tap.Hub
 }
 
 type modifier interface {
@@ -123,12 +127,18 @@ func MakeDefaultSupport(
 			rawProcessors = cfg.Processors
 		}
 
-		processors, err := processors.New(rawProcessors, log)
+		procs, err := processors.New(rawProcessors, log)
 		if err != nil {
 			return nil, fmt.Errorf("error initializing processors: %w", err)
 		}
+		// Global processors are shared by all clients, they can't publish
+		// events of their own.
+		if p := processors.FindPublisher(procs); p != nil {
+			_ = procs.Close()
+			return nil, fmt.Errorf("error initializing processors: %v can only be used in the processors of an input", p)
+		}
 
-		return newBuilder(info, log, processors, cfg.EventMetadata, modifiers, !normalize, cfg.TimeSeries)
+		return newBuilder(info, log, procs, cfg.EventMetadata, modifiers, !normalize, cfg.TimeSeries)
 	}
 }
 
@@ -208,6 +218,7 @@ func newBuilder(
 		log:           log,
 		info:          info,
 		timeSeries:    timeSeries,
+		tap:           tap.DefaultHub,
 	}
 
 	hasProcessors := processors != nil && len(processors.List) > 0
@@ -258,6 +269,11 @@ func newBuilder(
 	return b, nil
 }
 
+// SetTap sets the hub the processing pipelines created afterwards report to.
+func (b *builder) SetTap(hub *tap.Hub) {
+	b.tap = hub
+}
+
 // Processors returns a string description of the processor config
 func (b *builder) Processors() []string {
 	procList := []string{}
@@ -289,7 +305,12 @@ func (b *builder) Processors() []string {
 func (b *builder) Create(cfg beat.ProcessingConfig, drop bool) (beat.Processor, error) {
 	var (
 		// pipeline processors
-		processors = newGroup("processPipeline", b.log)
+		processors = &group{
+			title:   "processPipeline",
+			log:     b.log,
+			tap:     b.tap,
+			inputID: cfg.InputID,
+		}
 
 		// client fields and metadata
 		clientMeta      = cfg.Meta
@@ -387,8 +408,8 @@ func (b *builder) Create(cfg beat.ProcessingConfig, drop bool) (beat.Processor,
 			return nil, fmt.Errorf("failed setting paths for global processors: %w", err)
 		}
 
-		// Add the global pipeline as a function processor, so clients cannot close it
-		processors.add(newProcessor(b.processors.title, b.processors.Run))
+		// Add the global pipeline as a wrapper without Close, so clients cannot close it
+		processors.add(&globalProcessors{group: b.processors})
 	}
 
 	// setup 9: time series metadata
@@ -19,6 +19,7 @@ package processing
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 	"time"
 
@@ -30,6 +31,8 @@ import (
 	"github.com/elastic/beats/v7/libbeat/ecs"
 	"github.com/elastic/beats/v7/libbeat/processors"
 	"github.com/elastic/beats/v7/libbeat/processors/actions/addfields"
+	_ "github.com/elastic/beats/v7/libbeat/processors/aggregate"
+	"github.com/elastic/beats/v7/libbeat/publisher/tap"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/logp/logptest"
@@ -443,6 +446,16 @@ func TestDynamicFields(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGlobalPublishingProcessor(t *testing.T) {
+	cfg := config.MustNewConfigFrom(mapstr.M{
+		"processors": []mapstr.M{
+			{"if": mapstr.M{"has_fields": []string{"a"}}, "then": []mapstr.M{{"aggregate": mapstr.M{}}}},
+		},
+	})
+	_, err := MakeDefaultSupport(true, nil)(beat.Info{Paths: tmpPaths(t)}, logp.L(), cfg)
+	require.ErrorContains(t, err, "can only be used in the processors of an input")
+}
+
 func TestProcessingClose(t *testing.T) {
 	factory, err := MakeDefaultSupport(true, nil)(beat.Info{Paths: tmpPaths(t)}, logp.L(), config.NewConfig())
 	require.NoError(t, err)
@@ -487,6 +500,84 @@ func TestProcessingClose(t *testing.T) {
 	assert.True(t, factoryProcessor.closed)
 }
 
+func TestProcessingTap(t *testing.T) {
+	factory, err := MakeDefaultSupport(true, nil)(beat.Info{Paths: tmpPaths(t)}, logp.L(), config.NewConfig())
+	require.NoError(t, err)
+
+	b, ok := factory.(*builder)
+	require.True(t, ok)
+	b.tap = tap.NewHub()
+	b.processors = newGroup("global", logp.L())
+	b.processors.add(addfields.NewAddFields(mapstr.M{"global": "a"}, true, true))
+
+	client := newGroup("test", logp.L())
+	client.add(&processorWithClose{})
+
+	prog, err := factory.Create(beat.ProcessingConfig{
+		Processor: client,
+		InputID:   "my-input",
+	}, false)
+	require.NoError(t, err)
+
+	limits := tap.Limits{Rate: 1000, Buffer: 20}
+	inputSub, err := b.tap.Subscribe(tap.Filter{Point: tap.PointInput}, limits, 2)
+	require.NoError(t, err)
+	defer inputSub.Close()
+	processorSub, err := b.tap.Subscribe(tap.Filter{Point: tap.PointProcessor, InputID: "my-input"}, limits, 2)
+	require.NoError(t, err)
+	defer processorSub.Close()
+
+	actual, err := prog.Run(&beat.Event{Fields: mapstr.M{"hello": "world"}})
+	require.NoError(t, err)
+	assert.Equal(t, "a", actual.Fields["global"])
+
+	require.Len(t, inputSub.Records(), 1)
+	record := <-inputSub.Records()
+	assert.Equal(t, "my-input", record.InputID)
+	assert.NotContains(t, string(record.Event), `"global"`, "input point should capture the unprocessed event")
+
+	var stages []string
+	for len(processorSub.Records()) > 0 {
+		record := <-processorSub.Records()
+		stages = append(stages, record.Processor)
+	}
+	assert.Equal(t, []string{"generalizeEvent", "processorWithClose", "add_fields={\"global\":\"a\"}"}, stages,
+		"nested processors should be captured individually")
+}
+
+func TestProcessingTapDropped(t *testing.T) {
+	hub := tap.NewHub()
+	sub, err := hub.Subscribe(tap.Filter{Point: tap.PointProcessor}, tap.Limits{Rate: 1000, Buffer: 20}, 1)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	group := newGroup("test", logp.L())
+	group.add(newProcessor("fail", func(e *beat.Event) (*beat.Event, error) {
+		return e, errors.New("oops")
+	}))
+	group.add(newProcessor("drop", func(*beat.Event) (*beat.Event, error) {
+		return nil, nil
+	}))
+	group.add(newProcessor("never", func(e *beat.Event) (*beat.Event, error) {
+		t.Error("processor after the drop must not run")
+		return e, nil
+	}))
+
+	actual, err := group.runTapped(&beat.Event{Fields: mapstr.M{"hello": "world"}}, hub, "my-input")
+	require.NoError(t, err)
+	assert.Nil(t, actual)
+
+	require.Len(t, sub.Records(), 2)
+	record := <-sub.Records()
+	assert.Equal(t, "fail", record.Processor)
+	assert.Equal(t, "oops", record.Error)
+	assert.False(t, record.Dropped)
+	record = <-sub.Records()
+	assert.Equal(t, "drop", record.Processor)
+	assert.True(t, record.Dropped)
+	assert.Nil(t, record.Event)
+}
+
 func TestProcessingDiagnostics(t *testing.T) {
 	factory, err := MakeDefaultSupport(true, nil)(beat.Info{Paths: tmpPaths(t)}, logp.L(), config.NewConfig())
 	require.NoError(t, err)
@@ -19,6 +19,7 @@ package processing
 
 import (
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/publisher/tap"
 	"github.com/elastic/elastic-agent-libs/config"
 	"github.com/elastic/elastic-agent-libs/logp"
 )
@@ -42,3 +43,11 @@ type Supporter interface {
 	// Close the processor supporter
 	Close() error
 }
+
+// Tapper is implemented by Supporters whose processing pipelines report the
+// events passing through their processors to a tap hub.
+type Tapper interface {
+	// SetTap sets the hub the processing pipelines created afterwards
+	// report to. It defaults to tap.DefaultHub.
+	SetTap(hub *tap.Hub)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/outputs/codec/json"
 	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/publisher/tap"
 	"github.com/elastic/elastic-agent-libs/logp"
 	"github.com/elastic/elastic-agent-libs/mapstr"
 	"github.com/elastic/elastic-agent-libs/paths"
@@ -37,6 +38,11 @@ type group struct {
 	log   *logp.Logger
 	title string
 	list  []beat.Processor
+
+	// tap receives the events at the input and after each processor, when
+	// set. Nested groups are tapped by the outermost group only.
+	tap     *tap.Hub
+	inputID string
 }
 
 type processorFn struct {
@@ -128,11 +134,40 @@ func (p *group) SetPaths(paths *paths.Path) error {
 	return err
 }
 
+// SetPublisher sets the publisher of all processors in the group. Events
+// published by a processor are passed through the processors that follow
+// it in the group before being handed to publish.
+func (p *group) SetPublisher(publish func(beat.Event)) {
+	for i, processor := range p.list {
+		if _, ok := processor.(processors.PublisherSetter); !ok {
+			continue
+		}
+		rest := &group{log: p.log, title: p.title, list: p.list[i+1:]}
+		processors.SetPublisher(processor, func(e beat.Event) {
+			if event, _ := rest.Run(&e); event != nil {
+				publish(*event)
+			}
+		})
+	}
+}
+
+// Flush flushes all processors in the group.
+func (p *group) Flush() {
+	for _, processor := range p.list {
+		processors.Flush(processor)
+	}
+}
+
 func (p *group) Run(event *beat.Event) (*beat.Event, error) {
 	if p == nil || len(p.list) == 0 {
 		return event, nil
 	}
 
+	if p.tap.Active() {
+		p.tap.Capture(tap.PointInput, p.inputID, "", event, nil)
+		return p.runTapped(event, p.tap, p.inputID)
+	}
+
 	for _, sub := range p.list {
 		var err error
 
@@ -154,6 +189,53 @@ func (p *group) Run(event *beat.Event) (*beat.Event, error) {
 	return event, nil
 }
 
+// runTapped runs the processors like Run, capturing the event after each
+// processor. The processors of nested groups are captured individually.
+func (p *group) runTapped(event *beat.Event, hub *tap.Hub, inputID string) (*beat.Event, error) {
+	for _, sub := range p.list {
+		var err error
+
+		nested := nestedGroup(sub)
+		if nested != nil {
+			event, err = nested.runTapped(event, hub, inputID)
+		} else {
+			event, err = sub.Run(event)
+		}
+		if err != nil {
+			p.log.Debugf("Fail to apply processor %s: %s", p, err)
+		}
+		if nested == nil {
+			hub.Capture(tap.PointProcessor, inputID, sub.String(), event, err)
+		}
+
+		if event == nil {
+			return nil, err
+		}
+	}
+
+	return event, nil
+}
+
+// nestedGroup returns the group wrapped by processor, if any.
+func nestedGroup(processor beat.Processor) *group {
+	switch p := processor.(type) {
+	case *group:
+		return p
+	case *globalProcessors:
+		return p.group
+	}
+	return nil
+}
+
+// globalProcessors runs the global processors as part of a client's
+// processing chain, without exposing Close to the client.
+type globalProcessors struct {
+	group *group
+}
+
+func (p *globalProcessors) String() string                         { return p.group.title }
+func (p *globalProcessors) Run(e *beat.Event) (*beat.Event, error) { return p.group.Run(e) }
+
 func newProcessor(name string, fn func(*beat.Event) (*beat.Event, error)) *processorFn {
 	return &processorFn{name: name, fn: fn}
 }
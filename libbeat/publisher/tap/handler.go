@@ -0,0 +1,223 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tap
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+)
+
+// Config holds the hard limits applied to the tap endpoint. Requests can
+// lower, but never exceed them.
+type Config struct {
+	Enabled        bool             `config:"enabled"`
+	MaxRate        float64          `config:"max_rate" validate:"positive,nonzero"`
+	MaxEvents      int              `config:"max_events" validate:"positive,nonzero"`
+	MaxEventSize   cfgtype.ByteSize `config:"max_event_size" validate:"positive,nonzero"`
+	MaxDuration    time.Duration    `config:"max_duration" validate:"positive,nonzero"`
+	MaxSubscribers int              `config:"max_subscribers" validate:"positive,nonzero"`
+}
+
+// DefaultConfig is the default configuration of the tap endpoint.
+var DefaultConfig = Config{
+	Enabled:        false,
+	MaxRate:        10,
+	MaxEvents:      1000,
+	MaxEventSize:   64 * 1024,
+	MaxDuration:    5 * time.Minute,
+	MaxSubscribers: 2,
+}
+
+type handlerAttacher interface {
+	AttachHandler(route string, h http.Handler) (err error)
+}
+
+// HttpAttach attaches the /debug/tap handler streaming the events captured by
+// DefaultHub to the given mux, if enabled in cfg.
+func HttpAttach(cfg *config.C, mux handlerAttacher, log *logp.Logger) error {
+	if cfg == nil {
+		return nil
+	}
+	tapConfig := DefaultConfig
+	if err := cfg.Unpack(&tapConfig); err != nil {
+		return err
+	}
+	if !tapConfig.Enabled {
+		return nil
+	}
+	return mux.AttachHandler("/debug/tap", NewHandler(DefaultHub, tapConfig, log.Named("tap")))
+}
+
+// recordBuffer is the number of records buffered per request before new
+// records are dropped.
+const recordBuffer = 64
+
+// Handler streams the events captured by a hub as newline delimited JSON.
+//
+// Supported query parameters:
+//   - point: one of input, processor or output (default output)
+//   - input_id: only capture events from the input with this ID
+//   - condition: a processor condition in JSON, e.g. {"equals":{"event.module":"nginx"}}
+//   - rate: maximum number of events per second
+//   - limit: number of events after which the stream ends
+//   - duration: time after which the stream ends
+type Handler struct {
+	hub    *Hub
+	config Config
+	log    *logp.Logger
+}
+
+// NewHandler creates a handler serving the events captured by hub.
+func NewHandler(hub *Hub, config Config, log *logp.Logger) *Handler {
+	return &Handler{hub: hub, config: config, log: log}
+}
+
+type request struct {
+	filter   Filter
+	rate     float64
+	limit    int
+	duration time.Duration
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	req, err := h.parseRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming not supported", http.StatusInternalServerError)
+		return
+	}
+
+	sub, err := h.hub.Subscribe(req.filter, Limits{
+		Rate:         req.rate,
+		MaxEventSize: int(h.config.MaxEventSize),
+		Buffer:       recordBuffer,
+	}, h.config.MaxSubscribers)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	defer sub.Close()
+
+	h.log.Infof("Tap started on %s point (input_id=%q, rate=%v, limit=%d, duration=%v)",
+		req.filter.Point, req.filter.InputID, req.rate, req.limit, req.duration)
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	timer := time.NewTimer(req.duration)
+	defer timer.Stop()
+
+	enc := json.NewEncoder(w)
+	sent := 0
+	for sent < req.limit {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-timer.C:
+			h.log.Infof("Tap stopped after %v, %d events sent, %d dropped", req.duration, sent, sub.Dropped())
+			return
+		case record := <-sub.Records():
+			if err := enc.Encode(record); err != nil {
+				return
+			}
+			flusher.Flush()
+			sent++
+		}
+	}
+	h.log.Infof("Tap stopped after %d events, %d dropped", sent, sub.Dropped())
+}
+
+func (h *Handler) parseRequest(r *http.Request) (request, error) {
+	query := r.URL.Query()
+	req := request{
+		filter: Filter{
+			Point:   PointQueue,
+			InputID: query.Get("input_id"),
+		},
+		rate:     h.config.MaxRate,
+		limit:    h.config.MaxEvents,
+		duration: h.config.MaxDuration,
+	}
+
+	if point := query.Get("point"); point != "" {
+		switch p := Point(point); p {
+		case PointInput, PointProcessor, PointQueue:
+			req.filter.Point = p
+		default:
+			return req, fmt.Errorf("invalid point %q, must be one of input, processor or queue", point)
+		}
+	}
+
+	if s := query.Get("condition"); s != "" {
+		cfg, err := config.NewConfigWithYAML([]byte(s), "condition")
+		if err != nil {
+			return req, fmt.Errorf("invalid condition: %w", err)
+		}
+		var condCfg conditions.Config
+		if err := cfg.Unpack(&condCfg); err != nil {
+			return req, fmt.Errorf("invalid condition: %w", err)
+		}
+		req.filter.Condition, err = conditions.NewCondition(&condCfg, h.log)
+		if err != nil {
+			return req, fmt.Errorf("invalid condition: %w", err)
+		}
+	}
+
+	if s := query.Get("rate"); s != "" {
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil || v <= 0 {
+			return req, fmt.Errorf("invalid rate %q", s)
+		}
+		req.rate = min(v, h.config.MaxRate)
+	}
+	if s := query.Get("limit"); s != "" {
+		v, err := strconv.Atoi(s)
+		if err != nil || v <= 0 {
+			return req, fmt.Errorf("invalid limit %q", s)
+		}
+		req.limit = min(v, h.config.MaxEvents)
+	}
+	if s := query.Get("duration"); s != "" {
+		v, err := time.ParseDuration(s)
+		if err != nil || v <= 0 {
+			return req, fmt.Errorf("invalid duration %q", s)
+		}
+		req.duration = min(v, h.config.MaxDuration)
+	}
+
+	return req, nil
+}
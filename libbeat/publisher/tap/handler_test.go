@@ -0,0 +1,162 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tap
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+func TestHandlerStreamsRecords(t *testing.T) {
+	hub := NewHub()
+	srv := httptest.NewServer(NewHandler(hub, DefaultConfig, logptest.NewTestingLogger(t, "")))
+	defer srv.Close()
+
+	query := url.Values{
+		"point":     {"processor"},
+		"input_id":  {"my-input"},
+		"condition": {`{"equals":{"service":"web"}}`},
+		"limit":     {"2"},
+	}
+	resp, err := http.Get(srv.URL + "?" + query.Encode())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+
+	// Publish until the handler has subscribed and received its events.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for n := 0; ; n++ {
+			select {
+			case <-done:
+				return
+			case <-time.After(10 * time.Millisecond):
+			}
+			hub.Capture(PointProcessor, "my-input", "add_fields", testEvent(mapstr.M{"service": "db", "n": n}), nil)
+			hub.Capture(PointProcessor, "my-input", "add_fields", testEvent(mapstr.M{"service": "web", "n": n}), nil)
+		}
+	}()
+
+	var records []Record
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, record)
+	}
+	require.Len(t, records, 2, "the stream should end after limit events")
+	for _, record := range records {
+		assert.Equal(t, PointProcessor, record.Point)
+		assert.Equal(t, "add_fields", record.Processor)
+		assert.Contains(t, string(record.Event), `"service":"web"`)
+	}
+	assert.Eventually(t, func() bool { return !hub.Active() }, 5*time.Second, 10*time.Millisecond,
+		"the subscription should be closed when the stream ends")
+}
+
+func TestHandlerDuration(t *testing.T) {
+	hub := NewHub()
+	srv := httptest.NewServer(NewHandler(hub, DefaultConfig, logptest.NewTestingLogger(t, "")))
+	defer srv.Close()
+
+	start := time.Now()
+	resp, err := http.Get(srv.URL + "?duration=50ms")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	assert.False(t, scanner.Scan(), "no events should be streamed")
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestHandlerParseRequest(t *testing.T) {
+	h := NewHandler(NewHub(), DefaultConfig, logptest.NewTestingLogger(t, ""))
+
+	req, err := h.parseRequest(httptest.NewRequest(http.MethodGet, "/?rate=1000&limit=1000000&duration=24h", nil))
+	require.NoError(t, err)
+	assert.Equal(t, PointQueue, req.filter.Point, "queue should be the default tap point")
+	assert.Equal(t, DefaultConfig.MaxRate, req.rate, "rate should be capped")
+	assert.Equal(t, DefaultConfig.MaxEvents, req.limit, "limit should be capped")
+	assert.Equal(t, DefaultConfig.MaxDuration, req.duration, "duration should be capped")
+
+	req, err = h.parseRequest(httptest.NewRequest(http.MethodGet, "/?point=input&rate=0.5&limit=5&duration=10s", nil))
+	require.NoError(t, err)
+	assert.Equal(t, PointInput, req.filter.Point)
+	assert.Equal(t, 0.5, req.rate)
+	assert.Equal(t, 5, req.limit)
+	assert.Equal(t, 10*time.Second, req.duration)
+
+	for _, query := range []string{
+		"point=output",
+		"rate=-1",
+		"limit=abc",
+		"duration=0s",
+		"condition=" + url.QueryEscape(`{"no_such_condition":{}}`),
+	} {
+		_, err := h.parseRequest(httptest.NewRequest(http.MethodGet, "/?"+query, nil))
+		assert.Error(t, err, query)
+	}
+}
+
+func TestHandlerTooManySubscribers(t *testing.T) {
+	hub := NewHub()
+	cfg := DefaultConfig
+	cfg.MaxSubscribers = 1
+	sub, err := hub.Subscribe(Filter{Point: PointQueue}, testLimits, 1)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	rec := httptest.NewRecorder()
+	NewHandler(hub, cfg, logptest.NewTestingLogger(t, "")).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+}
+
+type testAttacher map[string]http.Handler
+
+func (a testAttacher) AttachHandler(route string, h http.Handler) error {
+	a[route] = h
+	return nil
+}
+
+func TestHttpAttach(t *testing.T) {
+	logger := logptest.NewTestingLogger(t, "")
+
+	attacher := testAttacher{}
+	require.NoError(t, HttpAttach(nil, attacher, logger))
+	require.NoError(t, HttpAttach(config.MustNewConfigFrom(mapstr.M{"enabled": false}), attacher, logger))
+	assert.Empty(t, attacher, "tap should not be attached when disabled")
+
+	require.NoError(t, HttpAttach(config.MustNewConfigFrom(mapstr.M{"enabled": true}), attacher, logger))
+	assert.Contains(t, attacher, "/debug/tap")
+
+	assert.Error(t, HttpAttach(config.MustNewConfigFrom(mapstr.M{"enabled": true, "max_rate": 0}), attacher, logger))
+}
@@ -0,0 +1,263 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package tap provides a sampled live view of the events flowing through the
+// publisher pipeline, for debugging processor chains on running Beats.
+//
+// Capture points are cheap when nobody is listening: they only check an
+// atomic counter. Events matching an active subscription are rate limited
+// and encoded to JSON synchronously, so subscribers never observe events
+// that are still being modified by the pipeline.
+package tap
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	jsoncodec "github.com/elastic/beats/v7/libbeat/outputs/codec/json"
+	"github.com/elastic/beats/v7/libbeat/version"
+)
+
+// Point identifies where in the pipeline events are captured.
+type Point string
+
+const (
+	// PointInput captures events as published by the input, before any
+	// processing.
+	PointInput Point = "input"
+
+	// PointProcessor captures events after each processor of the
+	// processing chain.
+	PointProcessor Point = "processor"
+
+	// PointQueue captures the final events as they are handed to the
+	// queue, once all processors ran. Outputs encode them later, possibly
+	// several times if they are retried, and may still drop them.
+	PointQueue Point = "queue"
+)
+
+var errTooManySubscribers = errors.New("too many active tap subscribers")
+
+// DefaultHub is the hub used by the publisher pipeline and the HTTP API.
+var DefaultHub = NewHub()
+
+// Hub distributes captured events to its subscribers.
+type Hub struct {
+	active atomic.Int32
+
+	mu   sync.RWMutex
+	subs map[*Subscription]struct{}
+}
+
+// Filter selects the events delivered to a subscription.
+type Filter struct {
+	Point Point
+
+	// InputID restricts the subscription to events published by the
+	// input with this ID. Empty matches all inputs.
+	InputID string
+
+	// Condition restricts the subscription to matching events. Nil
+	// matches all events.
+	Condition conditions.Condition
+}
+
+// Limits bound the resources used by a subscription.
+type Limits struct {
+	// Rate is the maximum number of events per second.
+	Rate float64
+
+	// MaxEventSize is the maximum size of an encoded event. Larger events
+	// are reported without their content.
+	MaxEventSize int
+
+	// Buffer is the number of records that can be queued for the
+	// subscriber. Records are dropped when the buffer is full.
+	Buffer int
+}
+
+// Record is a single captured event.
+type Record struct {
+	Timestamp time.Time       `json:"@timestamp"`
+	Point     Point           `json:"point"`
+	Processor string          `json:"processor,omitempty"`
+	InputID   string          `json:"input_id,omitempty"`
+	Event     json.RawMessage `json:"event,omitempty"`
+	Size      int             `json:"size,omitempty"`
+	Truncated bool            `json:"truncated,omitempty"`
+
+	// Dropped is set when the processor dropped the event, Error when it
+	// failed.
+	Dropped bool   `json:"dropped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Subscription receives the records matching its filter.
+type Subscription struct {
+	hub     *Hub
+	filter  Filter
+	limits  Limits
+	limiter *rate.Limiter
+	records chan Record
+	dropped atomic.Uint64
+
+	// mu protects the encoder, which is shared by the goroutines
+	// publishing events.
+	mu      sync.Mutex
+	encoder *jsoncodec.Encoder
+
+	closeOnce sync.Once
+}
+
+// NewHub creates a hub without subscribers.
+func NewHub() *Hub {
+	return &Hub{subs: map[*Subscription]struct{}{}}
+}
+
+// Active reports whether the hub has any subscribers. It is safe to call on
+// a nil hub.
+func (h *Hub) Active() bool {
+	return h != nil && h.active.Load() > 0
+}
+
+// Subscribers returns the number of active subscriptions.
+func (h *Hub) Subscribers() int {
+	return int(h.active.Load())
+}
+
+// Subscribe registers a new subscription. It fails if the hub already has
+// maxSubscribers subscriptions.
+func (h *Hub) Subscribe(filter Filter, limits Limits, maxSubscribers int) (*Subscription, error) {
+	if limits.Buffer <= 0 {
+		limits.Buffer = 1
+	}
+	burst := max(int(min(limits.Rate, math.MaxInt32)), 1)
+	s := &Subscription{
+		hub:     h,
+		filter:  filter,
+		limits:  limits,
+		limiter: rate.NewLimiter(rate.Limit(limits.Rate), burst),
+		records: make(chan Record, limits.Buffer),
+		encoder: jsoncodec.New(version.GetDefaultVersion(), jsoncodec.Config{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.subs) >= maxSubscribers {
+		return nil, errTooManySubscribers
+	}
+	h.subs[s] = struct{}{}
+	h.active.Add(1)
+	return s, nil
+}
+
+// Capture offers an event to all subscriptions. processor names the
+// processor that ran last for PointProcessor captures, err is the error it
+// returned if any, and a nil event signals that it dropped the event.
+// Capture never blocks and doesn't retain or modify the event.
+func (h *Hub) Capture(point Point, inputID, processor string, event *beat.Event, err error) {
+	if !h.Active() {
+		return
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for s := range h.subs {
+		s.capture(point, inputID, processor, event, err)
+	}
+}
+
+// Records returns the channel delivering the captured records.
+func (s *Subscription) Records() <-chan Record {
+	return s.records
+}
+
+// Dropped returns the number of records dropped because the subscriber
+// didn't keep up.
+func (s *Subscription) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+// Close unregisters the subscription from its hub.
+func (s *Subscription) Close() {
+	s.closeOnce.Do(func() {
+		s.hub.mu.Lock()
+		defer s.hub.mu.Unlock()
+		delete(s.hub.subs, s)
+		s.hub.active.Add(-1)
+	})
+}
+
+func (s *Subscription) capture(point Point, inputID, processor string, event *beat.Event, processorErr error) {
+	if point != s.filter.Point {
+		return
+	}
+	if s.filter.InputID != "" && s.filter.InputID != inputID {
+		return
+	}
+	// Dropped events can't be checked against the condition, they are
+	// only reported to subscriptions without one.
+	if s.filter.Condition != nil && (event == nil || !s.filter.Condition.Check(event)) {
+		return
+	}
+	if !s.limiter.Allow() {
+		return
+	}
+
+	record := Record{
+		Timestamp: time.Now().UTC(),
+		Point:     point,
+		Processor: processor,
+		InputID:   inputID,
+		Dropped:   event == nil,
+	}
+	if processorErr != nil {
+		record.Error = processorErr.Error()
+	}
+
+	if event != nil {
+		s.mu.Lock()
+		encoded, err := s.encoder.Encode("tap", event)
+		if err == nil {
+			record.Size = len(encoded)
+			if s.limits.MaxEventSize > 0 && len(encoded) > s.limits.MaxEventSize {
+				record.Truncated = true
+			} else {
+				// The encoder reuses its buffer, the record needs its own copy.
+				record.Event = append(json.RawMessage(nil), encoded...)
+			}
+		}
+		s.mu.Unlock()
+		if err != nil {
+			return
+		}
+	}
+
+	select {
+	case s.records <- record:
+	default:
+		s.dropped.Add(1)
+	}
+}
@@ -0,0 +1,175 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tap
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"github.com/elastic/elastic-agent-libs/logp/logptest"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+var testLimits = Limits{Rate: 1000, MaxEventSize: 1024, Buffer: 10}
+
+func testEvent(fields mapstr.M) *beat.Event {
+	return &beat.Event{Timestamp: time.Now(), Fields: fields}
+}
+
+func TestHubInactive(t *testing.T) {
+	var nilHub *Hub
+	assert.False(t, nilHub.Active())
+	nilHub.Capture(PointInput, "", "", testEvent(mapstr.M{"a": 1}), nil)
+
+	hub := NewHub()
+	assert.False(t, hub.Active())
+
+	sub, err := hub.Subscribe(Filter{Point: PointInput}, testLimits, 1)
+	require.NoError(t, err)
+	assert.True(t, hub.Active())
+
+	sub.Close()
+	sub.Close()
+	assert.False(t, hub.Active())
+	assert.Equal(t, 0, hub.Subscribers())
+}
+
+func TestHubFilter(t *testing.T) {
+	equals, err := conditions.NewEqualsCondition(map[string]interface{}{"service": "web"}, logptest.NewTestingLogger(t, ""))
+	require.NoError(t, err)
+
+	hub := NewHub()
+	sub, err := hub.Subscribe(Filter{
+		Point:     PointProcessor,
+		InputID:   "my-input",
+		Condition: equals,
+	}, testLimits, 1)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	hub.Capture(PointProcessor, "my-input", "add_fields", testEvent(mapstr.M{"service": "web", "n": 1}), nil)
+	hub.Capture(PointInput, "my-input", "", testEvent(mapstr.M{"service": "web", "n": 2}), nil)
+	hub.Capture(PointProcessor, "other-input", "add_fields", testEvent(mapstr.M{"service": "web", "n": 3}), nil)
+	hub.Capture(PointProcessor, "my-input", "add_fields", testEvent(mapstr.M{"service": "db", "n": 4}), nil)
+
+	require.Len(t, sub.Records(), 1, "only the matching event should be captured")
+	record := <-sub.Records()
+	assert.Equal(t, PointProcessor, record.Point)
+	assert.Equal(t, "my-input", record.InputID)
+	assert.Equal(t, "add_fields", record.Processor)
+
+	var event map[string]interface{}
+	require.NoError(t, json.Unmarshal(record.Event, &event))
+	assert.EqualValues(t, 1, event["n"])
+	assert.Contains(t, event, "@timestamp")
+}
+
+func TestHubLimits(t *testing.T) {
+	t.Run("rate", func(t *testing.T) {
+		hub := NewHub()
+		sub, err := hub.Subscribe(Filter{Point: PointQueue}, Limits{Rate: 2, Buffer: 10}, 1)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		for i := 0; i < 10; i++ {
+			hub.Capture(PointQueue, "", "", testEvent(mapstr.M{"n": i}), nil)
+		}
+		assert.Len(t, sub.Records(), 2, "events above the rate should be sampled out")
+	})
+
+	t.Run("buffer", func(t *testing.T) {
+		hub := NewHub()
+		sub, err := hub.Subscribe(Filter{Point: PointQueue}, Limits{Rate: 100, Buffer: 3}, 1)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		for i := 0; i < 10; i++ {
+			hub.Capture(PointQueue, "", "", testEvent(mapstr.M{"n": i}), nil)
+		}
+		assert.Len(t, sub.Records(), 3)
+		assert.EqualValues(t, 7, sub.Dropped(), "records should be dropped when the buffer is full")
+	})
+
+	t.Run("event size", func(t *testing.T) {
+		hub := NewHub()
+		sub, err := hub.Subscribe(Filter{Point: PointQueue}, Limits{Rate: 100, MaxEventSize: 100, Buffer: 3}, 1)
+		require.NoError(t, err)
+		defer sub.Close()
+
+		hub.Capture(PointQueue, "", "", testEvent(mapstr.M{"message": string(make([]byte, 200))}), nil)
+		record := <-sub.Records()
+		assert.True(t, record.Truncated)
+		assert.Nil(t, record.Event)
+		assert.Greater(t, record.Size, 200)
+	})
+
+	t.Run("subscribers", func(t *testing.T) {
+		hub := NewHub()
+		sub, err := hub.Subscribe(Filter{Point: PointQueue}, testLimits, 1)
+		require.NoError(t, err)
+
+		_, err = hub.Subscribe(Filter{Point: PointQueue}, testLimits, 1)
+		assert.ErrorIs(t, err, errTooManySubscribers)
+
+		sub.Close()
+		sub, err = hub.Subscribe(Filter{Point: PointQueue}, testLimits, 1)
+		require.NoError(t, err, "closing a subscription should free its slot")
+		sub.Close()
+	})
+}
+
+func TestCaptureDoesNotRetainEvent(t *testing.T) {
+	hub := NewHub()
+	sub, err := hub.Subscribe(Filter{Point: PointInput}, testLimits, 1)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	event := testEvent(mapstr.M{"message": "before"})
+	hub.Capture(PointInput, "", "", event, nil)
+	event.Fields["message"] = "after"
+
+	record := <-sub.Records()
+	assert.Contains(t, string(record.Event), `"message":"before"`)
+}
+
+func TestCaptureDropped(t *testing.T) {
+	hub := NewHub()
+	sub, err := hub.Subscribe(Filter{Point: PointProcessor}, testLimits, 1)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	hub.Capture(PointProcessor, "", "drop_event", nil, nil)
+	hub.Capture(PointProcessor, "", "decode_json", testEvent(mapstr.M{"a": 1}), errors.New("invalid JSON"))
+
+	record := <-sub.Records()
+	assert.True(t, record.Dropped)
+	assert.Equal(t, "drop_event", record.Processor)
+	assert.Nil(t, record.Event)
+
+	record = <-sub.Records()
+	assert.False(t, record.Dropped)
+	assert.Equal(t, "invalid JSON", record.Error)
+	assert.NotNil(t, record.Event)
+}
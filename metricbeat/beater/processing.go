@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package beater
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/mb/module"
+	"github.com/elastic/elastic-agent-libs/paths"
+)
+
+// InputProcessing returns the processing settings of the metricset named
+// <module>.<metricset>, from the first enabled module configuration running
+// it. Modules are looked up in the modules setting and in the modules.d
+// directory. It is used by the test processors command.
+func InputProcessing(b *beat.Beat, id string) (beat.ProcessingConfig, error) {
+	moduleName, metricSetName, ok := strings.Cut(id, ".")
+	if !ok {
+		return beat.ProcessingConfig{}, fmt.Errorf("invalid metricset %q, expected <module>.<metricset>", id)
+	}
+
+	config := defaultConfig
+	if err := b.BeatConfig.Unpack(&config); err != nil {
+		return beat.ProcessingConfig{}, fmt.Errorf("error reading configuration file: %w", err)
+	}
+
+	modules := config.Modules
+	if config.ConfigModules.Enabled() {
+		loader := cfgfile.NewReloader(b.Info.Logger.Named("module.reload"), nil, config.ConfigModules, b.Info.Paths)
+		configs, err := loader.Configs()
+		if err != nil {
+			return beat.ProcessingConfig{}, err
+		}
+		modules = append(modules, configs...)
+	}
+
+	// Light modules define processors for their metricsets.
+	mb.Registry.SetSecondarySource(mb.NewLightModulesSource(b.Info.Logger, b.Info.Paths.Resolve(paths.Home, "module")))
+
+	for _, moduleCfg := range modules {
+		if !moduleCfg.Enabled() {
+			continue
+		}
+		var names struct {
+			Module     string   `config:"module"`
+			MetricSets []string `config:"metricsets"`
+		}
+		if err := moduleCfg.Unpack(&names); err != nil {
+			return beat.ProcessingConfig{}, err
+		}
+		// Modules without metricsets run their default metricsets.
+		if names.Module != moduleName || (len(names.MetricSets) > 0 && !slices.Contains(names.MetricSets, metricSetName)) {
+			continue
+		}
+		return module.ProcessingConfig(b.Info, mb.Registry, moduleCfg, moduleName, metricSetName)
+	}
+	return beat.ProcessingConfig{}, fmt.Errorf("no module running the metricset %q found", id)
+}
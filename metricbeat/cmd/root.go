@@ -59,10 +59,11 @@ func MetricbeatSettings(moduleNameSpace string) instance.Settings {
 	runFlags := pflag.NewFlagSet(Name, pflag.ExitOnError)
 	runFlags.AddGoFlag(flag.CommandLine.Lookup("system.hostfs"))
 	return instance.Settings{
-		RunFlags:      runFlags,
-		Name:          Name,
-		HasDashboards: true,
-		Processing:    processing.MakeDefaultSupport(true, nil, withECSVersion, processing.WithHost, processing.WithAgentMeta()),
+		RunFlags:        runFlags,
+		Name:            Name,
+		HasDashboards:   true,
+		Processing:      processing.MakeDefaultSupport(true, nil, withECSVersion, processing.WithHost, processing.WithAgentMeta()),
+		InputProcessing: beater.InputProcessing,
 		Initialize: []func(){
 			include.InitializeModule,
 			func() { module.RegisterMonitoringModules(moduleNameSpace) },
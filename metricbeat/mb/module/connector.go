@@ -112,14 +112,37 @@ func (c *Connector) addProcessors(procs []beat.Processor) {
 
 func (c *Connector) Connect() (beat.Client, error) {
 	return c.pipeline.ConnectWith(beat.ClientConfig{
-		Processing: beat.ProcessingConfig{
-			EventMetadata: c.eventMeta,
-			Processor:     c.processors,
-			KeepNull:      c.keepNull,
-		},
+		Processing: c.processingConfig(),
 	})
 }
 
+func (c *Connector) processingConfig() beat.ProcessingConfig {
+	return beat.ProcessingConfig{
+		EventMetadata: c.eventMeta,
+		Processor:     c.processors,
+		KeepNull:      c.keepNull,
+	}
+}
+
+// ProcessingConfig returns the processing settings applied by the runners
+// created by the Factory to the clients of the metricset of the module
+// configured by c. Processors added by the metricset itself are not included.
+func ProcessingConfig(
+	beatInfo beat.Info,
+	r metricSetRegister,
+	c *conf.C,
+	moduleName, metricSetName string,
+) (beat.ProcessingConfig, error) {
+	connector, err := NewConnector(beatInfo, nil, c)
+	if err != nil {
+		return beat.ProcessingConfig{}, err
+	}
+	if err := connector.UseMetricSetProcessors(r, moduleName, metricSetName); err != nil {
+		return beat.ProcessingConfig{}, err
+	}
+	return connector.processingConfig(), nil
+}
+
 // processorsForConfig assembles the Processors for a Connector.
 func processorsForConfig(
 	beatInfo beat.Info, config connectorConfig,
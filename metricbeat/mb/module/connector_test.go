@@ -134,6 +134,24 @@ func TestUseMetricSetProcessors_ReadingProcessorsSucceeded(t *testing.T) {
 	require.Len(t, connector.processors.List, 2)
 }
 
+func TestProcessingConfig(t *testing.T) {
+	r := &fakeMetricSetRegister{
+		success: true,
+	}
+
+	c := conf.MustNewConfigFrom(`
+index: 'test'
+keep_null: true
+fields.env: test
+`)
+	processing, err := ProcessingConfig(beat.Info{}, r, c, "module", "metricset")
+	require.NoError(t, err)
+	assert.True(t, processing.KeepNull)
+	assert.Equal(t, mapstr.M{"env": "test"}, processing.EventMetadata.Fields)
+	// The metricset processors run before the index processor.
+	require.Len(t, processing.Processor.(*processors.Processors).List, 3)
+}
+
 // Helper function to convert from YML input string to an unpacked
 // connectorConfig
 func connectorConfigFromString(s string) (connectorConfig, error) {
@@ -96,7 +96,7 @@ func newURL(host string, port int64, path, query string) *ecs.Url {
 	}
 	if path != "" {
 		periodIndex := strings.LastIndex(path, ".")
-		if periodIndex != -1 && periodIndex < len(path) {
+		if periodIndex != -1 && periodIndex > strings.LastIndex(path, "/") {
 			u.Extension = path[(periodIndex + 1):]
 		}
 	}
@@ -32,5 +32,5 @@ func init() {
 // AssetHttp returns asset data.
 // This is the base64 encoded zlib format compressed contents of protos/http.
 func AssetHttp() string {
-	return "eJzUVMuOlUAQ3fMVJ7N2+AAWJm6MszEubuJyUkAB7dCP6a72yt+b5nEdoKMxNy5kBfU451T1aR7xwlOFQcQVgCgZucLDp8vly0MBtBwar5woayqk4GNw3KhONeDvbASd4rENZYH1rSoA4BGGNN9Q0yOT4wq9t3GL7LCfTGe9pvQBqm0UyMAzIzy/Rg4CMi08B2dN4HLFeEv6lnjtucUzk2RqzhpzHLsBmVr2YZfbcGz9jRs5pJbg81LxwtPV+vZQslP6/pAEPkCTQ2ONkDLK9POiGnISPberoFUzOm/1nF9nLU9oXwfVDNsYELshQYXE0ak+eqpHLvHU3cquSoYZNpDmE+QqIS0I5BnOc0hWUWbu0RwC9fwufUy4qnFEzQjsyJNwi3o6ITZWawplkT2C1KfzJ0CjomNGq97Tslvx8ajekQwVoh/L18h+KjLGWgz4B2ediv7eWkFIYnh2g6dwlLmjvGwXZenA0nE8av5B2qW7/dkKPtpo2vw6/x9H7/8Ev567LH1CSxa/09InzHr6vaUb2x6F3Gfo9B8ubxtbnTWz5K9UznP/QMHK8zMAAP//lgPSsQ=="
+	return "eJzUVcFu3DgMvfsriJwTL7DHOSywaJEmlyRop+gxkG3aViNLCkXH8d8XkuXB2NYMkCAtUOSSkan3HslH6gqecNxBy2wzAJascAcXN/v9w0UGUKErSVqWRu/AH145i6WsZQn4gpqhlqgql2cQ/9tlAABXoEWHB1R/xKPFHTRk+vlkgX2ra0Od8EQgCtMzcIuBEQife3QMQldA6KzRDvOIcUx6TBzvHM4TmSRithpTHIsEUVRIbvFtxjHFTyyP4f3fdPg4RTzhOBiqViELpf+tPgL8D52wUBrNQmqpm1CoUljuCasoKGqGmkwXvsdc82wBBQA/Wlm2cxrAZkYC6TxHLZueRKEwh9v6EDZIbgOsEx1uIKMEXyAQhGAJnbeK1OFOh86JBi/9jxEGqRQUCA6tIMFYQTFuEEvTdcLlWbIF/l6X7oBQUqy/dLKhYLMdMPVr9VZwu4OeVP7cI41ZtmabDZid6Fd01ibo7dZyLLh3j7Yl4fCcRfbzoEw3YLqxbjW+is762b4zDNem11W6nLHHf4Gjl5vggyy9QfMWj1HvtfQGsxjPW7o0FX6oof0ezg8Vi84KLEn+pOd+g4LIs3gyGrJldmpmThhp+3g0Xx8+QSmUcpcwhBXn21YKIokVmBekMDL//Jtn6Umc5TikF1ke53TK6AttfibrXqkRnnuhZO1ZPSCYOjgm6IvYeZaY0haVMoMhVeVfCJGRso22Drk11XukbaRMUEkl38R448Vs+Y99tBahjG7OKQiscWF5I85qfM/yU1QfkupJ0kTud/f7x+v773eft4rizB9RT4kzvvI5PZE+Xl8q2JDMz3aMdm8q83LRhlr0XYHkOWdACC9zMU5lUBI150FmmAe/MU0neb3DhhY1WEE8y486YRAOtOHD8k6mFHfAn8nJzxjS+3KahCaS+jUArXcxxg=="
 }
@@ -0,0 +1,178 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/http2/hpack"
+
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// grpcMessagePrefixSize is the size of the prefix of length-prefixed gRPC
+// messages: a compression flag and the message length.
+const grpcMessagePrefixSize = 5
+
+var contentTypeGRPC = []byte("application/grpc")
+
+// grpcStatusNames contains the names of the gRPC status codes.
+var grpcStatusNames = []string{
+	"OK",
+	"CANCELLED",
+	"UNKNOWN",
+	"INVALID_ARGUMENT",
+	"DEADLINE_EXCEEDED",
+	"NOT_FOUND",
+	"ALREADY_EXISTS",
+	"PERMISSION_DENIED",
+	"RESOURCE_EXHAUSTED",
+	"FAILED_PRECONDITION",
+	"ABORTED",
+	"OUT_OF_RANGE",
+	"UNIMPLEMENTED",
+	"INTERNAL",
+	"UNAVAILABLE",
+	"DATA_LOSS",
+	"UNAUTHENTICATED",
+}
+
+func grpcStatusName(code int) string {
+	if code >= 0 && code < len(grpcStatusNames) {
+		return grpcStatusNames[code]
+	}
+	return fmt.Sprintf("Unknown(%d)", code)
+}
+
+// isGRPCContentType reports whether a content type is used by gRPC, such as
+// application/grpc or application/grpc+proto.
+func isGRPCContentType(contentType []byte) bool {
+	return bytes.HasPrefix(contentType, contentTypeGRPC)
+}
+
+// grpcStream holds the gRPC details of an HTTP/2 stream.
+type grpcStream struct {
+	enabled bool
+
+	requ, resp grpcFraming
+
+	hasStatus bool
+	status    int
+	message   string
+}
+
+// grpcFraming counts the length-prefixed messages sent in a gRPC stream.
+type grpcFraming struct {
+	prefix    [grpcMessagePrefixSize]byte
+	buffered  int
+	remaining int
+	messages  int
+
+	// incomplete is set when part of the stream was not seen, in which
+	// case the messages can no longer be counted.
+	incomplete bool
+}
+
+func (g *grpcStream) framing(isRequest bool) *grpcFraming {
+	if isRequest {
+		return &g.requ
+	}
+	return &g.resp
+}
+
+// setStatus records the status of the call, which is sent in the trailers,
+// or in the headers of a trailers-only response.
+func (g *grpcStream) setStatus(fields []hpack.HeaderField) {
+	for _, f := range fields {
+		switch f.Name {
+		case "grpc-status":
+			code, err := strconv.Atoi(f.Value)
+			if err != nil {
+				continue
+			}
+			g.hasStatus = true
+			g.status = code
+		case "grpc-message":
+			// The message is percent-encoded.
+			msg, err := url.PathUnescape(f.Value)
+			if err != nil {
+				msg = f.Value
+			}
+			g.message = msg
+		}
+	}
+}
+
+// failed reports whether the call ended with a non-OK status.
+func (g *grpcStream) failed() bool {
+	return g.hasStatus && g.status != 0
+}
+
+// fields returns the grpc fields of the event. The path of a gRPC request
+// has the form /package.Service/Method.
+func (g *grpcStream) fields(path string) mapstr.M {
+	fields := mapstr.M{}
+	if service, method, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/"); ok {
+		fields["service"] = service
+		fields["method"] = method
+	}
+	if g.hasStatus {
+		fields["status_code"] = g.status
+		fields["status"] = grpcStatusName(g.status)
+		if g.message != "" {
+			fields["message"] = g.message
+		}
+	}
+	if !g.requ.incomplete {
+		fields["request"] = mapstr.M{"messages": g.requ.messages}
+	}
+	if !g.resp.incomplete {
+		fields["response"] = mapstr.M{"messages": g.resp.messages}
+	}
+	return fields
+}
+
+// feed counts the messages in p. partial indicates that the data following
+// p in the stream was not seen.
+func (f *grpcFraming) feed(p []byte, partial bool) {
+	if f.incomplete {
+		return
+	}
+	for len(p) > 0 {
+		if f.remaining > 0 {
+			n := min(f.remaining, len(p))
+			f.remaining -= n
+			p = p[n:]
+			continue
+		}
+		n := copy(f.prefix[f.buffered:], p)
+		f.buffered += n
+		p = p[n:]
+		if f.buffered == len(f.prefix) {
+			f.messages++
+			f.remaining = int(binary.BigEndian.Uint32(f.prefix[1:]))
+			f.buffered = 0
+		}
+	}
+	f.incomplete = partial
+}
@@ -77,6 +77,9 @@ type httpConnectionData struct {
 	streams   [2]*stream
 	requests  messageList
 	responses messageList
+
+	// h2 is set once the connection has switched to HTTP/2.
+	h2 *h2Connection
 }
 
 type messageList struct {
@@ -305,6 +308,11 @@ func (http *httpPlugin) doParse(
 		detailedf("Payload received: [%s]", pkt.Payload)
 	}
 
+	if conn.h2 != nil {
+		http.parseHTTP2(conn, pkt.Payload, pkt.Ts, tcptuple, dir)
+		return conn
+	}
+
 	extraMsgSize := 0 // size of a "seen" packet for which we don't store the actual bytes
 
 	st := conn.streams[dir]
@@ -333,6 +341,17 @@ func (http *httpPlugin) doParse(
 			st.message = &message{ts: pkt.Ts}
 		}
 
+		if st.parseState == stateStart && st.parseOffset == 0 && extraMsgSize == 0 {
+			if isPartialHTTP2Preface(st.data) {
+				// wait for the rest of the HTTP/2 connection preface
+				break
+			}
+			if isHTTP2(st.data) {
+				http.startHTTP2(conn, pkt.Ts, tcptuple)
+				return conn
+			}
+		}
+
 		parser := newParser(&http.parserConfig)
 		ok, complete := parser.parse(st, extraMsgSize)
 		extraMsgSize = 0
@@ -403,6 +422,11 @@ func (http *httpPlugin) GapInStream(tcptuple *common.TCPTuple, dir uint8,
 		return private, false
 	}
 
+	if conn.h2 != nil {
+		http.gapInHTTP2(conn, dir, nbytes)
+		return conn, false
+	}
+
 	stream := conn.streams[dir]
 	if stream == nil || stream.message == nil {
 		// nothing to do
@@ -746,7 +770,7 @@ func extractHostHeader(header string) (host string, port int) {
 			return trimSquareBracket(header), port
 		}
 	}
-	pi, err := strconv.ParseInt(ps, 10, 16)
+	pi, err := strconv.ParseUint(ps, 10, 16)
 	if err != nil || pi == 0 {
 		return header, port
 	}
@@ -886,6 +910,9 @@ func (http *httpPlugin) Expired(tuple *common.TCPTuple, private protos.ProtocolD
 	if isDebug {
 		debugf("expired connection %s", tuple)
 	}
+	if conn.h2 != nil {
+		http.flushHTTP2(conn)
+	}
 	// terminate streams
 	for dir, s := range conn.streams {
 		// Do not send incomplete or empty messages
@@ -0,0 +1,745 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package http
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/packetbeat/protos/tcp"
+)
+
+const (
+	// http2FrameHeaderSize is the size of the fixed HTTP/2 frame header.
+	http2FrameHeaderSize = 9
+
+	// http2MaxFrameSize limits the size of buffered frames. The payload of
+	// larger DATA frames is counted, but not inspected.
+	http2MaxFrameSize = tcp.TCPMaxDataInStream
+
+	// http2HeaderTableSize is the initial size of the HPACK dynamic table.
+	http2HeaderTableSize = 4096
+
+	// http2MaxStreams limits the number of streams tracked per connection.
+	// Servers usually allow 100 to 250 concurrent streams.
+	http2MaxStreams = 1024
+)
+
+var http2Preface = []byte(http2.ClientPreface)
+
+var (
+	errHTTP2FrameSize    = errors.New("invalid HTTP/2 frame size")
+	errHTTP2Padding      = errors.New("invalid HTTP/2 frame padding")
+	errHTTP2Continuation = errors.New("unexpected HTTP/2 CONTINUATION frame")
+	errHTTP2HeaderBlock  = errors.New("HTTP/2 header block too large")
+	errHTTP2PacketLoss   = errors.New("packet loss in HTTP/2 stream")
+)
+
+// h2Connection holds the state of a cleartext HTTP/2 (h2c) connection.
+// Requests and responses are multiplexed over streams, which are tracked by
+// their stream ID until the response is complete, or until no frame has been
+// seen for the transaction timeout.
+type h2Connection struct {
+	dirs    [2]*h2Direction
+	streams *common.Cache
+
+	// expired collects the streams removed by streams.CleanUp, which are
+	// published once the clean up is done.
+	expired     []*h2Stream
+	timeout     time.Duration
+	nextCleanup time.Time
+}
+
+// h2Direction holds the frame parser and HPACK decoder state for one
+// direction of an HTTP/2 connection.
+type h2Direction struct {
+	data []byte
+
+	// prefaceChecked is set once the start of the stream has been checked
+	// for the client connection preface.
+	prefaceChecked bool
+
+	// failed is set when the frames sent in this direction can no longer be
+	// decoded, either because of a protocol error or packet loss.
+	failed bool
+
+	// skip is the number of bytes of an oversized or partially lost DATA
+	// frame that have not been seen yet.
+	skip int
+
+	decoder *hpack.Decoder
+
+	// Header block of a HEADERS or PUSH_PROMISE frame, which is completed
+	// by CONTINUATION frames.
+	inBlock     bool
+	block       []byte
+	blockStream uint32
+	blockFlags  http2.Flags
+	blockSize   int
+	blockPush   bool
+}
+
+// h2Stream is a request/response exchange on an HTTP/2 stream.
+type h2Stream struct {
+	id         uint32
+	requ, resp *message
+	requDone   bool
+	reset      bool
+
+	grpc grpcStream
+}
+
+type h2Frame struct {
+	typ     http2.FrameType
+	flags   http2.Flags
+	stream  uint32
+	length  int
+	payload []byte
+}
+
+func newH2Connection(timeout time.Duration) *h2Connection {
+	h2 := &h2Connection{
+		timeout:     timeout,
+		nextCleanup: time.Now().Add(timeout),
+	}
+	h2.streams = common.NewCacheWithRemovalListener(timeout, 16, func(_ common.Key, v common.Value) {
+		h2.expired = append(h2.expired, v.(*h2Stream)) //nolint:errcheck // Only streams are stored.
+	})
+	for i := range h2.dirs {
+		h2.dirs[i] = &h2Direction{decoder: hpack.NewDecoder(http2HeaderTableSize, nil)}
+	}
+	return h2
+}
+
+// isHTTP2 reports whether data starts with the HTTP/2 client connection
+// preface, or with the SETTINGS frame that servers send first.
+func isHTTP2(data []byte) bool {
+	if bytes.HasPrefix(data, http2Preface) {
+		return true
+	}
+	if len(data) < http2FrameHeaderSize {
+		return false
+	}
+	f := readHTTP2FrameHeader(data)
+	return f.typ == http2.FrameSettings && f.stream == 0 &&
+		f.flags&^http2.FlagSettingsAck == 0 && f.length%6 == 0
+}
+
+// isPartialHTTP2Preface reports whether data may be the start of the HTTP/2
+// client connection preface.
+func isPartialHTTP2Preface(data []byte) bool {
+	return len(data) < len(http2Preface) && bytes.HasPrefix(http2Preface, data)
+}
+
+func readHTTP2FrameHeader(data []byte) h2Frame {
+	return h2Frame{
+		length: int(data[0])<<16 | int(data[1])<<8 | int(data[2]),
+		typ:    http2.FrameType(data[3]),
+		flags:  http2.Flags(data[4]),
+		stream: binary.BigEndian.Uint32(data[5:]) & (1<<31 - 1),
+	}
+}
+
+// startHTTP2 switches the connection to HTTP/2. Data that has already been
+// buffered for a new HTTP/1.x message is parsed as HTTP/2 frames instead.
+func (http *httpPlugin) startHTTP2(
+	conn *httpConnectionData,
+	ts time.Time,
+	tcptuple *common.TCPTuple,
+) {
+	if isDebug {
+		debugf("Switching to HTTP/2: %s", tcptuple)
+	}
+
+	conn.h2 = newH2Connection(http.transactionTimeout)
+	streams := conn.streams
+	conn.streams = [2]*stream{}
+	for dir, st := range streams {
+		if st != nil && st.parseState == stateStart && len(st.data) > 0 {
+			http.parseHTTP2(conn, st.data, ts, tcptuple, uint8(dir))
+		}
+	}
+}
+
+// parseHTTP2 processes the HTTP/2 frames sent in one direction.
+func (http *httpPlugin) parseHTTP2(
+	conn *httpConnectionData,
+	data []byte,
+	ts time.Time,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) {
+	if now := time.Now(); now.After(conn.h2.nextCleanup) {
+		http.expireHTTP2Streams(conn)
+		conn.h2.nextCleanup = now.Add(conn.h2.timeout)
+	}
+
+	d := conn.h2.dirs[dir]
+	if d.failed {
+		return
+	}
+	if d.skip > 0 {
+		n := min(d.skip, len(data))
+		d.skip -= n
+		data = data[n:]
+	}
+	d.data = append(d.data, data...)
+
+	if !d.prefaceChecked {
+		if isPartialHTTP2Preface(d.data) {
+			return
+		}
+		d.prefaceChecked = true
+		d.data = bytes.TrimPrefix(d.data, http2Preface)
+	}
+
+	for len(d.data) >= http2FrameHeaderSize {
+		f := readHTTP2FrameHeader(d.data)
+		if f.length > http2MaxFrameSize && f.typ == http2.FrameData && !d.inBlock {
+			if isDebug {
+				debugf("HTTP/2 DATA frame too large, skipping %d bytes", f.length)
+			}
+			err := http.onHTTP2Data(conn, f, d.data[http2FrameHeaderSize:], dir)
+			if err != nil {
+				http.failHTTP2(d, err)
+				return
+			}
+			d.skip = http2FrameHeaderSize + f.length - len(d.data)
+			d.data = nil
+			return
+		}
+		if f.length > http2MaxFrameSize {
+			http.failHTTP2(d, errHTTP2FrameSize)
+			return
+		}
+		if len(d.data) < http2FrameHeaderSize+f.length {
+			// wait for more data
+			return
+		}
+
+		f.payload = d.data[http2FrameHeaderSize : http2FrameHeaderSize+f.length]
+		if err := http.onHTTP2Frame(conn, f, ts, tcptuple, dir); err != nil {
+			http.failHTTP2(d, err)
+			return
+		}
+		d.data = d.data[http2FrameHeaderSize+f.length:]
+	}
+}
+
+func (http *httpPlugin) failHTTP2(d *h2Direction, err error) {
+	if isDebug {
+		debugf("Ignoring HTTP/2 stream: %v", err)
+	}
+	d.failed = true
+	d.data = nil
+	d.block = nil
+}
+
+func (http *httpPlugin) onHTTP2Frame(
+	conn *httpConnectionData,
+	f h2Frame,
+	ts time.Time,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) error {
+	d := conn.h2.dirs[dir]
+	if d.inBlock && (f.typ != http2.FrameContinuation || f.stream != d.blockStream) {
+		return errHTTP2Continuation
+	}
+
+	switch f.typ {
+	case http2.FrameData:
+		return http.onHTTP2Data(conn, f, f.payload, dir)
+
+	case http2.FrameHeaders:
+		p, err := unpadHTTP2(f, http2.FlagHeadersPadded)
+		if err != nil {
+			return err
+		}
+		if f.flags.Has(http2.FlagHeadersPriority) {
+			if len(p) < 5 {
+				return errHTTP2FrameSize
+			}
+			p = p[5:]
+		}
+		d.startBlock(f, p, false)
+		if f.flags.Has(http2.FlagHeadersEndHeaders) {
+			return http.onHTTP2HeaderBlock(conn, ts, tcptuple, dir)
+		}
+
+	case http2.FramePushPromise:
+		p, err := unpadHTTP2(f, http2.FlagPushPromisePadded)
+		if err != nil {
+			return err
+		}
+		if len(p) < 4 {
+			return errHTTP2FrameSize
+		}
+		d.startBlock(f, p[4:], true)
+		if f.flags.Has(http2.FlagPushPromiseEndHeaders) {
+			return http.onHTTP2HeaderBlock(conn, ts, tcptuple, dir)
+		}
+
+	case http2.FrameContinuation:
+		if !d.inBlock {
+			return errHTTP2Continuation
+		}
+		if d.blockSize+http2FrameHeaderSize+f.length > http.maxMessageSize {
+			return errHTTP2HeaderBlock
+		}
+		d.block = append(d.block, f.payload...)
+		d.blockSize += http2FrameHeaderSize + f.length
+		if f.flags.Has(http2.FlagContinuationEndHeaders) {
+			return http.onHTTP2HeaderBlock(conn, ts, tcptuple, dir)
+		}
+
+	case http2.FrameRSTStream:
+		if f.length != 4 {
+			return errHTTP2FrameSize
+		}
+		http.onHTTP2Reset(conn, f.stream, http2.ErrCode(binary.BigEndian.Uint32(f.payload)))
+
+	case http2.FrameSettings:
+		if f.flags.Has(http2.FlagSettingsAck) {
+			return nil
+		}
+		if f.length%6 != 0 {
+			return errHTTP2FrameSize
+		}
+		for p := f.payload; len(p) >= 6; p = p[6:] {
+			if http2.SettingID(binary.BigEndian.Uint16(p)) == http2.SettingHeaderTableSize {
+				// The setting limits the dynamic table of the HPACK
+				// encoder used by the peer.
+				size := binary.BigEndian.Uint32(p[2:])
+				conn.h2.dirs[1-dir].decoder.SetAllowedMaxDynamicTableSize(size)
+			}
+		}
+	}
+	return nil
+}
+
+func unpadHTTP2(f h2Frame, padded http2.Flags) ([]byte, error) {
+	if !f.flags.Has(padded) {
+		return f.payload, nil
+	}
+	if len(f.payload) == 0 {
+		return nil, errHTTP2Padding
+	}
+	padding := int(f.payload[0])
+	if padding >= len(f.payload) {
+		return nil, errHTTP2Padding
+	}
+	return f.payload[1 : len(f.payload)-padding], nil
+}
+
+func (d *h2Direction) startBlock(f h2Frame, fragment []byte, push bool) {
+	d.inBlock = true
+	d.block = append(d.block[:0], fragment...)
+	d.blockStream = f.stream
+	d.blockFlags = f.flags
+	d.blockSize = http2FrameHeaderSize + f.length
+	d.blockPush = push
+}
+
+// onHTTP2HeaderBlock decodes a complete header block. Blocks must be decoded
+// in order, even for streams that are not tracked, to keep the HPACK dynamic
+// table in sync with the peer.
+func (http *httpPlugin) onHTTP2HeaderBlock(
+	conn *httpConnectionData,
+	ts time.Time,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) error {
+	d := conn.h2.dirs[dir]
+	d.inBlock = false
+	fields, err := d.decoder.DecodeFull(d.block)
+	if err != nil {
+		return fmt.Errorf("decoding HTTP/2 header block: %w", err)
+	}
+	if d.blockPush {
+		// Pushed responses are not reported.
+		return nil
+	}
+
+	endStream := d.blockFlags.Has(http2.FlagHeadersEndStream)
+	http.onHTTP2Headers(conn, d.blockStream, fields, endStream, d.blockSize, ts, tcptuple, dir)
+	return nil
+}
+
+func (http *httpPlugin) onHTTP2Headers(
+	conn *httpConnectionData,
+	id uint32,
+	fields []hpack.HeaderField,
+	endStream bool,
+	size int,
+	ts time.Time,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) {
+	s := conn.h2.stream(id)
+	var m *message
+	switch {
+	case hasHTTP2Field(fields, ":method"):
+		if s != nil {
+			// a new request replaces stale state of a reused stream ID
+			http.publishHTTP2Stream(conn, s)
+		}
+		if s = http.newHTTP2Stream(conn, id); s == nil {
+			return
+		}
+		m = http.newHTTP2Message(fields, true, ts, tcptuple, dir)
+		s.requ = m
+		s.grpc.enabled = isGRPCContentType(m.contentType)
+
+	case hasHTTP2Field(fields, ":status"):
+		m = http.newHTTP2Message(fields, false, ts, tcptuple, dir)
+		if 100 <= m.statusCode && m.statusCode < 200 {
+			// informational responses precede the final response
+			return
+		}
+		if s == nil {
+			if s = http.newHTTP2Stream(conn, id); s == nil {
+				return
+			}
+			s.grpc.enabled = isGRPCContentType(m.contentType)
+		}
+		s.resp = m
+
+	default:
+		// trailers
+		if s == nil {
+			return
+		}
+		m = s.message(dir)
+		if m == nil {
+			return
+		}
+	}
+
+	m.size += uint64(size)
+	if m == s.resp {
+		s.grpc.setStatus(fields)
+	}
+	if endStream {
+		http.endHTTP2Message(conn, s, m)
+	}
+}
+
+func hasHTTP2Field(fields []hpack.HeaderField, name string) bool {
+	for _, f := range fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// newHTTP2Message creates a message from the decoded HEADERS of a request or
+// response. The header fields are also rendered in HTTP/1.x format, which is
+// used for the raw request and response.
+func (http *httpPlugin) newHTTP2Message(
+	fields []hpack.HeaderField,
+	isRequest bool,
+	ts time.Time,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) *message {
+	m := &message{
+		ts:        ts,
+		isRequest: isRequest,
+		version:   version{major: 2},
+		headers:   map[string]common.NetString{},
+		tcpTuple:  *tcptuple,
+		direction: dir,
+	}
+	m.cmdlineTuple = http.watcher.FindProcessesTupleTCP(tcptuple.IPPort())
+
+	for _, f := range fields {
+		switch f.Name {
+		case ":method":
+			m.method = common.NetString(f.Value)
+		case ":path":
+			m.requestURI = common.NetString(f.Value)
+		case ":authority":
+			m.host = common.NetString(f.Value)
+		case ":status":
+			code, _ := strconv.ParseUint(f.Value, 10, 16)
+			m.statusCode = uint16(code)
+		}
+	}
+
+	var raw bytes.Buffer
+	if isRequest {
+		fmt.Fprintf(&raw, "%s %s HTTP/2\r\n", m.method, m.requestURI)
+	} else {
+		fmt.Fprintf(&raw, "HTTP/2 %d\r\n", m.statusCode)
+	}
+	m.headerOffset = raw.Len()
+
+	parser := newParser(&http.parserConfig)
+	for _, f := range fields {
+		if f.IsPseudo() {
+			continue
+		}
+		parser.applyHeader(m, []byte(f.Name), []byte(f.Value))
+		fmt.Fprintf(&raw, "%s: %s\r\n", f.Name, f.Value)
+	}
+	raw.WriteString("\r\n")
+	m.rawHeaders = raw.Bytes()
+
+	// The body size is counted from the DATA frames.
+	m.contentLength = 0
+
+	if isRequest {
+		m.sendBody = parser.shouldIncludeInBody(m.contentType, http.parserConfig.includeRequestBodyFor)
+	} else {
+		m.sendBody = parser.shouldIncludeInBody(m.contentType, http.parserConfig.includeResponseBodyFor)
+	}
+	m.saveBody = m.sendBody || bytes.Contains(m.contentType, []byte("urlencoded"))
+
+	if !http.redactAuthorization {
+		m.username = extractBasicAuthUser(m.headers)
+	}
+	http.hideHeaders(m)
+	return m
+}
+
+// onHTTP2Data processes a DATA frame. The payload can be shorter than the
+// frame length if the frame is too large or if part of it was lost.
+func (http *httpPlugin) onHTTP2Data(
+	conn *httpConnectionData,
+	f h2Frame,
+	payload []byte,
+	dir uint8,
+) error {
+	s := conn.h2.stream(f.stream)
+	if s == nil {
+		return nil
+	}
+	m := s.message(dir)
+	if m == nil || (m == s.requ && s.requDone) {
+		return nil
+	}
+
+	size := f.length
+	if f.flags.Has(http2.FlagDataPadded) {
+		if len(payload) == 0 {
+			return errHTTP2Padding
+		}
+		size -= 1 + int(payload[0])
+		payload = payload[1:]
+		if size < 0 {
+			return errHTTP2Padding
+		}
+	}
+	partial := len(payload) < size
+	if !partial {
+		payload = payload[:size]
+	}
+
+	m.size += uint64(http2FrameHeaderSize + f.length)
+	m.contentLength += size
+	if m.saveBody && len(m.body)+len(payload) <= http.maxMessageSize {
+		m.body = append(m.body, payload...)
+	}
+	if s.grpc.enabled {
+		s.grpc.framing(m == s.requ).feed(payload, partial)
+	}
+
+	if f.flags.Has(http2.FlagDataEndStream) {
+		http.endHTTP2Message(conn, s, m)
+	}
+	return nil
+}
+
+func (http *httpPlugin) notePacketLoss(m *message) {
+	if m.isRequest {
+		if !m.packetLossReq {
+			m.packetLossReq = true
+			m.notes = append(m.notes, "Packet loss while capturing the request")
+		}
+	} else {
+		if !m.packetLossResp {
+			m.packetLossResp = true
+			m.notes = append(m.notes, "Packet loss while capturing the response")
+		}
+	}
+}
+
+func (http *httpPlugin) onHTTP2Reset(conn *httpConnectionData, id uint32, code http2.ErrCode) {
+	s := conn.h2.stream(id)
+	if s == nil {
+		return
+	}
+	if isDebug {
+		debugf("HTTP/2 stream %d reset: %v", id, code)
+	}
+	s.reset = true
+	m := s.resp
+	if m == nil {
+		m = s.requ
+	}
+	m.notes = append(m.notes, "Stream reset: "+code.String())
+	http.publishHTTP2Stream(conn, s)
+}
+
+// stream returns the stream with the given ID, or nil if it is not tracked.
+func (h2 *h2Connection) stream(id uint32) *h2Stream {
+	s, _ := h2.streams.Get(id).(*h2Stream)
+	return s
+}
+
+// newHTTP2Stream starts tracking a stream. It returns nil if the connection
+// already has too many streams in progress.
+func (http *httpPlugin) newHTTP2Stream(conn *httpConnectionData, id uint32) *h2Stream {
+	if conn.h2.streams.Size() >= http2MaxStreams {
+		http.expireHTTP2Streams(conn)
+		if conn.h2.streams.Size() >= http2MaxStreams {
+			if isDebug {
+				debugf("Too many HTTP/2 streams in progress, ignoring stream %d", id)
+			}
+			return nil
+		}
+	}
+	s := &h2Stream{id: id}
+	conn.h2.streams.Put(id, s)
+	return s
+}
+
+// expireHTTP2Streams publishes the streams that have not seen any frame for
+// the transaction timeout.
+func (http *httpPlugin) expireHTTP2Streams(conn *httpConnectionData) {
+	conn.h2.streams.CleanUp()
+	http.publishHTTP2Streams(conn, conn.h2.expired)
+	conn.h2.expired = nil
+}
+
+// publishHTTP2Streams publishes streams in stream ID order.
+func (http *httpPlugin) publishHTTP2Streams(conn *httpConnectionData, streams []*h2Stream) {
+	slices.SortFunc(streams, func(a, b *h2Stream) int {
+		return cmp.Compare(a.id, b.id)
+	})
+	for _, s := range streams {
+		http.publishHTTP2Stream(conn, s)
+	}
+}
+
+// message returns the message of s that is sent in the direction dir.
+func (s *h2Stream) message(dir uint8) *message {
+	for _, m := range []*message{s.requ, s.resp} {
+		if m != nil && m.direction == dir {
+			return m
+		}
+	}
+	return nil
+}
+
+// endHTTP2Message is called when a message ends its side of the stream. A
+// transaction is published as soon as the response is complete.
+func (http *httpPlugin) endHTTP2Message(conn *httpConnectionData, s *h2Stream, m *message) {
+	if m == s.requ {
+		s.requDone = true
+		return
+	}
+	http.publishHTTP2Stream(conn, s)
+}
+
+func (http *httpPlugin) publishHTTP2Stream(conn *httpConnectionData, s *h2Stream) {
+	conn.h2.streams.Delete(s.id)
+
+	switch {
+	case s.requ == nil:
+		unmatchedResponses.Add(1)
+	case s.resp == nil:
+		unmatchedRequests.Add(1)
+	}
+
+	event := http.newTransaction(s.requ, s.resp)
+	if s.reset {
+		event.Fields["status"] = common.ERROR_STATUS
+	}
+	if s.grpc.enabled {
+		var path string
+		if s.requ != nil {
+			path = string(s.requ.requestURI)
+		}
+		event.Fields["grpc"] = s.grpc.fields(path)
+		if s.grpc.failed() {
+			event.Fields["status"] = common.ERROR_STATUS
+		}
+	}
+	http.publishTransaction(event)
+}
+
+// flushHTTP2 publishes the streams that are still in progress.
+func (http *httpPlugin) flushHTTP2(conn *httpConnectionData) {
+	conn.h2.streams.CleanUp()
+	streams := conn.h2.expired
+	conn.h2.expired = nil
+	for _, v := range conn.h2.streams.Entries() {
+		streams = append(streams, v.(*h2Stream)) //nolint:errcheck // Only streams are stored.
+	}
+	http.publishHTTP2Streams(conn, streams)
+}
+
+// gapInHTTP2 handles packet loss in an HTTP/2 connection. Only gaps in the
+// payload of DATA frames can be recovered from. Any other loss makes the
+// direction undecodable, as frame boundaries and the HPACK state are lost.
+func (http *httpPlugin) gapInHTTP2(conn *httpConnectionData, dir uint8, nbytes int) {
+	d := conn.h2.dirs[dir]
+	if d.failed {
+		return
+	}
+	if d.skip > 0 {
+		if nbytes <= d.skip {
+			d.skip -= nbytes
+			return
+		}
+		http.failHTTP2(d, errHTTP2PacketLoss)
+		return
+	}
+
+	if len(d.data) >= http2FrameHeaderSize && !d.inBlock {
+		f := readHTTP2FrameHeader(d.data)
+		remaining := http2FrameHeaderSize + f.length - len(d.data)
+		if f.typ == http2.FrameData && nbytes <= remaining {
+			if s := conn.h2.stream(f.stream); s != nil {
+				if m := s.message(dir); m != nil {
+					http.notePacketLoss(m)
+				}
+			}
+			err := http.onHTTP2Data(conn, f, d.data[http2FrameHeaderSize:], dir)
+			if err == nil {
+				d.skip = remaining - nbytes
+				d.data = nil
+				return
+			}
+		}
+	}
+	http.failHTTP2(d, errHTTP2PacketLoss)
+}
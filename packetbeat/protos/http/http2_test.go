@@ -0,0 +1,600 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+
+package http
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/packetbeat/protos"
+	"github.com/elastic/beats/v7/packetbeat/protos/tcp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+// h2Peer encodes the frames sent by one side of an HTTP/2 connection.
+type h2Peer struct {
+	out    bytes.Buffer
+	framer *http2.Framer
+	hbuf   bytes.Buffer
+	enc    *hpack.Encoder
+}
+
+func newH2Peer() *h2Peer {
+	p := &h2Peer{}
+	p.framer = http2.NewFramer(&p.out, nil)
+	p.enc = hpack.NewEncoder(&p.hbuf)
+	return p
+}
+
+func (p *h2Peer) flush() []byte {
+	b := bytes.Clone(p.out.Bytes())
+	p.out.Reset()
+	return b
+}
+
+// block encodes header fields given as name/value pairs.
+func (p *h2Peer) block(t *testing.T, fields ...string) []byte {
+	t.Helper()
+	p.hbuf.Reset()
+	for i := 0; i+1 < len(fields); i += 2 {
+		require.NoError(t, p.enc.WriteField(hpack.HeaderField{Name: fields[i], Value: fields[i+1]}))
+	}
+	return bytes.Clone(p.hbuf.Bytes())
+}
+
+func (p *h2Peer) headers(t *testing.T, id uint32, endStream bool, fields ...string) []byte {
+	t.Helper()
+	require.NoError(t, p.framer.WriteHeaders(http2.HeadersFrameParam{
+		StreamID:      id,
+		BlockFragment: p.block(t, fields...),
+		EndStream:     endStream,
+		EndHeaders:    true,
+	}))
+	return p.flush()
+}
+
+func (p *h2Peer) data(t *testing.T, id uint32, endStream bool, data []byte) []byte {
+	t.Helper()
+	require.NoError(t, p.framer.WriteData(id, endStream, data))
+	return p.flush()
+}
+
+func (p *h2Peer) settings(t *testing.T, settings ...http2.Setting) []byte {
+	t.Helper()
+	require.NoError(t, p.framer.WriteSettings(settings...))
+	return p.flush()
+}
+
+// grpcMessages encodes length-prefixed gRPC messages.
+func grpcMessages(msgs ...string) []byte {
+	var b []byte
+	for _, msg := range msgs {
+		b = append(b, 0)
+		b = binary.BigEndian.AppendUint32(b, uint32(len(msg)))
+		b = append(b, msg...)
+	}
+	return b
+}
+
+// h2Conn feeds the packets of a single connection to the plugin.
+type h2Conn struct {
+	http    *httpPlugin
+	tuple   *common.TCPTuple
+	private protos.ProtocolData
+}
+
+func newH2Conn(http *httpPlugin) *h2Conn {
+	return &h2Conn{http: http, tuple: testCreateTCPTuple()}
+}
+
+func (c *h2Conn) send(dir uint8, payloads ...[]byte) {
+	for _, payload := range payloads {
+		pkt := protos.Packet{Tuple: *c.tuple.IPPort(), Payload: payload}
+		c.private = c.http.Parse(&pkt, c.tuple, dir, c.private)
+	}
+}
+
+func (c *h2Conn) client(payloads ...[]byte) { c.send(tcp.TCPDirectionOriginal, payloads...) }
+func (c *h2Conn) server(payloads ...[]byte) { c.send(tcp.TCPDirectionReverse, payloads...) }
+
+func (c *h2Conn) start(t *testing.T, client, server *h2Peer) {
+	c.client(append([]byte(http2.ClientPreface), client.settings(t)...))
+	c.server(server.settings(t), server.flushSettingsAck(t))
+	c.client(client.flushSettingsAck(t))
+}
+
+func (p *h2Peer) flushSettingsAck(t *testing.T) []byte {
+	t.Helper()
+	require.NoError(t, p.framer.WriteSettingsAck())
+	return p.flush()
+}
+
+func getValue(t *testing.T, m mapstr.M, key string) interface{} {
+	t.Helper()
+	v, err := m.GetValue(key)
+	if err != nil {
+		t.Fatalf("missing %s in %v", key, m)
+	}
+	return v
+}
+
+func TestIsHTTP2(t *testing.T) {
+	settings := newH2Peer().settings(t, http2.Setting{ID: http2.SettingMaxConcurrentStreams, Val: 100})
+	for _, test := range []struct {
+		name string
+		data []byte
+		want bool
+	}{
+		{"preface", []byte(http2.ClientPreface), true},
+		{"server settings", settings, true},
+		{"empty server settings", newH2Peer().settings(t), true},
+		{"http/1.1 request", []byte("GET / HTTP/1.1\r\n\r\n"), false},
+		{"http/1.1 response", []byte("HTTP/1.1 200 OK\r\n\r\n"), false},
+		{"partial preface", []byte("PRI * HTTP/2.0"), false},
+		{"data frame", newH2Peer().data(t, 1, true, []byte("x")), false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, isHTTP2(test.data))
+		})
+	}
+	assert.True(t, isPartialHTTP2Preface([]byte("PRI * HTTP/2.0\r\n")))
+	assert.False(t, isPartialHTTP2Preface([]byte("POST / HTTP/1.1\r\n")))
+}
+
+func TestHTTP2_RequestResponse(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	c.client(client.headers(t, 1, true,
+		":method", "GET", ":scheme", "http", ":authority", "example.com:8080",
+		":path", "/index.html?q=1", "user-agent", "curl/8.5.0"))
+	c.server(
+		server.headers(t, 1, false, ":status", "404", "content-type", "text/html"),
+		server.data(t, 1, true, []byte("not found")),
+	)
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "http", trans["type"])
+	assert.Equal(t, common.ERROR_STATUS, trans["status"])
+	assert.Equal(t, common.NetString("GET"), trans["method"])
+	assert.Equal(t, "GET /index.html", trans["query"])
+	assert.Equal(t, "2.0", getValue(t, trans, "http.version"))
+	assert.EqualValues(t, 404, getValue(t, trans, "http.response.status_code"))
+	assert.EqualValues(t, 9, getValue(t, trans, "http.response.body.bytes"))
+	assert.Equal(t, "/index.html", getValue(t, trans, "url.path"))
+	assert.Equal(t, "q=1", getValue(t, trans, "url.query"))
+	assert.Equal(t, "example.com", getValue(t, trans, "url.domain"))
+	assert.Equal(t, "curl/8.5.0", getValue(t, trans, "user_agent.original"))
+	assert.NotZero(t, getValue(t, trans, "source.bytes"))
+	assert.NotZero(t, getValue(t, trans, "destination.bytes"))
+}
+
+func TestHTTP2_Multiplexing(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	// Both requests use the same header fields, so the second one is
+	// encoded from the dynamic table.
+	c.client(
+		client.headers(t, 1, true, ":method", "GET", ":scheme", "http",
+			":authority", "example.com", ":path", "/slow", "x-request-id", "a"),
+		client.headers(t, 3, false, ":method", "POST", ":scheme", "http",
+			":authority", "example.com", ":path", "/fast", "x-request-id", "b"),
+		client.data(t, 3, true, []byte("payload")),
+	)
+	c.server(
+		server.headers(t, 3, false, ":status", "201"),
+		server.headers(t, 1, false, ":status", "200"),
+		server.data(t, 1, false, []byte("slow ")),
+		server.data(t, 3, true, []byte("ok")),
+		server.data(t, 1, true, []byte("response")),
+	)
+
+	require.Len(t, store.events, 2)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "/fast", getValue(t, trans, "url.path"))
+	assert.EqualValues(t, 201, getValue(t, trans, "http.response.status_code"))
+	assert.EqualValues(t, 7, getValue(t, trans, "http.request.body.bytes"))
+	assert.EqualValues(t, 2, getValue(t, trans, "http.response.body.bytes"))
+
+	trans = expectTransaction(t, &store)
+	assert.Equal(t, "/slow", getValue(t, trans, "url.path"))
+	assert.EqualValues(t, 200, getValue(t, trans, "http.response.status_code"))
+	assert.EqualValues(t, 13, getValue(t, trans, "http.response.body.bytes"))
+}
+
+func TestHTTP2_ServerSettingsFirst(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+
+	c.server(server.settings(t, http2.Setting{ID: http2.SettingMaxConcurrentStreams, Val: 250}))
+	c.client(append([]byte(http2.ClientPreface), client.settings(t)...))
+	c.client(client.headers(t, 1, true, ":method", "GET", ":scheme", "http", ":authority", "a", ":path", "/"))
+	c.server(server.headers(t, 1, true, ":status", "204"))
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, common.OK_STATUS, trans["status"])
+	assert.EqualValues(t, 204, getValue(t, trans, "http.response.status_code"))
+}
+
+func TestHTTP2_SplitSegments(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+
+	clientData := append([]byte(http2.ClientPreface), client.settings(t)...)
+	clientData = append(clientData, client.headers(t, 1, true,
+		":method", "GET", ":scheme", "http", ":authority", "example.com", ":path", "/split")...)
+	serverData := append(server.settings(t), server.headers(t, 1, false, ":status", "200")...)
+	serverData = append(serverData, server.data(t, 1, true, []byte("hello"))...)
+
+	for data := range slices.Chunk(clientData, 7) {
+		c.client(data)
+	}
+	for data := range slices.Chunk(serverData, 7) {
+		c.server(data)
+	}
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "/split", getValue(t, trans, "url.path"))
+	assert.EqualValues(t, 5, getValue(t, trans, "http.response.body.bytes"))
+}
+
+func TestHTTP2_ContinuationAndPadding(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	block := client.block(t, ":method", "GET", ":scheme", "http", ":authority", "example.com",
+		":path", "/continued", "user-agent", "test")
+	require.NoError(t, client.framer.WriteHeaders(http2.HeadersFrameParam{
+		StreamID:      1,
+		BlockFragment: block[:5],
+		EndStream:     true,
+		PadLength:     4,
+		Priority:      http2.PriorityParam{StreamDep: 0, Weight: 15},
+	}))
+	require.NoError(t, client.framer.WriteContinuation(1, false, block[5:10]))
+	require.NoError(t, client.framer.WriteContinuation(1, true, block[10:]))
+	c.client(client.flush())
+
+	require.NoError(t, server.framer.WriteHeaders(http2.HeadersFrameParam{
+		StreamID:      1,
+		BlockFragment: server.block(t, ":status", "200"),
+		EndHeaders:    true,
+	}))
+	require.NoError(t, server.framer.WriteDataPadded(1, true, []byte("body"), []byte{0, 0, 0}))
+	c.server(server.flush())
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "/continued", getValue(t, trans, "url.path"))
+	assert.Equal(t, "test", getValue(t, trans, "user_agent.original"))
+	assert.EqualValues(t, 4, getValue(t, trans, "http.response.body.bytes"))
+}
+
+func TestHTTP2_HeaderTableSize(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+
+	// The client allows a larger dynamic table, which the server's
+	// encoder announces with a table size update.
+	c.client(append([]byte(http2.ClientPreface),
+		client.settings(t, http2.Setting{ID: http2.SettingHeaderTableSize, Val: 65536})...))
+	c.server(server.settings(t))
+	server.enc.SetMaxDynamicTableSizeLimit(65536)
+	server.enc.SetMaxDynamicTableSize(65536)
+
+	c.client(client.headers(t, 1, true, ":method", "GET", ":scheme", "http", ":authority", "a", ":path", "/"))
+	c.server(server.headers(t, 1, true, ":status", "200", "x-large", string(bytes.Repeat([]byte("x"), 8000))))
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, common.OK_STATUS, trans["status"])
+}
+
+func TestHTTP2_StreamReset(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	c.client(client.headers(t, 1, false, ":method", "POST", ":scheme", "http", ":authority", "a", ":path", "/upload"))
+	require.NoError(t, client.framer.WriteRSTStream(1, http2.ErrCodeCancel))
+	c.client(client.flush())
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, common.ERROR_STATUS, trans["status"])
+	assert.Contains(t, getValue(t, trans, "error.message"), "Stream reset: CANCEL")
+}
+
+func TestHTTP2_SendHeadersAndRawMessages(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	http.sendRequest = true
+	http.sendResponse = true
+	http.parserConfig.sendHeaders = true
+	http.parserConfig.sendAllHeaders = true
+	http.redactAuthorization = true
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	c.client(client.headers(t, 1, true, ":method", "GET", ":scheme", "http", ":authority", "a",
+		":path", "/", "authorization", "Bearer secret", "accept", "*/*"))
+	c.server(server.headers(t, 1, true, ":status", "200", "server", "envoy"))
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "GET / HTTP/2\r\nauthorization:**************\r\naccept: */*\r\n\r\n", trans["request"])
+	assert.Equal(t, "HTTP/2 200\r\nserver: envoy\r\n\r\n", trans["response"])
+	assert.Equal(t, common.NetString("*/*"), getValue(t, trans, "http.request.headers.accept"))
+	assert.Equal(t, common.NetString("*"), getValue(t, trans, "http.request.headers.authorization"))
+	assert.Equal(t, common.NetString("envoy"), getValue(t, trans, "http.response.headers.server"))
+}
+
+func TestHTTP2_GapInData(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	c.client(client.headers(t, 1, true, ":method", "GET", ":scheme", "http", ":authority", "a", ":path", "/big"))
+	data := append(server.headers(t, 1, false, ":status", "200"),
+		server.data(t, 1, true, bytes.Repeat([]byte("x"), 1000))...)
+	c.server(data[:len(data)-600])
+	private, drop := http.GapInStream(c.tuple, tcp.TCPDirectionReverse, 500, c.private)
+	assert.False(t, drop)
+	c.private = private
+	c.server(data[len(data)-100:])
+
+	// The connection is still usable after the gap.
+	c.client(client.headers(t, 3, true, ":method", "GET", ":scheme", "http", ":authority", "a", ":path", "/next"))
+	c.server(server.headers(t, 3, true, ":status", "200"))
+
+	require.Len(t, store.events, 2)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "/big", getValue(t, trans, "url.path"))
+	assert.EqualValues(t, 1000, getValue(t, trans, "http.response.body.bytes"))
+	assert.Equal(t, "Packet loss while capturing the response", getValue(t, trans, "error.message"))
+	trans = expectTransaction(t, &store)
+	assert.Equal(t, "/next", getValue(t, trans, "url.path"))
+}
+
+func TestHTTP2_Expired(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	c.client(
+		client.headers(t, 3, true, ":method", "GET", ":scheme", "http", ":authority", "a", ":path", "/b"),
+		client.headers(t, 1, true, ":method", "GET", ":scheme", "http", ":authority", "a", ":path", "/a"),
+	)
+	http.Expired(c.tuple, c.private)
+
+	require.Len(t, store.events, 2)
+	for _, path := range []string{"/a", "/b"} {
+		trans := expectTransaction(t, &store)
+		assert.Equal(t, path, getValue(t, trans, "url.path"))
+		assert.Equal(t, common.ERROR_STATUS, trans["status"])
+		assert.Equal(t, "Unmatched request", getValue(t, trans, "error.message"))
+	}
+}
+
+func TestHTTP2_ContinuationFlood(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	http.maxMessageSize = 1000
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	block := client.block(t, ":method", "GET", ":scheme", "http", ":authority", "a", ":path", "/")
+	require.NoError(t, client.framer.WriteHeaders(http2.HeadersFrameParam{
+		StreamID:      1,
+		BlockFragment: block,
+		EndStream:     true,
+	}))
+	for i := 0; i < 10; i++ {
+		require.NoError(t, client.framer.WriteContinuation(1, false, bytes.Repeat([]byte{0}, 200)))
+	}
+	c.client(client.flush())
+
+	d := c.private.(*httpConnectionData).h2.dirs[tcp.TCPDirectionOriginal]
+	assert.True(t, d.failed)
+	assert.Empty(t, d.block)
+}
+
+func TestHTTP2_StreamTimeout(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	http.transactionTimeout = time.Millisecond
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	c.client(client.headers(t, 1, true, ":method", "GET", ":scheme", "http", ":authority", "a", ":path", "/abandoned"))
+	time.Sleep(10 * time.Millisecond)
+	c.client(client.headers(t, 3, true, ":method", "GET", ":scheme", "http", ":authority", "a", ":path", "/next"))
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, "/abandoned", getValue(t, trans, "url.path"))
+	assert.Equal(t, "Unmatched request", getValue(t, trans, "error.message"))
+	assert.Equal(t, 1, c.private.(*httpConnectionData).h2.streams.Size())
+}
+
+func TestHTTP2_MaxStreams(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	for id := uint32(1); id < 2*http2MaxStreams+10; id += 2 {
+		c.client(client.headers(t, id, true, ":method", "GET", ":scheme", "http", ":authority", "a", ":path", "/"))
+	}
+	assert.Equal(t, http2MaxStreams, c.private.(*httpConnectionData).h2.streams.Size())
+	assert.Empty(t, store.events)
+}
+
+func TestGRPC_UnaryCall(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	c.client(
+		client.headers(t, 1, false, ":method", "POST", ":scheme", "http", ":authority", "greeter:50051",
+			":path", "/helloworld.Greeter/SayHello", "content-type", "application/grpc", "te", "trailers"),
+		client.data(t, 1, true, grpcMessages("\n\x05world")),
+	)
+	c.server(
+		server.headers(t, 1, false, ":status", "200", "content-type", "application/grpc"),
+		server.data(t, 1, false, grpcMessages("\n\x0bHello world")),
+		server.headers(t, 1, true, "grpc-status", "0"),
+	)
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, common.OK_STATUS, trans["status"])
+	assert.Equal(t, common.NetString("POST"), trans["method"])
+	assert.Equal(t, "helloworld.Greeter", getValue(t, trans, "grpc.service"))
+	assert.Equal(t, "SayHello", getValue(t, trans, "grpc.method"))
+	assert.Equal(t, 0, getValue(t, trans, "grpc.status_code"))
+	assert.Equal(t, "OK", getValue(t, trans, "grpc.status"))
+	assert.Equal(t, 1, getValue(t, trans, "grpc.request.messages"))
+	assert.Equal(t, 1, getValue(t, trans, "grpc.response.messages"))
+}
+
+func TestGRPC_ErrorStatus(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	c.client(
+		client.headers(t, 1, false, ":method", "POST", ":scheme", "http", ":authority", "users",
+			":path", "/users.v1.Users/Get", "content-type", "application/grpc+proto"),
+		client.data(t, 1, true, grpcMessages("\x08\x2a")),
+	)
+	// trailers-only response
+	c.server(server.headers(t, 1, true, ":status", "200", "content-type", "application/grpc",
+		"grpc-status", "5", "grpc-message", "user%2042%20not%20found"))
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, common.ERROR_STATUS, trans["status"])
+	assert.EqualValues(t, 200, getValue(t, trans, "http.response.status_code"))
+	assert.Equal(t, "users.v1.Users", getValue(t, trans, "grpc.service"))
+	assert.Equal(t, "Get", getValue(t, trans, "grpc.method"))
+	assert.Equal(t, 5, getValue(t, trans, "grpc.status_code"))
+	assert.Equal(t, "NOT_FOUND", getValue(t, trans, "grpc.status"))
+	assert.Equal(t, "user 42 not found", getValue(t, trans, "grpc.message"))
+	assert.Equal(t, 0, getValue(t, trans, "grpc.response.messages"))
+}
+
+func TestGRPC_Streaming(t *testing.T) {
+	var store eventStore
+	http := httpModForTests(&store)
+	client, server := newH2Peer(), newH2Peer()
+	c := newH2Conn(http)
+	c.start(t, client, server)
+
+	// Messages are split across DATA frames, including their prefixes.
+	requ := grpcMessages("one", "two", "three")
+	c.client(
+		client.headers(t, 1, false, ":method", "POST", ":scheme", "http", ":authority", "a",
+			":path", "/chat.Chat/Stream", "content-type", "application/grpc"),
+		client.data(t, 1, false, requ[:2]),
+		client.data(t, 1, false, requ[2:10]),
+		client.data(t, 1, true, requ[10:]),
+	)
+	c.server(
+		server.headers(t, 1, false, ":status", "200", "content-type", "application/grpc"),
+		server.data(t, 1, false, grpcMessages("a", "")),
+		server.data(t, 1, false, grpcMessages("b")),
+		server.headers(t, 1, true, "grpc-status", "0"),
+	)
+
+	require.Len(t, store.events, 1)
+	trans := expectTransaction(t, &store)
+	assert.Equal(t, 3, getValue(t, trans, "grpc.request.messages"))
+	assert.Equal(t, 3, getValue(t, trans, "grpc.response.messages"))
+}
+
+func TestGRPCFraming(t *testing.T) {
+	msgs := grpcMessages("first", "", "third message")
+	for size := 1; size <= len(msgs); size++ {
+		var f grpcFraming
+		for p := msgs; len(p) > 0; {
+			n := min(size, len(p))
+			f.feed(p[:n], false)
+			p = p[n:]
+		}
+		assert.Equal(t, 3, f.messages, "chunk size %d", size)
+		assert.Zero(t, f.remaining)
+		assert.Zero(t, f.buffered)
+	}
+
+	var f grpcFraming
+	f.feed(msgs[:3], true)
+	f.feed(msgs[3:], false)
+	assert.True(t, f.incomplete)
+}
+
+func TestGRPCStatusName(t *testing.T) {
+	assert.Equal(t, "OK", grpcStatusName(0))
+	assert.Equal(t, "UNAVAILABLE", grpcStatusName(14))
+	assert.Equal(t, "UNAUTHENTICATED", grpcStatusName(16))
+	assert.Equal(t, "Unknown(42)", grpcStatusName(42))
+}
@@ -1876,6 +1876,14 @@ func TestHttpParser_Extension(t *testing.T) {
 				"url.extension": nil,
 			},
 		},
+		{
+			title: "Period In Directory",
+			path:  "/helloworld.Greeter/SayHello",
+			expected: mapstr.M{
+				"url.full":      "http://abc.com/helloworld.Greeter/SayHello",
+				"url.extension": nil,
+			},
+		},
 	} {
 		t.Run(test.title, func(t *testing.T) {
 			request := fmt.Sprintf(template, test.path)
@@ -1915,6 +1923,8 @@ func TestExtractHostHeader(t *testing.T) {
 		{header: "localhost:9001", wantHost: "localhost", wantPort: 9001},
 		{header: "localhost:9000000", wantHost: "localhost:9000000", wantPort: 0},
 		{header: "127.0.0.1:9001", wantHost: "127.0.0.1", wantPort: 9001},
+		{header: "127.0.0.1:50051", wantHost: "127.0.0.1", wantPort: 50051},
+		{header: "localhost:65536", wantHost: "localhost:65536", wantPort: 0},
 		{header: "127.0.0.1", wantHost: "127.0.0.1", wantPort: 0},
 		{header: "[::]", wantHost: "::", wantPort: 0},
 		{header: ":0", wantHost: ":0", wantPort: 0},
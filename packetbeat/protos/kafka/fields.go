@@ -0,0 +1,36 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Code generated by beats/dev-tools/cmd/asset/asset.go - DO NOT EDIT.
+
+package kafka
+
+import (
+	"github.com/elastic/beats/v7/libbeat/asset"
+)
+
+func init() {
+	if err := asset.SetFields("packetbeat", "kafka", asset.ModuleFieldsPri, AssetKafka); err != nil {
+		panic(err)
+	}
+}
+
+// AssetKafka returns asset data.
+// This is the base64 encoded zlib format compressed contents of protos/kafka.
+func AssetKafka() string {
+	return "eJyslk9v4jAQxe98ilHPBe2Zw0rVokpRtYAqVntMjD0hFoknHU/Kpp9+5fyBwKYtWqpcwInf/N742ckU9ljPYa/SvZoAiJUc53D3FP7fTQAMes22FEtuDt8nAADNvakvUdvUasBXdAKpxdz4GWwyhKRAycgk7SBocqKs8yAZglMFAqXh90kMHtbRPaTEgH9UUeYIyZrJVBoTIIbkEUVnyT0oZyBh9FRxuBX0hEqrfSMV/jK+VOgFGFNkD0KzCXRs8+ahaUNwMhwuqUucw46pKruR4YzhLFXaeI/1cbyfm5PbDQZHmtZfoT+uKpCtBmvQiU0tcteRUzdmk9Har8jekruxfqfyT1GoPBrY1sNOjoBoYsZchUjE1tzIEi3OqurchjQJHavgkKZJgBUPjL4k53EMr5EYI9tjfSA218N1NNECfKCy7owlQ2WQRwgGmbypfMic71epFX0v5OMMsTVfgBEtroJoN3CIUZevYWnow15bt+tltjVEC2AlGTJIplzIXjA94qdULFYuVP8ncK4qtt2GCxQD4Yv1ncEjMfxEUUZJf1S0V/eEvwc5UxxotT3dojmpvptYpfdf50rpvaNDjmZXoJN2rcr2MOUmOZbRz6A7X0dtwcFK1lCBx2YzfoNMvSI4+sgGoyY2fqapcvJlfjrV4w5UPXqPG94QjFKxa5t9vlTNq+Ma6m0t+OkqpMSFkjlcPvyJG2/fMKA10/q91BaGrRKd4Qf+ztQuvX7uTzImkRxjsQXGhb9xYYJKsFLYPLceNTnTnwdtvuGg/LFoc64reKlI1AgbMhPHmgzeSJVa9gKO3PQNmVpdCLqQUuWu2YPNlNvPysHnzYDi4tvm1/Jpufq9jDerdfQjXj3H64fnTbSJVstkNvk7AMXBzG4="
+}
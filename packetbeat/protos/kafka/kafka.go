@@ -0,0 +1,411 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	conf "github.com/elastic/elastic-agent-libs/config"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+	"github.com/elastic/elastic-agent-libs/monitoring"
+
+	"github.com/elastic/beats/v7/packetbeat/pb"
+	"github.com/elastic/beats/v7/packetbeat/procs"
+	"github.com/elastic/beats/v7/packetbeat/protos"
+	"github.com/elastic/beats/v7/packetbeat/protos/tcp"
+)
+
+var debugf = logp.MakeDebug("kafka")
+
+type kafkaPlugin struct {
+	// config
+	ports []int
+
+	requests           *common.Cache
+	responses          *common.Cache
+	transactionTimeout time.Duration
+
+	results protos.Reporter
+	watcher *procs.ProcessesWatcher
+}
+
+type transactionKey struct {
+	tcp common.HashableTCPTuple
+	id  int32
+}
+
+var unmatchedRequests = monitoring.NewInt(nil, "kafka.unmatched_requests")
+
+func init() {
+	protos.Register("kafka", New)
+}
+
+func New(
+	testMode bool,
+	results protos.Reporter,
+	watcher *procs.ProcessesWatcher,
+	cfg *conf.C,
+) (protos.Plugin, error) {
+	p := &kafkaPlugin{}
+	config := defaultConfig
+	if !testMode {
+		if err := cfg.Unpack(&config); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := p.init(results, watcher, &config); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (kafka *kafkaPlugin) init(results protos.Reporter, watcher *procs.ProcessesWatcher, config *kafkaConfig) error {
+	debugf("Init a Kafka protocol parser")
+	kafka.setFromConfig(config)
+
+	kafka.requests = common.NewCache(
+		kafka.transactionTimeout,
+		protos.DefaultTransactionHashSize)
+	kafka.requests.StartJanitor(kafka.transactionTimeout)
+	kafka.responses = common.NewCache(
+		kafka.transactionTimeout,
+		protos.DefaultTransactionHashSize)
+	kafka.responses.StartJanitor(kafka.transactionTimeout)
+	kafka.results = results
+	kafka.watcher = watcher
+
+	return nil
+}
+
+func (kafka *kafkaPlugin) setFromConfig(config *kafkaConfig) {
+	kafka.ports = config.Ports
+	kafka.transactionTimeout = config.TransactionTimeout
+}
+
+func (kafka *kafkaPlugin) Close() {
+	kafka.requests.StopJanitor()
+	kafka.responses.StopJanitor()
+}
+
+func (kafka *kafkaPlugin) GetPorts() []int {
+	return kafka.ports
+}
+
+func (kafka *kafkaPlugin) ConnectionTimeout() time.Duration {
+	return kafka.transactionTimeout
+}
+
+func (kafka *kafkaPlugin) Parse(
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+	private protos.ProtocolData,
+) protos.ProtocolData {
+	debugf("Parse method triggered")
+
+	conn := ensureKafkaConnection(private)
+	conn = kafka.doParse(conn, pkt, tcptuple, dir)
+	if conn == nil {
+		return nil
+	}
+	return conn
+}
+
+func ensureKafkaConnection(private protos.ProtocolData) *kafkaConnectionData {
+	if private == nil {
+		return &kafkaConnectionData{}
+	}
+
+	priv, ok := private.(*kafkaConnectionData)
+	if !ok {
+		logp.Warn("kafka connection data type error, create new one")
+		return &kafkaConnectionData{}
+	}
+	if priv == nil {
+		debugf("Unexpected: kafka connection data not set, create new one")
+		return &kafkaConnectionData{}
+	}
+
+	return priv
+}
+
+func (kafka *kafkaPlugin) doParse(
+	conn *kafkaConnectionData,
+	pkt *protos.Packet,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) *kafkaConnectionData {
+	st := conn.streams[dir]
+	if st == nil {
+		st = &stream{tcptuple: tcptuple, data: pkt.Payload, ts: pkt.Ts}
+		conn.streams[dir] = st
+		debugf("new stream: %p (dir=%v, len=%v)", st, dir, len(pkt.Payload))
+	} else {
+		if len(st.data) == 0 {
+			st.ts = pkt.Ts
+		}
+		// concatenate bytes
+		st.data = append(st.data, pkt.Payload...)
+		if len(st.data) > tcp.TCPMaxDataInStream {
+			debugf("Stream data too large, dropping TCP stream")
+			conn.streams[dir] = nil
+			return conn
+		}
+	}
+
+	// Requests flow towards the broker port, there is nothing in the
+	// message itself telling requests and responses apart.
+	isRequest := kafka.isServerPort(pkt.Tuple.DstPort)
+
+	for len(st.data) > 0 {
+		if st.skip > 0 {
+			n := min(st.skip, len(st.data))
+			st.data = st.data[n:]
+			st.skip -= n
+			continue
+		}
+
+		msg, ok := kafkaMessageParser(st, isRequest)
+		if !ok {
+			// drop this tcp stream. Will retry parsing with the next
+			// segment in it
+			conn.streams[dir] = nil
+			debugf("Ignore Kafka message. Drop tcp stream. Try parsing with the next segment")
+			return conn
+		}
+
+		if msg == nil {
+			// wait for more data
+			debugf("Kafka wait for more data before parsing message")
+			break
+		}
+
+		// all ok, go to next level
+		debugf("Kafka message complete")
+		msg.ts = st.ts
+		st.ts = pkt.Ts
+		kafka.handleKafka(msg, tcptuple, dir)
+	}
+
+	return conn
+}
+
+func (kafka *kafkaPlugin) isServerPort(port uint16) bool {
+	return slices.Contains(kafka.ports, int(port))
+}
+
+func (kafka *kafkaPlugin) handleKafka(
+	m *kafkaMessage,
+	tcptuple *common.TCPTuple,
+	dir uint8,
+) {
+	m.tcpTuple = *tcptuple
+	m.direction = dir
+	m.cmdlineTuple = kafka.watcher.FindProcessesTupleTCP(tcptuple.IPPort())
+
+	if m.isRequest {
+		debugf("Kafka request message")
+		kafka.onRequest(m)
+	} else {
+		debugf("Kafka response message")
+		kafka.onResponse(m)
+	}
+}
+
+func (kafka *kafkaPlugin) onRequest(msg *kafkaMessage) {
+	msg.info = &apiInfo{}
+	if err := decodeBody(msg.apiKey, msg.apiVersion, msg.body, true, msg.info); err != nil {
+		debugf("Failed to decode %s v%d request: %v", apiName(msg.apiKey), msg.apiVersion, err)
+	}
+	msg.body = nil
+
+	// publish request only transaction, the broker does not reply to
+	// produce requests with acks=0
+	if msg.apiKey == apiKeyProduce && msg.info.hasAcks && msg.info.acks == 0 {
+		kafka.onTransComplete(msg, nil)
+		return
+	}
+
+	key := transactionKey{tcp: msg.tcpTuple.Hashable(), id: msg.correlationID}
+
+	// try to find matching response potentially inserted before
+	if v := kafka.responses.Delete(key); v != nil {
+		resp, ok := v.(*kafkaMessage)
+		if !ok {
+			debugf("Unexpected type in responses cache for key %+v", key)
+			return
+		}
+		kafka.onTransComplete(msg, resp)
+		return
+	}
+
+	// insert into cache for correlation
+	old := kafka.requests.Put(key, msg)
+	if old != nil {
+		debugf("Two requests without a Response. Dropping old request")
+		unmatchedRequests.Add(1)
+	}
+}
+
+func (kafka *kafkaPlugin) onResponse(msg *kafkaMessage) {
+	key := transactionKey{tcp: msg.tcpTuple.Hashable(), id: msg.correlationID}
+
+	// try to find matching request
+	if v := kafka.requests.Delete(key); v != nil {
+		requ, ok := v.(*kafkaMessage)
+		if !ok {
+			debugf("Unexpected type in requests cache for key %+v", key)
+			return
+		}
+		kafka.onTransComplete(requ, msg)
+		return
+	}
+
+	// The response can only be decoded once the request is known, keep a
+	// copy of the body as the stream buffer will be reused.
+	msg.body = bytes.Clone(msg.body)
+	kafka.responses.Put(key, msg)
+}
+
+func (kafka *kafkaPlugin) onTransComplete(requ, resp *kafkaMessage) {
+	if resp != nil {
+		if err := decodeBody(requ.apiKey, requ.apiVersion, resp.body, false, requ.info); err != nil {
+			debugf("Failed to decode %s v%d response: %v", apiName(requ.apiKey), requ.apiVersion, err)
+		}
+		resp.body = nil
+	}
+
+	trans := newTransaction(requ, resp)
+	debugf("Kafka transaction completed: %s v%d", apiName(trans.apiKey), trans.apiVersion)
+	kafka.publishTransaction(trans)
+}
+
+func newTransaction(requ, resp *kafkaMessage) *transaction {
+	trans := &transaction{
+		cmdline:       requ.cmdlineTuple,
+		ts:            requ.ts,
+		bytesIn:       requ.size,
+		apiKey:        requ.apiKey,
+		apiVersion:    requ.apiVersion,
+		correlationID: requ.correlationID,
+		clientID:      requ.clientID,
+		info:          requ.info,
+	}
+	trans.src, trans.dst = common.MakeEndpointPair(requ.tcpTuple.BaseTuple, requ.cmdlineTuple)
+	if requ.direction == tcp.TCPDirectionReverse {
+		trans.src, trans.dst = trans.dst, trans.src
+	}
+
+	if resp != nil {
+		trans.endTime = resp.ts
+		trans.bytesOut = resp.size
+	}
+
+	return trans
+}
+
+func (kafka *kafkaPlugin) GapInStream(tcptuple *common.TCPTuple, dir uint8,
+	nbytes int, private protos.ProtocolData) (priv protos.ProtocolData, drop bool) {
+	// A gap in the body of a message being skipped does not affect framing
+	if conn, ok := private.(*kafkaConnectionData); ok && conn != nil {
+		if st := conn.streams[dir]; st != nil && len(st.data) == 0 && st.skip >= nbytes {
+			st.skip -= nbytes
+			return private, false
+		}
+	}
+	return private, true
+}
+
+func (kafka *kafkaPlugin) ReceivedFin(tcptuple *common.TCPTuple, dir uint8,
+	private protos.ProtocolData) protos.ProtocolData {
+	return private
+}
+
+func (kafka *kafkaPlugin) publishTransaction(t *transaction) {
+	if kafka.results == nil {
+		debugf("Try to publish transaction with null results")
+		return
+	}
+
+	evt, pbf := pb.NewBeatEvent(t.ts)
+	pbf.SetSource(&t.src)
+	pbf.AddIP(t.src.IP)
+	pbf.SetDestination(&t.dst)
+	pbf.AddIP(t.dst.IP)
+	pbf.Source.Bytes = int64(t.bytesIn)
+	pbf.Destination.Bytes = int64(t.bytesOut)
+	pbf.Event.Dataset = "kafka"
+	pbf.Event.Start = t.ts
+	pbf.Event.End = t.endTime
+	pbf.Network.Transport = "tcp"
+	pbf.Network.Protocol = pbf.Event.Dataset
+
+	fields := evt.Fields
+	fields["type"] = pbf.Event.Dataset
+	fields["method"] = apiName(t.apiKey)
+
+	kafkaFields := mapstr.M{
+		"api_key":        t.apiKey,
+		"api_version":    t.apiVersion,
+		"correlation_id": t.correlationID,
+	}
+	if t.clientID != "" {
+		kafkaFields["client_id"] = t.clientID
+	}
+
+	info := t.info
+	if len(info.topics) > 0 {
+		kafkaFields["topics"] = info.topics
+		fields["resource"] = strings.Join(info.topics, ",")
+	}
+	if len(info.topicIDs) > 0 {
+		kafkaFields["topic_ids"] = info.topicIDs
+	}
+	if info.partitions > 0 {
+		kafkaFields["partitions"] = info.partitions
+	}
+	if info.hasAcks {
+		kafkaFields["acks"] = info.acks
+	}
+	if info.hasRecords {
+		kafkaFields["records"] = mapstr.M{
+			"count": info.records,
+			"bytes": info.recordsBytes,
+		}
+	}
+	if info.hasThrottle {
+		kafkaFields["throttle_time_ms"] = info.throttleTimeMs
+	}
+
+	if info.errorCode == 0 {
+		fields["status"] = common.OK_STATUS
+	} else {
+		fields["status"] = common.ERROR_STATUS
+		kafkaFields["error_code"] = info.errorCode
+		kafkaFields["error"] = errorName(info.errorCode)
+	}
+	fields["kafka"] = kafkaFields
+
+	kafka.results(evt)
+}
@@ -0,0 +1,346 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// apiCodec decodes the bodies of an API. Only the versions up to maxVersion
+// are decoded, later versions are reported with their header only.
+type apiCodec struct {
+	maxVersion int16
+
+	// flexibleVersion is the first version using the compact encodings
+	flexibleVersion int16
+
+	request  func(d *decoder, version int16, info *apiInfo)
+	response func(d *decoder, version int16, info *apiInfo)
+}
+
+var apiCodecs = map[int16]apiCodec{
+	apiKeyProduce:     {maxVersion: 13, flexibleVersion: 9, request: decodeProduceRequest, response: decodeProduceResponse},
+	apiKeyFetch:       {maxVersion: 17, flexibleVersion: 12, request: decodeFetchRequest, response: decodeFetchResponse},
+	apiKeyMetadata:    {maxVersion: 13, flexibleVersion: 9, request: decodeMetadataRequest, response: decodeMetadataResponse},
+	apiKeyApiVersions: {maxVersion: 4, flexibleVersion: 3, response: decodeApiVersionsResponse},
+}
+
+// decodeBody adds the details of a request or response body to info.
+func decodeBody(apiKey, version int16, body []byte, isRequest bool, info *apiInfo) error {
+	codec, ok := apiCodecs[apiKey]
+	if !ok || version > codec.maxVersion {
+		return nil
+	}
+	decode := codec.response
+	if isRequest {
+		decode = codec.request
+	}
+	if decode == nil {
+		return nil
+	}
+
+	d := &decoder{buf: body, flexible: version >= codec.flexibleVersion}
+	// Flexible versions end the header with tagged fields, except for
+	// ApiVersions responses which always use the v0 response header.
+	if isRequest || apiKey != apiKeyApiVersions {
+		d.taggedFields()
+	}
+	decode(d, version, info)
+	return d.err
+}
+
+func decodeProduceRequest(d *decoder, version int16, info *apiInfo) {
+	if version >= 3 {
+		d.nullableString() // transactional_id
+	}
+	info.acks = d.int16()
+	info.hasAcks = true
+	d.int32() // timeout_ms
+	for i, n := 0, d.arrayLen(); i < n && d.err == nil; i++ {
+		readTopic(d, version >= 13, info)
+		for j, np := 0, d.arrayLen(); j < np && d.err == nil; j++ {
+			d.int32() // partition index
+			info.addRecords(d.bytes())
+			info.partitions++
+			d.taggedFields()
+		}
+		d.taggedFields()
+	}
+}
+
+func decodeProduceResponse(d *decoder, version int16, info *apiInfo) {
+	for i, n := 0, d.arrayLen(); i < n && d.err == nil; i++ {
+		skipTopic(d, version >= 13)
+		for j, np := 0, d.arrayLen(); j < np && d.err == nil; j++ {
+			d.int32() // partition index
+			info.setError(d.int16())
+			d.int64() // base_offset
+			if version >= 2 {
+				d.int64() // log_append_time_ms
+			}
+			if version >= 5 {
+				d.int64() // log_start_offset
+			}
+			if version >= 8 {
+				for k, ne := 0, d.arrayLen(); k < ne && d.err == nil; k++ {
+					d.int32()          // batch_index
+					d.nullableString() // batch_index_error_message
+					d.taggedFields()
+				}
+				d.nullableString() // error_message
+			}
+			d.taggedFields()
+		}
+		d.taggedFields()
+	}
+	if version >= 1 {
+		info.setThrottle(d.int32())
+	}
+}
+
+func decodeFetchRequest(d *decoder, version int16, info *apiInfo) {
+	if version <= 14 {
+		d.int32() // replica_id
+	}
+	d.int32() // max_wait_ms
+	d.int32() // min_bytes
+	if version >= 3 {
+		d.int32() // max_bytes
+	}
+	if version >= 4 {
+		d.int8() // isolation_level
+	}
+	if version >= 7 {
+		d.int32() // session_id
+		d.int32() // session_epoch
+	}
+	for i, n := 0, d.arrayLen(); i < n && d.err == nil; i++ {
+		readTopic(d, version >= 13, info)
+		for j, np := 0, d.arrayLen(); j < np && d.err == nil; j++ {
+			d.int32() // partition
+			if version >= 9 {
+				d.int32() // current_leader_epoch
+			}
+			d.int64() // fetch_offset
+			if version >= 12 {
+				d.int32() // last_fetched_epoch
+			}
+			if version >= 5 {
+				d.int64() // log_start_offset
+			}
+			d.int32() // partition_max_bytes
+			info.partitions++
+			d.taggedFields()
+		}
+		d.taggedFields()
+	}
+	// forgotten_topics_data and rack_id are not reported
+}
+
+func decodeFetchResponse(d *decoder, version int16, info *apiInfo) {
+	if version >= 1 {
+		info.setThrottle(d.int32())
+	}
+	if version >= 7 {
+		info.setError(d.int16())
+		d.int32() // session_id
+	}
+	for i, n := 0, d.arrayLen(); i < n && d.err == nil; i++ {
+		skipTopic(d, version >= 13)
+		for j, np := 0, d.arrayLen(); j < np && d.err == nil; j++ {
+			d.int32() // partition_index
+			info.setError(d.int16())
+			d.int64() // high_watermark
+			if version >= 4 {
+				d.int64() // last_stable_offset
+			}
+			if version >= 5 {
+				d.int64() // log_start_offset
+			}
+			if version >= 4 {
+				for k, na := 0, d.arrayLen(); k < na && d.err == nil; k++ {
+					d.int64() // producer_id
+					d.int64() // first_offset
+					d.taggedFields()
+				}
+			}
+			if version >= 11 {
+				d.int32() // preferred_read_replica
+			}
+			info.addRecords(d.bytes())
+			d.taggedFields()
+		}
+		d.taggedFields()
+	}
+}
+
+func decodeMetadataRequest(d *decoder, version int16, info *apiInfo) {
+	// An empty (v0) or null (v1+) topics array requests all topics
+	for i, n := 0, d.arrayLen(); i < n && d.err == nil; i++ {
+		if version >= 10 {
+			d.uuid() // topic_id
+			info.addTopic(d.nullableString())
+		} else {
+			info.addTopic(d.string())
+		}
+		d.taggedFields()
+	}
+}
+
+func decodeMetadataResponse(d *decoder, version int16, info *apiInfo) {
+	if version >= 3 {
+		info.setThrottle(d.int32())
+	}
+	for i, n := 0, d.arrayLen(); i < n && d.err == nil; i++ {
+		d.int32()  // node_id
+		d.string() // host
+		d.int32()  // port
+		if version >= 1 {
+			d.nullableString() // rack
+		}
+		d.taggedFields()
+	}
+	if version >= 2 {
+		d.nullableString() // cluster_id
+	}
+	if version >= 1 {
+		d.int32() // controller_id
+	}
+	for i, n := 0, d.arrayLen(); i < n && d.err == nil; i++ {
+		info.setError(d.int16())
+		d.nullableString() // name
+		if version >= 10 {
+			d.uuid() // topic_id
+		}
+		if version >= 1 {
+			d.bool() // is_internal
+		}
+		for j, np := 0, d.arrayLen(); j < np && d.err == nil; j++ {
+			info.setError(d.int16())
+			d.int32() // partition_index
+			d.int32() // leader_id
+			if version >= 7 {
+				d.int32() // leader_epoch
+			}
+			skipInt32Array(d) // replica_nodes
+			skipInt32Array(d) // isr_nodes
+			if version >= 5 {
+				skipInt32Array(d) // offline_replicas
+			}
+			info.partitions++
+			d.taggedFields()
+		}
+		if version >= 8 {
+			d.int32() // topic_authorized_operations
+		}
+		d.taggedFields()
+	}
+	if version >= 8 && version <= 10 {
+		d.int32() // cluster_authorized_operations
+	}
+	if version >= 13 {
+		info.setError(d.int16())
+	}
+}
+
+func decodeApiVersionsResponse(d *decoder, _ int16, info *apiInfo) {
+	info.setError(d.int16())
+}
+
+func readTopic(d *decoder, useID bool, info *apiInfo) {
+	if useID {
+		info.addTopicID(d.uuid())
+	} else {
+		info.addTopic(d.string())
+	}
+}
+
+func skipTopic(d *decoder, useID bool) {
+	if useID {
+		d.uuid()
+	} else {
+		d.string()
+	}
+}
+
+func skipInt32Array(d *decoder) {
+	d.read(4 * d.arrayLen())
+}
+
+func (info *apiInfo) addTopic(name string) {
+	if name != "" && !slices.Contains(info.topics, name) {
+		info.topics = append(info.topics, name)
+	}
+}
+
+func (info *apiInfo) addTopicID(id string) {
+	if id != "" && !slices.Contains(info.topicIDs, id) {
+		info.topicIDs = append(info.topicIDs, id)
+	}
+}
+
+func (info *apiInfo) addRecords(records []byte) {
+	info.hasRecords = true
+	info.records += countRecords(records)
+	info.recordsBytes += len(records)
+}
+
+// setError keeps the first error code reported in a response.
+func (info *apiInfo) setError(code int16) {
+	if info.errorCode == 0 {
+		info.errorCode = code
+	}
+}
+
+func (info *apiInfo) setThrottle(ms int32) {
+	info.throttleTimeMs = ms
+	info.hasThrottle = true
+}
+
+// countRecords returns the number of records in a record set. Record batches
+// (magic 2) carry their record count, legacy message sets are counted per
+// message with compressed wrapper messages counting as one. A truncated
+// trailing batch, which brokers may return in fetch responses, is ignored.
+func countRecords(b []byte) int {
+	const (
+		// baseOffset (8) + batchLength/messageSize (4)
+		entryHeaderSize = 12
+		magicOffset     = 16
+		batchHeaderSize = 61
+		recordsOffset   = 57
+	)
+
+	count := 0
+	for len(b) > magicOffset {
+		size := entryHeaderSize + int(int32(binary.BigEndian.Uint32(b[8:12])))
+		if size <= magicOffset || size > len(b) {
+			break
+		}
+		if b[magicOffset] == 2 {
+			if size < batchHeaderSize {
+				break
+			}
+			count += int(int32(binary.BigEndian.Uint32(b[recordsOffset:])))
+		} else {
+			count++
+		}
+		b = b[size:]
+	}
+	return count
+}
@@ -0,0 +1,264 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kafka
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/elastic/beats/v7/packetbeat/protos/tcp"
+)
+
+const (
+	// lengthSize is the size of the length prefix of every message.
+	lengthSize = 4
+
+	// requestHeaderSize is the minimum size of a request header:
+	// api_key (2) + api_version (2) + correlation_id (4) + client_id length (2).
+	requestHeaderSize = 10
+
+	// responseHeaderSize is the minimum size of a response header: correlation_id (4).
+	responseHeaderSize = 4
+
+	// maxMessageSize bounds the accepted length prefix. Larger values are
+	// assumed not to be kafka and the stream is dropped.
+	maxMessageSize = 256 << 20
+)
+
+var (
+	errShortBuffer   = errors.New("kafka message too short")
+	errInvalidHeader = errors.New("invalid kafka request header")
+)
+
+// kafkaMessageParser reads the next message from the stream. It returns false
+// if the data can not be kafka and the stream must be dropped. The message is
+// nil if more data is required.
+//
+// Messages too large to be buffered are reported with their header only, and
+// the remainder of the message is skipped.
+func kafkaMessageParser(st *stream, isRequest bool) (*kafkaMessage, bool) {
+	if len(st.data) < lengthSize {
+		return nil, true
+	}
+
+	size := int(binary.BigEndian.Uint32(st.data))
+	minSize := responseHeaderSize
+	if isRequest {
+		minSize = requestHeaderSize
+	}
+	if size < minSize || size > maxMessageSize {
+		debugf("Invalid kafka message length: %d", size)
+		return nil, false
+	}
+
+	total := lengthSize + size
+	if len(st.data) >= total {
+		msg, err := parseHeader(st.data[lengthSize:total], isRequest)
+		if err != nil {
+			debugf("Failed to parse kafka message header: %v", err)
+			return nil, false
+		}
+		msg.size = total
+		st.data = st.data[total:]
+		return msg, true
+	}
+
+	if total <= tcp.TCPMaxDataInStream {
+		// wait for more data
+		return nil, true
+	}
+
+	msg, err := parseHeader(st.data[lengthSize:], isRequest)
+	if errors.Is(err, errShortBuffer) {
+		return nil, true
+	}
+	if err != nil {
+		debugf("Failed to parse kafka message header: %v", err)
+		return nil, false
+	}
+	debugf("Kafka message of %d bytes exceeds the stream buffer, skipping its body", total)
+	msg.size = total
+	msg.body = nil
+	st.skip = total - len(st.data)
+	st.data = nil
+	return msg, true
+}
+
+// parseHeader decodes the request or response header of a message. The
+// tagged fields of flexible header versions are left in the body, as their
+// presence depends on the API version.
+func parseHeader(b []byte, isRequest bool) (*kafkaMessage, error) {
+	d := &decoder{buf: b}
+	m := &kafkaMessage{isRequest: isRequest}
+	if isRequest {
+		m.apiKey = d.int16()
+		m.apiVersion = d.int16()
+	}
+	m.correlationID = d.int32()
+	if isRequest {
+		// client_id is never a compact string, even in flexible versions
+		m.clientID = d.nullableString()
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	if isRequest && (m.apiKey < 0 || m.apiVersion < 0) {
+		return nil, errInvalidHeader
+	}
+	m.body = d.buf
+	return m, nil
+}
+
+// decoder reads the primitive types of the kafka protocol. The first error is
+// sticky: once set all subsequent reads return zero values.
+type decoder struct {
+	buf []byte
+	err error
+
+	// flexible enables the compact encodings and tagged fields introduced
+	// with KIP-482.
+	flexible bool
+}
+
+func (d *decoder) read(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(d.buf) {
+		d.err = errShortBuffer
+		d.buf = nil
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) int8() int8 {
+	b := d.read(1)
+	if b == nil {
+		return 0
+	}
+	return int8(b[0])
+}
+
+func (d *decoder) bool() bool {
+	return d.int8() != 0
+}
+
+func (d *decoder) int16() int16 {
+	b := d.read(2)
+	if b == nil {
+		return 0
+	}
+	return int16(binary.BigEndian.Uint16(b))
+}
+
+func (d *decoder) int32() int32 {
+	b := d.read(4)
+	if b == nil {
+		return 0
+	}
+	return int32(binary.BigEndian.Uint32(b))
+}
+
+func (d *decoder) int64() int64 {
+	b := d.read(8)
+	if b == nil {
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(b))
+}
+
+func (d *decoder) uvarint() int {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.buf)
+	if n <= 0 || v > math.MaxInt32 {
+		d.err = errShortBuffer
+		d.buf = nil
+		return 0
+	}
+	d.buf = d.buf[n:]
+	return int(v)
+}
+
+// length reads the length of a string, bytes or array field. Null values
+// have a negative length.
+func (d *decoder) length(size func() int) int {
+	if d.flexible {
+		return d.uvarint() - 1
+	}
+	return size()
+}
+
+func (d *decoder) string() string {
+	n := d.length(func() int { return int(d.int16()) })
+	if n < 0 {
+		return ""
+	}
+	return string(d.read(n))
+}
+
+// nullableString reads a string that may be null, which is returned as empty.
+func (d *decoder) nullableString() string {
+	return d.string()
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.length(func() int { return int(d.int32()) })
+	if n < 0 {
+		return nil
+	}
+	return d.read(n)
+}
+
+// arrayLen returns the number of elements of an array, 0 for null arrays.
+func (d *decoder) arrayLen() int {
+	n := d.length(func() int { return int(d.int32()) })
+	// every element takes at least one byte
+	if n > len(d.buf) {
+		d.err = errShortBuffer
+		d.buf = nil
+		return 0
+	}
+	return max(n, 0)
+}
+
+// uuid reads a topic ID, formatted like kafka does.
+func (d *decoder) uuid() string {
+	b := d.read(16)
+	if b == nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// taggedFields skips the tagged fields of flexible versions.
+func (d *decoder) taggedFields() {
+	if !d.flexible {
+		return
+	}
+	for i, n := 0, d.uvarint(); i < n && d.err == nil; i++ {
+		d.uvarint() // tag
+		d.read(d.uvarint())
+	}
+}
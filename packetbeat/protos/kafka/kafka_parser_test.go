@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+
+package kafka
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecoder(t *testing.T) {
+	e := &encoder{}
+	e.int16(-1).string("topic").int32(2).int64(-7)
+	d := &decoder{buf: e.buf}
+	assert.Equal(t, "", d.nullableString())
+	assert.Equal(t, "topic", d.string())
+	assert.Equal(t, 2, d.arrayLen())
+	assert.Equal(t, int64(-7), d.int64())
+	assert.NoError(t, d.err)
+	assert.Empty(t, d.buf)
+
+	// the first error is sticky
+	assert.Equal(t, int32(0), d.int32())
+	assert.ErrorIs(t, d.err, errShortBuffer)
+	assert.Equal(t, "", d.string())
+}
+
+func TestDecoderFlexible(t *testing.T) {
+	e := &encoder{flexible: true}
+	e.uvarint(0).string("topic").bytes([]byte{1, 2}).array(1)
+	// two tagged fields
+	e.uvarint(2).uvarint(0).uvarint(1).int8(9).uvarint(5).uvarint(0)
+	e.int8(42)
+
+	d := &decoder{buf: e.buf, flexible: true}
+	assert.Equal(t, "", d.nullableString())
+	assert.Equal(t, "topic", d.string())
+	assert.Equal(t, []byte{1, 2}, d.bytes())
+	assert.Equal(t, 1, d.arrayLen())
+	d.taggedFields()
+	assert.Equal(t, int8(42), d.int8())
+	assert.NoError(t, d.err)
+}
+
+func TestDecoderArrayLength(t *testing.T) {
+	// an array can't have more elements than there are bytes left
+	d := &decoder{buf: []byte{0x7f, 0xff, 0xff, 0xff, 0}}
+	assert.Equal(t, 0, d.arrayLen())
+	assert.ErrorIs(t, d.err, errShortBuffer)
+
+	d = &decoder{buf: []byte{0xff, 0xff, 0xff, 0xff}}
+	assert.Equal(t, 0, d.arrayLen())
+	assert.NoError(t, d.err)
+}
+
+func TestCountRecords(t *testing.T) {
+	// legacy message set entry: offset, message_size, crc, magic, ...
+	legacy := func(size int) []byte {
+		e := &encoder{}
+		e.int64(0).int32(int32(size)).int32(0).int8(1)
+		return append(e.buf, make([]byte, size-5)...)
+	}
+
+	tests := []struct {
+		name    string
+		records []byte
+		want    int
+	}{
+		{"empty", nil, 0},
+		{"batch", recordBatch(5, 10), 5},
+		{"batches", append(recordBatch(5, 10), recordBatch(7, 0)...), 12},
+		{"truncated batch", append(recordBatch(5, 10), recordBatch(7, 0)[:40]...), 5},
+		{"message set", append(legacy(20), legacy(30)...), 2},
+		{"garbage", []byte("not a record batch at all"), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, countRecords(tt.records))
+		})
+	}
+}
+
+func TestParseHeader(t *testing.T) {
+	req := requestHeader(apiKeyFetch, 11, 99, "client", false).int32(1).buf
+	m, err := parseHeader(req, true)
+	assert.NoError(t, err)
+	assert.Equal(t, apiKeyFetch, m.apiKey)
+	assert.Equal(t, int16(11), m.apiVersion)
+	assert.Equal(t, int32(99), m.correlationID)
+	assert.Equal(t, "client", m.clientID)
+	assert.Equal(t, []byte{0, 0, 0, 1}, m.body)
+
+	_, err = parseHeader(requestHeader(-3, 0, 1, "", false).buf, true)
+	assert.ErrorIs(t, err, errInvalidHeader)
+
+	_, err = parseHeader([]byte{0, 1}, false)
+	assert.ErrorIs(t, err, errShortBuffer)
+}
@@ -0,0 +1,548 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build !integration
+
+package kafka
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/packetbeat/pb"
+	"github.com/elastic/beats/v7/packetbeat/procs"
+	"github.com/elastic/beats/v7/packetbeat/protos"
+	"github.com/elastic/beats/v7/packetbeat/protos/tcp"
+	"github.com/elastic/elastic-agent-libs/logp"
+	"github.com/elastic/elastic-agent-libs/mapstr"
+)
+
+type eventStore struct {
+	events []beat.Event
+}
+
+func (e *eventStore) publish(event beat.Event) {
+	e.events = append(e.events, event)
+}
+
+// Helper function returning a Kafka module that can be used
+// in tests. It publishes the transactions in the results channel.
+func kafkaModForTests() (*eventStore, *kafkaPlugin) {
+	var kafka kafkaPlugin
+	results := &eventStore{}
+	config := defaultConfig
+	config.Ports = []int{9092}
+	_ = kafka.init(results.publish, &procs.ProcessesWatcher{}, &config)
+	return results, &kafka
+}
+
+var (
+	clientTuple = common.IPPortTuple{
+		IPLength: 4,
+		BaseTuple: common.BaseTuple{
+			SrcIP: net.IPv4(192, 168, 0, 1), DstIP: net.IPv4(192, 168, 0, 2),
+			SrcPort: 50123, DstPort: 9092,
+		},
+	}
+	serverTuple = common.IPPortTuple{
+		IPLength: 4,
+		BaseTuple: common.BaseTuple{
+			SrcIP: net.IPv4(192, 168, 0, 2), DstIP: net.IPv4(192, 168, 0, 1),
+			SrcPort: 9092, DstPort: 50123,
+		},
+	}
+)
+
+// Helper function that returns an example TcpTuple
+func testTCPTuple() *common.TCPTuple {
+	t := common.TCPTupleFromIPPort(&clientTuple, 1)
+	return &t
+}
+
+// kafkaConn feeds packets of a single connection to the plugin.
+type kafkaConn struct {
+	kafka   *kafkaPlugin
+	tuple   *common.TCPTuple
+	private protos.ProtocolData
+	ts      time.Time
+}
+
+func newKafkaConn(kafka *kafkaPlugin) *kafkaConn {
+	return &kafkaConn{kafka: kafka, tuple: testTCPTuple(), ts: time.Now()}
+}
+
+func (c *kafkaConn) request(payload []byte) {
+	c.ts = c.ts.Add(time.Millisecond)
+	pkt := &protos.Packet{Ts: c.ts, Tuple: clientTuple, Payload: payload}
+	c.private = c.kafka.Parse(pkt, c.tuple, tcp.TCPDirectionOriginal, c.private)
+}
+
+func (c *kafkaConn) response(payload []byte) {
+	c.ts = c.ts.Add(time.Millisecond)
+	pkt := &protos.Packet{Ts: c.ts, Tuple: serverTuple, Payload: payload}
+	c.private = c.kafka.Parse(pkt, c.tuple, tcp.TCPDirectionReverse, c.private)
+}
+
+// Helper function to read from the results Queue. Raises
+// an error if nothing is found in the queue.
+func expectTransaction(t *testing.T, e *eventStore) mapstr.M {
+	t.Helper()
+	if len(e.events) == 0 {
+		t.Fatal("No transaction")
+	}
+
+	event := e.events[0]
+	e.events = e.events[1:]
+	return event.Fields
+}
+
+// encoder writes kafka protocol primitives for building test messages.
+type encoder struct {
+	buf      []byte
+	flexible bool
+}
+
+func (e *encoder) int8(v int8) *encoder {
+	e.buf = append(e.buf, byte(v))
+	return e
+}
+
+func (e *encoder) int16(v int16) *encoder {
+	e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(v))
+	return e
+}
+
+func (e *encoder) int32(v int32) *encoder {
+	e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(v))
+	return e
+}
+
+func (e *encoder) int64(v int64) *encoder {
+	e.buf = binary.BigEndian.AppendUint64(e.buf, uint64(v))
+	return e
+}
+
+func (e *encoder) uvarint(v int) *encoder {
+	e.buf = binary.AppendUvarint(e.buf, uint64(v))
+	return e
+}
+
+func (e *encoder) length(n int, size func(int) *encoder) *encoder {
+	if e.flexible {
+		return e.uvarint(n + 1)
+	}
+	return size(n)
+}
+
+func (e *encoder) string(s string) *encoder {
+	e.length(len(s), func(n int) *encoder { return e.int16(int16(n)) })
+	e.buf = append(e.buf, s...)
+	return e
+}
+
+func (e *encoder) bytes(b []byte) *encoder {
+	e.length(len(b), func(n int) *encoder { return e.int32(int32(n)) })
+	e.buf = append(e.buf, b...)
+	return e
+}
+
+func (e *encoder) array(n int) *encoder {
+	return e.length(n, func(n int) *encoder { return e.int32(int32(n)) })
+}
+
+func (e *encoder) uuid(b byte) *encoder {
+	for i := 0; i < 16; i++ {
+		e.buf = append(e.buf, b)
+	}
+	return e
+}
+
+func (e *encoder) tagged() *encoder {
+	if e.flexible {
+		e.uvarint(0)
+	}
+	return e
+}
+
+// requestHeader starts a request, the header tagged fields are added for
+// flexible versions.
+func requestHeader(apiKey, apiVersion int16, correlationID int32, clientID string, flexible bool) *encoder {
+	e := &encoder{}
+	e.int16(apiKey).int16(apiVersion).int32(correlationID).string(clientID)
+	e.flexible = flexible
+	return e.tagged()
+}
+
+func responseHeader(correlationID int32, flexible bool) *encoder {
+	e := &encoder{flexible: flexible}
+	return e.int32(correlationID).tagged()
+}
+
+// frame prefixes the message with its length.
+func (e *encoder) frame() []byte {
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(e.buf))), e.buf...)
+}
+
+// recordBatch returns a v2 record batch holding count records.
+func recordBatch(count int32, payloadSize int) []byte {
+	b := make([]byte, 61+payloadSize)
+	binary.BigEndian.PutUint32(b[8:], uint32(len(b)-12))
+	b[16] = 2
+	binary.BigEndian.PutUint32(b[57:], uint32(count))
+	return b
+}
+
+func produceRequestV3(correlationID int32, acks int16) []byte {
+	e := requestHeader(apiKeyProduce, 3, correlationID, "producer-1", false)
+	e.string("").int16(acks).int32(30000)
+	e.array(2)
+	e.string("orders").array(2)
+	e.int32(0).bytes(recordBatch(3, 100))
+	e.int32(1).bytes(recordBatch(2, 50))
+	e.string("payments").array(1)
+	e.int32(0).bytes(recordBatch(1, 10))
+	return e.frame()
+}
+
+func produceResponseV3(correlationID int32, errorCode int16) []byte {
+	e := responseHeader(correlationID, false)
+	e.array(1).string("orders").array(1)
+	e.int32(0).int16(errorCode).int64(42).int64(-1)
+	e.int32(5) // throttle_time_ms
+	return e.frame()
+}
+
+func TestProduce(t *testing.T) {
+	logp.TestingSetup(logp.WithSelectors("kafka"))
+
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	req := produceRequestV3(7, -1)
+	resp := produceResponseV3(7, 0)
+	conn.request(req)
+	conn.response(resp)
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "kafka", trans["type"])
+	assert.Equal(t, "OK", trans["status"])
+	assert.Equal(t, "Produce", trans["method"])
+	assert.Equal(t, "orders,payments", trans["resource"])
+	assert.Equal(t, mapstr.M{
+		"api_key":          int16(0),
+		"api_version":      int16(3),
+		"correlation_id":   int32(7),
+		"client_id":        "producer-1",
+		"topics":           []string{"orders", "payments"},
+		"partitions":       3,
+		"acks":             int16(-1),
+		"records":          mapstr.M{"count": 6, "bytes": 3*61 + 160},
+		"throttle_time_ms": int32(5),
+	}, trans["kafka"])
+
+	pbf, err := pb.GetFields(trans)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(req)), pbf.Source.Bytes)
+	assert.Equal(t, int64(len(resp)), pbf.Destination.Bytes)
+	assert.Empty(t, results.events)
+}
+
+func TestProduceError(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	conn.request(produceRequestV3(8, 1))
+	conn.response(produceResponseV3(8, 19))
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "Error", trans["status"])
+	errorCode, _ := trans.GetValue("kafka.error_code")
+	assert.Equal(t, int16(19), errorCode)
+	errorName, _ := trans.GetValue("kafka.error")
+	assert.Equal(t, "NOT_ENOUGH_REPLICAS", errorName)
+}
+
+// Produce requests with acks=0 have no response and are published at once.
+func TestProduceNoAcks(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	conn.request(produceRequestV3(9, 0))
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "OK", trans["status"])
+	acks, _ := trans.GetValue("kafka.acks")
+	assert.Equal(t, int16(0), acks)
+	pbf, err := pb.GetFields(trans)
+	require.NoError(t, err)
+	assert.Zero(t, pbf.Destination.Bytes)
+}
+
+func TestFetch(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	req := requestHeader(apiKeyFetch, 11, 21, "consumer-1", false)
+	req.int32(-1).int32(500).int32(1).int32(52428800).int8(0).int32(0).int32(-1)
+	req.array(1).string("orders").array(2)
+	for p := int32(0); p < 2; p++ {
+		req.int32(p).int32(-1).int64(100).int64(-1).int32(1048576)
+	}
+	req.array(0).string("") // forgotten_topics_data, rack_id
+	conn.request(req.frame())
+
+	resp := responseHeader(21, false)
+	resp.int32(0).int16(0).int32(0)
+	resp.array(1).string("orders").array(2)
+	resp.int32(0).int16(0).int64(200).int64(200).int64(0).array(0).int32(-1)
+	// trailing partial batch, as returned by brokers, is not counted
+	records := append(recordBatch(4, 20), recordBatch(9, 20)[:30]...)
+	resp.bytes(records)
+	resp.int32(1).int16(1).int64(-1).int64(-1).int64(-1).array(0).int32(-1).bytes(nil)
+	conn.response(resp.frame())
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "Error", trans["status"])
+	assert.Equal(t, "Fetch", trans["method"])
+	assert.Equal(t, mapstr.M{
+		"api_key":          int16(1),
+		"api_version":      int16(11),
+		"correlation_id":   int32(21),
+		"client_id":        "consumer-1",
+		"topics":           []string{"orders"},
+		"partitions":       2,
+		"records":          mapstr.M{"count": 4, "bytes": len(records)},
+		"throttle_time_ms": int32(0),
+		"error_code":       int16(1),
+		"error":            "OFFSET_OUT_OF_RANGE",
+	}, trans["kafka"])
+}
+
+// Fetch v13+ is flexible and identifies topics by ID.
+func TestFetchTopicIDs(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	req := requestHeader(apiKeyFetch, 13, 3, "consumer-1", true)
+	req.int32(-1).int32(500).int32(1).int32(52428800).int8(0).int32(0).int32(-1)
+	req.array(1).uuid(0xab).array(1)
+	req.int32(0).int32(-1).int64(100).int32(-1).int64(-1).int32(1048576).tagged()
+	req.tagged()
+	req.array(0).string("").tagged()
+	conn.request(req.frame())
+
+	resp := responseHeader(3, true)
+	resp.int32(0).int16(0).int32(0)
+	resp.array(1).uuid(0xab).array(1)
+	resp.int32(0).int16(0).int64(200).int64(200).int64(0).array(0).int32(-1)
+	resp.bytes(recordBatch(2, 10)).tagged()
+	resp.tagged()
+	resp.tagged()
+	conn.response(resp.frame())
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "OK", trans["status"])
+	assert.NotContains(t, trans, "resource")
+	topicIDs, _ := trans.GetValue("kafka.topic_ids")
+	assert.Equal(t, []string{"q6urq6urq6urq6urq6urqw"}, topicIDs)
+	count, _ := trans.GetValue("kafka.records.count")
+	assert.Equal(t, 2, count)
+}
+
+func TestMetadataFlexible(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	req := requestHeader(apiKeyMetadata, 9, 1, "admin", true)
+	req.array(2)
+	req.string("orders").tagged()
+	req.string("missing").tagged()
+	req.int8(1).int8(0).int8(0).tagged()
+	conn.request(req.frame())
+
+	resp := responseHeader(1, true)
+	resp.int32(0)
+	resp.array(1).int32(1).string("broker-1").int32(9092).string("").tagged()
+	resp.string("cluster").int32(1)
+	resp.array(2)
+	resp.int16(0).string("orders").int8(0).array(1)
+	resp.int16(0).int32(0).int32(1).int32(0).array(1).int32(1).array(1).int32(1).array(0).tagged()
+	resp.int32(0).tagged()
+	resp.int16(3).string("missing").int8(0).array(0).int32(0).tagged()
+	resp.int32(0).tagged()
+	conn.response(resp.frame())
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "Error", trans["status"])
+	assert.Equal(t, "Metadata", trans["method"])
+	assert.Equal(t, "orders,missing", trans["resource"])
+	partitions, _ := trans.GetValue("kafka.partitions")
+	assert.Equal(t, 1, partitions)
+	errorName, _ := trans.GetValue("kafka.error")
+	assert.Equal(t, "UNKNOWN_TOPIC_OR_PARTITION", errorName)
+}
+
+// ApiVersions responses always use the v0 response header, even for flexible versions.
+func TestApiVersions(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	req := requestHeader(apiKeyApiVersions, 3, 0, "client", true)
+	req.string("apache-kafka-java").string("3.7.0").tagged()
+	conn.request(req.frame())
+
+	resp := responseHeader(0, false)
+	resp.int16(35).array(0).int32(0)
+	conn.response(resp.frame())
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "ApiVersions", trans["method"])
+	errorName, _ := trans.GetValue("kafka.error")
+	assert.Equal(t, "UNSUPPORTED_VERSION", errorName)
+}
+
+// APIs without a body decoder are reported with their header fields only.
+func TestUndecodedAPI(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	req := requestHeader(12, 4, 5, "consumer-1", true)
+	req.string("group").int32(3).string("member").string("").tagged()
+	conn.request(req.frame())
+	conn.response(responseHeader(5, true).int32(0).int16(27).tagged().frame())
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "OK", trans["status"])
+	assert.Equal(t, "Heartbeat", trans["method"])
+	assert.Equal(t, mapstr.M{
+		"api_key":        int16(12),
+		"api_version":    int16(4),
+		"correlation_id": int32(5),
+		"client_id":      "consumer-1",
+	}, trans["kafka"])
+}
+
+// Responses are correlated to their request by correlation ID, regardless
+// of the order they are seen in.
+func TestCorrelation(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	// response seen before its request
+	conn.response(produceResponseV3(1, 0))
+	conn.request(produceRequestV3(1, 1))
+	trans := expectTransaction(t, results)
+	correlationID, _ := trans.GetValue("kafka.correlation_id")
+	assert.Equal(t, int32(1), correlationID)
+	assert.Equal(t, "OK", trans["status"])
+
+	// pipelined requests in a single packet, answered out of order
+	conn.request(append(produceRequestV3(2, 1), produceRequestV3(3, 1)...))
+	conn.response(produceResponseV3(3, 6))
+	conn.response(produceResponseV3(2, 0))
+
+	trans = expectTransaction(t, results)
+	correlationID, _ = trans.GetValue("kafka.correlation_id")
+	assert.Equal(t, int32(3), correlationID)
+	assert.Equal(t, "Error", trans["status"])
+
+	trans = expectTransaction(t, results)
+	correlationID, _ = trans.GetValue("kafka.correlation_id")
+	assert.Equal(t, int32(2), correlationID)
+	assert.Equal(t, "OK", trans["status"])
+}
+
+// Messages split over several packets are reassembled.
+func TestSplitMessages(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	req := produceRequestV3(4, 1)
+	for i := 0; i < len(req); i += 7 {
+		conn.request(req[i:min(i+7, len(req))])
+	}
+	resp := produceResponseV3(4, 0)
+	conn.response(resp[:2])
+	conn.response(resp[2:])
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "OK", trans["status"])
+	count, _ := trans.GetValue("kafka.records.count")
+	assert.Equal(t, 6, count)
+}
+
+// Messages larger than the stream buffer are reported without their body.
+func TestOversizedResponse(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	req := requestHeader(apiKeyFetch, 4, 10, "consumer-1", false)
+	req.int32(-1).int32(500).int32(1).int32(52428800).int8(0)
+	req.array(1).string("orders").array(1).int32(0).int64(0).int32(52428800)
+	conn.request(req.frame())
+
+	resp := responseHeader(10, false)
+	resp.int32(0).array(1).string("orders").array(1)
+	resp.int32(0).int16(0).int64(200).int64(200).array(0)
+	resp.bytes(recordBatch(1000, tcp.TCPMaxDataInStream))
+	data := resp.frame()
+	next := produceRequestV3(11, 0)
+
+	// deliver in segments, with a gap inside the skipped body
+	const segment = 64 << 10
+	conn.response(data[:segment])
+	_, drop := kafka.GapInStream(conn.tuple, tcp.TCPDirectionReverse, segment, conn.private)
+	require.False(t, drop)
+	for i := 2 * segment; i < len(data); i += segment {
+		conn.response(data[i:min(i+segment, len(data))])
+	}
+	conn.request(next)
+
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "Fetch", trans["method"])
+	assert.Equal(t, "OK", trans["status"])
+	assert.NotContains(t, trans["kafka"], "records")
+	pbf, err := pb.GetFields(trans)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), pbf.Destination.Bytes)
+
+	// the stream is still in sync
+	trans = expectTransaction(t, results)
+	correlationID, _ := trans.GetValue("kafka.correlation_id")
+	assert.Equal(t, int32(11), correlationID)
+}
+
+func TestInvalidData(t *testing.T) {
+	results, kafka := kafkaModForTests()
+	conn := newKafkaConn(kafka)
+
+	conn.request([]byte("GET / HTTP/1.1\r\nHost: example.com\r\n\r\n"))
+	conn.request([]byte{0, 0, 0, 2, 0, 0})
+	assert.Empty(t, results.events)
+
+	// a new stream is started with the next message
+	conn.request(produceRequestV3(1, 0))
+	trans := expectTransaction(t, results)
+	assert.Equal(t, "Produce", trans["method"])
+}
@@ -0,0 +1,54 @@
+from packetbeat import BaseTest
+
+"""
+Tests for the Kafka protocol analyzer
+"""
+
+
+class Test(BaseTest):
+
+    def test_kafka_session(self):
+        """
+        Should correlate requests and responses of a Kafka session,
+        including a failed produce request.
+        """
+        self.render_config_template(
+            kafka_ports=[9092]
+        )
+        self.run_packetbeat(pcap="kafka_session.pcap", debug_selectors=["*"])
+
+        objs = self.read_output()
+        assert len(objs) == 5
+        assert all([o["type"] == "kafka" for o in objs])
+        assert all([o["event.dataset"] == "kafka" for o in objs])
+        assert all([o["server.port"] == 9092 for o in objs])
+        assert all([o["kafka.client_id"] == "console-producer" for o in objs])
+        assert [o["kafka.correlation_id"] for o in objs] == [1, 2, 3, 4, 5]
+
+        assert objs[0]["method"] == "ApiVersions"
+        assert objs[0]["status"] == "OK"
+        assert objs[0]["kafka.api_key"] == 18
+        assert objs[0]["kafka.api_version"] == 3
+
+        assert objs[1]["method"] == "Metadata"
+        assert objs[1]["resource"] == "orders"
+        assert objs[1]["kafka.partitions"] == 2
+
+        assert objs[2]["method"] == "Produce"
+        assert objs[2]["status"] == "OK"
+        assert objs[2]["kafka.topics"] == ["orders"]
+        assert objs[2]["kafka.acks"] == -1
+        assert objs[2]["kafka.records.count"] == 3
+
+        assert objs[3]["method"] == "Fetch"
+        assert objs[3]["kafka.api_version"] == 11
+        assert objs[3]["kafka.records.count"] == 3
+
+        assert objs[4]["method"] == "Produce"
+        assert objs[4]["status"] == "Error"
+        assert objs[4]["resource"] == "missing"
+        assert objs[4]["kafka.error_code"] == 3
+        assert objs[4]["kafka.error"] == "UNKNOWN_TOPIC_OR_PARTITION"
+
+        assert all(["source.bytes" in o for o in objs])
+        assert all(["destination.bytes" in o for o in objs])
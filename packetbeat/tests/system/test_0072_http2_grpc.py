@@ -0,0 +1,54 @@
+from packetbeat import BaseTest
+
+"""
+Tests for HTTP/2 and gRPC parsing in the HTTP analyzer
+"""
+
+
+class Test(BaseTest):
+
+    def test_http2_grpc_streams(self):
+        """
+        Should report each stream of a cleartext HTTP/2 connection as
+        a separate transaction, including the gRPC call status.
+        """
+        self.render_config_template(
+            http_ports=[50051]
+        )
+        self.run_packetbeat(pcap="http2_grpc.pcap", debug_selectors=["http"])
+
+        objs = self.read_output()
+        assert len(objs) == 3
+        assert all([o["type"] == "http" for o in objs])
+        assert all([o["http.version"] == "2.0" for o in objs])
+        assert all([o["source.ip"] == "10.0.0.5" for o in objs])
+        assert all([o["server.port"] == 50051 for o in objs])
+        assert all([o["url.domain"] == "10.0.0.7" for o in objs])
+
+        assert objs[0]["method"] == "GET"
+        assert objs[0]["url.path"] == "/healthz"
+        assert objs[0]["status"] == "OK"
+        assert objs[0]["http.response.status_code"] == 200
+        assert objs[0]["http.response.body.bytes"] == 2
+        assert "grpc.service" not in objs[0]
+
+        assert objs[1]["method"] == "POST"
+        assert objs[1]["url.path"] == "/helloworld.Greeter/SayHello"
+        assert "url.extension" not in objs[1]
+        assert objs[1]["status"] == "OK"
+        assert objs[1]["grpc.service"] == "helloworld.Greeter"
+        assert objs[1]["grpc.method"] == "SayHello"
+        assert objs[1]["grpc.status_code"] == 0
+        assert objs[1]["grpc.status"] == "OK"
+        assert objs[1]["grpc.request.messages"] == 1
+        assert objs[1]["grpc.response.messages"] == 1
+
+        assert objs[2]["url.path"] == "/helloworld.Greeter/SayGoodbye"
+        assert objs[2]["http.response.status_code"] == 200
+        assert objs[2]["status"] == "Error"
+        assert objs[2]["grpc.method"] == "SayGoodbye"
+        assert objs[2]["grpc.status_code"] == 12
+        assert objs[2]["grpc.status"] == "UNIMPLEMENTED"
+        assert objs[2]["grpc.message"] == \
+            "unknown method SayGoodbye for service helloworld.Greeter"
+        assert objs[2]["grpc.response.messages"] == 0
@@ -17,9 +17,13 @@ import (
 	"github.com/elastic/beats/v7/libbeat/publisher/processing"
 
 	// Import OSS monitor types.
+	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/dns"
+	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/grpc"
 	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/http"
 	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/icmp"
 	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/tcp"
+	_ "github.com/elastic/beats/v7/heartbeat/monitors/active/tls"
+	_ "github.com/elastic/beats/v7/heartbeat/monitors/passive/push"
 
 	// Import X-Pack modules.
 	_ "github.com/elastic/beats/v7/x-pack/libbeat/include"